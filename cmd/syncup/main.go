@@ -25,12 +25,13 @@ import (
 	"os"
 
 	"github.com/Aton-Kish/syncup/cmd/syncup/registry"
+	"github.com/Aton-Kish/syncup/internal/syncup/interface/command"
 )
 
 func main() {
 	ctx := context.Background()
 	cmd := registry.RegisterCommands(ctx)
 	if err := cmd.Execute(ctx); err != nil {
-		os.Exit(1)
+		os.Exit(command.ExitCode(err))
 	}
 }
@@ -34,8 +34,16 @@ func RegisterCommands(ctx context.Context) command.Command {
 	versionCommand := command.NewVersionCommand(repo)
 	pullCommand := command.NewPullCommand(repo)
 	pushCommand := command.NewPushCommand(repo)
+	diffCommand := command.NewDiffCommand(repo)
+	validateCommand := command.NewValidateCommand(repo)
+	restoreCommand := command.NewRestoreCommand(repo)
+	envCommand := command.NewEnvCommand(repo)
+	envEditCommand := command.NewEnvEditCommand(repo)
+	testCommand := command.NewTestCommand(repo)
+	fmtCommand := command.NewFmtCommand(repo)
 
-	rootCmd.RegisterSubCommands(versionCommand, pullCommand, pushCommand)
+	envCommand.RegisterSubCommands(envEditCommand)
+	rootCmd.RegisterSubCommands(versionCommand, pullCommand, pushCommand, diffCommand, validateCommand, restoreCommand, envCommand, testCommand, fmtCommand)
 
 	return rootCmd
 }
@@ -22,12 +22,21 @@ package syncup
 
 import (
 	"context"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/xsync"
 )
 
 type contextKey int
 
 const (
 	contextKeyRequestID contextKey = iota
+	contextKeyWorkerPool
+	contextKeyBaseDir
+	contextKeyExpectedHash
+	contextKeyEnvironment
+	contextKeyAgeRecipients
+	contextKeySchemaLayout
 )
 
 func RequestID(ctx context.Context) string {
@@ -42,3 +51,89 @@ func RequestID(ctx context.Context) string {
 func WithRequestID(ctx context.Context, id string) context.Context {
 	return context.WithValue(ctx, contextKeyRequestID, id)
 }
+
+func WorkerPool(ctx context.Context) *xsync.Pool {
+	v, ok := ctx.Value(contextKeyWorkerPool).(*xsync.Pool)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func WithWorkerPool(ctx context.Context, pool *xsync.Pool) context.Context {
+	return context.WithValue(ctx, contextKeyWorkerPool, pool)
+}
+
+func BaseDir(ctx context.Context) string {
+	v, ok := ctx.Value(contextKeyBaseDir).(string)
+	if !ok {
+		return ""
+	}
+
+	return v
+}
+
+func WithBaseDir(ctx context.Context, dir string) context.Context {
+	return context.WithValue(ctx, contextKeyBaseDir, dir)
+}
+
+// ExpectedHash returns the hash that a remote resource is expected to have before it is overwritten.
+// An empty hash means that the resource is overwritten unconditionally.
+func ExpectedHash(ctx context.Context) string {
+	v, ok := ctx.Value(contextKeyExpectedHash).(string)
+	if !ok {
+		return ""
+	}
+
+	return v
+}
+
+func WithExpectedHash(ctx context.Context, hash string) context.Context {
+	return context.WithValue(ctx, contextKeyExpectedHash, hash)
+}
+
+// Environment returns the name of the selected environment, whose overlay files are merged on top of the local resources.
+// An empty name means that no overlay files are used.
+func Environment(ctx context.Context) string {
+	v, ok := ctx.Value(contextKeyEnvironment).(string)
+	if !ok {
+		return ""
+	}
+
+	return v
+}
+
+func WithEnvironment(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, contextKeyEnvironment, name)
+}
+
+// AgeRecipients returns the age recipients that the local environment variables are encrypted for.
+// No recipients means that the environment variables are saved in plain text.
+func AgeRecipients(ctx context.Context) []string {
+	v, ok := ctx.Value(contextKeyAgeRecipients).([]string)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func WithAgeRecipients(ctx context.Context, recipients []string) context.Context {
+	return context.WithValue(ctx, contextKeyAgeRecipients, recipients)
+}
+
+// SchemaLayout returns the layout in which the local schema is saved.
+// An empty layout means that the layout of the existing local schema is kept.
+func SchemaLayout(ctx context.Context) model.SchemaLayout {
+	v, ok := ctx.Value(contextKeySchemaLayout).(model.SchemaLayout)
+	if !ok {
+		return ""
+	}
+
+	return v
+}
+
+func WithSchemaLayout(ctx context.Context, layout model.SchemaLayout) context.Context {
+	return context.WithValue(ctx, contextKeySchemaLayout, layout)
+}
@@ -24,6 +24,8 @@ import (
 	"context"
 	"testing"
 
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/xsync"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -110,3 +112,511 @@ func TestWithRequestID(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkerPool(t *testing.T) {
+	pool := xsync.NewPool(1)
+
+	type args struct {
+		ctx context.Context //nolint:containedctx
+	}
+
+	type expected struct {
+		res *xsync.Pool
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: worker pool was found",
+			args: args{
+				ctx: context.WithValue(context.Background(), contextKeyWorkerPool, pool),
+			},
+			expected: expected{
+				res: pool,
+			},
+		},
+		{
+			name: "happy path: worker pool was not found",
+			args: args{
+				ctx: context.Background(),
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			actual := WorkerPool(tt.args.ctx)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func TestWithWorkerPool(t *testing.T) {
+	pool := xsync.NewPool(1)
+
+	type args struct {
+		pool *xsync.Pool
+	}
+
+	type expected struct {
+		res context.Context //nolint:containedctx
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				pool: pool,
+			},
+			expected: expected{
+				res: context.WithValue(context.Background(), contextKeyWorkerPool, pool),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := WithWorkerPool(ctx, tt.args.pool)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func TestBaseDir(t *testing.T) {
+	type args struct {
+		ctx context.Context //nolint:containedctx
+	}
+
+	type expected struct {
+		res string
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: base dir was found",
+			args: args{
+				ctx: context.WithValue(context.Background(), contextKeyBaseDir, "BaseDir"),
+			},
+			expected: expected{
+				res: "BaseDir",
+			},
+		},
+		{
+			name: "happy path: base dir was not found",
+			args: args{
+				ctx: context.Background(),
+			},
+			expected: expected{
+				res: "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			actual := BaseDir(tt.args.ctx)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func TestWithBaseDir(t *testing.T) {
+	type args struct {
+		dir string
+	}
+
+	type expected struct {
+		res context.Context //nolint:containedctx
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				dir: "BaseDir",
+			},
+			expected: expected{
+				res: context.WithValue(context.Background(), contextKeyBaseDir, "BaseDir"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := WithBaseDir(ctx, tt.args.dir)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func TestExpectedHash(t *testing.T) {
+	type args struct {
+		ctx context.Context //nolint:containedctx
+	}
+
+	type expected struct {
+		res string
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: expected hash was found",
+			args: args{
+				ctx: context.WithValue(context.Background(), contextKeyExpectedHash, "ExpectedHash"),
+			},
+			expected: expected{
+				res: "ExpectedHash",
+			},
+		},
+		{
+			name: "happy path: expected hash was not found",
+			args: args{
+				ctx: context.Background(),
+			},
+			expected: expected{
+				res: "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			actual := ExpectedHash(tt.args.ctx)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func TestWithExpectedHash(t *testing.T) {
+	type args struct {
+		hash string
+	}
+
+	type expected struct {
+		res context.Context //nolint:containedctx
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				hash: "ExpectedHash",
+			},
+			expected: expected{
+				res: context.WithValue(context.Background(), contextKeyExpectedHash, "ExpectedHash"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := WithExpectedHash(ctx, tt.args.hash)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func TestEnvironment(t *testing.T) {
+	type args struct {
+		ctx context.Context //nolint:containedctx
+	}
+
+	type expected struct {
+		res string
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: environment was found",
+			args: args{
+				ctx: context.WithValue(context.Background(), contextKeyEnvironment, "Environment"),
+			},
+			expected: expected{
+				res: "Environment",
+			},
+		},
+		{
+			name: "happy path: environment was not found",
+			args: args{
+				ctx: context.Background(),
+			},
+			expected: expected{
+				res: "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			actual := Environment(tt.args.ctx)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func TestWithEnvironment(t *testing.T) {
+	type args struct {
+		name string
+	}
+
+	type expected struct {
+		res context.Context //nolint:containedctx
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				name: "Environment",
+			},
+			expected: expected{
+				res: context.WithValue(context.Background(), contextKeyEnvironment, "Environment"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := WithEnvironment(ctx, tt.args.name)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func TestAgeRecipients(t *testing.T) {
+	type args struct {
+		ctx context.Context //nolint:containedctx
+	}
+
+	type expected struct {
+		res []string
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: age recipients was found",
+			args: args{
+				ctx: context.WithValue(context.Background(), contextKeyAgeRecipients, []string{"AgeRecipient"}),
+			},
+			expected: expected{
+				res: []string{"AgeRecipient"},
+			},
+		},
+		{
+			name: "happy path: age recipients was not found",
+			args: args{
+				ctx: context.Background(),
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			actual := AgeRecipients(tt.args.ctx)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func TestWithAgeRecipients(t *testing.T) {
+	type args struct {
+		recipients []string
+	}
+
+	type expected struct {
+		res context.Context //nolint:containedctx
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				recipients: []string{"AgeRecipient"},
+			},
+			expected: expected{
+				res: context.WithValue(context.Background(), contextKeyAgeRecipients, []string{"AgeRecipient"}),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := WithAgeRecipients(ctx, tt.args.recipients)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func TestSchemaLayout(t *testing.T) {
+	type args struct {
+		ctx context.Context //nolint:containedctx
+	}
+
+	type expected struct {
+		res model.SchemaLayout
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: schema layout was found",
+			args: args{
+				ctx: context.WithValue(context.Background(), contextKeySchemaLayout, model.SchemaLayoutDirectory),
+			},
+			expected: expected{
+				res: model.SchemaLayoutDirectory,
+			},
+		},
+		{
+			name: "happy path: schema layout was not found",
+			args: args{
+				ctx: context.Background(),
+			},
+			expected: expected{
+				res: "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			actual := SchemaLayout(tt.args.ctx)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func TestWithSchemaLayout(t *testing.T) {
+	type args struct {
+		layout model.SchemaLayout
+	}
+
+	type expected struct {
+		res context.Context //nolint:containedctx
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				layout: model.SchemaLayoutDirectory,
+			},
+			expected: expected{
+				res: context.WithValue(context.Background(), contextKeySchemaLayout, model.SchemaLayoutDirectory),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := WithSchemaLayout(ctx, tt.args.layout)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
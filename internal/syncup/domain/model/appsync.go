@@ -91,3 +91,171 @@ type RuntimeName string
 const (
 	RuntimeNameAppsyncJs RuntimeName = "APPSYNC_JS"
 )
+
+type DataSource struct {
+	DataSourceArn            *string                             `json:"-"`
+	Name                     *string                             `json:"name,omitempty"`
+	Description              *string                             `json:"description,omitempty"`
+	Type                     DataSourceType                      `json:"type,omitempty"`
+	ServiceRoleArn           *string                             `json:"serviceRoleArn,omitempty"`
+	DynamodbConfig           *DynamodbDataSourceConfig           `json:"dynamodbConfig,omitempty"`
+	LambdaConfig             *LambdaDataSourceConfig             `json:"lambdaConfig,omitempty"`
+	ElasticsearchConfig      *ElasticsearchDataSourceConfig      `json:"elasticsearchConfig,omitempty"`
+	OpenSearchServiceConfig  *OpenSearchServiceDataSourceConfig  `json:"openSearchServiceConfig,omitempty"`
+	HttpConfig               *HttpDataSourceConfig               `json:"httpConfig,omitempty"`
+	RelationalDatabaseConfig *RelationalDatabaseDataSourceConfig `json:"relationalDatabaseConfig,omitempty"`
+	EventBridgeConfig        *EventBridgeDataSourceConfig        `json:"eventBridgeConfig,omitempty"`
+	MetricsConfig            DataSourceLevelMetricsConfig        `json:"metricsConfig,omitempty"`
+}
+
+type DataSourceType string
+
+type DynamodbDataSourceConfig struct {
+	TableName            *string          `json:"tableName,omitempty"`
+	AwsRegion            *string          `json:"awsRegion,omitempty"`
+	UseCallerCredentials bool             `json:"useCallerCredentials"`
+	DeltaSyncConfig      *DeltaSyncConfig `json:"deltaSyncConfig,omitempty"`
+	Versioned            bool             `json:"versioned"`
+}
+
+type DeltaSyncConfig struct {
+	BaseTableTTL       int64   `json:"baseTableTTL"`
+	DeltaSyncTableName *string `json:"deltaSyncTableName,omitempty"`
+	DeltaSyncTableTTL  int64   `json:"deltaSyncTableTTL"`
+}
+
+type LambdaDataSourceConfig struct {
+	LambdaFunctionArn *string `json:"lambdaFunctionArn,omitempty"`
+}
+
+type ElasticsearchDataSourceConfig struct {
+	Endpoint  *string `json:"endpoint,omitempty"`
+	AwsRegion *string `json:"awsRegion,omitempty"`
+}
+
+type OpenSearchServiceDataSourceConfig struct {
+	Endpoint  *string `json:"endpoint,omitempty"`
+	AwsRegion *string `json:"awsRegion,omitempty"`
+}
+
+type HttpDataSourceConfig struct {
+	Endpoint            *string              `json:"endpoint,omitempty"`
+	AuthorizationConfig *AuthorizationConfig `json:"authorizationConfig,omitempty"`
+}
+
+type AuthorizationConfig struct {
+	AuthorizationType AuthorizationType `json:"authorizationType,omitempty"`
+	AwsIamConfig      *AwsIamConfig     `json:"awsIamConfig,omitempty"`
+}
+
+type AuthorizationType string
+
+type AwsIamConfig struct {
+	SigningRegion      *string `json:"signingRegion,omitempty"`
+	SigningServiceName *string `json:"signingServiceName,omitempty"`
+}
+
+type RelationalDatabaseDataSourceConfig struct {
+	RelationalDatabaseSourceType RelationalDatabaseSourceType `json:"relationalDatabaseSourceType,omitempty"`
+	RdsHttpEndpointConfig        *RdsHttpEndpointConfig       `json:"rdsHttpEndpointConfig,omitempty"`
+}
+
+type RelationalDatabaseSourceType string
+
+type RdsHttpEndpointConfig struct {
+	AwsRegion           *string `json:"awsRegion,omitempty"`
+	DbClusterIdentifier *string `json:"dbClusterIdentifier,omitempty"`
+	DatabaseName        *string `json:"databaseName,omitempty"`
+	Schema              *string `json:"schema,omitempty"`
+	AwsSecretStoreArn   *string `json:"awsSecretStoreArn,omitempty"`
+}
+
+type EventBridgeDataSourceConfig struct {
+	EventBusArn *string `json:"eventBusArn,omitempty"`
+}
+
+type DataSourceLevelMetricsConfig string
+
+type GraphqlApi struct {
+	ApiId                             *string                            `json:"-"`
+	Arn                               *string                            `json:"-"`
+	Name                              *string                            `json:"-"`
+	ApiType                           GraphQLApiType                     `json:"apiType,omitempty"`
+	Visibility                        GraphQLApiVisibility               `json:"visibility,omitempty"`
+	AuthenticationType                AuthenticationType                 `json:"authenticationType,omitempty"`
+	AdditionalAuthenticationProviders []AdditionalAuthenticationProvider `json:"additionalAuthenticationProviders,omitempty"`
+	LambdaAuthorizerConfig            *LambdaAuthorizerConfig            `json:"lambdaAuthorizerConfig,omitempty"`
+	UserPoolConfig                    *UserPoolConfig                    `json:"userPoolConfig,omitempty"`
+	OpenIDConnectConfig               *OpenIDConnectConfig               `json:"openIDConnectConfig,omitempty"`
+	LogConfig                         *LogConfig                         `json:"logConfig,omitempty"`
+	XrayEnabled                       bool                               `json:"xrayEnabled"`
+	IntrospectionConfig               GraphQLApiIntrospectionConfig      `json:"introspectionConfig,omitempty"`
+	QueryDepthLimit                   int32                              `json:"queryDepthLimit"`
+	ResolverCountLimit                int32                              `json:"resolverCountLimit"`
+	EnhancedMetricsConfig             *EnhancedMetricsConfig             `json:"enhancedMetricsConfig,omitempty"`
+	MergedApiExecutionRoleArn         *string                            `json:"mergedApiExecutionRoleArn,omitempty"`
+	OwnerContact                      *string                            `json:"ownerContact,omitempty"`
+}
+
+type GraphQLApiType string
+
+type GraphQLApiVisibility string
+
+type AuthenticationType string
+
+type AdditionalAuthenticationProvider struct {
+	AuthenticationType     AuthenticationType      `json:"authenticationType,omitempty"`
+	LambdaAuthorizerConfig *LambdaAuthorizerConfig `json:"lambdaAuthorizerConfig,omitempty"`
+	OpenIDConnectConfig    *OpenIDConnectConfig    `json:"openIDConnectConfig,omitempty"`
+	UserPoolConfig         *CognitoUserPoolConfig  `json:"userPoolConfig,omitempty"`
+}
+
+type LambdaAuthorizerConfig struct {
+	AuthorizerUri                *string `json:"authorizerUri,omitempty"`
+	AuthorizerResultTtlInSeconds int32   `json:"authorizerResultTtlInSeconds"`
+	IdentityValidationExpression *string `json:"identityValidationExpression,omitempty"`
+}
+
+type UserPoolConfig struct {
+	AwsRegion        *string       `json:"awsRegion,omitempty"`
+	DefaultAction    DefaultAction `json:"defaultAction,omitempty"`
+	UserPoolId       *string       `json:"userPoolId,omitempty"`
+	AppIdClientRegex *string       `json:"appIdClientRegex,omitempty"`
+}
+
+type DefaultAction string
+
+type CognitoUserPoolConfig struct {
+	AwsRegion        *string `json:"awsRegion,omitempty"`
+	UserPoolId       *string `json:"userPoolId,omitempty"`
+	AppIdClientRegex *string `json:"appIdClientRegex,omitempty"`
+}
+
+type OpenIDConnectConfig struct {
+	Issuer   *string `json:"issuer,omitempty"`
+	ClientId *string `json:"clientId,omitempty"`
+	IatTTL   int64   `json:"iatTTL"`
+	AuthTTL  int64   `json:"authTTL"`
+}
+
+type LogConfig struct {
+	CloudWatchLogsRoleArn *string       `json:"cloudWatchLogsRoleArn,omitempty"`
+	FieldLogLevel         FieldLogLevel `json:"fieldLogLevel,omitempty"`
+	ExcludeVerboseContent bool          `json:"excludeVerboseContent"`
+}
+
+type FieldLogLevel string
+
+type GraphQLApiIntrospectionConfig string
+
+type EnhancedMetricsConfig struct {
+	DataSourceLevelMetricsBehavior DataSourceLevelMetricsBehavior `json:"dataSourceLevelMetricsBehavior,omitempty"`
+	OperationLevelMetricsConfig    OperationLevelMetricsConfig    `json:"operationLevelMetricsConfig,omitempty"`
+	ResolverLevelMetricsBehavior   ResolverLevelMetricsBehavior   `json:"resolverLevelMetricsBehavior,omitempty"`
+}
+
+type DataSourceLevelMetricsBehavior string
+
+type OperationLevelMetricsConfig string
+
+type ResolverLevelMetricsBehavior string
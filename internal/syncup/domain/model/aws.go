@@ -26,6 +26,7 @@ type AWSOptions struct {
 	Region           string
 	Profile          string
 	MFATokenProvider MFATokenProvider
+	RateLimit        float64
 }
 
 func NewAWSOptions(optFns ...func(o *AWSOptions)) *AWSOptions {
@@ -55,3 +56,9 @@ func AWSOptionsWithMFATokenProvider(provider MFATokenProvider) func(o *AWSOption
 		o.MFATokenProvider = provider
 	}
 }
+
+func AWSOptionsWithRateLimit(rateLimit float64) func(o *AWSOptions) {
+	return func(o *AWSOptions) {
+		o.RateLimit = rateLimit
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package model
+
+type Config struct {
+	Environments map[string]Environment `yaml:"environments"`
+}
+
+type Environment struct {
+	APIID       string `yaml:"apiId,omitempty"`
+	Region      string `yaml:"region,omitempty"`
+	Profile     string `yaml:"profile,omitempty"`
+	BaseDir     string `yaml:"baseDir,omitempty"`
+	KeepBackups *int   `yaml:"keepBackups,omitempty"`
+
+	AgeRecipients []string `yaml:"ageRecipients,omitempty"`
+	SchemaLayout  string   `yaml:"schemaLayout,omitempty"`
+	SortSchema    bool     `yaml:"sortSchema,omitempty"`
+}
@@ -32,6 +32,10 @@ var (
 
 	ErrNotFound     = errors.New("not found")
 	ErrCreateFailed = errors.New("failed to create")
+	ErrNotConfirmed = errors.New("not confirmed")
+	ErrConflict     = errors.New("conflict")
+
+	ErrBreakingChange = errors.New("breaking change")
 )
 
 type LibError struct {
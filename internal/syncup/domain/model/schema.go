@@ -0,0 +1,93 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package model
+
+import (
+	"fmt"
+)
+
+type SchemaLayout string
+
+const (
+	// SchemaLayoutFile keeps the whole schema in a single schema.graphqls file.
+	SchemaLayoutFile SchemaLayout = "file"
+	// SchemaLayoutDirectory splits the schema into one *.graphql file per type in a schema dir.
+	SchemaLayoutDirectory SchemaLayout = "directory"
+)
+
+type SchemaFormatOptions struct {
+	// Sort sorts the directives, types, fields and enum values by name.
+	Sort bool
+}
+
+func NewSchemaFormatOptions(optFns ...func(o *SchemaFormatOptions)) *SchemaFormatOptions {
+	o := new(SchemaFormatOptions)
+
+	for _, fn := range optFns {
+		fn(o)
+	}
+
+	return o
+}
+
+func SchemaFormatOptionsWithSort(sort bool) func(o *SchemaFormatOptions) {
+	return func(o *SchemaFormatOptions) {
+		o.Sort = sort
+	}
+}
+
+type SchemaChangeLevel string
+
+const (
+	// SchemaChangeLevelBreaking breaks existing clients or resolvers, e.g. a removed field.
+	SchemaChangeLevelBreaking SchemaChangeLevel = "breaking"
+	// SchemaChangeLevelDangerous may break existing clients or resolvers, e.g. an added enum value.
+	SchemaChangeLevelDangerous SchemaChangeLevel = "dangerous"
+	// SchemaChangeLevelSafe does not break anything, e.g. an added field.
+	SchemaChangeLevelSafe SchemaChangeLevel = "safe"
+)
+
+type SchemaChange struct {
+	Level SchemaChangeLevel
+	// Path is the coordinate of the changed element, e.g. Query.getPost(id:).
+	Path    string
+	Message string
+}
+
+type SchemaFile struct {
+	Path    string
+	Content string
+}
+
+type SchemaError struct {
+	Path    string
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.Path, e.Line, e.Column, e.Message)
+}
+
+func (e *SchemaError) Unwrap() error {
+	return ErrInvalidValue
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	ptr "github.com/Aton-Kish/goptr"
+)
+
+// State records the hashes of the remote resources as of the last pull or push.
+type State struct {
+	Functions map[string]string `json:"functions"`
+	Resolvers map[string]string `json:"resolvers"`
+}
+
+func NewState() *State {
+	return &State{
+		Functions: make(map[string]string),
+		Resolvers: make(map[string]string),
+	}
+}
+
+// FunctionHash returns the hash of the function contents.
+// Server-populated fields such as the function ID and ARN are excluded, as in FunctionService.Equal.
+func FunctionHash(function *Function) (string, error) {
+	return hash(function, function.RequestMappingTemplate, function.ResponseMappingTemplate, function.Code)
+}
+
+// ResolverHash returns the hash of the resolver contents.
+// Pipeline functions are hashed by ID, since resolvers fetched from AppSync have no function names.
+func ResolverHash(resolver *Resolver) (string, error) {
+	r := *resolver
+
+	var functionIDs []string
+	if r.PipelineConfig != nil {
+		functionIDs = r.PipelineConfig.Functions
+		r.PipelineConfig = &PipelineConfig{}
+	}
+
+	ids, err := json.Marshal(functionIDs)
+	if err != nil {
+		return "", err
+	}
+
+	return hash(&r, r.RequestMappingTemplate, r.ResponseMappingTemplate, r.Code, ptr.Pointer(string(ids)))
+}
+
+func hash(metadata any, contents ...*string) (string, error) {
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write(b)
+
+	for _, c := range contents {
+		// NOTE: a separator keeps the boundaries between contents, and a missing content is hashed as an empty one
+		h.Write([]byte{0})
+		h.Write([]byte(ptr.ToValue(c)))
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
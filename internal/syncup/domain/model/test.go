@@ -0,0 +1,75 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package model
+
+import (
+	"encoding/json"
+)
+
+type EvaluationHandler string
+
+const (
+	EvaluationHandlerRequest  EvaluationHandler = "request"
+	EvaluationHandlerResponse EvaluationHandler = "response"
+)
+
+// Evaluation is a handler of a resolver or function to evaluate against a context,
+// i.e. the code of the APPSYNC_JS runtime or the mapping template of the VTL runtime.
+type Evaluation struct {
+	Runtime  *Runtime
+	Code     *string
+	Template *string
+	Handler  EvaluationHandler
+	Context  string
+}
+
+type EvaluationResult struct {
+	Result *string
+	Error  *string
+	Logs   []string
+}
+
+// Test is a fixture in the tests dir of a resolver or function, with the context to evaluate its handlers against
+// and the outputs expected from them.
+type Test struct {
+	Kind       ResourceKind    `json:"-"`
+	Identifier string          `json:"-"`
+	Name       string          `json:"-"`
+	Context    json.RawMessage `json:"context"`
+	Expected   TestExpected    `json:"expected"`
+}
+
+type TestExpected struct {
+	Request  json.RawMessage `json:"request,omitempty"`
+	Response json.RawMessage `json:"response,omitempty"`
+}
+
+type TestResult struct {
+	Kind       ResourceKind
+	Identifier string
+	Name       string
+	Handler    EvaluationHandler
+	Passed     bool
+	Expected   string
+	Actual     string
+	Message    string
+	Logs       []string
+}
@@ -20,6 +20,10 @@
 
 package model
 
+import (
+	"time"
+)
+
 type TrackerStatus string
 
 const (
@@ -27,3 +31,28 @@ const (
 	TrackerStatusFailed     TrackerStatus = "failed"
 	TrackerStatusSuccess    TrackerStatus = "success"
 )
+
+type TrackerAction string
+
+const (
+	TrackerActionCreated   TrackerAction = "created"
+	TrackerActionUpdated   TrackerAction = "updated"
+	TrackerActionDeleted   TrackerAction = "deleted"
+	TrackerActionUnchanged TrackerAction = "unchanged"
+	TrackerActionFailed    TrackerAction = "failed"
+	TrackerActionChanged   TrackerAction = "changed"
+)
+
+type TrackerEvent struct {
+	Kind       ResourceKind
+	Identifier string
+	Action     TrackerAction
+	Duration   time.Duration
+	Err        error
+	Message    string
+	Level      SchemaChangeLevel
+}
+
+type Report struct {
+	Events []TrackerEvent
+}
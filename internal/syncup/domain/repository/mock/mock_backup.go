@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: backup.go
+//
+// Generated by this command:
+//
+//	mockgen -source=backup.go -destination=./mock/mock_backup.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBackupRepository is a mock of BackupRepository interface.
+type MockBackupRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupRepositoryMockRecorder
+}
+
+// MockBackupRepositoryMockRecorder is the mock recorder for MockBackupRepository.
+type MockBackupRepositoryMockRecorder struct {
+	mock *MockBackupRepository
+}
+
+// NewMockBackupRepository creates a new mock instance.
+func NewMockBackupRepository(ctrl *gomock.Controller) *MockBackupRepository {
+	mock := &MockBackupRepository{ctrl: ctrl}
+	mock.recorder = &MockBackupRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackupRepository) EXPECT() *MockBackupRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockBackupRepository) Create(ctx context.Context, apiID string) (*model.Backup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, apiID)
+	ret0, _ := ret[0].(*model.Backup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockBackupRepositoryMockRecorder) Create(ctx, apiID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBackupRepository)(nil).Create), ctx, apiID)
+}
+
+// Delete mocks base method.
+func (m *MockBackupRepository) Delete(ctx context.Context, apiID, timestamp string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, apiID, timestamp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBackupRepositoryMockRecorder) Delete(ctx, apiID, timestamp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBackupRepository)(nil).Delete), ctx, apiID, timestamp)
+}
+
+// Get mocks base method.
+func (m *MockBackupRepository) Get(ctx context.Context, apiID, timestamp string) (*model.Backup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, apiID, timestamp)
+	ret0, _ := ret[0].(*model.Backup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBackupRepositoryMockRecorder) Get(ctx, apiID, timestamp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBackupRepository)(nil).Get), ctx, apiID, timestamp)
+}
+
+// List mocks base method.
+func (m *MockBackupRepository) List(ctx context.Context, apiID string) ([]model.Backup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, apiID)
+	ret0, _ := ret[0].([]model.Backup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockBackupRepositoryMockRecorder) List(ctx, apiID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBackupRepository)(nil).List), ctx, apiID)
+}
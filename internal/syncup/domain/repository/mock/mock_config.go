@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: config.go
+//
+// Generated by this command:
+//
+//	mockgen -source=config.go -destination=./mock/mock_config.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfigRepository is a mock of ConfigRepository interface.
+type MockConfigRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigRepositoryMockRecorder
+}
+
+// MockConfigRepositoryMockRecorder is the mock recorder for MockConfigRepository.
+type MockConfigRepositoryMockRecorder struct {
+	mock *MockConfigRepository
+}
+
+// NewMockConfigRepository creates a new mock instance.
+func NewMockConfigRepository(ctrl *gomock.Controller) *MockConfigRepository {
+	mock := &MockConfigRepository{ctrl: ctrl}
+	mock.recorder = &MockConfigRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigRepository) EXPECT() *MockConfigRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockConfigRepository) Get(ctx context.Context, path string) (*model.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, path)
+	ret0, _ := ret[0].(*model.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockConfigRepositoryMockRecorder) Get(ctx, path any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockConfigRepository)(nil).Get), ctx, path)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: confirmation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=confirmation.go -destination=./mock/mock_confirmation.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfirmationRepository is a mock of ConfirmationRepository interface.
+type MockConfirmationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfirmationRepositoryMockRecorder
+}
+
+// MockConfirmationRepositoryMockRecorder is the mock recorder for MockConfirmationRepository.
+type MockConfirmationRepositoryMockRecorder struct {
+	mock *MockConfirmationRepository
+}
+
+// NewMockConfirmationRepository creates a new mock instance.
+func NewMockConfirmationRepository(ctrl *gomock.Controller) *MockConfirmationRepository {
+	mock := &MockConfirmationRepository{ctrl: ctrl}
+	mock.recorder = &MockConfirmationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfirmationRepository) EXPECT() *MockConfirmationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockConfirmationRepository) Confirm(ctx context.Context, msg string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", ctx, msg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockConfirmationRepositoryMockRecorder) Confirm(ctx, msg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockConfirmationRepository)(nil).Confirm), ctx, msg)
+}
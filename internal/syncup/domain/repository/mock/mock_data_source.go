@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: data_source.go
+//
+// Generated by this command:
+//
+//	mockgen -source=data_source.go -destination=./mock/mock_data_source.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDataSourceRepository is a mock of DataSourceRepository interface.
+type MockDataSourceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataSourceRepositoryMockRecorder
+}
+
+// MockDataSourceRepositoryMockRecorder is the mock recorder for MockDataSourceRepository.
+type MockDataSourceRepositoryMockRecorder struct {
+	mock *MockDataSourceRepository
+}
+
+// NewMockDataSourceRepository creates a new mock instance.
+func NewMockDataSourceRepository(ctrl *gomock.Controller) *MockDataSourceRepository {
+	mock := &MockDataSourceRepository{ctrl: ctrl}
+	mock.recorder = &MockDataSourceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataSourceRepository) EXPECT() *MockDataSourceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDataSourceRepository) Delete(ctx context.Context, apiID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, apiID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDataSourceRepositoryMockRecorder) Delete(ctx, apiID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataSourceRepository)(nil).Delete), ctx, apiID, name)
+}
+
+// Get mocks base method.
+func (m *MockDataSourceRepository) Get(ctx context.Context, apiID, name string) (*model.DataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, apiID, name)
+	ret0, _ := ret[0].(*model.DataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDataSourceRepositoryMockRecorder) Get(ctx, apiID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDataSourceRepository)(nil).Get), ctx, apiID, name)
+}
+
+// List mocks base method.
+func (m *MockDataSourceRepository) List(ctx context.Context, apiID string) ([]model.DataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, apiID)
+	ret0, _ := ret[0].([]model.DataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockDataSourceRepositoryMockRecorder) List(ctx, apiID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDataSourceRepository)(nil).List), ctx, apiID)
+}
+
+// Save mocks base method.
+func (m *MockDataSourceRepository) Save(ctx context.Context, apiID string, dataSource *model.DataSource) (*model.DataSource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, apiID, dataSource)
+	ret0, _ := ret[0].(*model.DataSource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockDataSourceRepositoryMockRecorder) Save(ctx, apiID, dataSource any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockDataSourceRepository)(nil).Save), ctx, apiID, dataSource)
+}
@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: editor.go
+//
+// Generated by this command:
+//
+//	mockgen -source=editor.go -destination=./mock/mock_editor.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEditorRepository is a mock of EditorRepository interface.
+type MockEditorRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEditorRepositoryMockRecorder
+}
+
+// MockEditorRepositoryMockRecorder is the mock recorder for MockEditorRepository.
+type MockEditorRepositoryMockRecorder struct {
+	mock *MockEditorRepository
+}
+
+// NewMockEditorRepository creates a new mock instance.
+func NewMockEditorRepository(ctrl *gomock.Controller) *MockEditorRepository {
+	mock := &MockEditorRepository{ctrl: ctrl}
+	mock.recorder = &MockEditorRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEditorRepository) EXPECT() *MockEditorRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Edit mocks base method.
+func (m *MockEditorRepository) Edit(ctx context.Context, name string, content []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Edit", ctx, name, content)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Edit indicates an expected call of Edit.
+func (mr *MockEditorRepositoryMockRecorder) Edit(ctx, name, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Edit", reflect.TypeOf((*MockEditorRepository)(nil).Edit), ctx, name, content)
+}
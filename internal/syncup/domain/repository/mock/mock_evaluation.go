@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: evaluation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=evaluation.go -destination=./mock/mock_evaluation.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEvaluationRepository is a mock of EvaluationRepository interface.
+type MockEvaluationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEvaluationRepositoryMockRecorder
+}
+
+// MockEvaluationRepositoryMockRecorder is the mock recorder for MockEvaluationRepository.
+type MockEvaluationRepositoryMockRecorder struct {
+	mock *MockEvaluationRepository
+}
+
+// NewMockEvaluationRepository creates a new mock instance.
+func NewMockEvaluationRepository(ctrl *gomock.Controller) *MockEvaluationRepository {
+	mock := &MockEvaluationRepository{ctrl: ctrl}
+	mock.recorder = &MockEvaluationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvaluationRepository) EXPECT() *MockEvaluationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Evaluate mocks base method.
+func (m *MockEvaluationRepository) Evaluate(ctx context.Context, evaluation *model.Evaluation) (*model.EvaluationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", ctx, evaluation)
+	ret0, _ := ret[0].(*model.EvaluationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Evaluate indicates an expected call of Evaluate.
+func (mr *MockEvaluationRepositoryMockRecorder) Evaluate(ctx, evaluation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockEvaluationRepository)(nil).Evaluate), ctx, evaluation)
+}
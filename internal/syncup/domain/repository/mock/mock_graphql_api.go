@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: graphql_api.go
+//
+// Generated by this command:
+//
+//	mockgen -source=graphql_api.go -destination=./mock/mock_graphql_api.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGraphqlApiRepository is a mock of GraphqlApiRepository interface.
+type MockGraphqlApiRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockGraphqlApiRepositoryMockRecorder
+}
+
+// MockGraphqlApiRepositoryMockRecorder is the mock recorder for MockGraphqlApiRepository.
+type MockGraphqlApiRepositoryMockRecorder struct {
+	mock *MockGraphqlApiRepository
+}
+
+// NewMockGraphqlApiRepository creates a new mock instance.
+func NewMockGraphqlApiRepository(ctrl *gomock.Controller) *MockGraphqlApiRepository {
+	mock := &MockGraphqlApiRepository{ctrl: ctrl}
+	mock.recorder = &MockGraphqlApiRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGraphqlApiRepository) EXPECT() *MockGraphqlApiRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockGraphqlApiRepository) Get(ctx context.Context, apiID string) (*model.GraphqlApi, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, apiID)
+	ret0, _ := ret[0].(*model.GraphqlApi)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGraphqlApiRepositoryMockRecorder) Get(ctx, apiID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGraphqlApiRepository)(nil).Get), ctx, apiID)
+}
+
+// Save mocks base method.
+func (m *MockGraphqlApiRepository) Save(ctx context.Context, apiID string, api *model.GraphqlApi) (*model.GraphqlApi, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, apiID, api)
+	ret0, _ := ret[0].(*model.GraphqlApi)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockGraphqlApiRepositoryMockRecorder) Save(ctx, apiID, api any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockGraphqlApiRepository)(nil).Save), ctx, apiID, api)
+}
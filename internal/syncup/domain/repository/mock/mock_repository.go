@@ -151,6 +151,20 @@ func (mr *MockRepositoryMockRecorder) ActivateAWS(ctx any, optFns ...any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateAWS", reflect.TypeOf((*MockRepository)(nil).ActivateAWS), varargs...)
 }
 
+// BackupRepositoryForFS mocks base method.
+func (m *MockRepository) BackupRepositoryForFS() repository.BackupRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackupRepositoryForFS")
+	ret0, _ := ret[0].(repository.BackupRepository)
+	return ret0
+}
+
+// BackupRepositoryForFS indicates an expected call of BackupRepositoryForFS.
+func (mr *MockRepositoryMockRecorder) BackupRepositoryForFS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).BackupRepositoryForFS))
+}
+
 // BaseDir mocks base method.
 func (m *MockRepository) BaseDir(ctx context.Context) string {
 	m.ctrl.T.Helper()
@@ -165,6 +179,76 @@ func (mr *MockRepositoryMockRecorder) BaseDir(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseDir", reflect.TypeOf((*MockRepository)(nil).BaseDir), ctx)
 }
 
+// ConfigRepositoryForFS mocks base method.
+func (m *MockRepository) ConfigRepositoryForFS() repository.ConfigRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigRepositoryForFS")
+	ret0, _ := ret[0].(repository.ConfigRepository)
+	return ret0
+}
+
+// ConfigRepositoryForFS indicates an expected call of ConfigRepositoryForFS.
+func (mr *MockRepositoryMockRecorder) ConfigRepositoryForFS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).ConfigRepositoryForFS))
+}
+
+// ConfirmationRepository mocks base method.
+func (m *MockRepository) ConfirmationRepository() repository.ConfirmationRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmationRepository")
+	ret0, _ := ret[0].(repository.ConfirmationRepository)
+	return ret0
+}
+
+// ConfirmationRepository indicates an expected call of ConfirmationRepository.
+func (mr *MockRepositoryMockRecorder) ConfirmationRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmationRepository", reflect.TypeOf((*MockRepository)(nil).ConfirmationRepository))
+}
+
+// DataSourceRepositoryForAppSync mocks base method.
+func (m *MockRepository) DataSourceRepositoryForAppSync() repository.DataSourceRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DataSourceRepositoryForAppSync")
+	ret0, _ := ret[0].(repository.DataSourceRepository)
+	return ret0
+}
+
+// DataSourceRepositoryForAppSync indicates an expected call of DataSourceRepositoryForAppSync.
+func (mr *MockRepositoryMockRecorder) DataSourceRepositoryForAppSync() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataSourceRepositoryForAppSync", reflect.TypeOf((*MockRepository)(nil).DataSourceRepositoryForAppSync))
+}
+
+// DataSourceRepositoryForFS mocks base method.
+func (m *MockRepository) DataSourceRepositoryForFS() repository.DataSourceRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DataSourceRepositoryForFS")
+	ret0, _ := ret[0].(repository.DataSourceRepository)
+	return ret0
+}
+
+// DataSourceRepositoryForFS indicates an expected call of DataSourceRepositoryForFS.
+func (mr *MockRepositoryMockRecorder) DataSourceRepositoryForFS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataSourceRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).DataSourceRepositoryForFS))
+}
+
+// EditorRepository mocks base method.
+func (m *MockRepository) EditorRepository() repository.EditorRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditorRepository")
+	ret0, _ := ret[0].(repository.EditorRepository)
+	return ret0
+}
+
+// EditorRepository indicates an expected call of EditorRepository.
+func (mr *MockRepositoryMockRecorder) EditorRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditorRepository", reflect.TypeOf((*MockRepository)(nil).EditorRepository))
+}
+
 // EnvironmentVariablesRepositoryForAppSync mocks base method.
 func (m *MockRepository) EnvironmentVariablesRepositoryForAppSync() repository.EnvironmentVariablesRepository {
 	m.ctrl.T.Helper()
@@ -193,6 +277,20 @@ func (mr *MockRepositoryMockRecorder) EnvironmentVariablesRepositoryForFS() *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnvironmentVariablesRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).EnvironmentVariablesRepositoryForFS))
 }
 
+// EvaluationRepositoryForAppSync mocks base method.
+func (m *MockRepository) EvaluationRepositoryForAppSync() repository.EvaluationRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluationRepositoryForAppSync")
+	ret0, _ := ret[0].(repository.EvaluationRepository)
+	return ret0
+}
+
+// EvaluationRepositoryForAppSync indicates an expected call of EvaluationRepositoryForAppSync.
+func (mr *MockRepositoryMockRecorder) EvaluationRepositoryForAppSync() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluationRepositoryForAppSync", reflect.TypeOf((*MockRepository)(nil).EvaluationRepositoryForAppSync))
+}
+
 // FunctionRepositoryForAppSync mocks base method.
 func (m *MockRepository) FunctionRepositoryForAppSync() repository.FunctionRepository {
 	m.ctrl.T.Helper()
@@ -221,6 +319,34 @@ func (mr *MockRepositoryMockRecorder) FunctionRepositoryForFS() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FunctionRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).FunctionRepositoryForFS))
 }
 
+// GraphqlApiRepositoryForAppSync mocks base method.
+func (m *MockRepository) GraphqlApiRepositoryForAppSync() repository.GraphqlApiRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GraphqlApiRepositoryForAppSync")
+	ret0, _ := ret[0].(repository.GraphqlApiRepository)
+	return ret0
+}
+
+// GraphqlApiRepositoryForAppSync indicates an expected call of GraphqlApiRepositoryForAppSync.
+func (mr *MockRepositoryMockRecorder) GraphqlApiRepositoryForAppSync() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GraphqlApiRepositoryForAppSync", reflect.TypeOf((*MockRepository)(nil).GraphqlApiRepositoryForAppSync))
+}
+
+// GraphqlApiRepositoryForFS mocks base method.
+func (m *MockRepository) GraphqlApiRepositoryForFS() repository.GraphqlApiRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GraphqlApiRepositoryForFS")
+	ret0, _ := ret[0].(repository.GraphqlApiRepository)
+	return ret0
+}
+
+// GraphqlApiRepositoryForFS indicates an expected call of GraphqlApiRepositoryForFS.
+func (mr *MockRepositoryMockRecorder) GraphqlApiRepositoryForFS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GraphqlApiRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).GraphqlApiRepositoryForFS))
+}
+
 // MFATokenProviderRepository mocks base method.
 func (m *MockRepository) MFATokenProviderRepository() repository.MFATokenProviderRepository {
 	m.ctrl.T.Helper()
@@ -235,6 +361,20 @@ func (mr *MockRepositoryMockRecorder) MFATokenProviderRepository() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MFATokenProviderRepository", reflect.TypeOf((*MockRepository)(nil).MFATokenProviderRepository))
 }
 
+// ReportRepository mocks base method.
+func (m *MockRepository) ReportRepository() repository.ReportRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportRepository")
+	ret0, _ := ret[0].(repository.ReportRepository)
+	return ret0
+}
+
+// ReportRepository indicates an expected call of ReportRepository.
+func (mr *MockRepositoryMockRecorder) ReportRepository() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportRepository", reflect.TypeOf((*MockRepository)(nil).ReportRepository))
+}
+
 // ResolverRepositoryForAppSync mocks base method.
 func (m *MockRepository) ResolverRepositoryForAppSync() repository.ResolverRepository {
 	m.ctrl.T.Helper()
@@ -263,6 +403,34 @@ func (mr *MockRepositoryMockRecorder) ResolverRepositoryForFS() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolverRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).ResolverRepositoryForFS))
 }
 
+// ResourceRepositoryForFS mocks base method.
+func (m *MockRepository) ResourceRepositoryForFS() repository.ResourceRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResourceRepositoryForFS")
+	ret0, _ := ret[0].(repository.ResourceRepository)
+	return ret0
+}
+
+// ResourceRepositoryForFS indicates an expected call of ResourceRepositoryForFS.
+func (mr *MockRepositoryMockRecorder) ResourceRepositoryForFS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResourceRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).ResourceRepositoryForFS))
+}
+
+// SchemaFileRepositoryForFS mocks base method.
+func (m *MockRepository) SchemaFileRepositoryForFS() repository.SchemaFileRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchemaFileRepositoryForFS")
+	ret0, _ := ret[0].(repository.SchemaFileRepository)
+	return ret0
+}
+
+// SchemaFileRepositoryForFS indicates an expected call of SchemaFileRepositoryForFS.
+func (mr *MockRepositoryMockRecorder) SchemaFileRepositoryForFS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchemaFileRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).SchemaFileRepositoryForFS))
+}
+
 // SchemaRepositoryForAppSync mocks base method.
 func (m *MockRepository) SchemaRepositoryForAppSync() repository.SchemaRepository {
 	m.ctrl.T.Helper()
@@ -303,6 +471,34 @@ func (mr *MockRepositoryMockRecorder) SetBaseDir(ctx, dir any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBaseDir", reflect.TypeOf((*MockRepository)(nil).SetBaseDir), ctx, dir)
 }
 
+// StateRepositoryForFS mocks base method.
+func (m *MockRepository) StateRepositoryForFS() repository.StateRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateRepositoryForFS")
+	ret0, _ := ret[0].(repository.StateRepository)
+	return ret0
+}
+
+// StateRepositoryForFS indicates an expected call of StateRepositoryForFS.
+func (mr *MockRepositoryMockRecorder) StateRepositoryForFS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).StateRepositoryForFS))
+}
+
+// TestRepositoryForFS mocks base method.
+func (m *MockRepository) TestRepositoryForFS() repository.TestRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TestRepositoryForFS")
+	ret0, _ := ret[0].(repository.TestRepository)
+	return ret0
+}
+
+// TestRepositoryForFS indicates an expected call of TestRepositoryForFS.
+func (mr *MockRepositoryMockRecorder) TestRepositoryForFS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).TestRepositoryForFS))
+}
+
 // TrackerRepository mocks base method.
 func (m *MockRepository) TrackerRepository() repository.TrackerRepository {
 	m.ctrl.T.Helper()
@@ -330,3 +526,17 @@ func (mr *MockRepositoryMockRecorder) Version() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockRepository)(nil).Version))
 }
+
+// WatcherRepositoryForFS mocks base method.
+func (m *MockRepository) WatcherRepositoryForFS() repository.WatcherRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatcherRepositoryForFS")
+	ret0, _ := ret[0].(repository.WatcherRepository)
+	return ret0
+}
+
+// WatcherRepositoryForFS indicates an expected call of WatcherRepositoryForFS.
+func (mr *MockRepositoryMockRecorder) WatcherRepositoryForFS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatcherRepositoryForFS", reflect.TypeOf((*MockRepository)(nil).WatcherRepositoryForFS))
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: resource.go
+//
+// Generated by this command:
+//
+//	mockgen -source=resource.go -destination=./mock/mock_resource.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResourceRepository is a mock of ResourceRepository interface.
+type MockResourceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockResourceRepositoryMockRecorder
+}
+
+// MockResourceRepositoryMockRecorder is the mock recorder for MockResourceRepository.
+type MockResourceRepositoryMockRecorder struct {
+	mock *MockResourceRepository
+}
+
+// NewMockResourceRepository creates a new mock instance.
+func NewMockResourceRepository(ctrl *gomock.Controller) *MockResourceRepository {
+	mock := &MockResourceRepository{ctrl: ctrl}
+	mock.recorder = &MockResourceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResourceRepository) EXPECT() *MockResourceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CheckFiles mocks base method.
+func (m *MockResourceRepository) CheckFiles(ctx context.Context, apiID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckFiles", ctx, apiID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckFiles indicates an expected call of CheckFiles.
+func (mr *MockResourceRepositoryMockRecorder) CheckFiles(ctx, apiID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckFiles", reflect.TypeOf((*MockResourceRepository)(nil).CheckFiles), ctx, apiID)
+}
+
+// Exists mocks base method.
+func (m *MockResourceRepository) Exists(ctx context.Context, apiID string, kind model.ResourceKind, identifier string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, apiID, kind, identifier)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockResourceRepositoryMockRecorder) Exists(ctx, apiID, kind, identifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockResourceRepository)(nil).Exists), ctx, apiID, kind, identifier)
+}
@@ -69,3 +69,56 @@ func (mr *MockSchemaRepositoryMockRecorder) Save(ctx, apiID, schema any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSchemaRepository)(nil).Save), ctx, apiID, schema)
 }
+
+// MockSchemaFileRepository is a mock of SchemaFileRepository interface.
+type MockSchemaFileRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchemaFileRepositoryMockRecorder
+}
+
+// MockSchemaFileRepositoryMockRecorder is the mock recorder for MockSchemaFileRepository.
+type MockSchemaFileRepositoryMockRecorder struct {
+	mock *MockSchemaFileRepository
+}
+
+// NewMockSchemaFileRepository creates a new mock instance.
+func NewMockSchemaFileRepository(ctrl *gomock.Controller) *MockSchemaFileRepository {
+	mock := &MockSchemaFileRepository{ctrl: ctrl}
+	mock.recorder = &MockSchemaFileRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchemaFileRepository) EXPECT() *MockSchemaFileRepositoryMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockSchemaFileRepository) List(ctx context.Context, apiID string) ([]model.SchemaFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, apiID)
+	ret0, _ := ret[0].([]model.SchemaFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSchemaFileRepositoryMockRecorder) List(ctx, apiID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSchemaFileRepository)(nil).List), ctx, apiID)
+}
+
+// Save mocks base method.
+func (m *MockSchemaFileRepository) Save(ctx context.Context, apiID string, file *model.SchemaFile) (*model.SchemaFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, apiID, file)
+	ret0, _ := ret[0].(*model.SchemaFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSchemaFileRepositoryMockRecorder) Save(ctx, apiID, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSchemaFileRepository)(nil).Save), ctx, apiID, file)
+}
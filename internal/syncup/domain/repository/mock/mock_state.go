@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: state.go
+//
+// Generated by this command:
+//
+//	mockgen -source=state.go -destination=./mock/mock_state.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStateRepository is a mock of StateRepository interface.
+type MockStateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateRepositoryMockRecorder
+}
+
+// MockStateRepositoryMockRecorder is the mock recorder for MockStateRepository.
+type MockStateRepositoryMockRecorder struct {
+	mock *MockStateRepository
+}
+
+// NewMockStateRepository creates a new mock instance.
+func NewMockStateRepository(ctrl *gomock.Controller) *MockStateRepository {
+	mock := &MockStateRepository{ctrl: ctrl}
+	mock.recorder = &MockStateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStateRepository) EXPECT() *MockStateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockStateRepository) Get(ctx context.Context, apiID string) (*model.State, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, apiID)
+	ret0, _ := ret[0].(*model.State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStateRepositoryMockRecorder) Get(ctx, apiID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStateRepository)(nil).Get), ctx, apiID)
+}
+
+// Save mocks base method.
+func (m *MockStateRepository) Save(ctx context.Context, apiID string, state *model.State) (*model.State, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, apiID, state)
+	ret0, _ := ret[0].(*model.State)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockStateRepositoryMockRecorder) Save(ctx, apiID, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStateRepository)(nil).Save), ctx, apiID, state)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tests.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tests.go -destination=./mock/mock_tests.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTestRepository is a mock of TestRepository interface.
+type MockTestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTestRepositoryMockRecorder
+}
+
+// MockTestRepositoryMockRecorder is the mock recorder for MockTestRepository.
+type MockTestRepositoryMockRecorder struct {
+	mock *MockTestRepository
+}
+
+// NewMockTestRepository creates a new mock instance.
+func NewMockTestRepository(ctrl *gomock.Controller) *MockTestRepository {
+	mock := &MockTestRepository{ctrl: ctrl}
+	mock.recorder = &MockTestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTestRepository) EXPECT() *MockTestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockTestRepository) List(ctx context.Context, apiID string) ([]model.Test, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, apiID)
+	ret0, _ := ret[0].([]model.Test)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockTestRepositoryMockRecorder) List(ctx, apiID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTestRepository)(nil).List), ctx, apiID)
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -63,6 +64,18 @@ func (mr *MockTrackerRepositoryMockRecorder) InProgress(ctx, msg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InProgress", reflect.TypeOf((*MockTrackerRepository)(nil).InProgress), ctx, msg)
 }
 
+// Record mocks base method.
+func (m *MockTrackerRepository) Record(ctx context.Context, event *model.TrackerEvent) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Record", ctx, event)
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockTrackerRepositoryMockRecorder) Record(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockTrackerRepository)(nil).Record), ctx, event)
+}
+
 // Success mocks base method.
 func (m *MockTrackerRepository) Success(ctx context.Context, msg string) {
 	m.ctrl.T.Helper()
@@ -74,3 +87,40 @@ func (mr *MockTrackerRepositoryMockRecorder) Success(ctx, msg any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Success", reflect.TypeOf((*MockTrackerRepository)(nil).Success), ctx, msg)
 }
+
+// MockReportRepository is a mock of ReportRepository interface.
+type MockReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepositoryMockRecorder
+}
+
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
+type MockReportRepositoryMockRecorder struct {
+	mock *MockReportRepository
+}
+
+// NewMockReportRepository creates a new mock instance.
+func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
+	mock := &MockReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockReportRepository) Get(ctx context.Context) *model.Report {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(*model.Report)
+	return ret0
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReportRepositoryMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReportRepository)(nil).Get), ctx)
+}
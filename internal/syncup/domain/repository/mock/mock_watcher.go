@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: watcher.go
+//
+// Generated by this command:
+//
+//	mockgen -source=watcher.go -destination=./mock/mock_watcher.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWatcherRepository is a mock of WatcherRepository interface.
+type MockWatcherRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatcherRepositoryMockRecorder
+}
+
+// MockWatcherRepositoryMockRecorder is the mock recorder for MockWatcherRepository.
+type MockWatcherRepositoryMockRecorder struct {
+	mock *MockWatcherRepository
+}
+
+// NewMockWatcherRepository creates a new mock instance.
+func NewMockWatcherRepository(ctrl *gomock.Controller) *MockWatcherRepository {
+	mock := &MockWatcherRepository{ctrl: ctrl}
+	mock.recorder = &MockWatcherRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatcherRepository) EXPECT() *MockWatcherRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Watch mocks base method.
+func (m *MockWatcherRepository) Watch(ctx context.Context, debounce time.Duration, fn func([]model.ResourceChange)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, debounce, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockWatcherRepositoryMockRecorder) Watch(ctx, debounce, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockWatcherRepository)(nil).Watch), ctx, debounce, fn)
+}
@@ -44,14 +44,39 @@ type Repository interface {
 	Version() *model.Version
 
 	TrackerRepository() TrackerRepository
+	ReportRepository() ReportRepository
 
 	MFATokenProviderRepository() MFATokenProviderRepository
 
+	ConfirmationRepository() ConfirmationRepository
+
+	EditorRepository() EditorRepository
+
+	ConfigRepositoryForFS() ConfigRepository
+
+	BackupRepositoryForFS() BackupRepository
+
+	StateRepositoryForFS() StateRepository
+
+	ResourceRepositoryForFS() ResourceRepository
+
+	WatcherRepositoryForFS() WatcherRepository
+
+	EvaluationRepositoryForAppSync() EvaluationRepository
+	TestRepositoryForFS() TestRepository
+
+	GraphqlApiRepositoryForAppSync() GraphqlApiRepository
+	GraphqlApiRepositoryForFS() GraphqlApiRepository
+
 	EnvironmentVariablesRepositoryForAppSync() EnvironmentVariablesRepository
 	EnvironmentVariablesRepositoryForFS() EnvironmentVariablesRepository
 
 	SchemaRepositoryForAppSync() SchemaRepository
 	SchemaRepositoryForFS() SchemaRepository
+	SchemaFileRepositoryForFS() SchemaFileRepository
+
+	DataSourceRepositoryForAppSync() DataSourceRepository
+	DataSourceRepositoryForFS() DataSourceRepository
 
 	FunctionRepositoryForAppSync() FunctionRepository
 	FunctionRepositoryForFS() FunctionRepository
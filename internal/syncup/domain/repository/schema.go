@@ -32,3 +32,8 @@ type SchemaRepository interface {
 	Get(ctx context.Context, apiID string) (*model.Schema, error)
 	Save(ctx context.Context, apiID string, schema *model.Schema) (*model.Schema, error)
 }
+
+type SchemaFileRepository interface {
+	List(ctx context.Context, apiID string) ([]model.SchemaFile, error)
+	Save(ctx context.Context, apiID string, file *model.SchemaFile) (*model.SchemaFile, error)
+}
@@ -24,10 +24,17 @@ package repository
 
 import (
 	"context"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 )
 
 type TrackerRepository interface {
 	InProgress(ctx context.Context, msg string)
 	Failed(ctx context.Context, msg string)
 	Success(ctx context.Context, msg string)
+	Record(ctx context.Context, event *model.TrackerEvent)
+}
+
+type ReportRepository interface {
+	Get(ctx context.Context) *model.Report
 }
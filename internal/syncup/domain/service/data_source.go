@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+type DataSourceService interface {
+	Equal(ctx context.Context, dataSource1, dataSource2 *model.DataSource) (bool, error)
+}
+
+type dataSourceService struct {
+}
+
+func NewDataSourceService(repo repository.Repository) DataSourceService {
+	return &dataSourceService{}
+}
+
+func (s *dataSourceService) Equal(ctx context.Context, dataSource1, dataSource2 *model.DataSource) (res bool, err error) {
+	defer wrap(&err)
+
+	if dataSource1 == nil || dataSource2 == nil {
+		return false, fmt.Errorf("%w: missing arguments in DataSourceService.Equal method", model.ErrNilValue)
+	}
+
+	// NOTE: server-populated fields such as the data source ARN are excluded, as they are when pulling metadata.json
+	metadata1, err := json.Marshal(dataSource1)
+	if err != nil {
+		return false, err
+	}
+
+	metadata2, err := json.Marshal(dataSource2)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(metadata1, metadata2), nil
+}
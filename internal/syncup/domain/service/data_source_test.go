@@ -0,0 +1,128 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_dataSourceService_Equal(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	dataSourceAMAZON_DYNAMODB := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AMAZON_DYNAMODB/metadata.json")))
+	remoteDataSourceAMAZON_DYNAMODB := dataSourceAMAZON_DYNAMODB
+	remoteDataSourceAMAZON_DYNAMODB.DataSourceArn = ptr.Pointer("DataSourceArn")
+	dataSourceAWS_LAMBDA := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AWS_LAMBDA/metadata.json")))
+
+	type args struct {
+		dataSource1 *model.DataSource
+		dataSource2 *model.DataSource
+	}
+
+	type expected struct {
+		res   bool
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: identical",
+			args: args{
+				dataSource1: &dataSourceAMAZON_DYNAMODB,
+				dataSource2: &dataSourceAMAZON_DYNAMODB,
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different server-populated fields",
+			args: args{
+				dataSource1: &remoteDataSourceAMAZON_DYNAMODB,
+				dataSource2: &dataSourceAMAZON_DYNAMODB,
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different metadata",
+			args: args{
+				dataSource1: &dataSourceAMAZON_DYNAMODB,
+				dataSource2: &dataSourceAWS_LAMBDA,
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil data source",
+			args: args{
+				dataSource1: &dataSourceAMAZON_DYNAMODB,
+				dataSource2: nil,
+			},
+			expected: expected{
+				res:   false,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &dataSourceService{}
+
+			// Act
+			actual, err := s.Equal(ctx, tt.args.dataSource1, tt.args.dataSource2)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,430 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+const (
+	diffContextLines = 3
+	diffNullFile     = "/dev/null"
+
+	diffPathGraphqlApi           = "api.json"
+	diffPathEnvironmentVariables = "env.json"
+	diffPathSchema               = "schema.graphqls"
+	diffDirDataSources           = "datasources"
+	diffDirFunctions             = "functions"
+	diffDirResolvers             = "resolvers"
+	diffFileMetadata             = "metadata.json"
+	diffFileVTLRequestMapping    = "request.vtl"
+	diffFileVTLResponseMapping   = "response.vtl"
+	diffFileAppSyncJSCode        = "code.js"
+	diffSourceFilePrefix         = "a"
+	diffDestinationFilePrefix    = "b"
+
+	diffMaskedValue         = "********"
+	diffMaskedChangedSource = "******** (before)"
+	diffMaskedChangedDest   = "******** (after)"
+)
+
+type DiffService interface {
+	DiffGraphqlApi(ctx context.Context, src, dst *model.GraphqlApi) (*model.Diff, error)
+	DiffEnvironmentVariables(ctx context.Context, src, dst model.EnvironmentVariables) (*model.Diff, error)
+	DiffSchema(ctx context.Context, src, dst *model.Schema) (*model.Diff, error)
+	DiffDataSources(ctx context.Context, src, dst []model.DataSource) ([]model.Diff, error)
+	DiffFunctions(ctx context.Context, src, dst []model.Function) ([]model.Diff, error)
+	DiffResolvers(ctx context.Context, src, dst []model.Resolver) ([]model.Diff, error)
+}
+
+type diffService struct {
+}
+
+func NewDiffService(repo repository.Repository) DiffService {
+	return &diffService{}
+}
+
+func (s *diffService) DiffGraphqlApi(ctx context.Context, src, dst *model.GraphqlApi) (res *model.Diff, err error) {
+	defer wrap(&err)
+
+	if src == nil || dst == nil {
+		return nil, fmt.Errorf("%w: missing arguments in DiffService.DiffGraphqlApi method", model.ErrNilValue)
+	}
+
+	// NOTE: server-populated fields such as the API ID and ARN are not marshaled, so they never show up as differences
+	srcData, err := json.MarshalIndent(src, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	dstData, err := json.MarshalIndent(dst, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.diff(
+		model.ResourceKindGraphqlApi,
+		"",
+		map[string]string{diffPathGraphqlApi: string(srcData)},
+		map[string]string{diffPathGraphqlApi: string(dstData)},
+	)
+}
+
+func (s *diffService) DiffEnvironmentVariables(ctx context.Context, src, dst model.EnvironmentVariables) (res *model.Diff, err error) {
+	defer wrap(&err)
+
+	if src == nil || dst == nil {
+		return nil, fmt.Errorf("%w: missing arguments in DiffService.DiffEnvironmentVariables method", model.ErrNilValue)
+	}
+
+	// NOTE: the values may be secrets resolved from references or decrypted, so that only which keys changed is shown
+	src, dst = s.maskEnvironmentVariables(src, dst)
+
+	srcData, err := json.MarshalIndent(src, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	dstData, err := json.MarshalIndent(dst, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.diff(
+		model.ResourceKindEnvironmentVariables,
+		"",
+		map[string]string{diffPathEnvironmentVariables: string(srcData)},
+		map[string]string{diffPathEnvironmentVariables: string(dstData)},
+	)
+}
+
+func (s *diffService) DiffSchema(ctx context.Context, src, dst *model.Schema) (res *model.Diff, err error) {
+	defer wrap(&err)
+
+	if src == nil || dst == nil {
+		return nil, fmt.Errorf("%w: missing arguments in DiffService.DiffSchema method", model.ErrNilValue)
+	}
+
+	return s.diff(
+		model.ResourceKindSchema,
+		"",
+		map[string]string{diffPathSchema: string(*src)},
+		map[string]string{diffPathSchema: string(*dst)},
+	)
+}
+
+func (s *diffService) DiffDataSources(ctx context.Context, src, dst []model.DataSource) (res []model.Diff, err error) {
+	defer wrap(&err)
+
+	srcFiles := make(map[string]map[string]string)
+	for _, ds := range src {
+		name, files, err := s.dataSourceFiles(&ds)
+		if err != nil {
+			return nil, err
+		}
+
+		srcFiles[name] = files
+	}
+
+	dstFiles := make(map[string]map[string]string)
+	for _, ds := range dst {
+		name, files, err := s.dataSourceFiles(&ds)
+		if err != nil {
+			return nil, err
+		}
+
+		dstFiles[name] = files
+	}
+
+	return s.diffAll(model.ResourceKindDataSource, srcFiles, dstFiles)
+}
+
+func (s *diffService) DiffFunctions(ctx context.Context, src, dst []model.Function) (res []model.Diff, err error) {
+	defer wrap(&err)
+
+	srcFiles := make(map[string]map[string]string)
+	for _, fn := range src {
+		name, files, err := s.functionFiles(&fn)
+		if err != nil {
+			return nil, err
+		}
+
+		srcFiles[name] = files
+	}
+
+	dstFiles := make(map[string]map[string]string)
+	for _, fn := range dst {
+		name, files, err := s.functionFiles(&fn)
+		if err != nil {
+			return nil, err
+		}
+
+		dstFiles[name] = files
+	}
+
+	return s.diffAll(model.ResourceKindFunction, srcFiles, dstFiles)
+}
+
+func (s *diffService) DiffResolvers(ctx context.Context, src, dst []model.Resolver) (res []model.Diff, err error) {
+	defer wrap(&err)
+
+	srcFiles := make(map[string]map[string]string)
+	for _, rslv := range src {
+		identifier, files, err := s.resolverFiles(&rslv)
+		if err != nil {
+			return nil, err
+		}
+
+		srcFiles[identifier] = files
+	}
+
+	dstFiles := make(map[string]map[string]string)
+	for _, rslv := range dst {
+		identifier, files, err := s.resolverFiles(&rslv)
+		if err != nil {
+			return nil, err
+		}
+
+		dstFiles[identifier] = files
+	}
+
+	return s.diffAll(model.ResourceKindResolver, srcFiles, dstFiles)
+}
+
+func (s *diffService) maskEnvironmentVariables(src, dst model.EnvironmentVariables) (model.EnvironmentVariables, model.EnvironmentVariables) {
+	maskedSrc := make(model.EnvironmentVariables, len(src))
+	for k, v := range src {
+		if w, ok := dst[k]; ok && v != w {
+			maskedSrc[k] = diffMaskedChangedSource
+		} else {
+			maskedSrc[k] = diffMaskedValue
+		}
+	}
+
+	maskedDst := make(model.EnvironmentVariables, len(dst))
+	for k, w := range dst {
+		if v, ok := src[k]; ok && v != w {
+			maskedDst[k] = diffMaskedChangedDest
+		} else {
+			maskedDst[k] = diffMaskedValue
+		}
+	}
+
+	return maskedSrc, maskedDst
+}
+
+func (s *diffService) dataSourceFiles(ds *model.DataSource) (identifier string, files map[string]string, err error) {
+	if ds.Name == nil {
+		return "", nil, fmt.Errorf("%w: missing name", model.ErrNilValue)
+	}
+
+	data, err := json.MarshalIndent(ds, "", "  ")
+	if err != nil {
+		return "", nil, err
+	}
+
+	return *ds.Name, map[string]string{path.Join(diffDirDataSources, *ds.Name, diffFileMetadata): string(data)}, nil
+}
+
+func (s *diffService) functionFiles(fn *model.Function) (identifier string, files map[string]string, err error) {
+	if fn.Name == nil {
+		return "", nil, fmt.Errorf("%w: missing name", model.ErrNilValue)
+	}
+
+	files, err = s.codeFiles(path.Join(diffDirFunctions, *fn.Name), fn, fn.Runtime, fn.RequestMappingTemplate, fn.ResponseMappingTemplate, fn.Code)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return *fn.Name, files, nil
+}
+
+func (s *diffService) resolverFiles(rslv *model.Resolver) (identifier string, files map[string]string, err error) {
+	if rslv.TypeName == nil {
+		return "", nil, fmt.Errorf("%w: missing type name", model.ErrNilValue)
+	}
+
+	if rslv.FieldName == nil {
+		return "", nil, fmt.Errorf("%w: missing field name", model.ErrNilValue)
+	}
+
+	files, err = s.codeFiles(path.Join(diffDirResolvers, *rslv.TypeName, *rslv.FieldName), rslv, rslv.Runtime, rslv.RequestMappingTemplate, rslv.ResponseMappingTemplate, rslv.Code)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return fmt.Sprintf("%s.%s", *rslv.TypeName, *rslv.FieldName), files, nil
+}
+
+func (s *diffService) codeFiles(dir string, metadata any, runtime *model.Runtime, requestMappingTemplate, responseMappingTemplate, code *string) (map[string]string, error) {
+	data, err := json.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	files := map[string]string{
+		path.Join(dir, diffFileMetadata): string(data),
+	}
+
+	switch {
+	case runtime == nil:
+		// VTL runtime
+		files[path.Join(dir, diffFileVTLRequestMapping)] = ptr.ToValue(requestMappingTemplate)
+		files[path.Join(dir, diffFileVTLResponseMapping)] = ptr.ToValue(responseMappingTemplate)
+	case runtime.Name == model.RuntimeNameAppsyncJs:
+		// AppSync JS runtime
+		files[path.Join(dir, diffFileAppSyncJSCode)] = ptr.ToValue(code)
+	default:
+		// invalid runtime
+		return nil, fmt.Errorf("%w: runtime %s", model.ErrInvalidValue, runtime.Name)
+	}
+
+	return files, nil
+}
+
+func (s *diffService) diffAll(kind model.ResourceKind, src, dst map[string]map[string]string) ([]model.Diff, error) {
+	identifiers := make([]string, 0, len(src)+len(dst))
+	for identifier := range src {
+		identifiers = append(identifiers, identifier)
+	}
+
+	for identifier := range dst {
+		if _, ok := src[identifier]; !ok {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+
+	slices.Sort(identifiers)
+
+	diffs := make([]model.Diff, 0)
+	for _, identifier := range identifiers {
+		d, err := s.diff(kind, identifier, src[identifier], dst[identifier])
+		if err != nil {
+			return nil, err
+		}
+
+		if d != nil {
+			diffs = append(diffs, *d)
+		}
+	}
+
+	return diffs, nil
+}
+
+func (s *diffService) diff(kind model.ResourceKind, identifier string, src, dst map[string]string) (*model.Diff, error) {
+	var action model.DiffAction
+	switch {
+	case src == nil:
+		action = model.DiffActionCreate
+	case dst == nil:
+		action = model.DiffActionDelete
+	default:
+		action = model.DiffActionUpdate
+	}
+
+	paths := make([]string, 0, len(src)+len(dst))
+	for p := range src {
+		paths = append(paths, p)
+	}
+
+	for p := range dst {
+		if _, ok := src[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+
+	slices.Sort(paths)
+
+	fileDiffs := make([]model.FileDiff, 0, len(paths))
+	for _, p := range paths {
+		srcContent, srcOK := src[p]
+		dstContent, dstOK := dst[p]
+		if srcOK && dstOK && srcContent == dstContent {
+			continue
+		}
+
+		ud := difflib.UnifiedDiff{
+			FromFile: path.Join(diffSourceFilePrefix, p),
+			ToFile:   path.Join(diffDestinationFilePrefix, p),
+			Context:  diffContextLines,
+		}
+
+		if srcOK {
+			ud.A = s.splitLines(srcContent)
+		} else {
+			ud.FromFile = diffNullFile
+		}
+
+		if dstOK {
+			ud.B = s.splitLines(dstContent)
+		} else {
+			ud.ToFile = diffNullFile
+		}
+
+		text, err := difflib.GetUnifiedDiffString(ud)
+		if err != nil {
+			return nil, err
+		}
+
+		fileDiffs = append(fileDiffs, model.FileDiff{
+			Path:        p,
+			UnifiedDiff: text,
+		})
+	}
+
+	if len(fileDiffs) == 0 {
+		return nil, nil
+	}
+
+	return &model.Diff{
+		Kind:       kind,
+		Identifier: identifier,
+		Action:     action,
+		FileDiffs:  fileDiffs,
+	}, nil
+}
+
+func (s *diffService) splitLines(content string) []string {
+	if content == "" {
+		return []string{}
+	}
+
+	lines := strings.SplitAfter(content, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+
+	lines[len(lines)-1] += "\n"
+
+	return lines
+}
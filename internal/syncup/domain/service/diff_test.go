@@ -0,0 +1,734 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_diffService_DiffGraphqlApi(t *testing.T) {
+	type args struct {
+		src *model.GraphqlApi
+		dst *model.GraphqlApi
+	}
+
+	type expected struct {
+		res   *model.Diff
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: ignore server-populated fields",
+			args: args{
+				src: &model.GraphqlApi{ApiId: ptr.Pointer("apiID"), Arn: ptr.Pointer("arn:aws:appsync:ap-northeast-1:123456789012:apis/apiID"), AuthenticationType: model.AuthenticationType("API_KEY")},
+				dst: &model.GraphqlApi{AuthenticationType: model.AuthenticationType("API_KEY")},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: some differences",
+			args: args{
+				src: &model.GraphqlApi{AuthenticationType: model.AuthenticationType("API_KEY")},
+				dst: &model.GraphqlApi{AuthenticationType: model.AuthenticationType("API_KEY"), XrayEnabled: true},
+			},
+			expected: expected{
+				res: &model.Diff{
+					Kind:       model.ResourceKindGraphqlApi,
+					Identifier: "",
+					Action:     model.DiffActionUpdate,
+					FileDiffs: []model.FileDiff{
+						{
+							Path: "api.json",
+							UnifiedDiff: `--- a/api.json
++++ b/api.json
+@@ -1,6 +1,6 @@
+ {
+   "authenticationType": "API_KEY",
+-  "xrayEnabled": false,
++  "xrayEnabled": true,
+   "queryDepthLimit": 0,
+   "resolverCountLimit": 0
+ }
+`,
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil source",
+			args: args{
+				src: nil,
+				dst: &model.GraphqlApi{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &diffService{}
+
+			// Act
+			actual, err := s.DiffGraphqlApi(ctx, tt.args.src, tt.args.dst)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_diffService_DiffEnvironmentVariables(t *testing.T) {
+	type args struct {
+		src model.EnvironmentVariables
+		dst model.EnvironmentVariables
+	}
+
+	type expected struct {
+		res   *model.Diff
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: no differences",
+			args: args{
+				src: model.EnvironmentVariables{"key": "value"},
+				dst: model.EnvironmentVariables{"key": "value"},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: some differences",
+			args: args{
+				src: model.EnvironmentVariables{"key1": "value1", "key2": "value2"},
+				dst: model.EnvironmentVariables{"key1": "value1", "key2": "updated"},
+			},
+			expected: expected{
+				res: &model.Diff{
+					Kind:       model.ResourceKindEnvironmentVariables,
+					Identifier: "",
+					Action:     model.DiffActionUpdate,
+					FileDiffs: []model.FileDiff{
+						{
+							Path: "env.json",
+							UnifiedDiff: `--- a/env.json
++++ b/env.json
+@@ -1,4 +1,4 @@
+ {
+   "key1": "********",
+-  "key2": "******** (before)"
++  "key2": "******** (after)"
+ }
+`,
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: added and removed keys",
+			args: args{
+				src: model.EnvironmentVariables{"key1": "value1", "key2": "secret2"},
+				dst: model.EnvironmentVariables{"key1": "value1", "key3": "secret3"},
+			},
+			expected: expected{
+				res: &model.Diff{
+					Kind:       model.ResourceKindEnvironmentVariables,
+					Identifier: "",
+					Action:     model.DiffActionUpdate,
+					FileDiffs: []model.FileDiff{
+						{
+							Path: "env.json",
+							UnifiedDiff: `--- a/env.json
++++ b/env.json
+@@ -1,4 +1,4 @@
+ {
+   "key1": "********",
+-  "key2": "********"
++  "key3": "********"
+ }
+`,
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil source",
+			args: args{
+				src: nil,
+				dst: model.EnvironmentVariables{"key": "value"},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &diffService{}
+
+			// Act
+			actual, err := s.DiffEnvironmentVariables(ctx, tt.args.src, tt.args.dst)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_diffService_DiffSchema(t *testing.T) {
+	type args struct {
+		src *model.Schema
+		dst *model.Schema
+	}
+
+	type expected struct {
+		res   *model.Diff
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: no differences",
+			args: args{
+				src: ptr.Pointer(model.Schema("type Query {\n  hello: String\n}\n")),
+				dst: ptr.Pointer(model.Schema("type Query {\n  hello: String\n}\n")),
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: some differences",
+			args: args{
+				src: ptr.Pointer(model.Schema("type Query {\n  hello: String\n}\n")),
+				dst: ptr.Pointer(model.Schema("type Query {\n  hello: String!\n}\n")),
+			},
+			expected: expected{
+				res: &model.Diff{
+					Kind:       model.ResourceKindSchema,
+					Identifier: "",
+					Action:     model.DiffActionUpdate,
+					FileDiffs: []model.FileDiff{
+						{
+							Path: "schema.graphqls",
+							UnifiedDiff: `--- a/schema.graphqls
++++ b/schema.graphqls
+@@ -1,3 +1,3 @@
+ type Query {
+-  hello: String
++  hello: String!
+ }
+`,
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil destination",
+			args: args{
+				src: ptr.Pointer(model.Schema("type Query {\n  hello: String\n}\n")),
+				dst: nil,
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &diffService{}
+
+			// Act
+			actual, err := s.DiffSchema(ctx, tt.args.src, tt.args.dst)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_diffService_DiffDataSources(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	dataSourceAMAZON_DYNAMODB := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AMAZON_DYNAMODB/metadata.json")))
+	dataSourceAMAZON_DYNAMODB_Remote := dataSourceAMAZON_DYNAMODB
+	dataSourceAMAZON_DYNAMODB_Remote.DataSourceArn = ptr.Pointer("arn:aws:appsync:ap-northeast-1:123456789012:apis/apiID/datasources/AMAZON_DYNAMODB")
+	dataSourceAMAZON_DYNAMODB_Updated := dataSourceAMAZON_DYNAMODB
+	dataSourceAMAZON_DYNAMODB_Updated.Description = ptr.Pointer("updated")
+
+	type args struct {
+		src []model.DataSource
+		dst []model.DataSource
+	}
+
+	type expected struct {
+		res   []model.Diff
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: ignore server-populated fields",
+			args: args{
+				src: []model.DataSource{dataSourceAMAZON_DYNAMODB_Remote},
+				dst: []model.DataSource{dataSourceAMAZON_DYNAMODB},
+			},
+			expected: expected{
+				res:   []model.Diff{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: updated metadata",
+			args: args{
+				src: []model.DataSource{dataSourceAMAZON_DYNAMODB},
+				dst: []model.DataSource{dataSourceAMAZON_DYNAMODB_Updated},
+			},
+			expected: expected{
+				res: []model.Diff{
+					{
+						Kind:       model.ResourceKindDataSource,
+						Identifier: "AMAZON_DYNAMODB",
+						Action:     model.DiffActionUpdate,
+						FileDiffs: []model.FileDiff{
+							{
+								Path: "datasources/AMAZON_DYNAMODB/metadata.json",
+								UnifiedDiff: `--- a/datasources/AMAZON_DYNAMODB/metadata.json
++++ b/datasources/AMAZON_DYNAMODB/metadata.json
+@@ -1,6 +1,6 @@
+ {
+   "name": "AMAZON_DYNAMODB",
+-  "description": "Amazon DynamoDB data source",
++  "description": "updated",
+   "type": "AMAZON_DYNAMODB",
+   "serviceRoleArn": "arn:aws:iam::123456789012:role/service-role/appsync-ds-ddb-role",
+   "dynamodbConfig": {
+`,
+							},
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: missing name",
+			args: args{
+				src: []model.DataSource{{}},
+				dst: []model.DataSource{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &diffService{}
+
+			// Act
+			actual, err := s.DiffDataSources(ctx, tt.args.src, tt.args.dst)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_diffService_DiffFunctions(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	functionAPPSYNC_JS_1_0_0 := testhelpers.MustUnmarshalJSON[model.Function](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/APPSYNC_JS_1.0.0/metadata.json")))
+	functionAPPSYNC_JS_1_0_0.Code = ptr.Pointer("export function request(ctx) {}\n")
+	functionAPPSYNC_JS_1_0_0_Remote := functionAPPSYNC_JS_1_0_0
+	functionAPPSYNC_JS_1_0_0_Remote.FunctionId = ptr.Pointer("functionID")
+	functionAPPSYNC_JS_1_0_0_Remote.FunctionArn = ptr.Pointer("arn:aws:appsync:ap-northeast-1:123456789012:apis/apiID/functions/functionID")
+	functionAPPSYNC_JS_1_0_0_Updated := functionAPPSYNC_JS_1_0_0
+	functionAPPSYNC_JS_1_0_0_Updated.Code = ptr.Pointer("export function request(ctx) {\n  return {};\n}\n")
+
+	type args struct {
+		src []model.Function
+		dst []model.Function
+	}
+
+	type expected struct {
+		res   []model.Diff
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: no differences",
+			args: args{
+				src: []model.Function{functionAPPSYNC_JS_1_0_0},
+				dst: []model.Function{functionAPPSYNC_JS_1_0_0},
+			},
+			expected: expected{
+				res:   []model.Diff{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: ignore server-populated fields",
+			args: args{
+				src: []model.Function{functionAPPSYNC_JS_1_0_0_Remote},
+				dst: []model.Function{functionAPPSYNC_JS_1_0_0},
+			},
+			expected: expected{
+				res:   []model.Diff{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: updated code",
+			args: args{
+				src: []model.Function{functionAPPSYNC_JS_1_0_0},
+				dst: []model.Function{functionAPPSYNC_JS_1_0_0_Updated},
+			},
+			expected: expected{
+				res: []model.Diff{
+					{
+						Kind:       model.ResourceKindFunction,
+						Identifier: "APPSYNC_JS_1.0.0",
+						Action:     model.DiffActionUpdate,
+						FileDiffs: []model.FileDiff{
+							{
+								Path: "functions/APPSYNC_JS_1.0.0/code.js",
+								UnifiedDiff: `--- a/functions/APPSYNC_JS_1.0.0/code.js
++++ b/functions/APPSYNC_JS_1.0.0/code.js
+@@ -1 +1,3 @@
+-export function request(ctx) {}
++export function request(ctx) {
++  return {};
++}
+`,
+							},
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: created",
+			args: args{
+				src: []model.Function{},
+				dst: []model.Function{functionAPPSYNC_JS_1_0_0},
+			},
+			expected: expected{
+				res: []model.Diff{
+					{
+						Kind:       model.ResourceKindFunction,
+						Identifier: "APPSYNC_JS_1.0.0",
+						Action:     model.DiffActionCreate,
+						FileDiffs: []model.FileDiff{
+							{
+								Path: "functions/APPSYNC_JS_1.0.0/code.js",
+								UnifiedDiff: `--- /dev/null
++++ b/functions/APPSYNC_JS_1.0.0/code.js
+@@ -0,0 +1 @@
++export function request(ctx) {}
+`,
+							},
+							{
+								Path: "functions/APPSYNC_JS_1.0.0/metadata.json",
+								UnifiedDiff: `--- /dev/null
++++ b/functions/APPSYNC_JS_1.0.0/metadata.json
+@@ -0,0 +1,17 @@
++{
++  "name": "APPSYNC_JS_1.0.0",
++  "description": "Description",
++  "dataSourceName": "DataSourceName",
++  "syncConfig": {
++    "conflictHandler": "ConflictHandler",
++    "conflictDetection": "ConflictDetection",
++    "lambdaConflictHandlerConfig": {
++      "lambdaConflictHandlerArn": "LambdaConflictHandlerArn"
++    }
++  },
++  "maxBatchSize": 0,
++  "runtime": {
++    "name": "APPSYNC_JS",
++    "runtimeVersion": "1.0.0"
++  }
++}
+`,
+							},
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: missing name",
+			args: args{
+				src: []model.Function{{}},
+				dst: []model.Function{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &diffService{}
+
+			// Act
+			actual, err := s.DiffFunctions(ctx, tt.args.src, tt.args.dst)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_diffService_DiffResolvers(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	resolverUNIT_VTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Resolver](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/UNIT/VTL_2018-05-29/metadata.json")))
+	resolverUNIT_VTL_2018_05_29.RequestMappingTemplate = ptr.Pointer("request\n")
+	resolverUNIT_VTL_2018_05_29.ResponseMappingTemplate = ptr.Pointer("response\n")
+	resolverUNIT_VTL_2018_05_29_Updated := resolverUNIT_VTL_2018_05_29
+	resolverUNIT_VTL_2018_05_29_Updated.ResponseMappingTemplate = ptr.Pointer("updated\n")
+
+	type args struct {
+		src []model.Resolver
+		dst []model.Resolver
+	}
+
+	type expected struct {
+		res   []model.Diff
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: no differences",
+			args: args{
+				src: []model.Resolver{resolverUNIT_VTL_2018_05_29},
+				dst: []model.Resolver{resolverUNIT_VTL_2018_05_29},
+			},
+			expected: expected{
+				res:   []model.Diff{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: updated response mapping template",
+			args: args{
+				src: []model.Resolver{resolverUNIT_VTL_2018_05_29},
+				dst: []model.Resolver{resolverUNIT_VTL_2018_05_29_Updated},
+			},
+			expected: expected{
+				res: []model.Diff{
+					{
+						Kind:       model.ResourceKindResolver,
+						Identifier: "UNIT.VTL_2018-05-29",
+						Action:     model.DiffActionUpdate,
+						FileDiffs: []model.FileDiff{
+							{
+								Path: "resolvers/UNIT/VTL_2018-05-29/response.vtl",
+								UnifiedDiff: `--- a/resolvers/UNIT/VTL_2018-05-29/response.vtl
++++ b/resolvers/UNIT/VTL_2018-05-29/response.vtl
+@@ -1 +1 @@
+-response
++updated
+`,
+							},
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: invalid runtime",
+			args: args{
+				src: []model.Resolver{
+					{
+						TypeName:  ptr.Pointer("Query"),
+						FieldName: ptr.Pointer("getPost"),
+						Runtime:   &model.Runtime{Name: "INVALID"},
+					},
+				},
+				dst: []model.Resolver{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &diffService{}
+
+			// Act
+			actual, err := s.DiffResolvers(ctx, tt.args.src, tt.args.dst)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
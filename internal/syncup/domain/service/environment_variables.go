@@ -0,0 +1,101 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"unicode/utf8"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+const (
+	maxEnvironmentVariables           = 50
+	maxEnvironmentVariableKeyLength   = 64
+	maxEnvironmentVariableValueLength = 512
+)
+
+var (
+	environmentVariableKeyPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]+$`)
+)
+
+type EnvironmentVariablesService interface {
+	Validate(ctx context.Context, variables model.EnvironmentVariables) error
+	Equal(ctx context.Context, variables1, variables2 model.EnvironmentVariables) (bool, error)
+}
+
+type environmentVariablesService struct {
+}
+
+func NewEnvironmentVariablesService(repo repository.Repository) EnvironmentVariablesService {
+	return &environmentVariablesService{}
+}
+
+// Validate checks the variables against the limits of AWS AppSync, so that all the violations are reported at once.
+func (s *environmentVariablesService) Validate(ctx context.Context, variables model.EnvironmentVariables) (err error) {
+	defer wrap(&err)
+
+	if variables == nil {
+		return fmt.Errorf("%w: missing arguments in EnvironmentVariablesService.Validate method", model.ErrNilValue)
+	}
+
+	errs := make([]error, 0)
+
+	if len(variables) > maxEnvironmentVariables {
+		errs = append(errs, fmt.Errorf("%w: %d environment variables exceed the limit of %d", model.ErrInvalidValue, len(variables), maxEnvironmentVariables))
+	}
+
+	keys := make([]string, 0, len(variables))
+	for k := range variables {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		if len(k) > maxEnvironmentVariableKeyLength || !environmentVariableKeyPattern.MatchString(k) {
+			errs = append(errs, fmt.Errorf("%w: environment variable key %q must start with a letter and consist of 2 to %d letters, digits and underscores", model.ErrInvalidValue, k, maxEnvironmentVariableKeyLength))
+		}
+
+		if n := utf8.RuneCountInString(variables[k]); n > maxEnvironmentVariableValueLength {
+			errs = append(errs, fmt.Errorf("%w: environment variable %s is %d characters long, exceeding the limit of %d", model.ErrInvalidValue, k, n, maxEnvironmentVariableValueLength))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *environmentVariablesService) Equal(ctx context.Context, variables1, variables2 model.EnvironmentVariables) (res bool, err error) {
+	defer wrap(&err)
+
+	if variables1 == nil || variables2 == nil {
+		return false, fmt.Errorf("%w: missing arguments in EnvironmentVariablesService.Equal method", model.ErrNilValue)
+	}
+
+	return maps.Equal(variables1, variables2), nil
+}
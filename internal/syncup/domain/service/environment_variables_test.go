@@ -0,0 +1,244 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_environmentVariablesService_Validate(t *testing.T) {
+	type args struct {
+		variables model.EnvironmentVariables
+	}
+
+	type expected struct {
+		errMessages []string
+		errIs       error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				variables: model.EnvironmentVariables{
+					"KEY":   "VALUE",
+					"KEY_2": strings.Repeat("v", 512),
+				},
+			},
+			expected: expected{
+				errMessages: nil,
+				errIs:       nil,
+			},
+		},
+		{
+			name: "happy path: no variables",
+			args: args{
+				variables: model.EnvironmentVariables{},
+			},
+			expected: expected{
+				errMessages: nil,
+				errIs:       nil,
+			},
+		},
+		{
+			name: "edge path: invalid environment variables",
+			args: args{
+				variables: model.EnvironmentVariables{
+					"1KEY": "VALUE",
+					"K":    "VALUE",
+					"KEY":  strings.Repeat("v", 513),
+				},
+			},
+			expected: expected{
+				errMessages: []string{
+					`environment variable key "1KEY"`,
+					`environment variable key "K"`,
+					"environment variable KEY is 513 characters long",
+				},
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: too many environment variables",
+			args: args{
+				variables: func() model.EnvironmentVariables {
+					vs := make(model.EnvironmentVariables)
+					for i := range 51 {
+						vs[fmt.Sprintf("KEY%d", i)] = "VALUE"
+					}
+					return vs
+				}(),
+			},
+			expected: expected{
+				errMessages: []string{"51 environment variables exceed the limit of 50"},
+				errIs:       model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: nil environment variables",
+			args: args{
+				variables: nil,
+			},
+			expected: expected{
+				errMessages: nil,
+				errIs:       model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &environmentVariablesService{}
+
+			// Act
+			err := s.Validate(ctx, tt.args.variables)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+
+				for _, msg := range tt.expected.errMessages {
+					assert.ErrorContains(t, err, msg)
+				}
+			}
+		})
+	}
+}
+
+func Test_environmentVariablesService_Equal(t *testing.T) {
+	type args struct {
+		variables1 model.EnvironmentVariables
+		variables2 model.EnvironmentVariables
+	}
+
+	type expected struct {
+		res   bool
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: identical",
+			args: args{
+				variables1: model.EnvironmentVariables{"key1": "value1", "key2": "value2"},
+				variables2: model.EnvironmentVariables{"key2": "value2", "key1": "value1"},
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: empty",
+			args: args{
+				variables1: model.EnvironmentVariables{},
+				variables2: model.EnvironmentVariables{},
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different value",
+			args: args{
+				variables1: model.EnvironmentVariables{"key1": "value1"},
+				variables2: model.EnvironmentVariables{"key1": "updated"},
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different keys",
+			args: args{
+				variables1: model.EnvironmentVariables{"key1": "value1"},
+				variables2: model.EnvironmentVariables{"key1": "value1", "key2": "value2"},
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil variables",
+			args: args{
+				variables1: model.EnvironmentVariables{"key1": "value1"},
+				variables2: nil,
+			},
+			expected: expected{
+				res:   false,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &environmentVariablesService{}
+
+			// Act
+			actual, err := s.Equal(ctx, tt.args.variables1, tt.args.variables2)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
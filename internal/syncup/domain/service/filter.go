@@ -0,0 +1,130 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+var (
+	filterPatternPrefixes = map[string]model.ResourceKind{
+		"ds":       model.ResourceKindDataSource,
+		"fn":       model.ResourceKindFunction,
+		"resolver": model.ResourceKindResolver,
+	}
+
+	singletonResourceKinds = []model.ResourceKind{
+		model.ResourceKindGraphqlApi,
+		model.ResourceKindEnvironmentVariables,
+		model.ResourceKindSchema,
+	}
+)
+
+type FilterService interface {
+	Validate(ctx context.Context, filter *model.Filter) error
+	Match(ctx context.Context, filter *model.Filter, kind model.ResourceKind, identifier string) bool
+}
+
+type filterService struct {
+}
+
+func NewFilterService(repo repository.Repository) FilterService {
+	return &filterService{}
+}
+
+func (s *filterService) Validate(ctx context.Context, filter *model.Filter) (err error) {
+	defer wrap(&err)
+
+	if filter == nil {
+		return fmt.Errorf("%w: missing arguments in FilterService.Validate method", model.ErrNilValue)
+	}
+
+	for _, pattern := range slices.Concat(filter.Include, filter.Exclude) {
+		if _, _, err := parseFilterPattern(pattern); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *filterService) Match(ctx context.Context, filter *model.Filter, kind model.ResourceKind, identifier string) bool {
+	if filter == nil {
+		return true
+	}
+
+	if len(filter.Kinds) > 0 && !slices.Contains(filter.Kinds, kind) {
+		return false
+	}
+
+	// NOTE: singleton resources have no identifier to match, so they are synced with --include only if selected explicitly
+	if slices.Contains(singletonResourceKinds, kind) {
+		return len(filter.Include) == 0 || slices.Contains(filter.Kinds, kind)
+	}
+
+	if len(filter.Include) > 0 && !matchFilterPatterns(filter.Include, kind, identifier) {
+		return false
+	}
+
+	return !matchFilterPatterns(filter.Exclude, kind, identifier)
+}
+
+func matchFilterPatterns(patterns []string, kind model.ResourceKind, identifier string) bool {
+	for _, pattern := range patterns {
+		k, glob, err := parseFilterPattern(pattern)
+		if err != nil || k != kind {
+			continue
+		}
+
+		if ok, _ := path.Match(glob, identifier); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseFilterPattern(pattern string) (kind model.ResourceKind, glob string, err error) {
+	kind, glob = model.ResourceKindResolver, pattern
+	if prefix, rest, ok := strings.Cut(pattern, ":"); ok {
+		k, ok := filterPatternPrefixes[prefix]
+		if !ok {
+			return "", "", fmt.Errorf("%w: unknown prefix %q in filter pattern %q", model.ErrInvalidValue, prefix, pattern)
+		}
+
+		kind, glob = k, rest
+	}
+
+	if _, err := path.Match(glob, ""); err != nil {
+		return "", "", fmt.Errorf("%w: filter pattern %q: %w", model.ErrInvalidValue, pattern, err)
+	}
+
+	return kind, glob, nil
+}
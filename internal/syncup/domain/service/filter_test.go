@@ -0,0 +1,293 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_filterService_Validate(t *testing.T) {
+	type args struct {
+		filter *model.Filter
+	}
+
+	type expected struct {
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				filter: &model.Filter{Kinds: []model.ResourceKind{model.ResourceKindResolver}, Include: []string{"Query.*", "fn:getPost*"}, Exclude: []string{"ds:Legacy*"}},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: empty filter",
+			args: args{
+				filter: &model.Filter{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil filter",
+			args: args{
+				filter: nil,
+			},
+			expected: expected{
+				errIs: model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: unknown prefix",
+			args: args{
+				filter: &model.Filter{Include: []string{"type:Query"}},
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: malformed pattern",
+			args: args{
+				filter: &model.Filter{Exclude: []string{"Query.["}},
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &filterService{}
+
+			// Act
+			err := s.Validate(ctx, tt.args.filter)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_filterService_Match(t *testing.T) {
+	type args struct {
+		filter     *model.Filter
+		kind       model.ResourceKind
+		identifier string
+	}
+
+	type expected struct {
+		res bool
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil filter",
+			args: args{
+				filter:     nil,
+				kind:       model.ResourceKindResolver,
+				identifier: "Query.getPost",
+			},
+			expected: expected{
+				res: true,
+			},
+		},
+		{
+			name: "happy path: empty filter",
+			args: args{
+				filter:     &model.Filter{},
+				kind:       model.ResourceKindFunction,
+				identifier: "getPost",
+			},
+			expected: expected{
+				res: true,
+			},
+		},
+		{
+			name: "happy path: kind not selected",
+			args: args{
+				filter:     &model.Filter{Kinds: []model.ResourceKind{model.ResourceKindSchema}},
+				kind:       model.ResourceKindFunction,
+				identifier: "getPost",
+			},
+			expected: expected{
+				res: false,
+			},
+		},
+		{
+			name: "happy path: singleton kind selected",
+			args: args{
+				filter:     &model.Filter{Kinds: []model.ResourceKind{model.ResourceKindSchema}},
+				kind:       model.ResourceKindSchema,
+				identifier: "",
+			},
+			expected: expected{
+				res: true,
+			},
+		},
+		{
+			name: "happy path: singleton kind with include patterns",
+			args: args{
+				filter:     &model.Filter{Include: []string{"Query.*"}},
+				kind:       model.ResourceKindEnvironmentVariables,
+				identifier: "",
+			},
+			expected: expected{
+				res: false,
+			},
+		},
+		{
+			name: "happy path: singleton kind selected with include patterns",
+			args: args{
+				filter:     &model.Filter{Kinds: []model.ResourceKind{model.ResourceKindEnvironmentVariables}, Include: []string{"Query.*"}},
+				kind:       model.ResourceKindEnvironmentVariables,
+				identifier: "",
+			},
+			expected: expected{
+				res: true,
+			},
+		},
+		{
+			name: "happy path: resolver matches include pattern",
+			args: args{
+				filter:     &model.Filter{Include: []string{"Query.*"}},
+				kind:       model.ResourceKindResolver,
+				identifier: "Query.getPost",
+			},
+			expected: expected{
+				res: true,
+			},
+		},
+		{
+			name: "happy path: resolver does not match include pattern",
+			args: args{
+				filter:     &model.Filter{Include: []string{"Query.*"}},
+				kind:       model.ResourceKindResolver,
+				identifier: "Mutation.createPost",
+			},
+			expected: expected{
+				res: false,
+			},
+		},
+		{
+			name: "happy path: function matches prefixed include pattern",
+			args: args{
+				filter:     &model.Filter{Include: []string{"fn:getPost*"}},
+				kind:       model.ResourceKindFunction,
+				identifier: "getPostById",
+			},
+			expected: expected{
+				res: true,
+			},
+		},
+		{
+			name: "happy path: resolver pattern does not match function",
+			args: args{
+				filter:     &model.Filter{Include: []string{"getPost*"}},
+				kind:       model.ResourceKindFunction,
+				identifier: "getPostById",
+			},
+			expected: expected{
+				res: false,
+			},
+		},
+		{
+			name: "happy path: data source matches prefixed include pattern",
+			args: args{
+				filter:     &model.Filter{Include: []string{"ds:Post*"}},
+				kind:       model.ResourceKindDataSource,
+				identifier: "PostTable",
+			},
+			expected: expected{
+				res: true,
+			},
+		},
+		{
+			name: "happy path: resolver matches exclude pattern",
+			args: args{
+				filter:     &model.Filter{Exclude: []string{"resolver:Mutation.*"}},
+				kind:       model.ResourceKindResolver,
+				identifier: "Mutation.createPost",
+			},
+			expected: expected{
+				res: false,
+			},
+		},
+		{
+			name: "happy path: exclude pattern takes precedence over include pattern",
+			args: args{
+				filter:     &model.Filter{Include: []string{"fn:*"}, Exclude: []string{"fn:deletePost"}},
+				kind:       model.ResourceKindFunction,
+				identifier: "deletePost",
+			},
+			expected: expected{
+				res: false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &filterService{}
+
+			// Act
+			actual := s.Match(ctx, tt.args.filter, tt.args.kind, tt.args.identifier)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
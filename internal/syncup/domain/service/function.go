@@ -23,15 +23,19 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 
+	ptr "github.com/Aton-Kish/goptr"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
 )
 
 type FunctionService interface {
 	Difference(ctx context.Context, functions1, functions2 []model.Function) ([]model.Function, error)
+	Equal(ctx context.Context, function1, function2 *model.Function) (bool, error)
 }
 
 type functionService struct {
@@ -66,3 +70,30 @@ func (s *functionService) Difference(ctx context.Context, functions1, functions2
 
 	return diff, nil
 }
+
+func (s *functionService) Equal(ctx context.Context, function1, function2 *model.Function) (res bool, err error) {
+	defer wrap(&err)
+
+	if function1 == nil || function2 == nil {
+		return false, fmt.Errorf("%w: missing arguments in FunctionService.Equal method", model.ErrNilValue)
+	}
+
+	// NOTE: server-populated fields such as the function ID and ARN are excluded from the metadata
+	metadata1, err := json.Marshal(function1)
+	if err != nil {
+		return false, err
+	}
+
+	metadata2, err := json.Marshal(function2)
+	if err != nil {
+		return false, err
+	}
+
+	if !bytes.Equal(metadata1, metadata2) {
+		return false, nil
+	}
+
+	return ptr.ToValue(function1.RequestMappingTemplate) == ptr.ToValue(function2.RequestMappingTemplate) &&
+		ptr.ToValue(function1.ResponseMappingTemplate) == ptr.ToValue(function2.ResponseMappingTemplate) &&
+		ptr.ToValue(function1.Code) == ptr.ToValue(function2.Code), nil
+}
@@ -137,3 +137,115 @@ func Test_functionService_Difference(t *testing.T) {
 		})
 	}
 }
+
+func Test_functionService_Equal(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	functionVTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Function](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/VTL_2018-05-29/metadata.json")))
+	functionVTL_2018_05_29.RequestMappingTemplate = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/VTL_2018-05-29/request.vtl"))))
+	functionVTL_2018_05_29.ResponseMappingTemplate = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/VTL_2018-05-29/response.vtl"))))
+	remoteFunctionVTL_2018_05_29 := functionVTL_2018_05_29
+	remoteFunctionVTL_2018_05_29.FunctionId = ptr.Pointer("FunctionId")
+	remoteFunctionVTL_2018_05_29.FunctionArn = ptr.Pointer("FunctionArn")
+	functionAPPSYNC_JS_1_0_0 := testhelpers.MustUnmarshalJSON[model.Function](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/APPSYNC_JS_1.0.0/metadata.json")))
+	functionAPPSYNC_JS_1_0_0.Code = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/APPSYNC_JS_1.0.0/code.js"))))
+	modifiedFunctionAPPSYNC_JS_1_0_0 := functionAPPSYNC_JS_1_0_0
+	modifiedFunctionAPPSYNC_JS_1_0_0.Code = ptr.Pointer("export function request(ctx) {}\n")
+
+	type args struct {
+		function1 *model.Function
+		function2 *model.Function
+	}
+
+	type expected struct {
+		res   bool
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: identical",
+			args: args{
+				function1: &functionAPPSYNC_JS_1_0_0,
+				function2: &functionAPPSYNC_JS_1_0_0,
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different server-populated fields",
+			args: args{
+				function1: &remoteFunctionVTL_2018_05_29,
+				function2: &functionVTL_2018_05_29,
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different code",
+			args: args{
+				function1: &functionAPPSYNC_JS_1_0_0,
+				function2: &modifiedFunctionAPPSYNC_JS_1_0_0,
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different metadata",
+			args: args{
+				function1: &functionVTL_2018_05_29,
+				function2: &functionAPPSYNC_JS_1_0_0,
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil function",
+			args: args{
+				function1: &functionVTL_2018_05_29,
+				function2: nil,
+			},
+			expected: expected{
+				res:   false,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &functionService{}
+
+			// Act
+			actual, err := s.Equal(ctx, tt.args.function1, tt.args.function2)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+type GraphqlApiService interface {
+	Equal(ctx context.Context, graphqlApi1, graphqlApi2 *model.GraphqlApi) (bool, error)
+}
+
+type graphqlApiService struct {
+}
+
+func NewGraphqlApiService(repo repository.Repository) GraphqlApiService {
+	return &graphqlApiService{}
+}
+
+func (s *graphqlApiService) Equal(ctx context.Context, graphqlApi1, graphqlApi2 *model.GraphqlApi) (res bool, err error) {
+	defer wrap(&err)
+
+	if graphqlApi1 == nil || graphqlApi2 == nil {
+		return false, fmt.Errorf("%w: missing arguments in GraphqlApiService.Equal method", model.ErrNilValue)
+	}
+
+	// NOTE: server-populated fields such as the API ID and ARN are excluded, as they are when pulling api.json
+	data1, err := json.Marshal(graphqlApi1)
+	if err != nil {
+		return false, err
+	}
+
+	data2, err := json.Marshal(graphqlApi2)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(data1, data2), nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_graphqlApiService_Equal(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	graphqlApi := testhelpers.MustUnmarshalJSON[model.GraphqlApi](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "graphql_api/api.json")))
+	remoteGraphqlApi := graphqlApi
+	remoteGraphqlApi.ApiId = ptr.Pointer("ApiId")
+	remoteGraphqlApi.Arn = ptr.Pointer("Arn")
+	remoteGraphqlApi.Name = ptr.Pointer("Name")
+	modifiedGraphqlApi := graphqlApi
+	modifiedGraphqlApi.XrayEnabled = !graphqlApi.XrayEnabled
+
+	type args struct {
+		graphqlApi1 *model.GraphqlApi
+		graphqlApi2 *model.GraphqlApi
+	}
+
+	type expected struct {
+		res   bool
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: identical",
+			args: args{
+				graphqlApi1: &graphqlApi,
+				graphqlApi2: &graphqlApi,
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different server-populated fields",
+			args: args{
+				graphqlApi1: &remoteGraphqlApi,
+				graphqlApi2: &graphqlApi,
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different settings",
+			args: args{
+				graphqlApi1: &graphqlApi,
+				graphqlApi2: &modifiedGraphqlApi,
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil GraphQL API",
+			args: args{
+				graphqlApi1: &graphqlApi,
+				graphqlApi2: nil,
+			},
+			expected: expected{
+				res:   false,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &graphqlApiService{}
+
+			// Act
+			actual, err := s.Equal(ctx, tt.args.graphqlApi1, tt.args.graphqlApi2)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
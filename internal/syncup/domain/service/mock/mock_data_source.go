@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: data_source.go
+//
+// Generated by this command:
+//
+//	mockgen -source=data_source.go -destination=./mock/mock_data_source.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDataSourceService is a mock of DataSourceService interface.
+type MockDataSourceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataSourceServiceMockRecorder
+}
+
+// MockDataSourceServiceMockRecorder is the mock recorder for MockDataSourceService.
+type MockDataSourceServiceMockRecorder struct {
+	mock *MockDataSourceService
+}
+
+// NewMockDataSourceService creates a new mock instance.
+func NewMockDataSourceService(ctrl *gomock.Controller) *MockDataSourceService {
+	mock := &MockDataSourceService{ctrl: ctrl}
+	mock.recorder = &MockDataSourceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataSourceService) EXPECT() *MockDataSourceServiceMockRecorder {
+	return m.recorder
+}
+
+// Equal mocks base method.
+func (m *MockDataSourceService) Equal(ctx context.Context, dataSource1, dataSource2 *model.DataSource) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", ctx, dataSource1, dataSource2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Equal indicates an expected call of Equal.
+func (mr *MockDataSourceServiceMockRecorder) Equal(ctx, dataSource1, dataSource2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockDataSourceService)(nil).Equal), ctx, dataSource1, dataSource2)
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: diff.go
+//
+// Generated by this command:
+//
+//	mockgen -source=diff.go -destination=./mock/mock_diff.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDiffService is a mock of DiffService interface.
+type MockDiffService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiffServiceMockRecorder
+}
+
+// MockDiffServiceMockRecorder is the mock recorder for MockDiffService.
+type MockDiffServiceMockRecorder struct {
+	mock *MockDiffService
+}
+
+// NewMockDiffService creates a new mock instance.
+func NewMockDiffService(ctrl *gomock.Controller) *MockDiffService {
+	mock := &MockDiffService{ctrl: ctrl}
+	mock.recorder = &MockDiffServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiffService) EXPECT() *MockDiffServiceMockRecorder {
+	return m.recorder
+}
+
+// DiffDataSources mocks base method.
+func (m *MockDiffService) DiffDataSources(ctx context.Context, src, dst []model.DataSource) ([]model.Diff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffDataSources", ctx, src, dst)
+	ret0, _ := ret[0].([]model.Diff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffDataSources indicates an expected call of DiffDataSources.
+func (mr *MockDiffServiceMockRecorder) DiffDataSources(ctx, src, dst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffDataSources", reflect.TypeOf((*MockDiffService)(nil).DiffDataSources), ctx, src, dst)
+}
+
+// DiffEnvironmentVariables mocks base method.
+func (m *MockDiffService) DiffEnvironmentVariables(ctx context.Context, src, dst model.EnvironmentVariables) (*model.Diff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffEnvironmentVariables", ctx, src, dst)
+	ret0, _ := ret[0].(*model.Diff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffEnvironmentVariables indicates an expected call of DiffEnvironmentVariables.
+func (mr *MockDiffServiceMockRecorder) DiffEnvironmentVariables(ctx, src, dst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffEnvironmentVariables", reflect.TypeOf((*MockDiffService)(nil).DiffEnvironmentVariables), ctx, src, dst)
+}
+
+// DiffFunctions mocks base method.
+func (m *MockDiffService) DiffFunctions(ctx context.Context, src, dst []model.Function) ([]model.Diff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffFunctions", ctx, src, dst)
+	ret0, _ := ret[0].([]model.Diff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffFunctions indicates an expected call of DiffFunctions.
+func (mr *MockDiffServiceMockRecorder) DiffFunctions(ctx, src, dst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffFunctions", reflect.TypeOf((*MockDiffService)(nil).DiffFunctions), ctx, src, dst)
+}
+
+// DiffGraphqlApi mocks base method.
+func (m *MockDiffService) DiffGraphqlApi(ctx context.Context, src, dst *model.GraphqlApi) (*model.Diff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffGraphqlApi", ctx, src, dst)
+	ret0, _ := ret[0].(*model.Diff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffGraphqlApi indicates an expected call of DiffGraphqlApi.
+func (mr *MockDiffServiceMockRecorder) DiffGraphqlApi(ctx, src, dst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffGraphqlApi", reflect.TypeOf((*MockDiffService)(nil).DiffGraphqlApi), ctx, src, dst)
+}
+
+// DiffResolvers mocks base method.
+func (m *MockDiffService) DiffResolvers(ctx context.Context, src, dst []model.Resolver) ([]model.Diff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffResolvers", ctx, src, dst)
+	ret0, _ := ret[0].([]model.Diff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffResolvers indicates an expected call of DiffResolvers.
+func (mr *MockDiffServiceMockRecorder) DiffResolvers(ctx, src, dst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffResolvers", reflect.TypeOf((*MockDiffService)(nil).DiffResolvers), ctx, src, dst)
+}
+
+// DiffSchema mocks base method.
+func (m *MockDiffService) DiffSchema(ctx context.Context, src, dst *model.Schema) (*model.Diff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffSchema", ctx, src, dst)
+	ret0, _ := ret[0].(*model.Diff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffSchema indicates an expected call of DiffSchema.
+func (mr *MockDiffServiceMockRecorder) DiffSchema(ctx, src, dst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffSchema", reflect.TypeOf((*MockDiffService)(nil).DiffSchema), ctx, src, dst)
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: environment_variables.go
+//
+// Generated by this command:
+//
+//	mockgen -source=environment_variables.go -destination=./mock/mock_environment_variables.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEnvironmentVariablesService is a mock of EnvironmentVariablesService interface.
+type MockEnvironmentVariablesService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnvironmentVariablesServiceMockRecorder
+}
+
+// MockEnvironmentVariablesServiceMockRecorder is the mock recorder for MockEnvironmentVariablesService.
+type MockEnvironmentVariablesServiceMockRecorder struct {
+	mock *MockEnvironmentVariablesService
+}
+
+// NewMockEnvironmentVariablesService creates a new mock instance.
+func NewMockEnvironmentVariablesService(ctrl *gomock.Controller) *MockEnvironmentVariablesService {
+	mock := &MockEnvironmentVariablesService{ctrl: ctrl}
+	mock.recorder = &MockEnvironmentVariablesServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnvironmentVariablesService) EXPECT() *MockEnvironmentVariablesServiceMockRecorder {
+	return m.recorder
+}
+
+// Equal mocks base method.
+func (m *MockEnvironmentVariablesService) Equal(ctx context.Context, variables1, variables2 model.EnvironmentVariables) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", ctx, variables1, variables2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Equal indicates an expected call of Equal.
+func (mr *MockEnvironmentVariablesServiceMockRecorder) Equal(ctx, variables1, variables2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockEnvironmentVariablesService)(nil).Equal), ctx, variables1, variables2)
+}
+
+// Validate mocks base method.
+func (m *MockEnvironmentVariablesService) Validate(ctx context.Context, variables model.EnvironmentVariables) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", ctx, variables)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockEnvironmentVariablesServiceMockRecorder) Validate(ctx, variables any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockEnvironmentVariablesService)(nil).Validate), ctx, variables)
+}
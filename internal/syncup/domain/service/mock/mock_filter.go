@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: filter.go
+//
+// Generated by this command:
+//
+//	mockgen -source=filter.go -destination=./mock/mock_filter.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFilterService is a mock of FilterService interface.
+type MockFilterService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFilterServiceMockRecorder
+}
+
+// MockFilterServiceMockRecorder is the mock recorder for MockFilterService.
+type MockFilterServiceMockRecorder struct {
+	mock *MockFilterService
+}
+
+// NewMockFilterService creates a new mock instance.
+func NewMockFilterService(ctrl *gomock.Controller) *MockFilterService {
+	mock := &MockFilterService{ctrl: ctrl}
+	mock.recorder = &MockFilterServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFilterService) EXPECT() *MockFilterServiceMockRecorder {
+	return m.recorder
+}
+
+// Match mocks base method.
+func (m *MockFilterService) Match(ctx context.Context, filter *model.Filter, kind model.ResourceKind, identifier string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Match", ctx, filter, kind, identifier)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Match indicates an expected call of Match.
+func (mr *MockFilterServiceMockRecorder) Match(ctx, filter, kind, identifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockFilterService)(nil).Match), ctx, filter, kind, identifier)
+}
+
+// Validate mocks base method.
+func (m *MockFilterService) Validate(ctx context.Context, filter *model.Filter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", ctx, filter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockFilterServiceMockRecorder) Validate(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockFilterService)(nil).Validate), ctx, filter)
+}
@@ -54,3 +54,18 @@ func (mr *MockFunctionServiceMockRecorder) Difference(ctx, functions1, functions
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Difference", reflect.TypeOf((*MockFunctionService)(nil).Difference), ctx, functions1, functions2)
 }
+
+// Equal mocks base method.
+func (m *MockFunctionService) Equal(ctx context.Context, function1, function2 *model.Function) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", ctx, function1, function2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Equal indicates an expected call of Equal.
+func (mr *MockFunctionServiceMockRecorder) Equal(ctx, function1, function2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockFunctionService)(nil).Equal), ctx, function1, function2)
+}
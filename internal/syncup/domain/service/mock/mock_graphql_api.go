@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: graphql_api.go
+//
+// Generated by this command:
+//
+//	mockgen -source=graphql_api.go -destination=./mock/mock_graphql_api.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGraphqlApiService is a mock of GraphqlApiService interface.
+type MockGraphqlApiService struct {
+	ctrl     *gomock.Controller
+	recorder *MockGraphqlApiServiceMockRecorder
+}
+
+// MockGraphqlApiServiceMockRecorder is the mock recorder for MockGraphqlApiService.
+type MockGraphqlApiServiceMockRecorder struct {
+	mock *MockGraphqlApiService
+}
+
+// NewMockGraphqlApiService creates a new mock instance.
+func NewMockGraphqlApiService(ctrl *gomock.Controller) *MockGraphqlApiService {
+	mock := &MockGraphqlApiService{ctrl: ctrl}
+	mock.recorder = &MockGraphqlApiServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGraphqlApiService) EXPECT() *MockGraphqlApiServiceMockRecorder {
+	return m.recorder
+}
+
+// Equal mocks base method.
+func (m *MockGraphqlApiService) Equal(ctx context.Context, graphqlApi1, graphqlApi2 *model.GraphqlApi) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", ctx, graphqlApi1, graphqlApi2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Equal indicates an expected call of Equal.
+func (mr *MockGraphqlApiServiceMockRecorder) Equal(ctx, graphqlApi1, graphqlApi2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockGraphqlApiService)(nil).Equal), ctx, graphqlApi1, graphqlApi2)
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reference.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reference.go -destination=./mock/mock_reference.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReferenceService is a mock of ReferenceService interface.
+type MockReferenceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReferenceServiceMockRecorder
+}
+
+// MockReferenceServiceMockRecorder is the mock recorder for MockReferenceService.
+type MockReferenceServiceMockRecorder struct {
+	mock *MockReferenceService
+}
+
+// NewMockReferenceService creates a new mock instance.
+func NewMockReferenceService(ctrl *gomock.Controller) *MockReferenceService {
+	mock := &MockReferenceService{ctrl: ctrl}
+	mock.recorder = &MockReferenceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReferenceService) EXPECT() *MockReferenceServiceMockRecorder {
+	return m.recorder
+}
+
+// OrphanedResolvers mocks base method.
+func (m *MockReferenceService) OrphanedResolvers(ctx context.Context, schemaFiles []model.SchemaFile, resolvers []model.Resolver) ([]model.Resolver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OrphanedResolvers", ctx, schemaFiles, resolvers)
+	ret0, _ := ret[0].([]model.Resolver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OrphanedResolvers indicates an expected call of OrphanedResolvers.
+func (mr *MockReferenceServiceMockRecorder) OrphanedResolvers(ctx, schemaFiles, resolvers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OrphanedResolvers", reflect.TypeOf((*MockReferenceService)(nil).OrphanedResolvers), ctx, schemaFiles, resolvers)
+}
+
+// Validate mocks base method.
+func (m *MockReferenceService) Validate(ctx context.Context, schemaFiles []model.SchemaFile, functions []model.Function, resolvers []model.Resolver) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", ctx, schemaFiles, functions, resolvers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockReferenceServiceMockRecorder) Validate(ctx, schemaFiles, functions, resolvers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockReferenceService)(nil).Validate), ctx, schemaFiles, functions, resolvers)
+}
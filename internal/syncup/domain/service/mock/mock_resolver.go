@@ -55,6 +55,21 @@ func (mr *MockResolverServiceMockRecorder) Difference(ctx, resolvers1, resolvers
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Difference", reflect.TypeOf((*MockResolverService)(nil).Difference), ctx, resolvers1, resolvers2)
 }
 
+// Equal mocks base method.
+func (m *MockResolverService) Equal(ctx context.Context, resolver1, resolver2 *model.Resolver) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", ctx, resolver1, resolver2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Equal indicates an expected call of Equal.
+func (mr *MockResolverServiceMockRecorder) Equal(ctx, resolver1, resolver2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockResolverService)(nil).Equal), ctx, resolver1, resolver2)
+}
+
 // ResolvePipelineConfigFunctionIDs mocks base method.
 func (m *MockResolverService) ResolvePipelineConfigFunctionIDs(ctx context.Context, resolver *model.Resolver, functions []model.Function) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: schema.go
+//
+// Generated by this command:
+//
+//	mockgen -source=schema.go -destination=./mock/mock_schema.go
+//
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	reflect "reflect"
+
+	model "github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSchemaService is a mock of SchemaService interface.
+type MockSchemaService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchemaServiceMockRecorder
+}
+
+// MockSchemaServiceMockRecorder is the mock recorder for MockSchemaService.
+type MockSchemaServiceMockRecorder struct {
+	mock *MockSchemaService
+}
+
+// NewMockSchemaService creates a new mock instance.
+func NewMockSchemaService(ctrl *gomock.Controller) *MockSchemaService {
+	mock := &MockSchemaService{ctrl: ctrl}
+	mock.recorder = &MockSchemaServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchemaService) EXPECT() *MockSchemaServiceMockRecorder {
+	return m.recorder
+}
+
+// Compare mocks base method.
+func (m *MockSchemaService) Compare(ctx context.Context, schema1, schema2 *model.Schema, resolvers []model.Resolver) ([]model.SchemaChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compare", ctx, schema1, schema2, resolvers)
+	ret0, _ := ret[0].([]model.SchemaChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Compare indicates an expected call of Compare.
+func (mr *MockSchemaServiceMockRecorder) Compare(ctx, schema1, schema2, resolvers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compare", reflect.TypeOf((*MockSchemaService)(nil).Compare), ctx, schema1, schema2, resolvers)
+}
+
+// Equal mocks base method.
+func (m *MockSchemaService) Equal(ctx context.Context, schema1, schema2 *model.Schema) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Equal", ctx, schema1, schema2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Equal indicates an expected call of Equal.
+func (mr *MockSchemaServiceMockRecorder) Equal(ctx, schema1, schema2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Equal", reflect.TypeOf((*MockSchemaService)(nil).Equal), ctx, schema1, schema2)
+}
+
+// Format mocks base method.
+func (m *MockSchemaService) Format(ctx context.Context, files []model.SchemaFile, optFns ...func(*model.SchemaFormatOptions)) ([]model.SchemaFile, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, files}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Format", varargs...)
+	ret0, _ := ret[0].([]model.SchemaFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Format indicates an expected call of Format.
+func (mr *MockSchemaServiceMockRecorder) Format(ctx, files any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, files}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Format", reflect.TypeOf((*MockSchemaService)(nil).Format), varargs...)
+}
+
+// Validate mocks base method.
+func (m *MockSchemaService) Validate(ctx context.Context, files []model.SchemaFile) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Validate", ctx, files)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Validate indicates an expected call of Validate.
+func (mr *MockSchemaServiceMockRecorder) Validate(ctx, files any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockSchemaService)(nil).Validate), ctx, files)
+}
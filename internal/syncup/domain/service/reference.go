@@ -0,0 +1,141 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+type ReferenceService interface {
+	Validate(ctx context.Context, schemaFiles []model.SchemaFile, functions []model.Function, resolvers []model.Resolver) error
+	OrphanedResolvers(ctx context.Context, schemaFiles []model.SchemaFile, resolvers []model.Resolver) ([]model.Resolver, error)
+}
+
+type referenceService struct {
+}
+
+func NewReferenceService(repo repository.Repository) ReferenceService {
+	return &referenceService{}
+}
+
+func (s *referenceService) Validate(ctx context.Context, schemaFiles []model.SchemaFile, functions []model.Function, resolvers []model.Resolver) (err error) {
+	defer wrap(&err)
+
+	if len(schemaFiles) == 0 {
+		return fmt.Errorf("%w: missing arguments in ReferenceService.Validate method", model.ErrNilValue)
+	}
+
+	schema, err := loadSchema(schemaFiles)
+	if err != nil {
+		return err
+	}
+
+	fnNames := make(map[string]bool)
+	for _, fn := range functions {
+		if fn.Name == nil {
+			return fmt.Errorf("%w: missing name", model.ErrNilValue)
+		}
+
+		fnNames[*fn.Name] = true
+	}
+
+	rslvs := slices.Clone(resolvers)
+	for _, rslv := range rslvs {
+		if rslv.TypeName == nil {
+			return fmt.Errorf("%w: missing type name", model.ErrNilValue)
+		}
+
+		if rslv.FieldName == nil {
+			return fmt.Errorf("%w: missing field name", model.ErrNilValue)
+		}
+	}
+
+	// NOTE: resolvers loaded in parallel come in no particular order, so sort them to report errors deterministically
+	slices.SortFunc(rslvs, func(a, b model.Resolver) int {
+		return cmp.Or(cmp.Compare(*a.TypeName, *b.TypeName), cmp.Compare(*a.FieldName, *b.FieldName))
+	})
+
+	errs := make([]error, 0)
+	for _, rslv := range rslvs {
+		identifier := fmt.Sprintf("%s.%s", *rslv.TypeName, *rslv.FieldName)
+
+		def := schema.Types[*rslv.TypeName]
+		switch {
+		case def == nil:
+			errs = append(errs, fmt.Errorf("%w: resolver %s: type %s is not defined in the schema", model.ErrInvalidValue, identifier, *rslv.TypeName))
+		case def.Fields.ForName(*rslv.FieldName) == nil:
+			errs = append(errs, fmt.Errorf("%w: resolver %s: field %s is not defined in the schema", model.ErrInvalidValue, identifier, identifier))
+		}
+
+		for _, name := range ptr.ToValue(rslv.PipelineConfig).FunctionNames {
+			if !fnNames[name] {
+				errs = append(errs, fmt.Errorf("%w: resolver %s: pipeline function %s does not exist", model.ErrInvalidValue, identifier, name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// OrphanedResolvers returns the resolvers whose type or field is not defined in the schema, sorted by type and field name.
+func (s *referenceService) OrphanedResolvers(ctx context.Context, schemaFiles []model.SchemaFile, resolvers []model.Resolver) (res []model.Resolver, err error) {
+	defer wrap(&err)
+
+	if len(schemaFiles) == 0 {
+		return nil, fmt.Errorf("%w: missing arguments in ReferenceService.OrphanedResolvers method", model.ErrNilValue)
+	}
+
+	schema, err := loadSchema(schemaFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	orphans := make([]model.Resolver, 0)
+	for _, rslv := range resolvers {
+		if rslv.TypeName == nil {
+			return nil, fmt.Errorf("%w: missing type name", model.ErrNilValue)
+		}
+
+		if rslv.FieldName == nil {
+			return nil, fmt.Errorf("%w: missing field name", model.ErrNilValue)
+		}
+
+		if def := schema.Types[*rslv.TypeName]; def == nil || def.Fields.ForName(*rslv.FieldName) == nil {
+			orphans = append(orphans, rslv)
+		}
+	}
+
+	slices.SortFunc(orphans, func(a, b model.Resolver) int {
+		return cmp.Or(cmp.Compare(*a.TypeName, *b.TypeName), cmp.Compare(*a.FieldName, *b.FieldName))
+	})
+
+	return orphans, nil
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_referenceService_Validate(t *testing.T) {
+	schemaFiles := []model.SchemaFile{
+		{
+			Path:    "schema.graphqls",
+			Content: "type Query {\n  getPost(id: ID!): Post\n  listPosts: [Post]\n}\n\ntype Post {\n  id: ID!\n  createdAt: AWSDateTime\n}\n",
+		},
+	}
+
+	type args struct {
+		schemaFiles []model.SchemaFile
+		functions   []model.Function
+		resolvers   []model.Resolver
+	}
+
+	type expected struct {
+		errMessages []string
+		errIs       error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				schemaFiles: schemaFiles,
+				functions: []model.Function{
+					{Name: ptr.Pointer("getPost")},
+				},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("getPost"), PipelineConfig: &model.PipelineConfig{FunctionNames: []string{"getPost"}}},
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("listPosts")},
+				},
+			},
+			expected: expected{
+				errMessages: nil,
+				errIs:       nil,
+			},
+		},
+		{
+			name: "edge path: undefined type and field",
+			args: args{
+				schemaFiles: schemaFiles,
+				functions:   []model.Function{},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("getComment")},
+					{TypeName: ptr.Pointer("Mutation"), FieldName: ptr.Pointer("createPost")},
+				},
+			},
+			expected: expected{
+				errMessages: []string{
+					"resolver Mutation.createPost: type Mutation is not defined in the schema",
+					"resolver Query.getComment: field Query.getComment is not defined in the schema",
+				},
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: missing pipeline function",
+			args: args{
+				schemaFiles: schemaFiles,
+				functions: []model.Function{
+					{Name: ptr.Pointer("getPost")},
+				},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("getPost"), PipelineConfig: &model.PipelineConfig{FunctionNames: []string{"authorize", "getPost"}}},
+				},
+			},
+			expected: expected{
+				errMessages: []string{
+					"resolver Query.getPost: pipeline function authorize does not exist",
+				},
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: invalid schema",
+			args: args{
+				schemaFiles: []model.SchemaFile{
+					{Path: "schema.graphqls", Content: "type Query {\n  hello String\n}\n"},
+				},
+				functions: []model.Function{},
+				resolvers: []model.Resolver{},
+			},
+			expected: expected{
+				errMessages: nil,
+				errIs:       model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: missing resolver field name",
+			args: args{
+				schemaFiles: schemaFiles,
+				functions:   []model.Function{},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query")},
+				},
+			},
+			expected: expected{
+				errMessages: nil,
+				errIs:       model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: no schema files",
+			args: args{
+				schemaFiles: nil,
+				functions:   []model.Function{},
+				resolvers:   []model.Resolver{},
+			},
+			expected: expected{
+				errMessages: nil,
+				errIs:       model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &referenceService{}
+
+			// Act
+			err := s.Validate(ctx, tt.args.schemaFiles, tt.args.functions, tt.args.resolvers)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+
+				for _, msg := range tt.expected.errMessages {
+					assert.ErrorContains(t, err, msg)
+				}
+			}
+		})
+	}
+}
+
+func Test_referenceService_OrphanedResolvers(t *testing.T) {
+	schemaFiles := []model.SchemaFile{
+		{
+			Path:    "schema.graphqls",
+			Content: "type Query {\n  getPost(id: ID!): Post\n  listPosts: [Post]\n}\n\ntype Post {\n  id: ID!\n  createdAt: AWSDateTime\n}\n",
+		},
+	}
+
+	type args struct {
+		schemaFiles []model.SchemaFile
+		resolvers   []model.Resolver
+	}
+
+	type expected struct {
+		res   []model.Resolver
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				schemaFiles: schemaFiles,
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("listPosts")},
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("getComment")},
+					{TypeName: ptr.Pointer("Comment"), FieldName: ptr.Pointer("post")},
+					{TypeName: ptr.Pointer("Post"), FieldName: ptr.Pointer("createdAt")},
+				},
+			},
+			expected: expected{
+				res: []model.Resolver{
+					{TypeName: ptr.Pointer("Comment"), FieldName: ptr.Pointer("post")},
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("getComment")},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: no orphaned resolvers",
+			args: args{
+				schemaFiles: schemaFiles,
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("getPost")},
+				},
+			},
+			expected: expected{
+				res:   []model.Resolver{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: syntax error",
+			args: args{
+				schemaFiles: []model.SchemaFile{
+					{Path: "schema.graphqls", Content: "type Query {\n"},
+				},
+				resolvers: []model.Resolver{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: missing field name",
+			args: args{
+				schemaFiles: schemaFiles,
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query")},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: no schema files",
+			args: args{
+				schemaFiles: nil,
+				resolvers:   []model.Resolver{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &referenceService{}
+
+			// Act
+			actual, err := s.OrphanedResolvers(ctx, tt.args.schemaFiles, tt.args.resolvers)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -23,15 +23,20 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 
+	ptr "github.com/Aton-Kish/goptr"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
 )
 
 type ResolverService interface {
 	Difference(ctx context.Context, resolvers1, resolvers2 []model.Resolver) ([]model.Resolver, error)
+	Equal(ctx context.Context, resolver1, resolver2 *model.Resolver) (bool, error)
 
 	ResolvePipelineConfigFunctionIDs(ctx context.Context, resolver *model.Resolver, functions []model.Function) error
 	ResolvePipelineConfigFunctionNames(ctx context.Context, resolver *model.Resolver, functions []model.Function) error
@@ -78,6 +83,43 @@ func (s *resolverService) Difference(ctx context.Context, resolvers1, resolvers2
 	return diff, nil
 }
 
+func (s *resolverService) Equal(ctx context.Context, resolver1, resolver2 *model.Resolver) (res bool, err error) {
+	defer wrap(&err)
+
+	if resolver1 == nil || resolver2 == nil {
+		return false, fmt.Errorf("%w: missing arguments in ResolverService.Equal method", model.ErrNilValue)
+	}
+
+	// NOTE: pipeline functions are compared by ID, since resolvers fetched from AppSync have no function names
+	r1, r2 := *resolver1, *resolver2
+	if r1.PipelineConfig != nil && r2.PipelineConfig != nil {
+		if !slices.Equal(r1.PipelineConfig.Functions, r2.PipelineConfig.Functions) {
+			return false, nil
+		}
+
+		r1.PipelineConfig = &model.PipelineConfig{}
+		r2.PipelineConfig = &model.PipelineConfig{}
+	}
+
+	metadata1, err := json.Marshal(&r1)
+	if err != nil {
+		return false, err
+	}
+
+	metadata2, err := json.Marshal(&r2)
+	if err != nil {
+		return false, err
+	}
+
+	if !bytes.Equal(metadata1, metadata2) {
+		return false, nil
+	}
+
+	return ptr.ToValue(r1.RequestMappingTemplate) == ptr.ToValue(r2.RequestMappingTemplate) &&
+		ptr.ToValue(r1.ResponseMappingTemplate) == ptr.ToValue(r2.ResponseMappingTemplate) &&
+		ptr.ToValue(r1.Code) == ptr.ToValue(r2.Code), nil
+}
+
 func (s *resolverService) ResolvePipelineConfigFunctionIDs(ctx context.Context, resolver *model.Resolver, functions []model.Function) (err error) {
 	defer wrap(&err)
 
@@ -145,6 +145,125 @@ func Test_resolverService_Difference(t *testing.T) {
 	}
 }
 
+func Test_resolverService_Equal(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	resolverPIPELINE_APPSYNC_JS_1_0_0 := testhelpers.MustUnmarshalJSON[model.Resolver](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/PIPELINE/APPSYNC_JS_1.0.0/metadata.json")))
+	resolverPIPELINE_APPSYNC_JS_1_0_0.PipelineConfig.Functions = []string{"VTL_2018-05-29", "APPSYNC_JS_1.0.0"}
+	resolverPIPELINE_APPSYNC_JS_1_0_0.Code = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/PIPELINE/APPSYNC_JS_1.0.0/code.js"))))
+	remoteResolverPIPELINE_APPSYNC_JS_1_0_0 := resolverPIPELINE_APPSYNC_JS_1_0_0
+	remoteResolverPIPELINE_APPSYNC_JS_1_0_0.ResolverArn = ptr.Pointer("ResolverArn")
+	remoteResolverPIPELINE_APPSYNC_JS_1_0_0.PipelineConfig = &model.PipelineConfig{
+		Functions: []string{"VTL_2018-05-29", "APPSYNC_JS_1.0.0"},
+	}
+	reorderedResolverPIPELINE_APPSYNC_JS_1_0_0 := resolverPIPELINE_APPSYNC_JS_1_0_0
+	reorderedResolverPIPELINE_APPSYNC_JS_1_0_0.PipelineConfig = &model.PipelineConfig{
+		Functions: []string{"APPSYNC_JS_1.0.0", "VTL_2018-05-29"},
+	}
+	resolverUNIT_VTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Resolver](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/UNIT/VTL_2018-05-29/metadata.json")))
+	resolverUNIT_VTL_2018_05_29.RequestMappingTemplate = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/UNIT/VTL_2018-05-29/request.vtl"))))
+	resolverUNIT_VTL_2018_05_29.ResponseMappingTemplate = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/UNIT/VTL_2018-05-29/response.vtl"))))
+	modifiedResolverUNIT_VTL_2018_05_29 := resolverUNIT_VTL_2018_05_29
+	modifiedResolverUNIT_VTL_2018_05_29.ResponseMappingTemplate = ptr.Pointer("$util.toJson($ctx.result)\n")
+
+	type args struct {
+		resolver1 *model.Resolver
+		resolver2 *model.Resolver
+	}
+
+	type expected struct {
+		res   bool
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: identical",
+			args: args{
+				resolver1: &resolverUNIT_VTL_2018_05_29,
+				resolver2: &resolverUNIT_VTL_2018_05_29,
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different server-populated fields",
+			args: args{
+				resolver1: &remoteResolverPIPELINE_APPSYNC_JS_1_0_0,
+				resolver2: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different pipeline functions",
+			args: args{
+				resolver1: &remoteResolverPIPELINE_APPSYNC_JS_1_0_0,
+				resolver2: &reorderedResolverPIPELINE_APPSYNC_JS_1_0_0,
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different mapping template",
+			args: args{
+				resolver1: &resolverUNIT_VTL_2018_05_29,
+				resolver2: &modifiedResolverUNIT_VTL_2018_05_29,
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil resolver",
+			args: args{
+				resolver1: nil,
+				resolver2: &resolverUNIT_VTL_2018_05_29,
+			},
+			expected: expected{
+				res:   false,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &resolverService{}
+
+			// Act
+			actual, err := s.Equal(ctx, tt.args.resolver1, tt.args.resolver2)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
 func Test_resolverService_ResolvePipelineConfigFunctionIDs(t *testing.T) {
 	type args struct {
 		resolver  *model.Resolver
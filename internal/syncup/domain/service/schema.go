@@ -0,0 +1,486 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package service
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// NOTE: AppSync provides these scalars and directives implicitly, so a schema
+// using them is only valid once they are declared alongside it.
+var appsyncPrelude = &ast.Source{
+	Name: "appsync.graphql",
+	Input: `scalar AWSDate
+scalar AWSTime
+scalar AWSDateTime
+scalar AWSTimestamp
+scalar AWSEmail
+scalar AWSJSON
+scalar AWSURL
+scalar AWSPhone
+scalar AWSIPAddress
+
+directive @aws_api_key on OBJECT | FIELD_DEFINITION
+directive @aws_iam on OBJECT | FIELD_DEFINITION
+directive @aws_oidc on OBJECT | FIELD_DEFINITION
+directive @aws_lambda on OBJECT | FIELD_DEFINITION
+directive @aws_cognito_user_pools(cognito_groups: [String]) on OBJECT | FIELD_DEFINITION
+directive @aws_auth(cognito_groups: [String]) on FIELD_DEFINITION
+directive @aws_subscribe(mutations: [String]) on FIELD_DEFINITION
+directive @aws_publish(subscriptions: [String]) on FIELD_DEFINITION
+`,
+	BuiltIn: true,
+}
+
+type SchemaService interface {
+	Equal(ctx context.Context, schema1, schema2 *model.Schema) (bool, error)
+	Validate(ctx context.Context, files []model.SchemaFile) error
+	Format(ctx context.Context, files []model.SchemaFile, optFns ...func(o *model.SchemaFormatOptions)) ([]model.SchemaFile, error)
+	Compare(ctx context.Context, schema1, schema2 *model.Schema, resolvers []model.Resolver) ([]model.SchemaChange, error)
+}
+
+type schemaService struct {
+}
+
+func NewSchemaService(repo repository.Repository) SchemaService {
+	return &schemaService{}
+}
+
+func (s *schemaService) Equal(ctx context.Context, schema1, schema2 *model.Schema) (res bool, err error) {
+	defer wrap(&err)
+
+	if schema1 == nil || schema2 == nil {
+		return false, fmt.Errorf("%w: missing arguments in SchemaService.Equal method", model.ErrNilValue)
+	}
+
+	// NOTE: AppSync may reorder and reindent the schema it returns, so that the formatted schemas are compared if both parse
+	formatted, err := s.Format(ctx, []model.SchemaFile{{Content: string(*schema1)}, {Content: string(*schema2)}}, model.SchemaFormatOptionsWithSort(true))
+	if err != nil {
+		return s.normalize(schema1) == s.normalize(schema2), nil
+	}
+
+	return formatted[0].Content == formatted[1].Content, nil
+}
+
+func (s *schemaService) Validate(ctx context.Context, files []model.SchemaFile) (err error) {
+	defer wrap(&err)
+
+	if len(files) == 0 {
+		return fmt.Errorf("%w: missing arguments in SchemaService.Validate method", model.ErrNilValue)
+	}
+
+	if _, err := loadSchema(files); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Format formats each schema file on its own, so that the files keep the definitions they hold.
+func (s *schemaService) Format(ctx context.Context, files []model.SchemaFile, optFns ...func(o *model.SchemaFormatOptions)) (res []model.SchemaFile, err error) {
+	defer wrap(&err)
+
+	o := model.NewSchemaFormatOptions(optFns...)
+
+	res = make([]model.SchemaFile, 0, len(files))
+	for _, file := range files {
+		doc, err := parser.ParseSchema(&ast.Source{Name: file.Path, Input: file.Content})
+		if err != nil {
+			return nil, schemaErrorOf(err)
+		}
+
+		if o.Sort {
+			sortSchemaDocument(doc)
+		}
+
+		res = append(res, model.SchemaFile{Path: file.Path, Content: formatSchemaDocument(doc)})
+	}
+
+	return res, nil
+}
+
+// Compare lists the changes from schema1 to schema2, the most severe first.
+// The resolvers tell which removed types are still in use.
+func (s *schemaService) Compare(ctx context.Context, schema1, schema2 *model.Schema, resolvers []model.Resolver) (res []model.SchemaChange, err error) {
+	defer wrap(&err)
+
+	if schema1 == nil || schema2 == nil {
+		return nil, fmt.Errorf("%w: missing arguments in SchemaService.Compare method", model.ErrNilValue)
+	}
+
+	ast2, err := loadSchema([]model.SchemaFile{{Path: "local", Content: string(*schema2)}})
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: AppSync may return a schema the parser does not support, so that it is compared as text as Equal does, and an unknown change is reported as dangerous
+	ast1, err := loadSchema([]model.SchemaFile{{Path: "remote", Content: string(*schema1)}})
+	if err != nil {
+		if s.normalize(schema1) == s.normalize(schema2) {
+			return []model.SchemaChange{}, nil
+		}
+
+		return []model.SchemaChange{
+			{Level: model.SchemaChangeLevelDangerous, Message: "the remote schema could not be parsed, so the changes could not be classified"},
+		}, nil
+	}
+
+	typeToFields := make(map[string][]string)
+	for _, rslv := range resolvers {
+		typeName, fieldName := ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName)
+		typeToFields[typeName] = append(typeToFields[typeName], fmt.Sprintf("%s.%s", typeName, fieldName))
+	}
+
+	c := &schemaComparator{changes: make([]model.SchemaChange, 0)}
+
+	for _, name := range sortedTypeNames(ast1) {
+		def1 := ast1.Types[name]
+
+		def2, ok := ast2.Types[name]
+		if !ok {
+			// NOTE: the fields that return a removed type are reported on their own, so the type itself only breaks the resolvers attached to it
+			if fields := typeToFields[name]; len(fields) > 0 {
+				slices.Sort(fields)
+				c.add(model.SchemaChangeLevelBreaking, name, "type %s was removed but is still used by resolvers %s", name, strings.Join(fields, ", "))
+			} else {
+				c.add(model.SchemaChangeLevelDangerous, name, "type %s was removed", name)
+			}
+
+			continue
+		}
+
+		c.compareDefinitions(def1, def2)
+	}
+
+	for _, name := range sortedTypeNames(ast2) {
+		if _, ok := ast1.Types[name]; !ok {
+			c.add(model.SchemaChangeLevelSafe, name, "type %s was added", name)
+		}
+	}
+
+	slices.SortStableFunc(c.changes, func(a, b model.SchemaChange) int {
+		return cmp.Compare(schemaChangeLevelRank(a.Level), schemaChangeLevelRank(b.Level))
+	})
+
+	return c.changes, nil
+}
+
+func loadSchema(files []model.SchemaFile) (*ast.Schema, error) {
+	sources := []*ast.Source{appsyncPrelude}
+	for _, file := range files {
+		sources = append(sources, &ast.Source{Name: file.Path, Input: file.Content})
+	}
+
+	schema, err := gqlparser.LoadSchema(sources...)
+	if err != nil {
+		return nil, schemaErrorOf(err)
+	}
+
+	return schema, nil
+}
+
+func schemaErrorOf(err error) error {
+	var gqlErr *gqlerror.Error
+	if !errors.As(err, &gqlErr) {
+		return fmt.Errorf("%w: %w", model.ErrInvalidValue, err)
+	}
+
+	schemaErr := &model.SchemaError{Message: gqlErr.Message}
+	if path, ok := gqlErr.Extensions["file"].(string); ok {
+		schemaErr.Path = path
+	}
+	if len(gqlErr.Locations) > 0 {
+		schemaErr.Line = gqlErr.Locations[0].Line
+		schemaErr.Column = gqlErr.Locations[0].Column
+	}
+
+	return schemaErr
+}
+
+func sortSchemaDocument(doc *ast.SchemaDocument) {
+	byName := func(a, b *ast.Definition) int { return cmp.Compare(a.Name, b.Name) }
+
+	slices.SortStableFunc(doc.Directives, func(a, b *ast.DirectiveDefinition) int { return cmp.Compare(a.Name, b.Name) })
+	slices.SortStableFunc(doc.Definitions, byName)
+	slices.SortStableFunc(doc.Extensions, byName)
+
+	for _, def := range slices.Concat(doc.Definitions, doc.Extensions) {
+		slices.SortStableFunc(def.Fields, func(a, b *ast.FieldDefinition) int { return cmp.Compare(a.Name, b.Name) })
+		slices.SortStableFunc(def.EnumValues, func(a, b *ast.EnumValueDefinition) int { return cmp.Compare(a.Name, b.Name) })
+	}
+}
+
+// formatSchemaDocument prints the definitions with two-space indents, block string descriptions and a blank line between them.
+func formatSchemaDocument(doc *ast.SchemaDocument) string {
+	docs := make([]*ast.SchemaDocument, 0, 2+len(doc.Directives)+len(doc.Definitions)+len(doc.Extensions)+1)
+	if len(doc.Schema) > 0 {
+		docs = append(docs, &ast.SchemaDocument{Schema: doc.Schema})
+	}
+	if len(doc.SchemaExtension) > 0 {
+		docs = append(docs, &ast.SchemaDocument{SchemaExtension: doc.SchemaExtension})
+	}
+	for _, def := range doc.Directives {
+		docs = append(docs, &ast.SchemaDocument{Directives: ast.DirectiveDefinitionList{def}})
+	}
+	for _, def := range doc.Definitions {
+		docs = append(docs, &ast.SchemaDocument{Definitions: ast.DefinitionList{def}})
+	}
+	for _, def := range doc.Extensions {
+		docs = append(docs, &ast.SchemaDocument{Extensions: ast.DefinitionList{def}})
+	}
+	docs = append(docs, &ast.SchemaDocument{Comment: doc.Comment})
+
+	blocks := make([]string, 0, len(docs))
+	for _, d := range docs {
+		var buf bytes.Buffer
+		formatter.NewFormatter(&buf, formatter.WithComments(), formatter.WithIndent("  ")).FormatSchemaDocument(d)
+
+		if block := strings.Trim(buf.String(), "\n"); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+
+	if len(blocks) == 0 {
+		return ""
+	}
+
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+func (s *schemaService) normalize(schema *model.Schema) string {
+	lines := strings.Split(strings.ReplaceAll(string(*schema), "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
+
+type schemaComparator struct {
+	changes []model.SchemaChange
+}
+
+func (c *schemaComparator) add(level model.SchemaChangeLevel, path string, format string, a ...any) {
+	c.changes = append(c.changes, model.SchemaChange{Level: level, Path: path, Message: fmt.Sprintf(format, a...)})
+}
+
+func (c *schemaComparator) compareDefinitions(def1, def2 *ast.Definition) {
+	if def1.Kind != def2.Kind {
+		c.add(model.SchemaChangeLevelBreaking, def1.Name, "type %s changed kind from %s to %s", def1.Name, def1.Kind, def2.Kind)
+		return
+	}
+
+	switch def1.Kind {
+	case ast.Object, ast.Interface:
+		c.compareOutputFields(def1, def2)
+		c.compareNames(def1.Name, "interface", def1.Interfaces, def2.Interfaces, model.SchemaChangeLevelSafe)
+	case ast.InputObject:
+		c.compareInputFields(def1, def2)
+	case ast.Union:
+		c.compareNames(def1.Name, "member", def1.Types, def2.Types, model.SchemaChangeLevelDangerous)
+	case ast.Enum:
+		values1 := make([]string, 0, len(def1.EnumValues))
+		for _, v := range def1.EnumValues {
+			values1 = append(values1, v.Name)
+		}
+
+		values2 := make([]string, 0, len(def2.EnumValues))
+		for _, v := range def2.EnumValues {
+			values2 = append(values2, v.Name)
+		}
+
+		// NOTE: clients that switch over the values may not handle a new one
+		c.compareNames(def1.Name, "enum value", values1, values2, model.SchemaChangeLevelDangerous)
+	}
+}
+
+// compareNames reports the removed names as breaking and the added ones at the given level.
+func (c *schemaComparator) compareNames(typeName string, noun string, names1, names2 []string, addedLevel model.SchemaChangeLevel) {
+	for _, name := range names1 {
+		if !slices.Contains(names2, name) {
+			c.add(model.SchemaChangeLevelBreaking, fmt.Sprintf("%s.%s", typeName, name), "%s %s was removed from %s", noun, name, typeName)
+		}
+	}
+
+	for _, name := range names2 {
+		if !slices.Contains(names1, name) {
+			c.add(addedLevel, fmt.Sprintf("%s.%s", typeName, name), "%s %s was added to %s", noun, name, typeName)
+		}
+	}
+}
+
+func (c *schemaComparator) compareOutputFields(def1, def2 *ast.Definition) {
+	for _, f1 := range def1.Fields {
+		path := fmt.Sprintf("%s.%s", def1.Name, f1.Name)
+
+		f2 := def2.Fields.ForName(f1.Name)
+		if f2 == nil {
+			c.add(model.SchemaChangeLevelBreaking, path, "field %s was removed", path)
+			continue
+		}
+
+		if f1.Type.String() != f2.Type.String() {
+			c.add(outputTypeChangeLevel(f1.Type, f2.Type), path, "field %s changed type from %s to %s", path, f1.Type, f2.Type)
+		}
+
+		c.compareArguments(path, f1.Arguments, f2.Arguments)
+	}
+
+	for _, f2 := range def2.Fields {
+		if def1.Fields.ForName(f2.Name) == nil {
+			path := fmt.Sprintf("%s.%s", def2.Name, f2.Name)
+			c.add(model.SchemaChangeLevelSafe, path, "field %s was added", path)
+		}
+	}
+}
+
+func (c *schemaComparator) compareInputFields(def1, def2 *ast.Definition) {
+	for _, f1 := range def1.Fields {
+		path := fmt.Sprintf("%s.%s", def1.Name, f1.Name)
+
+		f2 := def2.Fields.ForName(f1.Name)
+		if f2 == nil {
+			c.add(model.SchemaChangeLevelBreaking, path, "input field %s was removed", path)
+			continue
+		}
+
+		c.compareInputValues(path, "input field", f1.Type, f2.Type, f1.DefaultValue, f2.DefaultValue)
+	}
+
+	for _, f2 := range def2.Fields {
+		if def1.Fields.ForName(f2.Name) == nil {
+			path := fmt.Sprintf("%s.%s", def2.Name, f2.Name)
+			c.addInputValue(path, "input field", f2.Type, f2.DefaultValue)
+		}
+	}
+}
+
+func (c *schemaComparator) compareArguments(fieldPath string, args1, args2 ast.ArgumentDefinitionList) {
+	for _, a1 := range args1 {
+		path := fmt.Sprintf("%s(%s:)", fieldPath, a1.Name)
+
+		a2 := args2.ForName(a1.Name)
+		if a2 == nil {
+			c.add(model.SchemaChangeLevelBreaking, path, "argument %s was removed", path)
+			continue
+		}
+
+		c.compareInputValues(path, "argument", a1.Type, a2.Type, a1.DefaultValue, a2.DefaultValue)
+	}
+
+	for _, a2 := range args2 {
+		if args1.ForName(a2.Name) == nil {
+			c.addInputValue(fmt.Sprintf("%s(%s:)", fieldPath, a2.Name), "argument", a2.Type, a2.DefaultValue)
+		}
+	}
+}
+
+func (c *schemaComparator) compareInputValues(path string, noun string, type1, type2 *ast.Type, default1, default2 *ast.Value) {
+	if type1.String() != type2.String() {
+		c.add(inputTypeChangeLevel(type1, type2), path, "%s %s changed type from %s to %s", noun, path, type1, type2)
+	}
+
+	if valueString(default1) != valueString(default2) {
+		c.add(model.SchemaChangeLevelDangerous, path, "%s %s changed default value from %s to %s", noun, path, valueString(default1), valueString(default2))
+	}
+}
+
+func (c *schemaComparator) addInputValue(path string, noun string, typ *ast.Type, defaultValue *ast.Value) {
+	if typ.NonNull && defaultValue == nil {
+		c.add(model.SchemaChangeLevelBreaking, path, "required %s %s was added", noun, path)
+		return
+	}
+
+	c.add(model.SchemaChangeLevelSafe, path, "%s %s was added", noun, path)
+}
+
+// outputTypeChangeLevel classifies a changed field type: clients may not handle null from a field that became nullable, and resolvers returning null fail on a field that became non-null.
+func outputTypeChangeLevel(type1, type2 *ast.Type) model.SchemaChangeLevel {
+	if type1.NamedType != type2.NamedType || (type1.Elem == nil) != (type2.Elem == nil) || type1.NonNull != type2.NonNull {
+		return model.SchemaChangeLevelBreaking
+	}
+
+	if type1.Elem != nil {
+		return outputTypeChangeLevel(type1.Elem, type2.Elem)
+	}
+
+	return model.SchemaChangeLevelSafe
+}
+
+// inputTypeChangeLevel classifies a changed argument or input field type: clients may omit or pass null to it unless it was non-null already.
+func inputTypeChangeLevel(type1, type2 *ast.Type) model.SchemaChangeLevel {
+	if type1.NamedType != type2.NamedType || (type1.Elem == nil) != (type2.Elem == nil) || (!type1.NonNull && type2.NonNull) {
+		return model.SchemaChangeLevelBreaking
+	}
+
+	if type1.Elem != nil {
+		return inputTypeChangeLevel(type1.Elem, type2.Elem)
+	}
+
+	return model.SchemaChangeLevelSafe
+}
+
+func schemaChangeLevelRank(level model.SchemaChangeLevel) int {
+	switch level {
+	case model.SchemaChangeLevelBreaking:
+		return 0
+	case model.SchemaChangeLevelDangerous:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func sortedTypeNames(schema *ast.Schema) []string {
+	names := make([]string, 0, len(schema.Types))
+	for name := range schema.Types {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+func valueString(v *ast.Value) string {
+	if v == nil {
+		return "none"
+	}
+
+	return v.String()
+}
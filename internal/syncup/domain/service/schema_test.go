@@ -0,0 +1,582 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_schemaService_Equal(t *testing.T) {
+	type args struct {
+		schema1 *model.Schema
+		schema2 *model.Schema
+	}
+
+	type expected struct {
+		res   bool
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: identical",
+			args: args{
+				schema1: ptr.Pointer(model.Schema("type Query {\n  hello: String\n}\n")),
+				schema2: ptr.Pointer(model.Schema("type Query {\n  hello: String\n}\n")),
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different whitespace",
+			args: args{
+				schema1: ptr.Pointer(model.Schema("type Query {\n  hello: String\n}\n")),
+				schema2: ptr.Pointer(model.Schema("\r\ntype Query {  \r\n  hello: String\t\r\n}")),
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different order and indentation",
+			args: args{
+				schema1: ptr.Pointer(model.Schema("type Query {\n  hello: String\n  bye: String\n}\n\ntype Post {\n  id: ID!\n}\n")),
+				schema2: ptr.Pointer(model.Schema("type Post { id: ID! }\ntype Query {\n\tbye: String\n\thello: String\n}\n")),
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: different",
+			args: args{
+				schema1: ptr.Pointer(model.Schema("type Query {\n  hello: String\n}\n")),
+				schema2: ptr.Pointer(model.Schema("type Query {\n  hello: String!\n}\n")),
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil schema",
+			args: args{
+				schema1: ptr.Pointer(model.Schema("type Query {\n  hello: String\n}\n")),
+				schema2: nil,
+			},
+			expected: expected{
+				res:   false,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &schemaService{}
+
+			// Act
+			actual, err := s.Equal(ctx, tt.args.schema1, tt.args.schema2)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_schemaService_Validate(t *testing.T) {
+	type args struct {
+		files []model.SchemaFile
+	}
+
+	type expected struct {
+		errAs *model.SchemaError
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: plain schema",
+			args: args{
+				files: []model.SchemaFile{
+					{Path: "schema.graphqls", Content: "type Query {\n  hello: String\n}\n"},
+				},
+			},
+			expected: expected{
+				errAs: nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: appsync scalars and directives",
+			args: args{
+				files: []model.SchemaFile{
+					{
+						Path: "schema.graphqls",
+						Content: "schema {\n  query: Query\n  mutation: Mutation\n  subscription: Subscription\n}\n\n" +
+							"type Query @aws_iam @aws_cognito_user_pools {\n  now: AWSDateTime\n  payload: AWSJSON @aws_api_key\n}\n\n" +
+							"type Mutation {\n  ping(at: AWSTimestamp!): AWSDate @aws_cognito_user_pools(cognito_groups: [\"admin\"])\n}\n\n" +
+							"type Subscription {\n  onPing: AWSDate @aws_subscribe(mutations: [\"ping\"])\n}\n",
+					},
+				},
+			},
+			expected: expected{
+				errAs: nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: syntax error",
+			args: args{
+				files: []model.SchemaFile{
+					{Path: "schema.graphqls", Content: "type Query {\n  hello String\n}\n"},
+				},
+			},
+			expected: expected{
+				errAs: &model.SchemaError{Path: "schema.graphqls", Line: 2, Column: 9},
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: undefined type",
+			args: args{
+				files: []model.SchemaFile{
+					{Path: "schema.graphqls", Content: "type Query {\n  hello: Greeting\n}\n"},
+				},
+			},
+			expected: expected{
+				errAs: &model.SchemaError{Path: "schema.graphqls", Line: 2, Column: 10},
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: unknown directive",
+			args: args{
+				files: []model.SchemaFile{
+					{Path: "schema.graphqls", Content: "type Query {\n  hello: String @aws_unknown\n}\n"},
+				},
+			},
+			expected: expected{
+				errAs: &model.SchemaError{Path: "schema.graphqls", Line: 2, Column: 18},
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: no files",
+			args: args{
+				files: nil,
+			},
+			expected: expected{
+				errAs: nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &schemaService{}
+
+			// Act
+			err := s.Validate(ctx, tt.args.files)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errAs != nil {
+					var se *model.SchemaError
+					if assert.ErrorAs(t, err, &se) {
+						assert.Equal(t, tt.expected.errAs.Path, se.Path)
+						assert.Equal(t, tt.expected.errAs.Line, se.Line)
+						assert.Equal(t, tt.expected.errAs.Column, se.Column)
+						assert.NotEmpty(t, se.Message)
+					}
+				}
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_schemaService_Format(t *testing.T) {
+	type args struct {
+		files  []model.SchemaFile
+		optFns []func(o *model.SchemaFormatOptions)
+	}
+
+	type expected struct {
+		res   []model.SchemaFile
+		errAs *model.SchemaError
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				files: []model.SchemaFile{
+					{
+						Path: "schema.graphqls",
+						Content: "schema { query: Query }\n" +
+							"type Query @aws_iam {\n\t\"A post\" getPost(id: ID!): Post\n\tlistPosts: [Post] @aws_cognito_user_pools(cognito_groups: [\"admin\"])\n}\n" +
+							"enum Status { PUBLISHED DRAFT }\n",
+					},
+				},
+			},
+			expected: expected{
+				res: []model.SchemaFile{
+					{
+						Path: "schema.graphqls",
+						Content: "schema {\n  query: Query\n}\n\n" +
+							"type Query @aws_iam {\n  \"\"\"\n  A post\n  \"\"\"\n  getPost(id: ID!): Post\n  listPosts: [Post] @aws_cognito_user_pools(cognito_groups: [\"admin\"])\n}\n\n" +
+							"enum Status {\n  PUBLISHED\n  DRAFT\n}\n",
+					},
+				},
+				errAs: nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: sort",
+			args: args{
+				files: []model.SchemaFile{
+					{
+						Path:    "schema/types/Post.graphql",
+						Content: "type Post {\n  title: String\n  id: ID!\n}\n\nenum Status {\n  PUBLISHED\n  DRAFT\n}\n\nscalar Cursor\n",
+					},
+					{
+						Path:    "schema/Query.graphql",
+						Content: "type Query {\n  listPosts: [Post]\n  getPost(id: ID!): Post\n}\n",
+					},
+				},
+				optFns: []func(o *model.SchemaFormatOptions){model.SchemaFormatOptionsWithSort(true)},
+			},
+			expected: expected{
+				res: []model.SchemaFile{
+					{
+						Path:    "schema/types/Post.graphql",
+						Content: "scalar Cursor\n\ntype Post {\n  id: ID!\n  title: String\n}\n\nenum Status {\n  DRAFT\n  PUBLISHED\n}\n",
+					},
+					{
+						Path:    "schema/Query.graphql",
+						Content: "type Query {\n  getPost(id: ID!): Post\n  listPosts: [Post]\n}\n",
+					},
+				},
+				errAs: nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: comments",
+			args: args{
+				files: []model.SchemaFile{
+					{
+						Path:    "schema.graphqls",
+						Content: "# posts\ntype Query {\n  getPost: String\n}\n",
+					},
+				},
+			},
+			expected: expected{
+				res: []model.SchemaFile{
+					{
+						Path:    "schema.graphqls",
+						Content: "# posts\ntype Query {\n  getPost: String\n}\n",
+					},
+				},
+				errAs: nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: syntax error",
+			args: args{
+				files: []model.SchemaFile{
+					{Path: "schema.graphqls", Content: "type Query {\n  hello String\n}\n"},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errAs: &model.SchemaError{Path: "schema.graphqls", Line: 2, Column: 9},
+				errIs: model.ErrInvalidValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &schemaService{}
+
+			// Act
+			actual, err := s.Format(ctx, tt.args.files, tt.args.optFns...)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errAs != nil {
+					var se *model.SchemaError
+					if assert.ErrorAs(t, err, &se) {
+						assert.Equal(t, tt.expected.errAs.Path, se.Path)
+						assert.Equal(t, tt.expected.errAs.Line, se.Line)
+						assert.Equal(t, tt.expected.errAs.Column, se.Column)
+					}
+				}
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_schemaService_Compare(t *testing.T) {
+	schema := model.Schema("schema { query: Query mutation: Mutation }\n" +
+		"type Query { getPost(id: ID!): Post listPosts(limit: Int = 10): [Post!] }\n" +
+		"type Mutation { createPost(input: CreatePostInput!): Post }\n" +
+		"type Post { id: ID! title: String status: Status author: Author }\n" +
+		"type Author { name: String }\n" +
+		"input CreatePostInput { title: String! status: Status }\n" +
+		"enum Status { PUBLISHED DRAFT }\n")
+
+	type args struct {
+		schema1   *model.Schema
+		schema2   *model.Schema
+		resolvers []model.Resolver
+	}
+
+	type expected struct {
+		res   []model.SchemaChange
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: no changes",
+			args: args{
+				schema1: &schema,
+				schema2: ptr.Pointer(model.Schema("enum Status {\n  DRAFT\n  PUBLISHED\n}\n\n" +
+					"type Query {\n  listPosts(limit: Int = 10): [Post!]\n  getPost(id: ID!): Post\n}\n\n" +
+					"type Mutation {\n  createPost(input: CreatePostInput!): Post\n}\n\n" +
+					"type Post {\n  author: Author\n  id: ID!\n  status: Status\n  title: String\n}\n\n" +
+					"type Author {\n  name: String\n}\n\n" +
+					"input CreatePostInput {\n  status: Status\n  title: String!\n}\n")),
+				resolvers: nil,
+			},
+			expected: expected{
+				res:   []model.SchemaChange{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: breaking changes",
+			args: args{
+				schema1: &schema,
+				schema2: ptr.Pointer(model.Schema("schema { query: Query mutation: Mutation }\n" +
+					"type Query { getPost(id: ID!, version: Int!): Post listPosts(limit: Int!): [Post] }\n" +
+					"type Mutation { createPost(input: CreatePostInput!): Post }\n" +
+					"type Post { id: ID! status: Status }\n" +
+					"input CreatePostInput { title: String! status: Status body: String! }\n" +
+					"enum Status { PUBLISHED }\n")),
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("getPost")},
+					{TypeName: ptr.Pointer("Author"), FieldName: ptr.Pointer("name")},
+				},
+			},
+			expected: expected{
+				res: []model.SchemaChange{
+					{Level: model.SchemaChangeLevelBreaking, Path: "Author", Message: "type Author was removed but is still used by resolvers Author.name"},
+					{Level: model.SchemaChangeLevelBreaking, Path: "CreatePostInput.body", Message: "required input field CreatePostInput.body was added"},
+					{Level: model.SchemaChangeLevelBreaking, Path: "Post.title", Message: "field Post.title was removed"},
+					{Level: model.SchemaChangeLevelBreaking, Path: "Post.author", Message: "field Post.author was removed"},
+					{Level: model.SchemaChangeLevelBreaking, Path: "Query.getPost(version:)", Message: "required argument Query.getPost(version:) was added"},
+					{Level: model.SchemaChangeLevelBreaking, Path: "Query.listPosts", Message: "field Query.listPosts changed type from [Post!] to [Post]"},
+					{Level: model.SchemaChangeLevelBreaking, Path: "Query.listPosts(limit:)", Message: "argument Query.listPosts(limit:) changed type from Int to Int!"},
+					{Level: model.SchemaChangeLevelBreaking, Path: "Status.DRAFT", Message: "enum value DRAFT was removed from Status"},
+					{Level: model.SchemaChangeLevelDangerous, Path: "Query.listPosts(limit:)", Message: "argument Query.listPosts(limit:) changed default value from 10 to none"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: non-null fields, dangerous and safe changes",
+			args: args{
+				schema1: &schema,
+				schema2: ptr.Pointer(model.Schema("schema { query: Query mutation: Mutation }\n" +
+					"type Query { getPost(id: ID!, version: Int): Post listPosts(limit: Int = 10): [Post!] listAuthors: [Author] }\n" +
+					"type Mutation { createPost(input: CreatePostInput!): Post }\n" +
+					"type Post { id: ID! title: String! status: Status }\n" +
+					"type Author { name: String }\n" +
+					"input CreatePostInput { title: String status: Status }\n" +
+					"enum Status { PUBLISHED DRAFT ARCHIVED }\n")),
+				resolvers: nil,
+			},
+			expected: expected{
+				res: []model.SchemaChange{
+					{Level: model.SchemaChangeLevelBreaking, Path: "Post.title", Message: "field Post.title changed type from String to String!"},
+					{Level: model.SchemaChangeLevelBreaking, Path: "Post.author", Message: "field Post.author was removed"},
+					{Level: model.SchemaChangeLevelDangerous, Path: "Status.ARCHIVED", Message: "enum value ARCHIVED was added to Status"},
+					{Level: model.SchemaChangeLevelSafe, Path: "CreatePostInput.title", Message: "input field CreatePostInput.title changed type from String! to String"},
+					{Level: model.SchemaChangeLevelSafe, Path: "Query.getPost(version:)", Message: "argument Query.getPost(version:) was added"},
+					{Level: model.SchemaChangeLevelSafe, Path: "Query.listAuthors", Message: "field Query.listAuthors was added"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: removed type without resolvers",
+			args: args{
+				schema1:   ptr.Pointer(model.Schema("type Query { hello: String }\ntype Author { name: String }\n")),
+				schema2:   ptr.Pointer(model.Schema("type Query { hello: String }\ntype Comment { body: String }\n")),
+				resolvers: []model.Resolver{{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("hello")}},
+			},
+			expected: expected{
+				res: []model.SchemaChange{
+					{Level: model.SchemaChangeLevelDangerous, Path: "Author", Message: "type Author was removed"},
+					{Level: model.SchemaChangeLevelSafe, Path: "Comment", Message: "type Comment was added"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: unparsable remote schema",
+			args: args{
+				schema1:   ptr.Pointer(model.Schema("type Query { hello: String @unknown(arg: }\n")),
+				schema2:   &schema,
+				resolvers: nil,
+			},
+			expected: expected{
+				res: []model.SchemaChange{
+					{Level: model.SchemaChangeLevelDangerous, Message: "the remote schema could not be parsed, so the changes could not be classified"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: syntax error",
+			args: args{
+				schema1:   &schema,
+				schema2:   ptr.Pointer(model.Schema("type Query {\n")),
+				resolvers: nil,
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: nil schema",
+			args: args{
+				schema1:   &schema,
+				schema2:   nil,
+				resolvers: nil,
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			s := &schemaService{}
+
+			// Act
+			actual, err := s.Compare(ctx, tt.args.schema1, tt.args.schema2, tt.args.resolvers)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
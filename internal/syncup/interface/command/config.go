@@ -0,0 +1,182 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagNameConfig  = "config"
+	flagNameEnv     = "env"
+	flagNameProfile = "profile"
+	flagNameRegion  = "region"
+	flagNameAPIID   = "api-id"
+	flagNameDir     = "dir"
+
+	flagNameKeepBackups  = "keep-backups"
+	flagNameAgeRecipient = "age-recipient"
+	flagNameSchemaLayout = "schema-layout"
+	flagNameSortSchema   = "sort-schema"
+	flagNameRateLimit    = "rate-limit"
+
+	envVarPrefix = "SYNCUP_"
+
+	defaultConcurrency = 8
+	defaultKeepBackups = 10
+	defaultRateLimit   = 5
+)
+
+type configFlags struct {
+	config string
+	env    string
+}
+
+var (
+	configurableFlags = []struct {
+		name  string
+		value func(env *model.Environment) string
+	}{
+		{name: flagNameProfile, value: func(env *model.Environment) string { return env.Profile }},
+		{name: flagNameRegion, value: func(env *model.Environment) string { return env.Region }},
+		{name: flagNameAPIID, value: func(env *model.Environment) string { return env.APIID }},
+		{name: flagNameDir, value: func(env *model.Environment) string { return env.BaseDir }},
+		{name: flagNameKeepBackups, value: func(env *model.Environment) string {
+			// NOTE: keepBackups: 0 disables backups, so an unset value is told apart from zero
+			if env.KeepBackups == nil {
+				return ""
+			}
+
+			return strconv.Itoa(*env.KeepBackups)
+		}},
+		{name: flagNameAgeRecipient, value: func(env *model.Environment) string { return strings.Join(env.AgeRecipients, ",") }},
+		{name: flagNameSchemaLayout, value: func(env *model.Environment) string { return env.SchemaLayout }},
+		{name: flagNameSortSchema, value: func(env *model.Environment) string {
+			if !env.SortSchema {
+				return ""
+			}
+
+			return strconv.FormatBool(env.SortSchema)
+		}},
+	}
+)
+
+func registerConfigFlags(cmd *cobra.Command, flags *configFlags) {
+	cmd.Flags().StringVar(&flags.config, flagNameConfig, "", "The path to the config file (default is ./syncup.yaml).")
+	cmd.Flags().StringVar(&flags.env, flagNameEnv, "", "The name of the environment defined in the config file.")
+}
+
+func applyConfig(ctx context.Context, cmd *cobra.Command, repo repository.ConfigRepository) error {
+	for _, name := range []string{flagNameConfig, flagNameEnv} {
+		if err := applyEnvVar(cmd, name); err != nil {
+			return err
+		}
+	}
+
+	path, err := cmd.Flags().GetString(flagNameConfig)
+	if err != nil {
+		return err
+	}
+
+	envName, err := cmd.Flags().GetString(flagNameEnv)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := repo.Get(ctx, path)
+	if err != nil {
+		if path != "" || envName != "" || !errors.Is(err, model.ErrNotFound) {
+			return err
+		}
+
+		// NOTE: the config file is optional unless it is explicitly required
+		cfg = &model.Config{}
+	}
+
+	var env *model.Environment
+	if envName != "" {
+		e, ok := cfg.Environments[envName]
+		if !ok {
+			return fmt.Errorf("%w: environment %s", model.ErrNotFound, envName)
+		}
+
+		env = &e
+	}
+
+	for _, f := range configurableFlags {
+		// NOTE: not every command accepts every configurable flag
+		if cmd.Flags().Lookup(f.name) == nil {
+			continue
+		}
+
+		if err := applyEnvVar(cmd, f.name); err != nil {
+			return err
+		}
+
+		if cmd.Flags().Changed(f.name) || env == nil {
+			continue
+		}
+
+		if v := f.value(env); v != "" {
+			if err := cmd.Flags().Set(f.name, v); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func applyEnvVar(cmd *cobra.Command, name string) error {
+	if cmd.Flags().Changed(name) {
+		return nil
+	}
+
+	if v := os.Getenv(envVarName(name)); v != "" {
+		if err := cmd.Flags().Set(name, v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func envVarName(flagName string) string {
+	return envVarPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+func validateSchemaLayout(layout string) error {
+	switch model.SchemaLayout(layout) {
+	case "", model.SchemaLayoutFile, model.SchemaLayoutDirectory:
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported schema layout %q", model.ErrInvalidValue, layout)
+	}
+}
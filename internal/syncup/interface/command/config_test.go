@@ -0,0 +1,279 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_applyConfig(t *testing.T) {
+	config := &model.Config{
+		Environments: map[string]model.Environment{
+			"dev": {
+				APIID:       "devAPIID",
+				Region:      "ap-northeast-1",
+				Profile:     "dev",
+				BaseDir:     "dev",
+				KeepBackups: ptr.Pointer(0),
+
+				AgeRecipients: []string{"age1alice", "age1bob"},
+				SchemaLayout:  "directory",
+				SortSchema:    true,
+			},
+		},
+	}
+
+	type args struct {
+		args []string
+	}
+
+	type mockConfigRepositoryGetReturn struct {
+		res *model.Config
+		err error
+	}
+	type mockConfigRepositoryGet struct {
+		calls   int
+		returns []mockConfigRepositoryGetReturn
+	}
+
+	type expected struct {
+		flags map[string]string
+		errIs error
+	}
+
+	tests := []struct {
+		name                    string
+		args                    args
+		envs                    map[string]string
+		mockConfigRepositoryGet mockConfigRepositoryGet
+		expected                expected
+	}{
+		{
+			name: "happy path: fall back to environment",
+			args: args{
+				args: []string{"--env", "dev"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: config,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				flags: map[string]string{
+					"api-id":        "devAPIID",
+					"region":        "ap-northeast-1",
+					"profile":       "dev",
+					"dir":           "dev",
+					"keep-backups":  "0",
+					"age-recipient": "[age1alice,age1bob]",
+					"schema-layout": "directory",
+					"sort-schema":   "true",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: flags and environment variables take precedence",
+			args: args{
+				args: []string{"--env", "dev", "--api-id", "flagAPIID"},
+			},
+			envs: map[string]string{
+				"SYNCUP_API_ID": "envVarAPIID",
+				"SYNCUP_REGION": "us-east-1",
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: config,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				flags: map[string]string{
+					"api-id":  "flagAPIID",
+					"region":  "us-east-1",
+					"profile": "dev",
+					"dir":     "dev",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: environment selected by environment variable",
+			args: args{
+				args: []string{},
+			},
+			envs: map[string]string{
+				"SYNCUP_ENV": "dev",
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: config,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				flags: map[string]string{
+					"api-id":  "devAPIID",
+					"region":  "ap-northeast-1",
+					"profile": "dev",
+					"dir":     "dev",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: config file not found",
+			args: args{
+				args: []string{"--api-id", "flagAPIID"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrNotFound},
+					},
+				},
+			},
+			expected: expected{
+				flags: map[string]string{
+					"api-id":       "flagAPIID",
+					"region":       "",
+					"profile":      "",
+					"dir":          "",
+					"keep-backups": "10",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: config file not found with --env flag",
+			args: args{
+				args: []string{"--env", "dev"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrNotFound},
+					},
+				},
+			},
+			expected: expected{
+				flags: nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+		{
+			name: "edge path: unknown environment",
+			args: args{
+				args: []string{"--env", "prod"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: config,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				flags: nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			for k, v := range tt.envs {
+				t.Setenv(k, v)
+			}
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockConfigRepository := mock_repository.NewMockConfigRepository(ctrl)
+
+			mockConfigRepository.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, path string) (*model.Config, error) {
+					r := tt.mockConfigRepositoryGet.returns[tt.mockConfigRepositoryGet.calls]
+					tt.mockConfigRepositoryGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockConfigRepositoryGet.returns))
+
+			flags := new(configFlags)
+			cmd := &cobra.Command{}
+			registerConfigFlags(cmd, flags)
+			cmd.Flags().String("profile", "", "")
+			cmd.Flags().String("region", "", "")
+			cmd.Flags().String("api-id", "", "")
+			cmd.Flags().String("dir", "", "")
+			cmd.Flags().Int("keep-backups", 10, "")
+			cmd.Flags().StringSlice("age-recipient", nil, "")
+			cmd.Flags().String("schema-layout", "", "")
+			cmd.Flags().Bool("sort-schema", false, "")
+
+			if err := cmd.ParseFlags(tt.args.args); err != nil {
+				t.Fatal(err)
+			}
+
+			// Act
+			err := applyConfig(ctx, cmd, mockConfigRepository)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+
+				for name, value := range tt.expected.flags {
+					assert.Equal(t, value, cmd.Flags().Lookup(name).Value.String(), name)
+				}
+			} else {
+				assert.Error(t, err)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	"github.com/Aton-Kish/syncup/internal/xsync"
+	"github.com/spf13/cobra"
+)
+
+type diffFlags struct {
+	configFlags
+	filterFlags
+
+	region  string
+	profile string
+
+	apiID                 string
+	direction             string
+	deleteExtraneousFiles bool
+	exitCode              bool
+	sortSchema            bool
+	baseDir               string
+	concurrency           int
+	rateLimit             float64
+	output                string
+}
+
+type DiffCommand interface {
+	Command
+}
+
+type diffCommand struct {
+	options *options
+
+	useCase                    usecase.DiffUseCase
+	awsActivator               repository.AWSActivator
+	baseDirProvider            repository.BaseDirProvider
+	mfaTokenProviderRepository repository.MFATokenProviderRepository
+	configRepository           repository.ConfigRepository
+
+	cmd   *xcommand
+	flags *diffFlags
+	once  sync.Once
+}
+
+func NewDiffCommand(repo repository.Repository, optFns ...func(o *options)) DiffCommand {
+	return &diffCommand{
+		options: newOptions(optFns...),
+
+		useCase:                    usecase.NewDiffUseCase(repo),
+		awsActivator:               repo,
+		baseDirProvider:            repo,
+		mfaTokenProviderRepository: repo.MFATokenProviderRepository(),
+		configRepository:           repo.ConfigRepositoryForFS(),
+	}
+}
+
+func (c *diffCommand) Execute(ctx context.Context, args ...string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.SetArgs(args)
+
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *diffCommand) RegisterSubCommands(cmds ...Command) {
+	subs := make([]*cobra.Command, 0, len(cmds))
+	for _, cmd := range cmds {
+		subs = append(subs, cmd.command().Command)
+	}
+
+	cmd := c.command()
+	cmd.AddCommand(subs...)
+}
+
+func (c *diffCommand) GenerateReadme(ctx context.Context, dir string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.InitDefaultCompletionCmd()
+
+	return cmd.GenerateReadme(dir)
+}
+
+func (c *diffCommand) GenerateReferences(ctx context.Context, dir string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.InitDefaultVersionFlag()
+	cmd.InitDefaultCompletionCmd()
+
+	return cmd.GenerateReferences(dir)
+}
+
+func (c *diffCommand) command() *xcommand {
+	c.once.Do(func() {
+		c.flags = new(diffFlags)
+
+		c.cmd = newCommand(&cobra.Command{
+			Use:   "diff",
+			Short: "Show differences between local resources and AWS AppSync",
+			PreRunE: func(cmd *cobra.Command, args []string) (err error) {
+				defer wrap(&err)
+
+				ctx := cmd.Context()
+
+				if err := validateOutputFormat(c.flags.output); err != nil {
+					return err
+				}
+
+				if err := applyConfig(ctx, cmd, c.configRepository); err != nil {
+					return err
+				}
+
+				if err := c.awsActivator.ActivateAWS(
+					ctx,
+					model.AWSOptionsWithRegion(c.flags.region),
+					model.AWSOptionsWithProfile(c.flags.profile),
+					model.AWSOptionsWithMFATokenProvider(c.mfaTokenProviderRepository.Get(ctx)),
+					model.AWSOptionsWithRateLimit(c.flags.rateLimit),
+				); err != nil {
+					return err
+				}
+
+				c.baseDirProvider.SetBaseDir(ctx, c.flags.baseDir)
+
+				return nil
+			},
+			RunE: func(cmd *cobra.Command, args []string) (err error) {
+				defer wrap(&err)
+
+				ctx := syncup.WithWorkerPool(cmd.Context(), xsync.NewPool(c.flags.concurrency))
+				ctx = syncup.WithEnvironment(ctx, c.flags.env)
+
+				filter, err := newFilter(&c.flags.filterFlags)
+				if err != nil {
+					return err
+				}
+
+				// NOTE: extraneous resources are drift too, so they must not be hidden from --exit-code
+				out, err := c.useCase.Execute(
+					ctx,
+					&usecase.DiffInput{
+						APIID:                     c.flags.apiID,
+						Direction:                 model.DiffDirection(c.flags.direction),
+						DeleteExtraneousResources: c.flags.deleteExtraneousFiles || c.flags.exitCode,
+						SortSchema:                c.flags.sortSchema,
+						Filter:                    filter,
+					},
+				)
+				if err != nil {
+					return err
+				}
+
+				if c.flags.output == outputFormatJSON {
+					if err := printDiffsJSON(cmd.OutOrStdout(), out.Diffs); err != nil {
+						return err
+					}
+				} else {
+					if err := c.print(cmd.OutOrStdout(), out.Diffs); err != nil {
+						return err
+					}
+				}
+
+				// NOTE: drift gets its own exit code, so that CI can tell it apart from a failure to compare
+				if c.flags.exitCode && len(out.Diffs) > 0 {
+					return fmt.Errorf("%w: %d resources differ", errDriftDetected, len(out.Diffs))
+				}
+
+				return nil
+			},
+			SilenceUsage: true,
+		})
+
+		registerConfigFlags(c.cmd.Command, &c.flags.configFlags)
+		c.cmd.Flags().StringVar(&c.flags.profile, "profile", "", "Use a specific profile from your AWS credential file.")
+		c.cmd.Flags().StringVar(&c.flags.region, "region", "", "The AWS region to use. Overrides config/env settings.")
+
+		c.cmd.Flags().StringVar(&c.flags.apiID, "api-id", "", "The API ID of AWS AppSync.")
+		_ = c.cmd.MarkFlagRequired("api-id")
+		c.cmd.Flags().StringVar(&c.flags.direction, "direction", string(model.DiffDirectionPush), "The direction to compare in: push (local to AWS AppSync) or pull (AWS AppSync to local).")
+		c.cmd.Flags().BoolVar(&c.flags.deleteExtraneousFiles, "delete", false, "Show extraneous resources that would be deleted.")
+		c.cmd.Flags().BoolVar(&c.flags.exitCode, "exit-code", false, fmt.Sprintf("Exit with status %d if there are differences, including extraneous resources (implies --delete).", exitCodeDrift))
+		c.cmd.Flags().BoolVar(&c.flags.sortSchema, flagNameSortSchema, false, "Sort the types and fields of the schema by name, as pull does with the same flag.")
+		c.cmd.Flags().StringVar(&c.flags.baseDir, "dir", "", "The directory from which the local resources will be loaded (instead of current directory).")
+		c.cmd.Flags().IntVar(&c.flags.concurrency, "concurrency", defaultConcurrency, "The maximum number of resources to sync in parallel.")
+		c.cmd.Flags().Float64Var(&c.flags.rateLimit, flagNameRateLimit, defaultRateLimit, "The maximum number of requests per second to AWS AppSync.")
+		registerFilterFlags(c.cmd.Command, &c.flags.filterFlags)
+		registerOutputFlag(c.cmd.Command, &c.flags.output)
+
+		c.cmd.SetIn(c.options.stdio.in)
+		c.cmd.SetOut(c.options.stdio.out)
+		c.cmd.SetErr(c.options.stdio.err)
+	})
+
+	return c.cmd
+}
+
+func (c *diffCommand) print(w io.Writer, diffs []model.Diff) error {
+	if len(diffs) == 0 {
+		if _, err := fmt.Fprintln(w, "No differences."); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	counts := make(map[model.DiffAction]int)
+	for _, d := range diffs {
+		counts[d.Action]++
+
+		if _, err := fmt.Fprintf(w, "# %s will be %s\n", diffTitle(&d), diffActionPastParticiple(d.Action)); err != nil {
+			return err
+		}
+
+		for _, fd := range d.FileDiffs {
+			if _, err := fmt.Fprint(w, fd.UnifiedDiff); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(
+		w,
+		"Plan: %d to create, %d to update, %d to delete.\n",
+		counts[model.DiffActionCreate],
+		counts[model.DiffActionUpdate],
+		counts[model.DiffActionDelete],
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func diffTitle(d *model.Diff) string {
+	switch d.Kind {
+	case model.ResourceKindGraphqlApi:
+		return "GraphQL API settings"
+	case model.ResourceKindEnvironmentVariables:
+		return "environment variables"
+	case model.ResourceKindSchema:
+		return "schema"
+	default:
+		return fmt.Sprintf("%s %s", d.Kind, d.Identifier)
+	}
+}
+
+func diffActionPastParticiple(action model.DiffAction) string {
+	switch action {
+	case model.DiffActionCreate:
+		return "created"
+	case model.DiffActionUpdate:
+		return "updated"
+	case model.DiffActionDelete:
+		return "deleted"
+	default:
+		return string(action)
+	}
+}
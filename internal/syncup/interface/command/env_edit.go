@@ -0,0 +1,160 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	"github.com/spf13/cobra"
+)
+
+type envEditFlags struct {
+	configFlags
+
+	apiID         string
+	baseDir       string
+	ageRecipients []string
+}
+
+type EnvEditCommand interface {
+	Command
+}
+
+type envEditCommand struct {
+	options *options
+
+	useCase          usecase.EnvEditUseCase
+	baseDirProvider  repository.BaseDirProvider
+	configRepository repository.ConfigRepository
+
+	cmd   *xcommand
+	flags *envEditFlags
+	once  sync.Once
+}
+
+func NewEnvEditCommand(repo repository.Repository, optFns ...func(o *options)) EnvEditCommand {
+	return &envEditCommand{
+		options: newOptions(optFns...),
+
+		useCase:          usecase.NewEnvEditUseCase(repo),
+		baseDirProvider:  repo,
+		configRepository: repo.ConfigRepositoryForFS(),
+	}
+}
+
+func (c *envEditCommand) Execute(ctx context.Context, args ...string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.SetArgs(args)
+
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *envEditCommand) RegisterSubCommands(cmds ...Command) {
+	subs := make([]*cobra.Command, 0, len(cmds))
+	for _, cmd := range cmds {
+		subs = append(subs, cmd.command().Command)
+	}
+
+	cmd := c.command()
+	cmd.AddCommand(subs...)
+}
+
+func (c *envEditCommand) GenerateReadme(ctx context.Context, dir string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.InitDefaultCompletionCmd()
+
+	return cmd.GenerateReadme(dir)
+}
+
+func (c *envEditCommand) GenerateReferences(ctx context.Context, dir string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.InitDefaultVersionFlag()
+	cmd.InitDefaultCompletionCmd()
+
+	return cmd.GenerateReferences(dir)
+}
+
+func (c *envEditCommand) command() *xcommand {
+	c.once.Do(func() {
+		c.flags = new(envEditFlags)
+
+		c.cmd = newCommand(&cobra.Command{
+			Use:   "edit",
+			Short: "Edit local environment variables in $EDITOR, decrypted if they are encrypted with age",
+			PreRunE: func(cmd *cobra.Command, args []string) (err error) {
+				defer wrap(&err)
+
+				ctx := cmd.Context()
+
+				if err := applyConfig(ctx, cmd, c.configRepository); err != nil {
+					return err
+				}
+
+				c.baseDirProvider.SetBaseDir(ctx, c.flags.baseDir)
+
+				return nil
+			},
+			RunE: func(cmd *cobra.Command, args []string) (err error) {
+				defer wrap(&err)
+
+				ctx := syncup.WithEnvironment(cmd.Context(), c.flags.env)
+				ctx = syncup.WithAgeRecipients(ctx, c.flags.ageRecipients)
+
+				if _, err := c.useCase.Execute(
+					ctx,
+					&usecase.EnvEditInput{
+						APIID: c.flags.apiID,
+					},
+				); err != nil {
+					return err
+				}
+
+				return nil
+			},
+			SilenceUsage: true,
+		})
+
+		registerConfigFlags(c.cmd.Command, &c.flags.configFlags)
+		c.cmd.Flags().StringVar(&c.flags.apiID, "api-id", "", "The API ID of AWS AppSync.")
+		c.cmd.Flags().StringVar(&c.flags.baseDir, "dir", "", "The directory from which the local resources will be loaded (instead of current directory).")
+		c.cmd.Flags().StringSliceVar(&c.flags.ageRecipients, flagNameAgeRecipient, nil, "The age recipients to encrypt the environment variables for.")
+
+		c.cmd.SetIn(c.options.stdio.in)
+		c.cmd.SetOut(c.options.stdio.out)
+		c.cmd.SetErr(c.options.stdio.err)
+	})
+
+	return c.cmd
+}
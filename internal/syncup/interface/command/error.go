@@ -24,6 +24,22 @@ import (
 	"errors"
 )
 
+const (
+	exitCodeError = 1
+	exitCodeDrift = 2
+)
+
+var errDriftDetected = errors.New("drift detected")
+
+// ExitCode returns the exit status of the process for an error returned by a command.
+func ExitCode(err error) int {
+	if errors.Is(err, errDriftDetected) {
+		return exitCodeDrift
+	}
+
+	return exitCodeError
+}
+
 func wrap(errp *error) {
 	if errp == nil || *errp == nil {
 		return
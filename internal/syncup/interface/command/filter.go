@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagNameOnly    = "only"
+	flagNameInclude = "include"
+	flagNameExclude = "exclude"
+)
+
+type filterFlags struct {
+	only    []string
+	include []string
+	exclude []string
+}
+
+var (
+	filterKinds = map[string]model.ResourceKind{
+		"api":         model.ResourceKindGraphqlApi,
+		"env":         model.ResourceKindEnvironmentVariables,
+		"schema":      model.ResourceKindSchema,
+		"datasources": model.ResourceKindDataSource,
+		"functions":   model.ResourceKindFunction,
+		"resolvers":   model.ResourceKindResolver,
+	}
+)
+
+func registerFilterFlags(cmd *cobra.Command, flags *filterFlags) {
+	cmd.Flags().StringSliceVar(&flags.only, flagNameOnly, nil, "Limit to the kinds of resources: api, env, schema, datasources, functions, resolvers.")
+	cmd.Flags().StringSliceVar(&flags.include, flagNameInclude, nil, "Limit to the resources matching the glob patterns, e.g. Query.* for resolvers, fn:getUser* for functions and ds:Todo* for data sources.")
+	cmd.Flags().StringSliceVar(&flags.exclude, flagNameExclude, nil, "Skip the resources matching the glob patterns.")
+}
+
+func newFilter(flags *filterFlags) (*model.Filter, error) {
+	if len(flags.only) == 0 && len(flags.include) == 0 && len(flags.exclude) == 0 {
+		return nil, nil
+	}
+
+	kinds := make([]model.ResourceKind, 0, len(flags.only))
+	for _, name := range flags.only {
+		kind, ok := filterKinds[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: unsupported resource kind %q", model.ErrInvalidValue, name)
+		}
+
+		kinds = append(kinds, kind)
+	}
+
+	return &model.Filter{
+		Kinds:   kinds,
+		Include: flags.include,
+		Exclude: flags.exclude,
+	}, nil
+}
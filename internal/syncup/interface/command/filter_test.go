@@ -0,0 +1,103 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newFilter(t *testing.T) {
+	type args struct {
+		flags *filterFlags
+	}
+
+	type expected struct {
+		res   *model.Filter
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				flags: &filterFlags{
+					only:    []string{"functions", "resolvers"},
+					include: []string{"Query.*", "fn:getUser*"},
+					exclude: []string{"Query.legacy*"},
+				},
+			},
+			expected: expected{
+				res: &model.Filter{
+					Kinds:   []model.ResourceKind{model.ResourceKindFunction, model.ResourceKindResolver},
+					Include: []string{"Query.*", "fn:getUser*"},
+					Exclude: []string{"Query.legacy*"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: no filter flags",
+			args: args{
+				flags: &filterFlags{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: unsupported resource kind",
+			args: args{
+				flags: &filterFlags{
+					only: []string{"types"},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			actual, err := newFilter(tt.args.flags)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expected.errIs)
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	"github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	mock_usecase "github.com/Aton-Kish/syncup/internal/syncup/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_fmtCommand_Execute(t *testing.T) {
+	type args struct {
+		args []string
+	}
+
+	type mockConfigRepositoryGetReturn struct {
+		res *model.Config
+		err error
+	}
+	type mockConfigRepositoryGet struct {
+		calls   int
+		returns []mockConfigRepositoryGetReturn
+	}
+
+	type mockBaseDirProviderSetBaseDirReturn struct {
+	}
+	type mockBaseDirProviderSetBaseDir struct {
+		calls   int
+		returns []mockBaseDirProviderSetBaseDirReturn
+	}
+
+	type mockFmtUseCaseExecuteReturn struct {
+		res *usecase.FmtOutput
+		err error
+	}
+	type mockFmtUseCaseExecute struct {
+		calls   int
+		returns []mockFmtUseCaseExecuteReturn
+	}
+
+	type expected struct {
+		errIs error
+	}
+
+	tests := []struct {
+		name                          string
+		args                          args
+		mockConfigRepositoryGet       mockConfigRepositoryGet
+		mockBaseDirProviderSetBaseDir mockBaseDirProviderSetBaseDir
+		mockFmtUseCaseExecute         mockFmtUseCaseExecute
+		expected                      expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				args: []string{},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockFmtUseCaseExecute: mockFmtUseCaseExecute{
+				returns: []mockFmtUseCaseExecuteReturn{
+					{
+						res: &usecase.FmtOutput{Paths: []string{}},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: with environment",
+			args: args{
+				args: []string{"--env", "dev"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{
+							Environments: map[string]model.Environment{
+								"dev": {
+									Profile: "dev",
+									Region:  "ap-northeast-1",
+									APIID:   "apiID",
+									BaseDir: "dev",
+								},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockFmtUseCaseExecute: mockFmtUseCaseExecute{
+				returns: []mockFmtUseCaseExecuteReturn{
+					{
+						res: &usecase.FmtOutput{Paths: []string{}},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ConfigRepository.Get() error",
+			args: args{
+				args: []string{"--config", "syncup.json"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: nil,
+						err: model.ErrNotFound,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{},
+			},
+			mockFmtUseCaseExecute: mockFmtUseCaseExecute{
+				returns: []mockFmtUseCaseExecuteReturn{},
+			},
+			expected: expected{
+				errIs: model.ErrNotFound,
+			},
+		},
+		{
+			name: "happy path: check",
+			args: args{
+				args: []string{"--check"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockFmtUseCaseExecute: mockFmtUseCaseExecute{
+				returns: []mockFmtUseCaseExecuteReturn{
+					{
+						res: &usecase.FmtOutput{Paths: []string{}},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: check with unformatted files",
+			args: args{
+				args: []string{"--check"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockFmtUseCaseExecute: mockFmtUseCaseExecute{
+				returns: []mockFmtUseCaseExecuteReturn{
+					{
+						res: &usecase.FmtOutput{Paths: []string{"schema.graphqls"}},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: FmtUseCase.Execute() error",
+			args: args{
+				args: []string{},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockFmtUseCaseExecute: mockFmtUseCaseExecute{
+				returns: []mockFmtUseCaseExecuteReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFmtUseCase := mock_usecase.NewMockFmtUseCase(ctrl)
+			mockBaseDirProvider := mock_repository.NewMockBaseDirProvider(ctrl)
+			mockConfigRepository := mock_repository.NewMockConfigRepository(ctrl)
+
+			mockConfigRepository.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, path string) (*model.Config, error) {
+					r := tt.mockConfigRepositoryGet.returns[tt.mockConfigRepositoryGet.calls]
+					tt.mockConfigRepositoryGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockConfigRepositoryGet.returns))
+
+			mockBaseDirProvider.
+				EXPECT().
+				SetBaseDir(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, dir string) {
+					tt.mockBaseDirProviderSetBaseDir.calls++
+				}).
+				Times(len(tt.mockBaseDirProviderSetBaseDir.returns))
+
+			mockFmtUseCase.
+				EXPECT().
+				Execute(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, params *usecase.FmtInput) (*usecase.FmtOutput, error) {
+					r := tt.mockFmtUseCaseExecute.returns[tt.mockFmtUseCaseExecute.calls]
+					tt.mockFmtUseCaseExecute.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFmtUseCaseExecute.returns))
+
+			stdin := new(bytes.Reader)
+			stdout := new(bytes.Buffer)
+			stderr := new(bytes.Buffer)
+
+			c := &fmtCommand{
+				options:          newOptions(WithStdio(stdin, stdout, stderr)),
+				useCase:          mockFmtUseCase,
+				baseDirProvider:  mockBaseDirProvider,
+				configRepository: mockConfigRepository,
+			}
+
+			// Act
+			err := c.Execute(ctx, tt.args.args...)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+
+				assert.Equal(t, 0, stdin.Len())
+				assert.Equal(t, 0, stdout.Len())
+				assert.Equal(t, 0, stderr.Len())
+			} else {
+				var ce *commandError
+				assert.ErrorAs(t, err, &ce)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+
+				assert.Equal(t, 0, stdin.Len())
+				assert.Equal(t, 0, stdout.Len())
+				assert.Greater(t, stderr.Len(), 0)
+			}
+		})
+	}
+}
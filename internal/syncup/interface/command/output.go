@@ -0,0 +1,229 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagNameOutput = "output"
+
+	outputFormatText = "text"
+	outputFormatJSON = "json"
+)
+
+type reportJSON struct {
+	DryRun  bool              `json:"dryRun"`
+	Events  []reportEventJSON `json:"events"`
+	Summary reportSummaryJSON `json:"summary"`
+}
+
+type reportEventJSON struct {
+	Kind       model.ResourceKind      `json:"kind"`
+	Identifier string                  `json:"identifier,omitempty"`
+	Action     model.TrackerAction     `json:"action"`
+	Level      model.SchemaChangeLevel `json:"level,omitempty"`
+	Message    string                  `json:"message,omitempty"`
+	DurationMs int64                   `json:"durationMs"`
+	Error      string                  `json:"error,omitempty"`
+}
+
+type reportSummaryJSON struct {
+	Created    int   `json:"created"`
+	Updated    int   `json:"updated"`
+	Deleted    int   `json:"deleted"`
+	Unchanged  int   `json:"unchanged"`
+	Failed     int   `json:"failed"`
+	DurationMs int64 `json:"durationMs"`
+}
+
+type diffReportJSON struct {
+	Diffs   []diffJSON      `json:"diffs"`
+	Summary diffSummaryJSON `json:"summary"`
+}
+
+type diffJSON struct {
+	Kind       model.ResourceKind `json:"kind"`
+	Identifier string             `json:"identifier,omitempty"`
+	Action     model.DiffAction   `json:"action"`
+	Files      []diffFileJSON     `json:"files"`
+}
+
+type diffFileJSON struct {
+	Path        string `json:"path"`
+	UnifiedDiff string `json:"unifiedDiff"`
+}
+
+type diffSummaryJSON struct {
+	Create int `json:"create"`
+	Update int `json:"update"`
+	Delete int `json:"delete"`
+}
+
+type testReportJSON struct {
+	Results []testResultJSON `json:"results"`
+	Summary testSummaryJSON  `json:"summary"`
+}
+
+type testResultJSON struct {
+	Kind       model.ResourceKind      `json:"kind"`
+	Identifier string                  `json:"identifier"`
+	Name       string                  `json:"name"`
+	Handler    model.EvaluationHandler `json:"handler"`
+	Passed     bool                    `json:"passed"`
+	Expected   string                  `json:"expected"`
+	Actual     string                  `json:"actual,omitempty"`
+	Error      string                  `json:"error,omitempty"`
+	Logs       []string                `json:"logs,omitempty"`
+}
+
+type testSummaryJSON struct {
+	Passed int `json:"passed"`
+	Failed int `json:"failed"`
+}
+
+func registerOutputFlag(cmd *cobra.Command, output *string) {
+	cmd.Flags().StringVar(output, flagNameOutput, outputFormatText, "The output format: text or json.")
+}
+
+func validateOutputFormat(output string) error {
+	switch output {
+	case outputFormatText, outputFormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("%w: unsupported output format %q", model.ErrInvalidValue, output)
+	}
+}
+
+func printReportJSON(w io.Writer, report *model.Report, dryRun bool, duration time.Duration) error {
+	v := reportJSON{
+		DryRun: dryRun,
+		Events: make([]reportEventJSON, 0, len(report.Events)),
+		Summary: reportSummaryJSON{
+			DurationMs: duration.Milliseconds(),
+		},
+	}
+
+	for _, e := range report.Events {
+		ev := reportEventJSON{
+			Kind:       e.Kind,
+			Identifier: e.Identifier,
+			Action:     e.Action,
+			DurationMs: e.Duration.Milliseconds(),
+		}
+		if e.Err != nil {
+			ev.Error = e.Err.Error()
+		}
+		// NOTE: the other events are described by their kind, identifier and action, but a schema change needs its message
+		if e.Level != "" {
+			ev.Level = e.Level
+			ev.Message = e.Message
+		}
+		v.Events = append(v.Events, ev)
+
+		switch e.Action {
+		case model.TrackerActionCreated:
+			v.Summary.Created++
+		case model.TrackerActionUpdated:
+			v.Summary.Updated++
+		case model.TrackerActionDeleted:
+			v.Summary.Deleted++
+		case model.TrackerActionUnchanged:
+			v.Summary.Unchanged++
+		case model.TrackerActionFailed:
+			v.Summary.Failed++
+		}
+	}
+
+	return printJSON(w, v)
+}
+
+func printDiffsJSON(w io.Writer, diffs []model.Diff) error {
+	v := diffReportJSON{
+		Diffs: make([]diffJSON, 0, len(diffs)),
+	}
+
+	for _, d := range diffs {
+		dj := diffJSON{
+			Kind:       d.Kind,
+			Identifier: d.Identifier,
+			Action:     d.Action,
+			Files:      make([]diffFileJSON, 0, len(d.FileDiffs)),
+		}
+		for _, fd := range d.FileDiffs {
+			dj.Files = append(dj.Files, diffFileJSON{Path: fd.Path, UnifiedDiff: fd.UnifiedDiff})
+		}
+		v.Diffs = append(v.Diffs, dj)
+
+		switch d.Action {
+		case model.DiffActionCreate:
+			v.Summary.Create++
+		case model.DiffActionUpdate:
+			v.Summary.Update++
+		case model.DiffActionDelete:
+			v.Summary.Delete++
+		}
+	}
+
+	return printJSON(w, v)
+}
+
+func printTestResultsJSON(w io.Writer, results []model.TestResult) error {
+	v := testReportJSON{
+		Results: make([]testResultJSON, 0, len(results)),
+	}
+
+	for _, r := range results {
+		v.Results = append(v.Results, testResultJSON{
+			Kind:       r.Kind,
+			Identifier: r.Identifier,
+			Name:       r.Name,
+			Handler:    r.Handler,
+			Passed:     r.Passed,
+			Expected:   r.Expected,
+			Actual:     r.Actual,
+			Error:      r.Message,
+			Logs:       r.Logs,
+		})
+
+		if r.Passed {
+			v.Summary.Passed++
+		} else {
+			v.Summary.Failed++
+		}
+	}
+
+	return printJSON(w, v)
+}
+
+func printJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
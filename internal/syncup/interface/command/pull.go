@@ -23,20 +23,33 @@ package command
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
 	"github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	"github.com/Aton-Kish/syncup/internal/xsync"
 	"github.com/spf13/cobra"
 )
 
 type pullFlags struct {
+	configFlags
+	filterFlags
+
 	region  string
 	profile string
 
 	apiID                 string
 	deleteExtraneousFiles bool
+	dryRun                bool
 	baseDir               string
+	concurrency           int
+	rateLimit             float64
+	ageRecipients         []string
+	schemaLayout          string
+	sortSchema            bool
+	output                string
 }
 
 type PullCommand interface {
@@ -50,6 +63,8 @@ type pullCommand struct {
 	awsActivator               repository.AWSActivator
 	baseDirProvider            repository.BaseDirProvider
 	mfaTokenProviderRepository repository.MFATokenProviderRepository
+	configRepository           repository.ConfigRepository
+	reportRepository           repository.ReportRepository
 
 	cmd   *xcommand
 	flags *pullFlags
@@ -64,6 +79,8 @@ func NewPullCommand(repo repository.Repository, optFns ...func(o *options)) Pull
 		awsActivator:               repo,
 		baseDirProvider:            repo,
 		mfaTokenProviderRepository: repo.MFATokenProviderRepository(),
+		configRepository:           repo.ConfigRepositoryForFS(),
+		reportRepository:           repo.ReportRepository(),
 	}
 }
 
@@ -121,11 +138,24 @@ func (c *pullCommand) command() *xcommand {
 
 				ctx := cmd.Context()
 
+				if err := validateOutputFormat(c.flags.output); err != nil {
+					return err
+				}
+
+				if err := applyConfig(ctx, cmd, c.configRepository); err != nil {
+					return err
+				}
+
+				if err := validateSchemaLayout(c.flags.schemaLayout); err != nil {
+					return err
+				}
+
 				if err := c.awsActivator.ActivateAWS(
 					ctx,
 					model.AWSOptionsWithRegion(c.flags.region),
 					model.AWSOptionsWithProfile(c.flags.profile),
 					model.AWSOptionsWithMFATokenProvider(c.mfaTokenProviderRepository.Get(ctx)),
+					model.AWSOptionsWithRateLimit(c.flags.rateLimit),
 				); err != nil {
 					return err
 				}
@@ -137,15 +167,37 @@ func (c *pullCommand) command() *xcommand {
 			RunE: func(cmd *cobra.Command, args []string) (err error) {
 				defer wrap(&err)
 
-				ctx := cmd.Context()
+				ctx := syncup.WithWorkerPool(cmd.Context(), xsync.NewPool(c.flags.concurrency))
+				ctx = syncup.WithEnvironment(ctx, c.flags.env)
+				ctx = syncup.WithAgeRecipients(ctx, c.flags.ageRecipients)
+				ctx = syncup.WithSchemaLayout(ctx, model.SchemaLayout(c.flags.schemaLayout))
+
+				filter, err := newFilter(&c.flags.filterFlags)
+				if err != nil {
+					return err
+				}
+
+				start := time.Now()
 
-				if _, err := c.useCase.Execute(
+				_, err = c.useCase.Execute(
 					ctx,
 					&usecase.PullInput{
 						APIID:                     c.flags.apiID,
 						DeleteExtraneousResources: c.flags.deleteExtraneousFiles,
+						DryRun:                    c.flags.dryRun,
+						SortSchema:                c.flags.sortSchema,
+						Filter:                    filter,
 					},
-				); err != nil {
+				)
+
+				// NOTE: the report is printed even if the execution failed, so that CI can tell which resources failed
+				if c.flags.output == outputFormatJSON {
+					if err := printReportJSON(cmd.OutOrStdout(), c.reportRepository.Get(ctx), c.flags.dryRun, time.Since(start)); err != nil {
+						return err
+					}
+				}
+
+				if err != nil {
 					return err
 				}
 
@@ -154,13 +206,22 @@ func (c *pullCommand) command() *xcommand {
 			SilenceUsage: true,
 		})
 
+		registerConfigFlags(c.cmd.Command, &c.flags.configFlags)
 		c.cmd.Flags().StringVar(&c.flags.profile, "profile", "", "Use a specific profile from your AWS credential file.")
 		c.cmd.Flags().StringVar(&c.flags.region, "region", "", "The AWS region to use. Overrides config/env settings.")
 
 		c.cmd.Flags().StringVar(&c.flags.apiID, "api-id", "", "The API ID of AWS AppSync.")
 		_ = c.cmd.MarkFlagRequired("api-id")
 		c.cmd.Flags().BoolVar(&c.flags.deleteExtraneousFiles, "delete", false, "Delete extraneous resources from file system.")
+		c.cmd.Flags().BoolVar(&c.flags.dryRun, "dry-run", false, "Show what would be saved without making any changes to the file system.")
 		c.cmd.Flags().StringVar(&c.flags.baseDir, "dir", "", "The directory in which the resources will be saved (instead of current directory).")
+		c.cmd.Flags().IntVar(&c.flags.concurrency, "concurrency", defaultConcurrency, "The maximum number of resources to sync in parallel.")
+		c.cmd.Flags().Float64Var(&c.flags.rateLimit, flagNameRateLimit, defaultRateLimit, "The maximum number of requests per second to AWS AppSync.")
+		c.cmd.Flags().StringSliceVar(&c.flags.ageRecipients, flagNameAgeRecipient, nil, "The age recipients to encrypt the environment variables for.")
+		c.cmd.Flags().StringVar(&c.flags.schemaLayout, flagNameSchemaLayout, "", "The layout in which the schema will be saved: file (a single schema.graphqls) or directory (one file per type in schema/). Defaults to the layout of the existing schema.")
+		c.cmd.Flags().BoolVar(&c.flags.sortSchema, flagNameSortSchema, false, "Sort the types and fields of the schema by name.")
+		registerFilterFlags(c.cmd.Command, &c.flags.filterFlags)
+		registerOutputFlag(c.cmd.Command, &c.flags.output)
 
 		c.cmd.SetIn(c.options.stdio.in)
 		c.cmd.SetOut(c.options.stdio.out)
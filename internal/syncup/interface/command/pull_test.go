@@ -26,6 +26,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
@@ -48,6 +49,15 @@ func Test_pullCommand_Execute(t *testing.T) {
 		returns []mockMFATokenProviderRepositoryGetReturn
 	}
 
+	type mockConfigRepositoryGetReturn struct {
+		res *model.Config
+		err error
+	}
+	type mockConfigRepositoryGet struct {
+		calls   int
+		returns []mockConfigRepositoryGetReturn
+	}
+
 	type mockAWSActivatorActivateAWSReturn struct {
 		err error
 	}
@@ -72,17 +82,28 @@ func Test_pullCommand_Execute(t *testing.T) {
 		returns []mockPullUseCaseExecuteReturn
 	}
 
+	type mockReportRepositoryGetReturn struct {
+		res *model.Report
+	}
+	type mockReportRepositoryGet struct {
+		calls   int
+		returns []mockReportRepositoryGetReturn
+	}
+
 	type expected struct {
-		errIs error
+		stdout string
+		errIs  error
 	}
 
 	tests := []struct {
 		name                              string
 		args                              args
+		mockConfigRepositoryGet           mockConfigRepositoryGet
 		mockMFATokenProviderRepositoryGet mockMFATokenProviderRepositoryGet
 		mockAWSActivatorActivateAWS       mockAWSActivatorActivateAWS
 		mockBaseDirProviderSetBaseDir     mockBaseDirProviderSetBaseDir
 		mockPullUseCaseExecute            mockPullUseCaseExecute
+		mockReportRepositoryGet           mockReportRepositoryGet
 		expected                          expected
 	}{
 		{
@@ -90,6 +111,14 @@ func Test_pullCommand_Execute(t *testing.T) {
 			args: args{
 				args: []string{"--api-id", "apiID"},
 			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
 			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
 				returns: []mockMFATokenProviderRepositoryGetReturn{
 					{
@@ -119,15 +148,145 @@ func Test_pullCommand_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
 			expected: expected{
 				errIs: nil,
 			},
 		},
+		{
+			name: "happy path: with filter flags",
+			args: args{
+				args: []string{"--api-id", "apiID", "--only", "functions,resolvers", "--include", "Query.*", "--exclude", "fn:legacy*"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPullUseCaseExecute: mockPullUseCaseExecute{
+				returns: []mockPullUseCaseExecuteReturn{
+					{
+						res: &usecase.PullOutput{},
+						err: nil,
+					},
+				},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: --output json",
+			args: args{
+				args: []string{"--api-id", "apiID", "--output", "json"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPullUseCaseExecute: mockPullUseCaseExecute{
+				returns: []mockPullUseCaseExecuteReturn{
+					{
+						res: &usecase.PullOutput{},
+						err: nil,
+					},
+				},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{
+					{
+						res: &model.Report{
+							Events: []model.TrackerEvent{
+								{
+									Kind:       model.ResourceKindFunction,
+									Identifier: "getPost",
+									Action:     model.TrackerActionUpdated,
+									Duration:   12 * time.Millisecond,
+								},
+								{
+									Kind:       model.ResourceKindResolver,
+									Identifier: "Query.getPost",
+									Action:     model.TrackerActionFailed,
+									Duration:   34 * time.Millisecond,
+									Err:        errors.New("error"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: expected{
+				stdout: "{\n  \"dryRun\": false,\n  \"events\": [\n    {\n      \"kind\": \"function\",\n      \"identifier\": \"getPost\",\n      \"action\": \"updated\",\n      \"durationMs\": 12\n    },\n    {\n      \"kind\": \"resolver\",\n      \"identifier\": \"Query.getPost\",\n      \"action\": \"failed\",\n      \"durationMs\": 34,\n      \"error\": \"error\"\n    }\n  ],\n  \"summary\": {\n    \"created\": 0,\n    \"updated\": 1,\n    \"deleted\": 0,\n    \"unchanged\": 0,\n    \"failed\": 1,\n    \"durationMs\": 0\n  }\n}\n",
+				errIs:  nil,
+			},
+		},
 		{
 			name: "edge path: missing --api-id flag",
 			args: args{
 				args: []string{},
 			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
 			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
 				returns: []mockMFATokenProviderRepositoryGetReturn{
 					{
@@ -152,6 +311,9 @@ func Test_pullCommand_Execute(t *testing.T) {
 			mockPullUseCaseExecute: mockPullUseCaseExecute{
 				returns: []mockPullUseCaseExecuteReturn{},
 			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
 			expected: expected{
 				errIs: nil,
 			},
@@ -161,6 +323,14 @@ func Test_pullCommand_Execute(t *testing.T) {
 			args: args{
 				args: []string{"--api-id", "apiID"},
 			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
 			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
 				returns: []mockMFATokenProviderRepositoryGetReturn{
 					{
@@ -183,6 +353,9 @@ func Test_pullCommand_Execute(t *testing.T) {
 			mockPullUseCaseExecute: mockPullUseCaseExecute{
 				returns: []mockPullUseCaseExecuteReturn{},
 			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
 			expected: expected{
 				errIs: nil,
 			},
@@ -192,6 +365,14 @@ func Test_pullCommand_Execute(t *testing.T) {
 			args: args{
 				args: []string{"--api-id", "apiID"},
 			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
 			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
 				returns: []mockMFATokenProviderRepositoryGetReturn{
 					{
@@ -221,10 +402,116 @@ func Test_pullCommand_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
 			expected: expected{
 				errIs: nil,
 			},
 		},
+		{
+			name: "edge path: invalid --output flag",
+			args: args{
+				args: []string{"--api-id", "apiID", "--output", "yaml"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{},
+			},
+			mockPullUseCaseExecute: mockPullUseCaseExecute{
+				returns: []mockPullUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: invalid --schema-layout flag",
+			args: args{
+				args: []string{"--api-id", "apiID", "--schema-layout", "types"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{},
+			},
+			mockPullUseCaseExecute: mockPullUseCaseExecute{
+				returns: []mockPullUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: invalid --only flag",
+			args: args{
+				args: []string{"--api-id", "apiID", "--only", "types"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPullUseCaseExecute: mockPullUseCaseExecute{
+				returns: []mockPullUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -239,6 +526,18 @@ func Test_pullCommand_Execute(t *testing.T) {
 			mockAWSActivator := mock_repository.NewMockAWSActivator(ctrl)
 			mockBaseDirProvider := mock_repository.NewMockBaseDirProvider(ctrl)
 			mockMFATokenProviderRepository := mock_repository.NewMockMFATokenProviderRepository(ctrl)
+			mockConfigRepository := mock_repository.NewMockConfigRepository(ctrl)
+			mockReportRepository := mock_repository.NewMockReportRepository(ctrl)
+
+			mockConfigRepository.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, path string) (*model.Config, error) {
+					r := tt.mockConfigRepositoryGet.returns[tt.mockConfigRepositoryGet.calls]
+					tt.mockConfigRepositoryGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockConfigRepositoryGet.returns))
 
 			mockMFATokenProviderRepository.
 				EXPECT().
@@ -270,7 +569,7 @@ func Test_pullCommand_Execute(t *testing.T) {
 
 			mockPullUseCase.
 				EXPECT().
-				Execute(ctx, gomock.Any()).
+				Execute(gomock.Any(), gomock.Any()).
 				DoAndReturn(func(ctx context.Context, params *usecase.PullInput) (*usecase.PullOutput, error) {
 					r := tt.mockPullUseCaseExecute.returns[tt.mockPullUseCaseExecute.calls]
 					tt.mockPullUseCaseExecute.calls++
@@ -278,6 +577,16 @@ func Test_pullCommand_Execute(t *testing.T) {
 				}).
 				Times(len(tt.mockPullUseCaseExecute.returns))
 
+			mockReportRepository.
+				EXPECT().
+				Get(gomock.Any()).
+				DoAndReturn(func(ctx context.Context) *model.Report {
+					r := tt.mockReportRepositoryGet.returns[tt.mockReportRepositoryGet.calls]
+					tt.mockReportRepositoryGet.calls++
+					return r.res
+				}).
+				Times(len(tt.mockReportRepositoryGet.returns))
+
 			stdin := new(bytes.Reader)
 			stdout := new(bytes.Buffer)
 			stderr := new(bytes.Buffer)
@@ -288,6 +597,8 @@ func Test_pullCommand_Execute(t *testing.T) {
 				awsActivator:               mockAWSActivator,
 				baseDirProvider:            mockBaseDirProvider,
 				mfaTokenProviderRepository: mockMFATokenProviderRepository,
+				configRepository:           mockConfigRepository,
+				reportRepository:           mockReportRepository,
 			}
 
 			// Act
@@ -298,7 +609,7 @@ func Test_pullCommand_Execute(t *testing.T) {
 				assert.NoError(t, err)
 
 				assert.Equal(t, 0, stdin.Len())
-				assert.Equal(t, 0, stdout.Len())
+				assert.Equal(t, tt.expected.stdout, stdout.String())
 				assert.Equal(t, 0, stderr.Len())
 			} else {
 				var ce *commandError
@@ -22,21 +22,43 @@ package command
 
 import (
 	"context"
+	"os"
+	"os/signal"
 	"sync"
+	"time"
 
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
 	"github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	"github.com/Aton-Kish/syncup/internal/xsync"
 	"github.com/spf13/cobra"
 )
 
+// NOTE: long enough to cover editors that save a file in several writes
+const watchDebounce = 300 * time.Millisecond
+
 type pushFlags struct {
+	configFlags
+	filterFlags
+
 	region  string
 	profile string
 
 	apiID                 string
 	deleteExtraneousFiles bool
+	dryRun                bool
+	yes                   bool
+	atomic                bool
+	force                 bool
+	allowBreaking         bool
+	watch                 bool
+	keepBackups           int
 	baseDir               string
+	concurrency           int
+	rateLimit             float64
+	output                string
+	ageRecipients         []string
 }
 
 type PushCommand interface {
@@ -47,9 +69,12 @@ type pushCommand struct {
 	options *options
 
 	useCase                    usecase.PushUseCase
+	watchUseCase               usecase.WatchUseCase
 	awsActivator               repository.AWSActivator
 	baseDirProvider            repository.BaseDirProvider
 	mfaTokenProviderRepository repository.MFATokenProviderRepository
+	configRepository           repository.ConfigRepository
+	reportRepository           repository.ReportRepository
 
 	cmd   *xcommand
 	flags *pushFlags
@@ -61,9 +86,12 @@ func NewPushCommand(repo repository.Repository, optFns ...func(o *options)) Push
 		options: newOptions(optFns...),
 
 		useCase:                    usecase.NewPushUseCase(repo),
+		watchUseCase:               usecase.NewWatchUseCase(repo),
 		awsActivator:               repo,
 		baseDirProvider:            repo,
 		mfaTokenProviderRepository: repo.MFATokenProviderRepository(),
+		configRepository:           repo.ConfigRepositoryForFS(),
+		reportRepository:           repo.ReportRepository(),
 	}
 }
 
@@ -121,11 +149,20 @@ func (c *pushCommand) command() *xcommand {
 
 				ctx := cmd.Context()
 
+				if err := validateOutputFormat(c.flags.output); err != nil {
+					return err
+				}
+
+				if err := applyConfig(ctx, cmd, c.configRepository); err != nil {
+					return err
+				}
+
 				if err := c.awsActivator.ActivateAWS(
 					ctx,
 					model.AWSOptionsWithRegion(c.flags.region),
 					model.AWSOptionsWithProfile(c.flags.profile),
 					model.AWSOptionsWithMFATokenProvider(c.mfaTokenProviderRepository.Get(ctx)),
+					model.AWSOptionsWithRateLimit(c.flags.rateLimit),
 				); err != nil {
 					return err
 				}
@@ -137,13 +174,59 @@ func (c *pushCommand) command() *xcommand {
 			RunE: func(cmd *cobra.Command, args []string) (err error) {
 				defer wrap(&err)
 
-				ctx := cmd.Context()
+				ctx := syncup.WithWorkerPool(cmd.Context(), xsync.NewPool(c.flags.concurrency))
+				ctx = syncup.WithEnvironment(ctx, c.flags.env)
+				ctx = syncup.WithAgeRecipients(ctx, c.flags.ageRecipients)
+
+				filter, err := newFilter(&c.flags.filterFlags)
+				if err != nil {
+					return err
+				}
 
-				if _, err := c.useCase.Execute(
+				start := time.Now()
+
+				_, err = c.useCase.Execute(
 					ctx,
 					&usecase.PushInput{
 						APIID:                     c.flags.apiID,
 						DeleteExtraneousResources: c.flags.deleteExtraneousFiles,
+						DryRun:                    c.flags.dryRun,
+						SkipConfirmation:          c.flags.yes,
+						KeepBackups:               c.flags.keepBackups,
+						Atomic:                    c.flags.atomic,
+						Force:                     c.flags.force,
+						AllowBreaking:             c.flags.allowBreaking,
+						Filter:                    filter,
+					},
+				)
+
+				// NOTE: the report is printed even if the execution failed, so that CI can tell which resources failed
+				if c.flags.output == outputFormatJSON {
+					if err := printReportJSON(cmd.OutOrStdout(), c.reportRepository.Get(ctx), c.flags.dryRun, time.Since(start)); err != nil {
+						return err
+					}
+				}
+
+				if err != nil {
+					return err
+				}
+
+				if !c.flags.watch {
+					return nil
+				}
+
+				// NOTE: watching stops on interrupt, so that the command exits cleanly
+				ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+				defer stop()
+
+				if _, err := c.watchUseCase.Execute(
+					ctx,
+					&usecase.WatchInput{
+						APIID:         c.flags.apiID,
+						Debounce:      watchDebounce,
+						Force:         c.flags.force,
+						AllowBreaking: c.flags.allowBreaking,
+						Filter:        filter,
 					},
 				); err != nil {
 					return err
@@ -154,13 +237,27 @@ func (c *pushCommand) command() *xcommand {
 			SilenceUsage: true,
 		})
 
+		registerConfigFlags(c.cmd.Command, &c.flags.configFlags)
 		c.cmd.Flags().StringVar(&c.flags.profile, "profile", "", "Use a specific profile from your AWS credential file.")
 		c.cmd.Flags().StringVar(&c.flags.region, "region", "", "The AWS region to use. Overrides config/env settings.")
 
 		c.cmd.Flags().StringVar(&c.flags.apiID, "api-id", "", "The API ID of AWS AppSync.")
 		_ = c.cmd.MarkFlagRequired("api-id")
 		c.cmd.Flags().BoolVar(&c.flags.deleteExtraneousFiles, "delete", false, "Delete extraneous resources from AWS AppSync.")
+		c.cmd.Flags().BoolVar(&c.flags.dryRun, "dry-run", false, "Show what would be pushed without making any changes to AWS AppSync.")
+		c.cmd.Flags().BoolVar(&c.flags.yes, "yes", false, "Skip the confirmation before deleting extraneous resources and resolvers of removed fields.")
+		c.cmd.Flags().BoolVar(&c.flags.atomic, "atomic", false, "Roll back the changes made to AWS AppSync if the push fails.")
+		c.cmd.Flags().BoolVar(&c.flags.force, "force", false, "Overwrite functions and resolvers changed in AWS AppSync since the last pull or push.")
+		c.cmd.Flags().BoolVar(&c.flags.allowBreaking, "allow-breaking", false, "Push the schema even if it has breaking changes, e.g. removed fields or enum values.")
+		c.cmd.Flags().BoolVar(&c.flags.watch, "watch", false, "Keep watching the directory after the push and push each changed resource.")
+		c.cmd.MarkFlagsMutuallyExclusive("watch", "dry-run")
+		c.cmd.Flags().IntVar(&c.flags.keepBackups, flagNameKeepBackups, defaultKeepBackups, "The number of local backups of the remote resources to keep (0 disables backups).")
 		c.cmd.Flags().StringVar(&c.flags.baseDir, "dir", "", "The directory from which the resources will be loaded (instead of current directory).")
+		c.cmd.Flags().IntVar(&c.flags.concurrency, "concurrency", defaultConcurrency, "The maximum number of resources to sync in parallel.")
+		c.cmd.Flags().Float64Var(&c.flags.rateLimit, flagNameRateLimit, defaultRateLimit, "The maximum number of requests per second to AWS AppSync.")
+		c.cmd.Flags().StringSliceVar(&c.flags.ageRecipients, flagNameAgeRecipient, nil, "The age recipients to encrypt the environment variables in the backup for.")
+		registerFilterFlags(c.cmd.Command, &c.flags.filterFlags)
+		registerOutputFlag(c.cmd.Command, &c.flags.output)
 
 		c.cmd.SetIn(c.options.stdio.in)
 		c.cmd.SetOut(c.options.stdio.out)
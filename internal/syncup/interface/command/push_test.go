@@ -26,6 +26,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
@@ -48,6 +49,15 @@ func Test_pushCommand_Execute(t *testing.T) {
 		returns []mockMFATokenProviderRepositoryGetReturn
 	}
 
+	type mockConfigRepositoryGetReturn struct {
+		res *model.Config
+		err error
+	}
+	type mockConfigRepositoryGet struct {
+		calls   int
+		returns []mockConfigRepositoryGetReturn
+	}
+
 	type mockAWSActivatorActivateAWSReturn struct {
 		err error
 	}
@@ -72,17 +82,39 @@ func Test_pushCommand_Execute(t *testing.T) {
 		returns []mockPushUseCaseExecuteReturn
 	}
 
+	type mockWatchUseCaseExecuteReturn struct {
+		filtered bool
+		res      *usecase.WatchOutput
+		err      error
+	}
+	type mockWatchUseCaseExecute struct {
+		calls   int
+		returns []mockWatchUseCaseExecuteReturn
+	}
+
+	type mockReportRepositoryGetReturn struct {
+		res *model.Report
+	}
+	type mockReportRepositoryGet struct {
+		calls   int
+		returns []mockReportRepositoryGetReturn
+	}
+
 	type expected struct {
-		errIs error
+		stdout string
+		errIs  error
 	}
 
 	tests := []struct {
 		name                              string
 		args                              args
+		mockConfigRepositoryGet           mockConfigRepositoryGet
 		mockMFATokenProviderRepositoryGet mockMFATokenProviderRepositoryGet
 		mockAWSActivatorActivateAWS       mockAWSActivatorActivateAWS
 		mockBaseDirProviderSetBaseDir     mockBaseDirProviderSetBaseDir
 		mockPushUseCaseExecute            mockPushUseCaseExecute
+		mockWatchUseCaseExecute           mockWatchUseCaseExecute
+		mockReportRepositoryGet           mockReportRepositoryGet
 		expected                          expected
 	}{
 		{
@@ -90,6 +122,374 @@ func Test_pushCommand_Execute(t *testing.T) {
 			args: args{
 				args: []string{"--api-id", "apiID"},
 			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res: &usecase.PushOutput{},
+						err: nil,
+					},
+				},
+			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: --watch",
+			args: args{
+				args: []string{"--api-id", "apiID", "--watch"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res: &usecase.PushOutput{},
+						err: nil,
+					},
+				},
+			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{
+					{
+						res: &usecase.WatchOutput{},
+						err: nil,
+					},
+				},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: --watch with filter flags",
+			args: args{
+				args: []string{"--api-id", "apiID", "--watch", "--only", "functions,resolvers"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res: &usecase.PushOutput{},
+						err: nil,
+					},
+				},
+			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{
+					{
+						filtered: true,
+						res:      &usecase.WatchOutput{},
+						err:      nil,
+					},
+				},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: with filter flags",
+			args: args{
+				args: []string{"--api-id", "apiID", "--only", "functions,resolvers", "--include", "Query.*", "--exclude", "fn:legacy*"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res: &usecase.PushOutput{},
+						err: nil,
+					},
+				},
+			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: with --allow-breaking flag",
+			args: args{
+				args: []string{"--api-id", "apiID", "--allow-breaking"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res: &usecase.PushOutput{},
+						err: nil,
+					},
+				},
+			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: --output json",
+			args: args{
+				args: []string{"--api-id", "apiID", "--output", "json"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res: &usecase.PushOutput{},
+						err: nil,
+					},
+				},
+			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{
+					{
+						res: &model.Report{
+							Events: []model.TrackerEvent{
+								{
+									Kind:       model.ResourceKindSchema,
+									Identifier: "Post.title",
+									Action:     model.TrackerActionChanged,
+									Message:    "breaking change: field Post.title was removed",
+									Level:      model.SchemaChangeLevelBreaking,
+								},
+								{
+									Kind:       model.ResourceKindFunction,
+									Identifier: "getPost",
+									Action:     model.TrackerActionUpdated,
+									Duration:   12 * time.Millisecond,
+								},
+								{
+									Kind:       model.ResourceKindResolver,
+									Identifier: "Query.getPost",
+									Action:     model.TrackerActionFailed,
+									Duration:   34 * time.Millisecond,
+									Err:        errors.New("error"),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: expected{
+				stdout: "{\n  \"dryRun\": false,\n  \"events\": [\n    {\n      \"kind\": \"schema\",\n      \"identifier\": \"Post.title\",\n      \"action\": \"changed\",\n      \"level\": \"breaking\",\n      \"message\": \"breaking change: field Post.title was removed\",\n      \"durationMs\": 0\n    },\n    {\n      \"kind\": \"function\",\n      \"identifier\": \"getPost\",\n      \"action\": \"updated\",\n      \"durationMs\": 12\n    },\n    {\n      \"kind\": \"resolver\",\n      \"identifier\": \"Query.getPost\",\n      \"action\": \"failed\",\n      \"durationMs\": 34,\n      \"error\": \"error\"\n    }\n  ],\n  \"summary\": {\n    \"created\": 0,\n    \"updated\": 1,\n    \"deleted\": 0,\n    \"unchanged\": 0,\n    \"failed\": 1,\n    \"durationMs\": 0\n  }\n}\n",
+				errIs:  nil,
+			},
+		},
+		{
+			name: "happy path: with --env flag",
+			args: args{
+				args: []string{"--env", "dev"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{
+							Environments: map[string]model.Environment{
+								"dev": {
+									APIID:   "apiID",
+									Region:  "ap-northeast-1",
+									Profile: "dev",
+									BaseDir: "dev",
+								},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
 			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
 				returns: []mockMFATokenProviderRepositoryGetReturn{
 					{
@@ -119,6 +519,12 @@ func Test_pushCommand_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
 			expected: expected{
 				errIs: nil,
 			},
@@ -128,6 +534,14 @@ func Test_pushCommand_Execute(t *testing.T) {
 			args: args{
 				args: []string{},
 			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
 			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
 				returns: []mockMFATokenProviderRepositoryGetReturn{
 					{
@@ -152,6 +566,12 @@ func Test_pushCommand_Execute(t *testing.T) {
 			mockPushUseCaseExecute: mockPushUseCaseExecute{
 				returns: []mockPushUseCaseExecuteReturn{},
 			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
 			expected: expected{
 				errIs: nil,
 			},
@@ -161,6 +581,14 @@ func Test_pushCommand_Execute(t *testing.T) {
 			args: args{
 				args: []string{"--api-id", "apiID"},
 			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
 			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
 				returns: []mockMFATokenProviderRepositoryGetReturn{
 					{
@@ -183,6 +611,12 @@ func Test_pushCommand_Execute(t *testing.T) {
 			mockPushUseCaseExecute: mockPushUseCaseExecute{
 				returns: []mockPushUseCaseExecuteReturn{},
 			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
 			expected: expected{
 				errIs: nil,
 			},
@@ -192,6 +626,14 @@ func Test_pushCommand_Execute(t *testing.T) {
 			args: args{
 				args: []string{"--api-id", "apiID"},
 			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
 			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
 				returns: []mockMFATokenProviderRepositoryGetReturn{
 					{
@@ -221,10 +663,232 @@ func Test_pushCommand_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
 			expected: expected{
 				errIs: nil,
 			},
 		},
+		{
+			name: "edge path: WatchUseCase.Execute() error",
+			args: args{
+				args: []string{"--api-id", "apiID", "--watch"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res: &usecase.PushOutput{},
+						err: nil,
+					},
+				},
+			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: --watch with --dry-run",
+			args: args{
+				args: []string{"--api-id", "apiID", "--watch", "--dry-run"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{},
+			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ConfigRepository.Get() error",
+			args: args{
+				args: []string{"--api-id", "apiID", "--config", "missing.yaml"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{},
+			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: invalid --output flag",
+			args: args{
+				args: []string{"--api-id", "apiID", "--output", "yaml"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{},
+			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: invalid --only flag",
+			args: args{
+				args: []string{"--api-id", "apiID", "--only", "types"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{},
+			},
+			mockWatchUseCaseExecute: mockWatchUseCaseExecute{
+				returns: []mockWatchUseCaseExecuteReturn{},
+			},
+			mockReportRepositoryGet: mockReportRepositoryGet{
+				returns: []mockReportRepositoryGetReturn{},
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -236,9 +900,22 @@ func Test_pushCommand_Execute(t *testing.T) {
 			defer ctrl.Finish()
 
 			mockPushUseCase := mock_usecase.NewMockPushUseCase(ctrl)
+			mockWatchUseCase := mock_usecase.NewMockWatchUseCase(ctrl)
 			mockAWSActivator := mock_repository.NewMockAWSActivator(ctrl)
 			mockBaseDirProvider := mock_repository.NewMockBaseDirProvider(ctrl)
 			mockMFATokenProviderRepository := mock_repository.NewMockMFATokenProviderRepository(ctrl)
+			mockConfigRepository := mock_repository.NewMockConfigRepository(ctrl)
+			mockReportRepository := mock_repository.NewMockReportRepository(ctrl)
+
+			mockConfigRepository.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, path string) (*model.Config, error) {
+					r := tt.mockConfigRepositoryGet.returns[tt.mockConfigRepositoryGet.calls]
+					tt.mockConfigRepositoryGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockConfigRepositoryGet.returns))
 
 			mockMFATokenProviderRepository.
 				EXPECT().
@@ -270,7 +947,7 @@ func Test_pushCommand_Execute(t *testing.T) {
 
 			mockPushUseCase.
 				EXPECT().
-				Execute(ctx, gomock.Any()).
+				Execute(gomock.Any(), gomock.Any()).
 				DoAndReturn(func(ctx context.Context, params *usecase.PushInput) (*usecase.PushOutput, error) {
 					r := tt.mockPushUseCaseExecute.returns[tt.mockPushUseCaseExecute.calls]
 					tt.mockPushUseCaseExecute.calls++
@@ -278,6 +955,28 @@ func Test_pushCommand_Execute(t *testing.T) {
 				}).
 				Times(len(tt.mockPushUseCaseExecute.returns))
 
+			mockWatchUseCase.
+				EXPECT().
+				Execute(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, params *usecase.WatchInput) (*usecase.WatchOutput, error) {
+					r := tt.mockWatchUseCaseExecute.returns[tt.mockWatchUseCaseExecute.calls]
+					tt.mockWatchUseCaseExecute.calls++
+					// NOTE: the changes pushed while watching are filtered as the initial push is
+					assert.Equal(t, r.filtered, params.Filter != nil)
+					return r.res, r.err
+				}).
+				Times(len(tt.mockWatchUseCaseExecute.returns))
+
+			mockReportRepository.
+				EXPECT().
+				Get(gomock.Any()).
+				DoAndReturn(func(ctx context.Context) *model.Report {
+					r := tt.mockReportRepositoryGet.returns[tt.mockReportRepositoryGet.calls]
+					tt.mockReportRepositoryGet.calls++
+					return r.res
+				}).
+				Times(len(tt.mockReportRepositoryGet.returns))
+
 			stdin := new(bytes.Reader)
 			stdout := new(bytes.Buffer)
 			stderr := new(bytes.Buffer)
@@ -285,9 +984,12 @@ func Test_pushCommand_Execute(t *testing.T) {
 			c := &pushCommand{
 				options:                    newOptions(WithStdio(stdin, stdout, stderr)),
 				useCase:                    mockPushUseCase,
+				watchUseCase:               mockWatchUseCase,
 				awsActivator:               mockAWSActivator,
 				baseDirProvider:            mockBaseDirProvider,
 				mfaTokenProviderRepository: mockMFATokenProviderRepository,
+				configRepository:           mockConfigRepository,
+				reportRepository:           mockReportRepository,
 			}
 
 			// Act
@@ -298,7 +1000,7 @@ func Test_pushCommand_Execute(t *testing.T) {
 				assert.NoError(t, err)
 
 				assert.Equal(t, 0, stdin.Len())
-				assert.Equal(t, 0, stdout.Len())
+				assert.Equal(t, tt.expected.stdout, stdout.String())
 				assert.Equal(t, 0, stderr.Len())
 			} else {
 				var ce *commandError
@@ -0,0 +1,234 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	"github.com/Aton-Kish/syncup/internal/xsync"
+	"github.com/spf13/cobra"
+)
+
+type restoreFlags struct {
+	configFlags
+
+	region  string
+	profile string
+
+	apiID         string
+	backup        string
+	yes           bool
+	atomic        bool
+	keepBackups   int
+	baseDir       string
+	concurrency   int
+	rateLimit     float64
+	ageRecipients []string
+}
+
+type RestoreCommand interface {
+	Command
+}
+
+type restoreCommand struct {
+	options *options
+
+	useCase                    usecase.PushUseCase
+	backupRepository           repository.BackupRepository
+	resourceRepository         repository.ResourceRepository
+	awsActivator               repository.AWSActivator
+	baseDirProvider            repository.BaseDirProvider
+	mfaTokenProviderRepository repository.MFATokenProviderRepository
+	configRepository           repository.ConfigRepository
+
+	cmd   *xcommand
+	flags *restoreFlags
+	once  sync.Once
+}
+
+func NewRestoreCommand(repo repository.Repository, optFns ...func(o *options)) RestoreCommand {
+	return &restoreCommand{
+		options: newOptions(optFns...),
+
+		useCase:                    usecase.NewPushUseCase(repo),
+		backupRepository:           repo.BackupRepositoryForFS(),
+		resourceRepository:         repo.ResourceRepositoryForFS(),
+		awsActivator:               repo,
+		baseDirProvider:            repo,
+		mfaTokenProviderRepository: repo.MFATokenProviderRepository(),
+		configRepository:           repo.ConfigRepositoryForFS(),
+	}
+}
+
+func (c *restoreCommand) Execute(ctx context.Context, args ...string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.SetArgs(args)
+
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *restoreCommand) RegisterSubCommands(cmds ...Command) {
+	subs := make([]*cobra.Command, 0, len(cmds))
+	for _, cmd := range cmds {
+		subs = append(subs, cmd.command().Command)
+	}
+
+	cmd := c.command()
+	cmd.AddCommand(subs...)
+}
+
+func (c *restoreCommand) GenerateReadme(ctx context.Context, dir string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.InitDefaultCompletionCmd()
+
+	return cmd.GenerateReadme(dir)
+}
+
+func (c *restoreCommand) GenerateReferences(ctx context.Context, dir string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.InitDefaultVersionFlag()
+	cmd.InitDefaultCompletionCmd()
+
+	return cmd.GenerateReferences(dir)
+}
+
+func (c *restoreCommand) command() *xcommand {
+	c.once.Do(func() {
+		c.flags = new(restoreFlags)
+
+		c.cmd = newCommand(&cobra.Command{
+			Use:   "restore",
+			Short: "Restore resources to AWS AppSync from a local backup",
+			PreRunE: func(cmd *cobra.Command, args []string) (err error) {
+				defer wrap(&err)
+
+				ctx := cmd.Context()
+
+				if err := applyConfig(ctx, cmd, c.configRepository); err != nil {
+					return err
+				}
+
+				if err := c.awsActivator.ActivateAWS(
+					ctx,
+					model.AWSOptionsWithRegion(c.flags.region),
+					model.AWSOptionsWithProfile(c.flags.profile),
+					model.AWSOptionsWithMFATokenProvider(c.mfaTokenProviderRepository.Get(ctx)),
+					model.AWSOptionsWithRateLimit(c.flags.rateLimit),
+				); err != nil {
+					return err
+				}
+
+				c.baseDirProvider.SetBaseDir(ctx, c.flags.baseDir)
+
+				return nil
+			},
+			RunE: func(cmd *cobra.Command, args []string) (err error) {
+				defer wrap(&err)
+
+				ctx := syncup.WithWorkerPool(cmd.Context(), xsync.NewPool(c.flags.concurrency))
+				ctx = syncup.WithAgeRecipients(ctx, c.flags.ageRecipients)
+
+				backup, err := c.backupRepository.Get(ctx, c.flags.apiID, c.flags.backup)
+				if err != nil {
+					return err
+				}
+
+				ctx = syncup.WithBaseDir(ctx, backup.Dir)
+
+				exists, err := c.resourceRepository.Exists(ctx, c.flags.apiID, model.ResourceKindEnvironmentVariables, "")
+				if err != nil {
+					return err
+				}
+
+				var filter *model.Filter
+				if !exists {
+					// NOTE: the backups taken without age recipients leave the environment variables out, so the remote ones are kept as they are
+					filter = &model.Filter{
+						Kinds: []model.ResourceKind{
+							model.ResourceKindGraphqlApi,
+							model.ResourceKindSchema,
+							model.ResourceKindDataSource,
+							model.ResourceKindFunction,
+							model.ResourceKindResolver,
+						},
+					}
+				}
+
+				// NOTE: the backup is pushed as is, so that the remote resources match it exactly, even if they were changed since the last sync or the schema loses fields added since the backup
+				if _, err := c.useCase.Execute(
+					ctx,
+					&usecase.PushInput{
+						APIID:                     c.flags.apiID,
+						Filter:                    filter,
+						DeleteExtraneousResources: true,
+						SkipConfirmation:          c.flags.yes,
+						KeepBackups:               c.flags.keepBackups,
+						Atomic:                    c.flags.atomic,
+						Force:                     true,
+						AllowBreaking:             true,
+					},
+				); err != nil {
+					return err
+				}
+
+				return nil
+			},
+			SilenceUsage: true,
+		})
+
+		registerConfigFlags(c.cmd.Command, &c.flags.configFlags)
+		c.cmd.Flags().StringVar(&c.flags.profile, "profile", "", "Use a specific profile from your AWS credential file.")
+		c.cmd.Flags().StringVar(&c.flags.region, "region", "", "The AWS region to use. Overrides config/env settings.")
+
+		c.cmd.Flags().StringVar(&c.flags.apiID, "api-id", "", "The API ID of AWS AppSync.")
+		_ = c.cmd.MarkFlagRequired("api-id")
+		c.cmd.Flags().StringVar(&c.flags.backup, "backup", "", "The timestamp of the backup to restore.")
+		_ = c.cmd.MarkFlagRequired("backup")
+		c.cmd.Flags().BoolVar(&c.flags.yes, "yes", false, "Skip the confirmation before deleting resources missing from the backup.")
+		c.cmd.Flags().BoolVar(&c.flags.atomic, "atomic", false, "Roll back the changes made to AWS AppSync if the restore fails.")
+		c.cmd.Flags().IntVar(&c.flags.keepBackups, flagNameKeepBackups, defaultKeepBackups, "The number of local backups of the remote resources to keep (0 disables backups).")
+		c.cmd.Flags().StringVar(&c.flags.baseDir, "dir", "", "The directory containing the .syncup backups (instead of current directory).")
+		c.cmd.Flags().IntVar(&c.flags.concurrency, "concurrency", defaultConcurrency, "The maximum number of resources to sync in parallel.")
+		c.cmd.Flags().Float64Var(&c.flags.rateLimit, flagNameRateLimit, defaultRateLimit, "The maximum number of requests per second to AWS AppSync.")
+		c.cmd.Flags().StringSliceVar(&c.flags.ageRecipients, flagNameAgeRecipient, nil, "The age recipients to encrypt the environment variables in the backup for.")
+
+		c.cmd.SetIn(c.options.stdio.in)
+		c.cmd.SetOut(c.options.stdio.out)
+		c.cmd.SetErr(c.options.stdio.err)
+	})
+
+	return c.cmd
+}
@@ -0,0 +1,872 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	"github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	mock_usecase "github.com/Aton-Kish/syncup/internal/syncup/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_restoreCommand_Execute(t *testing.T) {
+	backup := &model.Backup{
+		APIID:     "apiID",
+		Timestamp: "20231101T090000.000Z",
+		Dir:       ".syncup/backups/apiID/20231101T090000.000Z",
+	}
+
+	type args struct {
+		args []string
+	}
+
+	type mockMFATokenProviderRepositoryGetReturn struct {
+		res model.MFATokenProvider
+	}
+	type mockMFATokenProviderRepositoryGet struct {
+		calls   int
+		returns []mockMFATokenProviderRepositoryGetReturn
+	}
+
+	type mockConfigRepositoryGetReturn struct {
+		res *model.Config
+		err error
+	}
+	type mockConfigRepositoryGet struct {
+		calls   int
+		returns []mockConfigRepositoryGetReturn
+	}
+
+	type mockAWSActivatorActivateAWSReturn struct {
+		err error
+	}
+	type mockAWSActivatorActivateAWS struct {
+		calls   int
+		returns []mockAWSActivatorActivateAWSReturn
+	}
+
+	type mockBaseDirProviderSetBaseDirReturn struct {
+	}
+	type mockBaseDirProviderSetBaseDir struct {
+		calls   int
+		returns []mockBaseDirProviderSetBaseDirReturn
+	}
+
+	type mockPushUseCaseExecuteReturn struct {
+		res             *usecase.PushOutput
+		err             error
+		breakingChanges bool
+	}
+	type mockPushUseCaseExecute struct {
+		calls   int
+		returns []mockPushUseCaseExecuteReturn
+	}
+
+	type mockBackupRepositoryGetReturn struct {
+		res *model.Backup
+		err error
+	}
+	type mockBackupRepositoryGet struct {
+		calls   int
+		returns []mockBackupRepositoryGetReturn
+	}
+
+	type mockResourceRepositoryExistsReturn struct {
+		res bool
+		err error
+	}
+	type mockResourceRepositoryExists struct {
+		calls   int
+		returns []mockResourceRepositoryExistsReturn
+	}
+
+	type expected struct {
+		stdout string
+		filter *model.Filter
+		errIs  error
+	}
+
+	tests := []struct {
+		name                              string
+		args                              args
+		mockConfigRepositoryGet           mockConfigRepositoryGet
+		mockMFATokenProviderRepositoryGet mockMFATokenProviderRepositoryGet
+		mockAWSActivatorActivateAWS       mockAWSActivatorActivateAWS
+		mockBaseDirProviderSetBaseDir     mockBaseDirProviderSetBaseDir
+		mockBackupRepositoryGet           mockBackupRepositoryGet
+		mockResourceRepositoryExists      mockResourceRepositoryExists
+		mockPushUseCaseExecute            mockPushUseCaseExecute
+		expected                          expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				args: []string{"--api-id", "apiID", "--backup", "20231101T090000.000Z"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockBackupRepositoryGet: mockBackupRepositoryGet{
+				returns: []mockBackupRepositoryGetReturn{
+					{
+						res: backup,
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryExists: mockResourceRepositoryExists{
+				returns: []mockResourceRepositoryExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res: &usecase.PushOutput{},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: backup without environment variables",
+			args: args{
+				args: []string{"--api-id", "apiID", "--backup", "20231101T090000.000Z"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockBackupRepositoryGet: mockBackupRepositoryGet{
+				returns: []mockBackupRepositoryGetReturn{
+					{
+						res: backup,
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryExists: mockResourceRepositoryExists{
+				returns: []mockResourceRepositoryExistsReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res: &usecase.PushOutput{},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				filter: &model.Filter{
+					Kinds: []model.ResourceKind{
+						model.ResourceKindGraphqlApi,
+						model.ResourceKindSchema,
+						model.ResourceKindDataSource,
+						model.ResourceKindFunction,
+						model.ResourceKindResolver,
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: backup schema missing a field of the remote schema",
+			args: args{
+				args: []string{"--api-id", "apiID", "--backup", "20231101T090000.000Z"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockBackupRepositoryGet: mockBackupRepositoryGet{
+				returns: []mockBackupRepositoryGetReturn{
+					{
+						res: backup,
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryExists: mockResourceRepositoryExists{
+				returns: []mockResourceRepositoryExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res:             &usecase.PushOutput{},
+						err:             nil,
+						breakingChanges: true,
+					},
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: with --env flag",
+			args: args{
+				args: []string{"--env", "dev", "--backup", "20231101T090000.000Z"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{
+							Environments: map[string]model.Environment{
+								"dev": {
+									APIID:   "apiID",
+									Region:  "ap-northeast-1",
+									Profile: "dev",
+									BaseDir: "dev",
+								},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockBackupRepositoryGet: mockBackupRepositoryGet{
+				returns: []mockBackupRepositoryGetReturn{
+					{
+						res: backup,
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryExists: mockResourceRepositoryExists{
+				returns: []mockResourceRepositoryExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res: &usecase.PushOutput{},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: missing --api-id flag",
+			args: args{
+				args: []string{"--backup", "20231101T090000.000Z"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockBackupRepositoryGet: mockBackupRepositoryGet{
+				returns: []mockBackupRepositoryGetReturn{},
+			},
+			mockResourceRepositoryExists: mockResourceRepositoryExists{
+				returns: []mockResourceRepositoryExistsReturn{},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: missing --backup flag",
+			args: args{
+				args: []string{"--api-id", "apiID"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockBackupRepositoryGet: mockBackupRepositoryGet{
+				returns: []mockBackupRepositoryGetReturn{},
+			},
+			mockResourceRepositoryExists: mockResourceRepositoryExists{
+				returns: []mockResourceRepositoryExistsReturn{},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: AWSActivator.ActivateAWS() error",
+			args: args{
+				args: []string{"--api-id", "apiID", "--backup", "20231101T090000.000Z"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: errors.New("error"),
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{},
+			},
+			mockBackupRepositoryGet: mockBackupRepositoryGet{
+				returns: []mockBackupRepositoryGetReturn{},
+			},
+			mockResourceRepositoryExists: mockResourceRepositoryExists{
+				returns: []mockResourceRepositoryExistsReturn{},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: BackupRepository.Get() error",
+			args: args{
+				args: []string{"--api-id", "apiID", "--backup", "20231101T090000.000Z"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockBackupRepositoryGet: mockBackupRepositoryGet{
+				returns: []mockBackupRepositoryGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrNotFound},
+					},
+				},
+			},
+			mockResourceRepositoryExists: mockResourceRepositoryExists{
+				returns: []mockResourceRepositoryExistsReturn{},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{},
+			},
+			expected: expected{
+				errIs: model.ErrNotFound,
+			},
+		},
+		{
+			name: "edge path: ResourceRepository.Exists() error",
+			args: args{
+				args: []string{"--api-id", "apiID", "--backup", "20231101T090000.000Z"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockBackupRepositoryGet: mockBackupRepositoryGet{
+				returns: []mockBackupRepositoryGetReturn{
+					{
+						res: backup,
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryExists: mockResourceRepositoryExists{
+				returns: []mockResourceRepositoryExistsReturn{
+					{
+						res: false,
+						err: errors.New("error"),
+					},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: PushUseCase.Execute() error",
+			args: args{
+				args: []string{"--api-id", "apiID", "--backup", "20231101T090000.000Z"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockBackupRepositoryGet: mockBackupRepositoryGet{
+				returns: []mockBackupRepositoryGetReturn{
+					{
+						res: backup,
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryExists: mockResourceRepositoryExists{
+				returns: []mockResourceRepositoryExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ConfigRepository.Get() error",
+			args: args{
+				args: []string{"--api-id", "apiID", "--config", "missing.yaml"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{},
+			},
+			mockBackupRepositoryGet: mockBackupRepositoryGet{
+				returns: []mockBackupRepositoryGetReturn{},
+			},
+			mockResourceRepositoryExists: mockResourceRepositoryExists{
+				returns: []mockResourceRepositoryExistsReturn{},
+			},
+			mockPushUseCaseExecute: mockPushUseCaseExecute{
+				returns: []mockPushUseCaseExecuteReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockPushUseCase := mock_usecase.NewMockPushUseCase(ctrl)
+			mockAWSActivator := mock_repository.NewMockAWSActivator(ctrl)
+			mockBaseDirProvider := mock_repository.NewMockBaseDirProvider(ctrl)
+			mockMFATokenProviderRepository := mock_repository.NewMockMFATokenProviderRepository(ctrl)
+			mockConfigRepository := mock_repository.NewMockConfigRepository(ctrl)
+			mockBackupRepository := mock_repository.NewMockBackupRepository(ctrl)
+			mockResourceRepository := mock_repository.NewMockResourceRepository(ctrl)
+
+			mockConfigRepository.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, path string) (*model.Config, error) {
+					r := tt.mockConfigRepositoryGet.returns[tt.mockConfigRepositoryGet.calls]
+					tt.mockConfigRepositoryGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockConfigRepositoryGet.returns))
+
+			mockMFATokenProviderRepository.
+				EXPECT().
+				Get(ctx).
+				DoAndReturn(func(ctx context.Context) model.MFATokenProvider {
+					r := tt.mockMFATokenProviderRepositoryGet.returns[tt.mockMFATokenProviderRepositoryGet.calls]
+					tt.mockMFATokenProviderRepositoryGet.calls++
+					return r.res
+				}).
+				Times(len(tt.mockMFATokenProviderRepositoryGet.returns))
+
+			mockAWSActivator.
+				EXPECT().
+				ActivateAWS(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, optFns ...func(o *model.AWSOptions)) error {
+					r := tt.mockAWSActivatorActivateAWS.returns[tt.mockAWSActivatorActivateAWS.calls]
+					tt.mockAWSActivatorActivateAWS.calls++
+					return r.err
+				}).
+				Times(len(tt.mockAWSActivatorActivateAWS.returns))
+
+			mockBaseDirProvider.
+				EXPECT().
+				SetBaseDir(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, dir string) {
+					tt.mockBaseDirProviderSetBaseDir.calls++
+				}).
+				Times(len(tt.mockBaseDirProviderSetBaseDir.returns))
+
+			mockBackupRepository.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, timestamp string) (*model.Backup, error) {
+					r := tt.mockBackupRepositoryGet.returns[tt.mockBackupRepositoryGet.calls]
+					tt.mockBackupRepositoryGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockBackupRepositoryGet.returns))
+
+			mockResourceRepository.
+				EXPECT().
+				Exists(gomock.Any(), gomock.Any(), model.ResourceKindEnvironmentVariables, "").
+				DoAndReturn(func(ctx context.Context, apiID string, kind model.ResourceKind, identifier string) (bool, error) {
+					assert.Equal(t, backup.Dir, syncup.BaseDir(ctx))
+					r := tt.mockResourceRepositoryExists.returns[tt.mockResourceRepositoryExists.calls]
+					tt.mockResourceRepositoryExists.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResourceRepositoryExists.returns))
+
+			mockPushUseCase.
+				EXPECT().
+				Execute(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, params *usecase.PushInput) (*usecase.PushOutput, error) {
+					assert.Equal(t, backup.Dir, syncup.BaseDir(ctx))
+					assert.Equal(t, tt.expected.filter, params.Filter)
+					r := tt.mockPushUseCaseExecute.returns[tt.mockPushUseCaseExecute.calls]
+					tt.mockPushUseCaseExecute.calls++
+					// NOTE: the push refuses the removed fields as it does for a real schema, unless breaking changes are allowed
+					if r.breakingChanges && !params.AllowBreaking {
+						return nil, &model.LibError{Err: model.ErrBreakingChange}
+					}
+					return r.res, r.err
+				}).
+				Times(len(tt.mockPushUseCaseExecute.returns))
+
+			stdin := new(bytes.Reader)
+			stdout := new(bytes.Buffer)
+			stderr := new(bytes.Buffer)
+
+			c := &restoreCommand{
+				options:                    newOptions(WithStdio(stdin, stdout, stderr)),
+				useCase:                    mockPushUseCase,
+				backupRepository:           mockBackupRepository,
+				resourceRepository:         mockResourceRepository,
+				awsActivator:               mockAWSActivator,
+				baseDirProvider:            mockBaseDirProvider,
+				mfaTokenProviderRepository: mockMFATokenProviderRepository,
+				configRepository:           mockConfigRepository,
+			}
+
+			// Act
+			err := c.Execute(ctx, tt.args.args...)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+
+				assert.Equal(t, 0, stdin.Len())
+				assert.Equal(t, tt.expected.stdout, stdout.String())
+				assert.Equal(t, 0, stderr.Len())
+			} else {
+				var ce *commandError
+				assert.ErrorAs(t, err, &ce)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+
+				assert.Equal(t, 0, stdin.Len())
+				assert.Equal(t, 0, stdout.Len())
+				assert.Greater(t, stderr.Len(), 0)
+			}
+		})
+	}
+}
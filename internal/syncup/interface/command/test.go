@@ -0,0 +1,266 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	"github.com/Aton-Kish/syncup/internal/xsync"
+	"github.com/spf13/cobra"
+)
+
+type testFlags struct {
+	configFlags
+
+	region  string
+	profile string
+
+	apiID       string
+	baseDir     string
+	concurrency int
+	rateLimit   float64
+	output      string
+}
+
+type TestCommand interface {
+	Command
+}
+
+type testCommand struct {
+	options *options
+
+	useCase                    usecase.TestUseCase
+	awsActivator               repository.AWSActivator
+	baseDirProvider            repository.BaseDirProvider
+	mfaTokenProviderRepository repository.MFATokenProviderRepository
+	configRepository           repository.ConfigRepository
+
+	cmd   *xcommand
+	flags *testFlags
+	once  sync.Once
+}
+
+func NewTestCommand(repo repository.Repository, optFns ...func(o *options)) TestCommand {
+	return &testCommand{
+		options: newOptions(optFns...),
+
+		useCase:                    usecase.NewTestUseCase(repo),
+		awsActivator:               repo,
+		baseDirProvider:            repo,
+		mfaTokenProviderRepository: repo.MFATokenProviderRepository(),
+		configRepository:           repo.ConfigRepositoryForFS(),
+	}
+}
+
+func (c *testCommand) Execute(ctx context.Context, args ...string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.SetArgs(args)
+
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *testCommand) RegisterSubCommands(cmds ...Command) {
+	subs := make([]*cobra.Command, 0, len(cmds))
+	for _, cmd := range cmds {
+		subs = append(subs, cmd.command().Command)
+	}
+
+	cmd := c.command()
+	cmd.AddCommand(subs...)
+}
+
+func (c *testCommand) GenerateReadme(ctx context.Context, dir string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.InitDefaultCompletionCmd()
+
+	return cmd.GenerateReadme(dir)
+}
+
+func (c *testCommand) GenerateReferences(ctx context.Context, dir string) (err error) {
+	defer wrap(&err)
+
+	cmd := c.command()
+	cmd.InitDefaultVersionFlag()
+	cmd.InitDefaultCompletionCmd()
+
+	return cmd.GenerateReferences(dir)
+}
+
+func (c *testCommand) command() *xcommand {
+	c.once.Do(func() {
+		c.flags = new(testFlags)
+
+		c.cmd = newCommand(&cobra.Command{
+			Use:   "test",
+			Short: "Evaluate resolvers and functions against the test fixtures in their tests dirs",
+			PreRunE: func(cmd *cobra.Command, args []string) (err error) {
+				defer wrap(&err)
+
+				ctx := cmd.Context()
+
+				if err := validateOutputFormat(c.flags.output); err != nil {
+					return err
+				}
+
+				if err := applyConfig(ctx, cmd, c.configRepository); err != nil {
+					return err
+				}
+
+				if err := c.awsActivator.ActivateAWS(
+					ctx,
+					model.AWSOptionsWithRegion(c.flags.region),
+					model.AWSOptionsWithProfile(c.flags.profile),
+					model.AWSOptionsWithMFATokenProvider(c.mfaTokenProviderRepository.Get(ctx)),
+					model.AWSOptionsWithRateLimit(c.flags.rateLimit),
+				); err != nil {
+					return err
+				}
+
+				c.baseDirProvider.SetBaseDir(ctx, c.flags.baseDir)
+
+				return nil
+			},
+			RunE: func(cmd *cobra.Command, args []string) (err error) {
+				defer wrap(&err)
+
+				ctx := syncup.WithWorkerPool(cmd.Context(), xsync.NewPool(c.flags.concurrency))
+
+				out, err := c.useCase.Execute(
+					ctx,
+					&usecase.TestInput{
+						APIID: c.flags.apiID,
+					},
+				)
+				if err != nil {
+					return err
+				}
+
+				if c.flags.output == outputFormatJSON {
+					if err := printTestResultsJSON(cmd.OutOrStdout(), out.Results); err != nil {
+						return err
+					}
+				} else {
+					if err := c.print(cmd.OutOrStdout(), out.Results); err != nil {
+						return err
+					}
+				}
+
+				if failed := countFailedTests(out.Results); failed > 0 {
+					return fmt.Errorf("%d of %d tests failed", failed, len(out.Results))
+				}
+
+				return nil
+			},
+			SilenceUsage: true,
+		})
+
+		registerConfigFlags(c.cmd.Command, &c.flags.configFlags)
+		c.cmd.Flags().StringVar(&c.flags.profile, "profile", "", "Use a specific profile from your AWS credential file.")
+		c.cmd.Flags().StringVar(&c.flags.region, "region", "", "The AWS region to use. Overrides config/env settings.")
+
+		c.cmd.Flags().StringVar(&c.flags.apiID, "api-id", "", "The API ID of AWS AppSync.")
+		c.cmd.Flags().StringVar(&c.flags.baseDir, "dir", "", "The directory from which the local resources will be loaded (instead of current directory).")
+		c.cmd.Flags().IntVar(&c.flags.concurrency, "concurrency", defaultConcurrency, "The maximum number of tests to run in parallel.")
+		c.cmd.Flags().Float64Var(&c.flags.rateLimit, flagNameRateLimit, defaultRateLimit, "The maximum number of requests per second to AWS AppSync.")
+		registerOutputFlag(c.cmd.Command, &c.flags.output)
+
+		c.cmd.SetIn(c.options.stdio.in)
+		c.cmd.SetOut(c.options.stdio.out)
+		c.cmd.SetErr(c.options.stdio.err)
+	})
+
+	return c.cmd
+}
+
+func (c *testCommand) print(w io.Writer, results []model.TestResult) error {
+	if len(results) == 0 {
+		if _, err := fmt.Fprintln(w, "No tests."); err != nil {
+			return err
+		}
+
+		return nil
+	}
+
+	for _, r := range results {
+		status := "PASS"
+		if !r.Passed {
+			status = "FAIL"
+		}
+
+		if _, err := fmt.Fprintf(w, "%s %s %s %s (%s)\n", status, r.Kind, r.Identifier, r.Name, r.Handler); err != nil {
+			return err
+		}
+
+		if r.Passed {
+			continue
+		}
+
+		if r.Message != "" {
+			if _, err := fmt.Fprintf(w, "  %s\n", strings.ReplaceAll(r.Message, "\n", "\n  ")); err != nil {
+				return err
+			}
+		}
+
+		if _, err := fmt.Fprintf(w, "  expected: %s\n  actual:   %s\n", r.Expected, r.Actual); err != nil {
+			return err
+		}
+
+		for _, l := range r.Logs {
+			if _, err := fmt.Fprintf(w, "  log: %s\n", l); err != nil {
+				return err
+			}
+		}
+	}
+
+	failed := countFailedTests(results)
+	if _, err := fmt.Fprintf(w, "Tests: %d passed, %d failed.\n", len(results)-failed, failed); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func countFailedTests(results []model.TestResult) int {
+	failed := 0
+	for _, r := range results {
+		if !r.Passed {
+			failed++
+		}
+	}
+
+	return failed
+}
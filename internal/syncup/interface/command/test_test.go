@@ -0,0 +1,523 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package command
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	"github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	mock_usecase "github.com/Aton-Kish/syncup/internal/syncup/usecase/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_testCommand_Execute(t *testing.T) {
+	type args struct {
+		args []string
+	}
+
+	type mockMFATokenProviderRepositoryGetReturn struct {
+		res model.MFATokenProvider
+	}
+	type mockMFATokenProviderRepositoryGet struct {
+		calls   int
+		returns []mockMFATokenProviderRepositoryGetReturn
+	}
+
+	type mockConfigRepositoryGetReturn struct {
+		res *model.Config
+		err error
+	}
+	type mockConfigRepositoryGet struct {
+		calls   int
+		returns []mockConfigRepositoryGetReturn
+	}
+
+	type mockAWSActivatorActivateAWSReturn struct {
+		err error
+	}
+	type mockAWSActivatorActivateAWS struct {
+		calls   int
+		returns []mockAWSActivatorActivateAWSReturn
+	}
+
+	type mockBaseDirProviderSetBaseDirReturn struct {
+	}
+	type mockBaseDirProviderSetBaseDir struct {
+		calls   int
+		returns []mockBaseDirProviderSetBaseDirReturn
+	}
+
+	type mockTestUseCaseExecuteReturn struct {
+		res *usecase.TestOutput
+		err error
+	}
+	type mockTestUseCaseExecute struct {
+		calls   int
+		returns []mockTestUseCaseExecuteReturn
+	}
+
+	type expected struct {
+		stdout string
+		errIs  error
+	}
+
+	tests := []struct {
+		name                              string
+		args                              args
+		mockConfigRepositoryGet           mockConfigRepositoryGet
+		mockMFATokenProviderRepositoryGet mockMFATokenProviderRepositoryGet
+		mockAWSActivatorActivateAWS       mockAWSActivatorActivateAWS
+		mockBaseDirProviderSetBaseDir     mockBaseDirProviderSetBaseDir
+		mockTestUseCaseExecute            mockTestUseCaseExecute
+		expected                          expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				args: []string{"--api-id", "apiID"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockTestUseCaseExecute: mockTestUseCaseExecute{
+				returns: []mockTestUseCaseExecuteReturn{
+					{
+						res: &usecase.TestOutput{
+							Results: []model.TestResult{
+								{
+									Kind:       model.ResourceKindFunction,
+									Identifier: "getPost",
+									Name:       "found",
+									Handler:    model.EvaluationHandlerRequest,
+									Passed:     true,
+									Expected:   `{"id":"1"}`,
+									Actual:     `{"id":"1"}`,
+								},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				stdout: "PASS function getPost found (request)\nTests: 1 passed, 0 failed.\n",
+				errIs:  nil,
+			},
+		},
+		{
+			name: "happy path: no tests",
+			args: args{
+				args: []string{"--api-id", "apiID"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockTestUseCaseExecute: mockTestUseCaseExecute{
+				returns: []mockTestUseCaseExecuteReturn{
+					{
+						res: &usecase.TestOutput{
+							Results: []model.TestResult{},
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				stdout: "No tests.\n",
+				errIs:  nil,
+			},
+		},
+		{
+			name: "happy path: --output json",
+			args: args{
+				args: []string{"--api-id", "apiID", "--output", "json"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockTestUseCaseExecute: mockTestUseCaseExecute{
+				returns: []mockTestUseCaseExecuteReturn{
+					{
+						res: &usecase.TestOutput{
+							Results: []model.TestResult{
+								{
+									Kind:       model.ResourceKindFunction,
+									Identifier: "getPost",
+									Name:       "found",
+									Handler:    model.EvaluationHandlerRequest,
+									Passed:     true,
+									Expected:   `{"id":"1"}`,
+									Actual:     `{"id":"1"}`,
+								},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				stdout: "{\n  \"results\": [\n    {\n      \"kind\": \"function\",\n      \"identifier\": \"getPost\",\n      \"name\": \"found\",\n      \"handler\": \"request\",\n      \"passed\": true,\n      \"expected\": \"{\\\"id\\\":\\\"1\\\"}\",\n      \"actual\": \"{\\\"id\\\":\\\"1\\\"}\"\n    }\n  ],\n  \"summary\": {\n    \"passed\": 1,\n    \"failed\": 0\n  }\n}\n",
+				errIs:  nil,
+			},
+		},
+		{
+			name: "edge path: tests failed",
+			args: args{
+				args: []string{"--api-id", "apiID"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockTestUseCaseExecute: mockTestUseCaseExecute{
+				returns: []mockTestUseCaseExecuteReturn{
+					{
+						res: &usecase.TestOutput{
+							Results: []model.TestResult{
+								{
+									Kind:       model.ResourceKindFunction,
+									Identifier: "getPost",
+									Name:       "found",
+									Handler:    model.EvaluationHandlerRequest,
+									Passed:     true,
+									Expected:   `{"id":"1"}`,
+									Actual:     `{"id":"1"}`,
+								},
+								{
+									Kind:       model.ResourceKindResolver,
+									Identifier: "Query.getPost",
+									Name:       "notFound",
+									Handler:    model.EvaluationHandlerResponse,
+									Passed:     false,
+									Expected:   `null`,
+									Actual:     `{"id":"1"}`,
+									Logs:       []string{"not found"},
+								},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				stdout: "PASS function getPost found (request)\nFAIL resolver Query.getPost notFound (response)\n  expected: null\n  actual:   {\"id\":\"1\"}\n  log: not found\nTests: 1 passed, 1 failed.\n",
+				errIs:  nil,
+			},
+		},
+		{
+			name: "edge path: AWSActivator.ActivateAWS() error",
+			args: args{
+				args: []string{"--api-id", "apiID"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: errors.New("error"),
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{},
+			},
+			mockTestUseCaseExecute: mockTestUseCaseExecute{
+				returns: []mockTestUseCaseExecuteReturn{},
+			},
+			expected: expected{
+				stdout: "",
+				errIs:  nil,
+			},
+		},
+		{
+			name: "edge path: TestUseCase.Execute() error",
+			args: args{
+				args: []string{"--api-id", "apiID"},
+			},
+			mockConfigRepositoryGet: mockConfigRepositoryGet{
+				returns: []mockConfigRepositoryGetReturn{
+					{
+						res: &model.Config{},
+						err: nil,
+					},
+				},
+			},
+			mockMFATokenProviderRepositoryGet: mockMFATokenProviderRepositoryGet{
+				returns: []mockMFATokenProviderRepositoryGetReturn{
+					{
+						res: func() (string, error) {
+							return "123456", nil
+						},
+					},
+				},
+			},
+			mockAWSActivatorActivateAWS: mockAWSActivatorActivateAWS{
+				returns: []mockAWSActivatorActivateAWSReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBaseDirProviderSetBaseDir: mockBaseDirProviderSetBaseDir{
+				returns: []mockBaseDirProviderSetBaseDirReturn{
+					{},
+				},
+			},
+			mockTestUseCaseExecute: mockTestUseCaseExecute{
+				returns: []mockTestUseCaseExecuteReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				stdout: "",
+				errIs:  nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTestUseCase := mock_usecase.NewMockTestUseCase(ctrl)
+			mockAWSActivator := mock_repository.NewMockAWSActivator(ctrl)
+			mockBaseDirProvider := mock_repository.NewMockBaseDirProvider(ctrl)
+			mockMFATokenProviderRepository := mock_repository.NewMockMFATokenProviderRepository(ctrl)
+			mockConfigRepository := mock_repository.NewMockConfigRepository(ctrl)
+
+			mockConfigRepository.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, path string) (*model.Config, error) {
+					r := tt.mockConfigRepositoryGet.returns[tt.mockConfigRepositoryGet.calls]
+					tt.mockConfigRepositoryGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockConfigRepositoryGet.returns))
+
+			mockMFATokenProviderRepository.
+				EXPECT().
+				Get(ctx).
+				DoAndReturn(func(ctx context.Context) model.MFATokenProvider {
+					r := tt.mockMFATokenProviderRepositoryGet.returns[tt.mockMFATokenProviderRepositoryGet.calls]
+					tt.mockMFATokenProviderRepositoryGet.calls++
+					return r.res
+				}).
+				Times(len(tt.mockMFATokenProviderRepositoryGet.returns))
+
+			mockAWSActivator.
+				EXPECT().
+				ActivateAWS(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, optFns ...func(o *model.AWSOptions)) error {
+					r := tt.mockAWSActivatorActivateAWS.returns[tt.mockAWSActivatorActivateAWS.calls]
+					tt.mockAWSActivatorActivateAWS.calls++
+					return r.err
+				}).
+				Times(len(tt.mockAWSActivatorActivateAWS.returns))
+
+			mockBaseDirProvider.
+				EXPECT().
+				SetBaseDir(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, dir string) {
+					tt.mockBaseDirProviderSetBaseDir.calls++
+				}).
+				Times(len(tt.mockBaseDirProviderSetBaseDir.returns))
+
+			mockTestUseCase.
+				EXPECT().
+				Execute(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, params *usecase.TestInput) (*usecase.TestOutput, error) {
+					r := tt.mockTestUseCaseExecute.returns[tt.mockTestUseCaseExecute.calls]
+					tt.mockTestUseCaseExecute.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockTestUseCaseExecute.returns))
+
+			stdin := new(bytes.Reader)
+			stdout := new(bytes.Buffer)
+			stderr := new(bytes.Buffer)
+
+			c := &testCommand{
+				options:                    newOptions(WithStdio(stdin, stdout, stderr)),
+				useCase:                    mockTestUseCase,
+				awsActivator:               mockAWSActivator,
+				baseDirProvider:            mockBaseDirProvider,
+				mfaTokenProviderRepository: mockMFATokenProviderRepository,
+				configRepository:           mockConfigRepository,
+			}
+
+			// Act
+			err := c.Execute(ctx, tt.args.args...)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+
+				assert.Equal(t, 0, stdin.Len())
+				assert.Equal(t, tt.expected.stdout, stdout.String())
+				assert.Equal(t, 0, stderr.Len())
+			} else {
+				var ce *commandError
+				assert.ErrorAs(t, err, &ce)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+
+				assert.Equal(t, 0, stdin.Len())
+				assert.Equal(t, tt.expected.stdout, stdout.String())
+				assert.Greater(t, stderr.Len(), 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"golang.org/x/term"
+)
+
+type confirmationRepository struct {
+	survey     isurvey
+	isTerminal func() bool
+}
+
+func NewConfirmationRepository() repository.ConfirmationRepository {
+	return &confirmationRepository{
+		survey: newSurvey(survey.WithStdio(os.Stdin, os.Stderr, os.Stderr)),
+		isTerminal: func() bool {
+			return term.IsTerminal(int(os.Stdin.Fd()))
+		},
+	}
+}
+
+func (r *confirmationRepository) Confirm(ctx context.Context, msg string) (res bool, err error) {
+	defer wrap(&err)
+
+	if !r.isTerminal() {
+		return false, fmt.Errorf("%w: stdin is not a terminal", model.ErrNotConfirmed)
+	}
+
+	ok, err := r.survey.Confirm(
+		ctx,
+		&survey.Confirm{
+			Message: msg,
+			Default: false,
+		},
+	)
+	if err != nil {
+		return false, err
+	}
+
+	return ok, nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package console
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_console "github.com/Aton-Kish/syncup/internal/syncup/interface/console/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_confirmationRepository_Confirm(t *testing.T) {
+	type args struct {
+		msg string
+	}
+
+	type mockSurveyConfirmReturn struct {
+		res bool
+		err error
+	}
+	type mockSurveyConfirm struct {
+		calls   int
+		returns []mockSurveyConfirmReturn
+	}
+
+	type expected struct {
+		res   bool
+		errIs error
+	}
+
+	tests := []struct {
+		name              string
+		args              args
+		isTerminal        bool
+		mockSurveyConfirm mockSurveyConfirm
+		expected          expected
+	}{
+		{
+			name: "happy path: confirmed",
+			args: args{
+				msg: "Do you want to continue?",
+			},
+			isTerminal: true,
+			mockSurveyConfirm: mockSurveyConfirm{
+				returns: []mockSurveyConfirmReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: declined",
+			args: args{
+				msg: "Do you want to continue?",
+			},
+			isTerminal: true,
+			mockSurveyConfirm: mockSurveyConfirm{
+				returns: []mockSurveyConfirmReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: stdin is not a terminal",
+			args: args{
+				msg: "Do you want to continue?",
+			},
+			isTerminal: false,
+			mockSurveyConfirm: mockSurveyConfirm{
+				returns: []mockSurveyConfirmReturn{},
+			},
+			expected: expected{
+				res:   false,
+				errIs: model.ErrNotConfirmed,
+			},
+		},
+		{
+			name: "edge path: survey error",
+			args: args{
+				msg: "Do you want to continue?",
+			},
+			isTerminal: true,
+			mockSurveyConfirm: mockSurveyConfirm{
+				returns: []mockSurveyConfirmReturn{
+					{
+						res: false,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSurvey := mock_console.NewMockisurvey(ctrl)
+
+			mockSurvey.
+				EXPECT().
+				Confirm(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, prompt *survey.Confirm, opts ...survey.AskOpt) (bool, error) {
+					r := tt.mockSurveyConfirm.returns[tt.mockSurveyConfirm.calls]
+					tt.mockSurveyConfirm.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSurveyConfirm.returns))
+
+			r := &confirmationRepository{
+				survey: mockSurvey,
+				isTerminal: func() bool {
+					return tt.isTerminal
+				},
+			}
+
+			// Act
+			actual, err := r.Confirm(ctx, tt.args.msg)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package console
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_editorRepository_Edit(t *testing.T) {
+	type args struct {
+		name    string
+		content []byte
+	}
+
+	type mockRunReturn struct {
+		content []byte
+		err     error
+	}
+
+	type expected struct {
+		name  string
+		args  []string
+		res   []byte
+		errIs error
+	}
+
+	tests := []struct {
+		name          string
+		args          args
+		envs          map[string]string
+		mockRunReturn mockRunReturn
+		expected      expected
+	}{
+		{
+			name: "happy path: edited",
+			args: args{
+				name:    "env.json",
+				content: []byte(`{"KEY1":"VALUE1"}`),
+			},
+			envs: map[string]string{
+				"EDITOR": "code --wait",
+			},
+			mockRunReturn: mockRunReturn{
+				content: []byte(`{"KEY1":"VALUE2"}`),
+				err:     nil,
+			},
+			expected: expected{
+				name:  "code",
+				args:  []string{"--wait"},
+				res:   []byte(`{"KEY1":"VALUE2"}`),
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: default editor",
+			args: args{
+				name:    "env.json",
+				content: []byte(`{"KEY1":"VALUE1"}`),
+			},
+			envs: map[string]string{
+				"EDITOR": "",
+			},
+			mockRunReturn: mockRunReturn{
+				content: nil,
+				err:     nil,
+			},
+			expected: expected{
+				name:  defaultEditor,
+				args:  []string{},
+				res:   []byte(`{"KEY1":"VALUE1"}`),
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: editor error",
+			args: args{
+				name:    "env.json",
+				content: []byte(`{"KEY1":"VALUE1"}`),
+			},
+			envs: map[string]string{
+				"EDITOR": "vim",
+			},
+			mockRunReturn: mockRunReturn{
+				content: nil,
+				err:     errors.New("exit status 1"),
+			},
+			expected: expected{
+				name:  "vim",
+				args:  []string{},
+				res:   nil,
+				errIs: model.ErrNotConfirmed,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			for k, v := range tt.envs {
+				t.Setenv(k, v)
+			}
+
+			var path string
+			r := &editorRepository{
+				run: func(ctx context.Context, name string, args ...string) error {
+					path = args[len(args)-1]
+
+					assert.Equal(t, tt.expected.name, name)
+					assert.Equal(t, tt.expected.args, args[:len(args)-1])
+					assert.Equal(t, tt.args.name, filepath.Base(path))
+					assert.Equal(t, tt.args.content, testhelpers.MustReadFile(t, path))
+
+					if tt.mockRunReturn.content != nil {
+						if err := os.WriteFile(path, tt.mockRunReturn.content, 0o600); err != nil {
+							t.Fatal(err)
+						}
+					}
+
+					return tt.mockRunReturn.err
+				},
+			}
+
+			// Act
+			actual, err := r.Edit(ctx, tt.args.name, tt.args.content)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+			assert.NoFileExists(t, path)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
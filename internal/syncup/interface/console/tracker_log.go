@@ -51,8 +51,32 @@ func (r *trackerRepositoryForLog) Success(ctx context.Context, msg string) {
 	r.logContext(ctx, model.TrackerStatusSuccess, msg)
 }
 
-func (r *trackerRepositoryForLog) logContext(ctx context.Context, status model.TrackerStatus, msg string) {
-	l := r.logger.With(slog.String("status", string(status)))
+func (r *trackerRepositoryForLog) Record(ctx context.Context, event *model.TrackerEvent) {
+	attrs := []any{
+		slog.String("kind", string(event.Kind)),
+		slog.String("identifier", event.Identifier),
+		slog.String("action", string(event.Action)),
+		slog.Duration("duration", event.Duration),
+	}
+
+	if event.Level != "" {
+		attrs = append(attrs, slog.String("changeLevel", string(event.Level)))
+	}
+
+	if event.Err != nil {
+		attrs = append(attrs, slog.String("error", event.Err.Error()))
+	}
+
+	status := model.TrackerStatusSuccess
+	if event.Action == model.TrackerActionFailed {
+		status = model.TrackerStatusFailed
+	}
+
+	r.logContext(ctx, status, event.Message, attrs...)
+}
+
+func (r *trackerRepositoryForLog) logContext(ctx context.Context, status model.TrackerStatus, msg string, attrs ...any) {
+	l := r.logger.With(slog.String("status", string(status))).With(attrs...)
 	switch status {
 	case model.TrackerStatusInProgress, model.TrackerStatusSuccess:
 		l.InfoContext(ctx, msg)
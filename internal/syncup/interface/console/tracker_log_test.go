@@ -23,9 +23,12 @@ package console
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"log/slog"
 	"testing"
 
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -139,3 +142,93 @@ func Test_trackerRepositoryForLog_Success(t *testing.T) {
 		})
 	}
 }
+
+func Test_trackerRepositoryForLog_Record(t *testing.T) {
+	type args struct {
+		event *model.TrackerEvent
+	}
+
+	type expected struct {
+		level       string
+		status      string
+		changeLevel any
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: updated",
+			args: args{
+				event: &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: "getPost",
+					Action:     model.TrackerActionUpdated,
+					Message:    "hello",
+				},
+			},
+			expected: expected{
+				level:  "INFO",
+				status: "success",
+			},
+		},
+		{
+			name: "happy path: failed",
+			args: args{
+				event: &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: "getPost",
+					Action:     model.TrackerActionFailed,
+					Err:        errors.New("error"),
+					Message:    "hello",
+				},
+			},
+			expected: expected{
+				level:  "ERROR",
+				status: "failed",
+			},
+		},
+		{
+			name: "happy path: allowed breaking schema change",
+			args: args{
+				event: &model.TrackerEvent{
+					Kind:       model.ResourceKindSchema,
+					Identifier: "Post.title",
+					Action:     model.TrackerActionChanged,
+					Message:    "breaking change: field Post.title was removed",
+					Level:      model.SchemaChangeLevelBreaking,
+				},
+			},
+			expected: expected{
+				level:       "INFO",
+				status:      "success",
+				changeLevel: "breaking",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			w := new(bytes.Buffer)
+
+			r := &trackerRepositoryForLog{
+				logger: slog.New(slog.NewJSONHandler(w, nil)).With(slog.String("app", "syncup"), slog.String("category", "tracker")),
+			}
+
+			// Act
+			r.Record(ctx, tt.args.event)
+
+			// Assert
+			var actual map[string]any
+			assert.NoError(t, json.Unmarshal(w.Bytes(), &actual))
+			assert.Equal(t, tt.expected.level, actual["level"])
+			assert.Equal(t, tt.expected.status, actual["status"])
+			assert.Equal(t, tt.expected.changeLevel, actual["changeLevel"])
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package console
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+type TrackerReportRepository interface {
+	repository.TrackerRepository
+	repository.ReportRepository
+}
+
+type trackerRepositoryForReport struct {
+	tracker repository.TrackerRepository
+
+	mu     sync.Mutex
+	events []model.TrackerEvent
+}
+
+func NewTrackerRepositoryForReport(tracker repository.TrackerRepository) TrackerReportRepository {
+	return &trackerRepositoryForReport{
+		tracker: tracker,
+		events:  make([]model.TrackerEvent, 0),
+	}
+}
+
+func (r *trackerRepositoryForReport) InProgress(ctx context.Context, msg string) {
+	r.tracker.InProgress(ctx, msg)
+}
+
+func (r *trackerRepositoryForReport) Failed(ctx context.Context, msg string) {
+	r.tracker.Failed(ctx, msg)
+}
+
+func (r *trackerRepositoryForReport) Success(ctx context.Context, msg string) {
+	r.tracker.Success(ctx, msg)
+}
+
+func (r *trackerRepositoryForReport) Record(ctx context.Context, event *model.TrackerEvent) {
+	r.mu.Lock()
+	r.events = append(r.events, *event)
+	r.mu.Unlock()
+
+	r.tracker.Record(ctx, event)
+}
+
+func (r *trackerRepositoryForReport) Get(ctx context.Context) *model.Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &model.Report{
+		Events: slices.Clone(r.events),
+	}
+}
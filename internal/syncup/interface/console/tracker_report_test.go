@@ -0,0 +1,140 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package console
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_trackerRepositoryForReport_Record(t *testing.T) {
+	type args struct {
+		events []*model.TrackerEvent
+	}
+
+	type mockTrackerRepositoryRecordReturn struct {
+	}
+	type mockTrackerRepositoryRecord struct {
+		calls   int
+		returns []mockTrackerRepositoryRecordReturn
+	}
+
+	type expected struct {
+		res *model.Report
+	}
+
+	tests := []struct {
+		name                        string
+		args                        args
+		mockTrackerRepositoryRecord mockTrackerRepositoryRecord
+		expected                    expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				events: []*model.TrackerEvent{
+					{
+						Kind:       model.ResourceKindFunction,
+						Identifier: "getPost",
+						Action:     model.TrackerActionUpdated,
+					},
+					{
+						Kind:       model.ResourceKindResolver,
+						Identifier: "Query.getPost",
+						Action:     model.TrackerActionCreated,
+					},
+				},
+			},
+			mockTrackerRepositoryRecord: mockTrackerRepositoryRecord{
+				returns: []mockTrackerRepositoryRecordReturn{
+					{},
+					{},
+				},
+			},
+			expected: expected{
+				res: &model.Report{
+					Events: []model.TrackerEvent{
+						{
+							Kind:       model.ResourceKindFunction,
+							Identifier: "getPost",
+							Action:     model.TrackerActionUpdated,
+						},
+						{
+							Kind:       model.ResourceKindResolver,
+							Identifier: "Query.getPost",
+							Action:     model.TrackerActionCreated,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "happy path: no events",
+			args: args{
+				events: []*model.TrackerEvent{},
+			},
+			mockTrackerRepositoryRecord: mockTrackerRepositoryRecord{
+				returns: []mockTrackerRepositoryRecordReturn{},
+			},
+			expected: expected{
+				res: &model.Report{
+					Events: []model.TrackerEvent{},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				Record(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, event *model.TrackerEvent) {
+					tt.mockTrackerRepositoryRecord.calls++
+				}).
+				Times(len(tt.mockTrackerRepositoryRecord.returns))
+
+			r := NewTrackerRepositoryForReport(mockTrackerRepository)
+
+			// Act
+			for _, event := range tt.args.events {
+				r.Record(ctx, event)
+			}
+			actual := r.Get(ctx)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
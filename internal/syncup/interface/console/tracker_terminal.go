@@ -59,6 +59,15 @@ func (r *trackerRepositoryForTerminal) Success(ctx context.Context, msg string)
 	r.done(ctx, model.TrackerStatusSuccess, msg)
 }
 
+func (r *trackerRepositoryForTerminal) Record(ctx context.Context, event *model.TrackerEvent) {
+	if event.Action == model.TrackerActionFailed {
+		r.done(ctx, model.TrackerStatusFailed, event.Message)
+		return
+	}
+
+	r.done(ctx, model.TrackerStatusSuccess, event.Message)
+}
+
 func (r *trackerRepositoryForTerminal) done(ctx context.Context, status model.TrackerStatus, msg string) {
 	var icon, iconStyle, msgStyle string
 	switch status {
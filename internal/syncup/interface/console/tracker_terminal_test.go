@@ -23,9 +23,11 @@ package console
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	mock_console "github.com/Aton-Kish/syncup/internal/syncup/interface/console/mock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -378,3 +380,145 @@ func Test_trackerRepositoryForTerminal_Success(t *testing.T) {
 		})
 	}
 }
+
+func Test_trackerRepositoryForTerminal_Record(t *testing.T) {
+	type args struct {
+		event *model.TrackerEvent
+	}
+
+	type mockSpinnerActiveReturn struct {
+		res bool
+	}
+	type mockSpinnerActive struct {
+		calls   int
+		returns []mockSpinnerActiveReturn
+	}
+
+	type mockSpinnerSetFinalMsgReturn struct {
+	}
+	type mockSpinnerSetFinalMsg struct {
+		calls   int
+		returns []mockSpinnerSetFinalMsgReturn
+	}
+
+	type mockSpinnerStopReturn struct {
+	}
+	type mockSpinnerStop struct {
+		calls   int
+		returns []mockSpinnerStopReturn
+	}
+
+	tests := []struct {
+		name                   string
+		args                   args
+		mockSpinnerActive      mockSpinnerActive
+		mockSpinnerSetFinalMsg mockSpinnerSetFinalMsg
+		mockSpinnerStop        mockSpinnerStop
+	}{
+		{
+			name: "happy path: updated",
+			args: args{
+				event: &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: "getPost",
+					Action:     model.TrackerActionUpdated,
+					Message:    "hello",
+				},
+			},
+			mockSpinnerActive: mockSpinnerActive{
+				returns: []mockSpinnerActiveReturn{
+					{
+						res: true,
+					},
+				},
+			},
+			mockSpinnerSetFinalMsg: mockSpinnerSetFinalMsg{
+				returns: []mockSpinnerSetFinalMsgReturn{
+					{},
+				},
+			},
+			mockSpinnerStop: mockSpinnerStop{
+				returns: []mockSpinnerStopReturn{
+					{},
+				},
+			},
+		},
+		{
+			name: "happy path: failed",
+			args: args{
+				event: &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: "getPost",
+					Action:     model.TrackerActionFailed,
+					Err:        errors.New("error"),
+					Message:    "hello",
+				},
+			},
+			mockSpinnerActive: mockSpinnerActive{
+				returns: []mockSpinnerActiveReturn{
+					{
+						res: false,
+					},
+				},
+			},
+			mockSpinnerSetFinalMsg: mockSpinnerSetFinalMsg{
+				returns: []mockSpinnerSetFinalMsgReturn{},
+			},
+			mockSpinnerStop: mockSpinnerStop{
+				returns: []mockSpinnerStopReturn{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			w := new(bytes.Buffer)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSpinner := mock_console.NewMockispinner(ctrl)
+
+			mockSpinner.
+				EXPECT().
+				Active().
+				DoAndReturn(func() bool {
+					r := tt.mockSpinnerActive.returns[tt.mockSpinnerActive.calls]
+					tt.mockSpinnerActive.calls++
+					return r.res
+				}).
+				Times(len(tt.mockSpinnerActive.returns))
+
+			mockSpinner.
+				EXPECT().
+				SetFinalMsg(gomock.Any()).
+				DoAndReturn(func(suffix string) {
+					tt.mockSpinnerSetFinalMsg.calls++
+				}).
+				Times(len(tt.mockSpinnerSetFinalMsg.returns))
+
+			mockSpinner.
+				EXPECT().
+				Stop().
+				DoAndReturn(func() {
+					tt.mockSpinnerStop.calls++
+					fmt.Fprint(w, fmt.Sprintln("spinner", tt.args.event.Message))
+				}).
+				Times(len(tt.mockSpinnerStop.returns))
+
+			r := &trackerRepositoryForTerminal{
+				writer:  w,
+				spinner: mockSpinner,
+			}
+
+			// Act
+			r.Record(ctx, tt.args.event)
+
+			// Assert
+			assert.Greater(t, w.Len(), 0)
+		})
+	}
+}
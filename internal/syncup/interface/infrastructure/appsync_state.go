@@ -0,0 +1,53 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+)
+
+// verifyExpectedHash makes a save an optimistic-concurrency update.
+// It fails if the remote resource was changed or deleted since the last pull or push, unless no hash is expected.
+func verifyExpectedHash[T any](ctx context.Context, hash func(v *T) (string, error), remote *T, description string) error {
+	expected := syncup.ExpectedHash(ctx)
+	if expected == "" {
+		return nil
+	}
+
+	if remote == nil {
+		return fmt.Errorf("%w: %s was deleted from AWS AppSync since the last pull or push", model.ErrConflict, description)
+	}
+
+	actual, err := hash(remote)
+	if err != nil {
+		return err
+	}
+
+	if actual != expected {
+		return fmt.Errorf("%w: %s was changed in AWS AppSync since the last pull or push", model.ErrConflict, description)
+	}
+
+	return nil
+}
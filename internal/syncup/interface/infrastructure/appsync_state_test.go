@@ -0,0 +1,114 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_verifyExpectedHash(t *testing.T) {
+	function := model.Function{
+		Name: ptr.Pointer("getPost"),
+		Code: ptr.Pointer("export function request(ctx) {}\n"),
+	}
+	functionHash, err := model.FunctionHash(&function)
+	assert.NoError(t, err)
+
+	type args struct {
+		expectedHash string
+		remote       *model.Function
+	}
+
+	type expected struct {
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: no expected hash",
+			args: args{
+				expectedHash: "",
+				remote:       nil,
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: unchanged remote",
+			args: args{
+				expectedHash: functionHash,
+				remote:       &function,
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: changed remote",
+			args: args{
+				expectedHash: "otherHash",
+				remote:       &function,
+			},
+			expected: expected{
+				errIs: model.ErrConflict,
+			},
+		},
+		{
+			name: "edge path: deleted remote",
+			args: args{
+				expectedHash: functionHash,
+				remote:       nil,
+			},
+			expected: expected{
+				errIs: model.ErrConflict,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := syncup.WithExpectedHash(context.Background(), tt.args.expectedHash)
+
+			// Act
+			err := verifyExpectedHash(ctx, model.FunctionHash, tt.args.remote, "function getPost")
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expected.errIs)
+			}
+		})
+	}
+}
@@ -25,16 +25,24 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	credscache "github.com/Aton-Kish/aws-credscache-go/sdkv2"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/smithy-go/middleware"
+	"golang.org/x/time/rate"
 )
 
 type appsyncClient interface {
+	GetGraphqlApi(ctx context.Context, params *appsync.GetGraphqlApiInput, optFns ...func(*appsync.Options)) (*appsync.GetGraphqlApiOutput, error)
+	UpdateGraphqlApi(ctx context.Context, params *appsync.UpdateGraphqlApiInput, optFns ...func(*appsync.Options)) (*appsync.UpdateGraphqlApiOutput, error)
+
 	GetGraphqlApiEnvironmentVariables(ctx context.Context, params *appsync.GetGraphqlApiEnvironmentVariablesInput, optFns ...func(*appsync.Options)) (*appsync.GetGraphqlApiEnvironmentVariablesOutput, error)
 	PutGraphqlApiEnvironmentVariables(ctx context.Context, params *appsync.PutGraphqlApiEnvironmentVariablesInput, optFns ...func(*appsync.Options)) (*appsync.PutGraphqlApiEnvironmentVariablesOutput, error)
 
@@ -42,6 +50,12 @@ type appsyncClient interface {
 	StartSchemaCreation(ctx context.Context, params *appsync.StartSchemaCreationInput, optFns ...func(*appsync.Options)) (*appsync.StartSchemaCreationOutput, error)
 	GetSchemaCreationStatus(ctx context.Context, params *appsync.GetSchemaCreationStatusInput, optFns ...func(*appsync.Options)) (*appsync.GetSchemaCreationStatusOutput, error)
 
+	ListDataSources(ctx context.Context, params *appsync.ListDataSourcesInput, optFns ...func(*appsync.Options)) (*appsync.ListDataSourcesOutput, error)
+	GetDataSource(ctx context.Context, params *appsync.GetDataSourceInput, optFns ...func(*appsync.Options)) (*appsync.GetDataSourceOutput, error)
+	CreateDataSource(ctx context.Context, params *appsync.CreateDataSourceInput, optFns ...func(*appsync.Options)) (*appsync.CreateDataSourceOutput, error)
+	UpdateDataSource(ctx context.Context, params *appsync.UpdateDataSourceInput, optFns ...func(*appsync.Options)) (*appsync.UpdateDataSourceOutput, error)
+	DeleteDataSource(ctx context.Context, params *appsync.DeleteDataSourceInput, optFns ...func(*appsync.Options)) (*appsync.DeleteDataSourceOutput, error)
+
 	ListFunctions(ctx context.Context, params *appsync.ListFunctionsInput, optFns ...func(*appsync.Options)) (*appsync.ListFunctionsOutput, error)
 	CreateFunction(ctx context.Context, params *appsync.CreateFunctionInput, optFns ...func(*appsync.Options)) (*appsync.CreateFunctionOutput, error)
 	UpdateFunction(ctx context.Context, params *appsync.UpdateFunctionInput, optFns ...func(*appsync.Options)) (*appsync.UpdateFunctionOutput, error)
@@ -54,8 +68,19 @@ type appsyncClient interface {
 	DeleteResolver(ctx context.Context, params *appsync.DeleteResolverInput, optFns ...func(*appsync.Options)) (*appsync.DeleteResolverOutput, error)
 
 	ListTypes(ctx context.Context, params *appsync.ListTypesInput, optFns ...func(*appsync.Options)) (*appsync.ListTypesOutput, error)
+
+	EvaluateCode(ctx context.Context, params *appsync.EvaluateCodeInput, optFns ...func(*appsync.Options)) (*appsync.EvaluateCodeOutput, error)
+	EvaluateMappingTemplate(ctx context.Context, params *appsync.EvaluateMappingTemplateInput, optFns ...func(*appsync.Options)) (*appsync.EvaluateMappingTemplateOutput, error)
 }
 
+const (
+	appsyncRequestsPerSecond = 5
+	appsyncRequestBurst      = 10
+	appsyncMaxAttempts       = 10
+	appsyncMaxBackoff        = 20 * time.Second
+	appsyncRetryQuota        = 5000
+)
+
 type awsClients struct {
 	appsyncClient appsyncClient
 }
@@ -71,6 +96,11 @@ func activatedAWSClients(ctx context.Context, optFns ...func(o *model.AWSOptions
 	awsActivateOnce.Do(func() {
 		o := model.NewAWSOptions(optFns...)
 
+		rateLimit := o.RateLimit
+		if rateLimit <= 0 {
+			rateLimit = appsyncRequestsPerSecond
+		}
+
 		var cfg aws.Config
 		cfg, err = config.LoadDefaultConfig(
 			ctx,
@@ -79,6 +109,16 @@ func activatedAWSClients(ctx context.Context, optFns ...func(o *model.AWSOptions
 			config.WithAssumeRoleCredentialOptions(func(aro *stscreds.AssumeRoleOptions) {
 				aro.TokenProvider = o.MFATokenProvider
 			}),
+			config.WithRetryer(func() aws.Retryer {
+				return retry.NewStandard(func(so *retry.StandardOptions) {
+					so.MaxAttempts = appsyncMaxAttempts
+					so.MaxBackoff = appsyncMaxBackoff
+					so.RateLimiter = ratelimit.NewTokenRateLimit(appsyncRetryQuota)
+				})
+			}),
+			config.WithAPIOptions([]func(*middleware.Stack) error{
+				withRateLimit(rate.NewLimiter(rate.Limit(rateLimit), appsyncRequestBurst)),
+			}),
 		)
 		if err != nil {
 			return
@@ -107,3 +147,22 @@ func activatedAWSClients(ctx context.Context, optFns ...func(o *model.AWSOptions
 
 	return awsClts, err
 }
+
+func withRateLimit(limiter *rate.Limiter) func(stack *middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		// NOTE: add after the retry middleware so that every attempt takes a token
+		return stack.Finalize.Add(
+			middleware.FinalizeMiddlewareFunc(
+				"RateLimit",
+				func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+					if err := limiter.Wait(ctx); err != nil {
+						return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+					}
+
+					return next.HandleFinalize(ctx, in)
+				},
+			),
+			middleware.After,
+		)
+	}
+}
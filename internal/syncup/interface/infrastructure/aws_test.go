@@ -0,0 +1,137 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go/middleware"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func Test_withRateLimit(t *testing.T) {
+	type args struct {
+		limiter  func() *rate.Limiter
+		canceled bool
+	}
+
+	type expected struct {
+		calls   int
+		minWait time.Duration
+		errIs   error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				limiter: func() *rate.Limiter {
+					return rate.NewLimiter(rate.Every(time.Hour), 1)
+				},
+				canceled: false,
+			},
+			expected: expected{
+				calls:   1,
+				minWait: 0,
+				errIs:   nil,
+			},
+		},
+		{
+			name: "happy path: wait for a token",
+			args: args{
+				limiter: func() *rate.Limiter {
+					l := rate.NewLimiter(rate.Every(50*time.Millisecond), 1)
+					l.Allow()
+					return l
+				},
+				canceled: false,
+			},
+			expected: expected{
+				calls:   1,
+				minWait: 25 * time.Millisecond,
+				errIs:   nil,
+			},
+		},
+		{
+			name: "edge path: context canceled",
+			args: args{
+				limiter: func() *rate.Limiter {
+					l := rate.NewLimiter(rate.Every(time.Hour), 1)
+					l.Allow()
+					return l
+				},
+				canceled: true,
+			},
+			expected: expected{
+				calls:   0,
+				minWait: 0,
+				errIs:   context.Canceled,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if tt.args.canceled {
+				cancel()
+			}
+
+			stack := middleware.NewStack("test", func() interface{} { return struct{}{} })
+			assert.NoError(t, withRateLimit(tt.args.limiter())(stack))
+
+			m, ok := stack.Finalize.Get("RateLimit")
+			assert.True(t, ok)
+
+			calls := 0
+			next := middleware.FinalizeHandlerFunc(func(ctx context.Context, in middleware.FinalizeInput) (middleware.FinalizeOutput, middleware.Metadata, error) {
+				calls++
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, nil
+			})
+
+			// Act
+			start := time.Now()
+			_, _, err := m.HandleFinalize(ctx, middleware.FinalizeInput{}, next)
+			elapsed := time.Since(start)
+
+			// Assert
+			assert.Equal(t, tt.expected.calls, calls)
+			assert.GreaterOrEqual(t, elapsed, tt.expected.minWait)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expected.errIs)
+			}
+		})
+	}
+}
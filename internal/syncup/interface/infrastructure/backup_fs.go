@@ -0,0 +1,178 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+const (
+	dirNameSyncup  = ".syncup"
+	dirNameBackups = "backups"
+
+	backupTimestampLayout = "20060102T150405.000Z"
+)
+
+type backupRepositoryForFS struct {
+	baseDir string
+}
+
+var (
+	_ interface {
+		repository.BaseDirProvider
+	} = (*backupRepositoryForFS)(nil)
+)
+
+func NewBackupRepositoryForFS() repository.BackupRepository {
+	return &backupRepositoryForFS{}
+}
+
+// NOTE: backups always live in the project base dir, even while a backup itself is used as the base dir
+func (r *backupRepositoryForFS) BaseDir(ctx context.Context) string {
+	return r.baseDir
+}
+
+func (r *backupRepositoryForFS) SetBaseDir(ctx context.Context, dir string) {
+	r.baseDir = dir
+}
+
+func (r *backupRepositoryForFS) List(ctx context.Context, apiID string) (res []model.Backup, err error) {
+	defer wrap(&err)
+
+	dir := r.dir(ctx, apiID)
+
+	es, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []model.Backup{}, nil
+		}
+
+		return nil, err
+	}
+
+	backups := make([]model.Backup, 0)
+	for _, e := range es {
+		if !e.IsDir() {
+			continue
+		}
+
+		if _, err := time.Parse(backupTimestampLayout, e.Name()); err != nil {
+			continue
+		}
+
+		backups = append(backups, model.Backup{
+			APIID:     apiID,
+			Timestamp: e.Name(),
+			Dir:       filepath.Join(dir, e.Name()),
+		})
+	}
+
+	// NOTE: the timestamp layout sorts chronologically
+	slices.SortFunc(backups, func(a, b model.Backup) int {
+		return cmp.Compare(a.Timestamp, b.Timestamp)
+	})
+
+	return backups, nil
+}
+
+func (r *backupRepositoryForFS) Get(ctx context.Context, apiID string, timestamp string) (res *model.Backup, err error) {
+	defer wrap(&err)
+
+	if _, err := time.Parse(backupTimestampLayout, timestamp); err != nil {
+		return nil, fmt.Errorf("%w: backup timestamp %s", model.ErrInvalidValue, timestamp)
+	}
+
+	dir := filepath.Join(r.dir(ctx, apiID), timestamp)
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: backup %s", model.ErrNotFound, timestamp)
+		}
+
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%w: backup %s", model.ErrNotFound, timestamp)
+	}
+
+	return &model.Backup{
+		APIID:     apiID,
+		Timestamp: timestamp,
+		Dir:       dir,
+	}, nil
+}
+
+func (r *backupRepositoryForFS) Create(ctx context.Context, apiID string) (res *model.Backup, err error) {
+	defer wrap(&err)
+
+	parent := r.dir(ctx, apiID)
+	if err := os.MkdirAll(parent, 0o755); err != nil {
+		return nil, err
+	}
+
+	timestamp := time.Now().UTC().Format(backupTimestampLayout)
+	dir := filepath.Join(parent, timestamp)
+
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			return nil, fmt.Errorf("%w: backup %s", model.ErrDuplicateValue, timestamp)
+		}
+
+		return nil, err
+	}
+
+	return &model.Backup{
+		APIID:     apiID,
+		Timestamp: timestamp,
+		Dir:       dir,
+	}, nil
+}
+
+func (r *backupRepositoryForFS) Delete(ctx context.Context, apiID string, timestamp string) (err error) {
+	defer wrap(&err)
+
+	if _, err := time.Parse(backupTimestampLayout, timestamp); err != nil {
+		return fmt.Errorf("%w: backup timestamp %s", model.ErrInvalidValue, timestamp)
+	}
+
+	if err := os.RemoveAll(filepath.Join(r.dir(ctx, apiID), timestamp)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *backupRepositoryForFS) dir(ctx context.Context, apiID string) string {
+	return filepath.Join(r.BaseDir(ctx), dirNameSyncup, dirNameBackups, apiID)
+}
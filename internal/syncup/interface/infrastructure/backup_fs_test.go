@@ -0,0 +1,382 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_backupRepositoryForFS_List(t *testing.T) {
+	baseDir := testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+		".syncup/backups/apiID/20231101T090000.000Z/schema.graphqls": "type Query {\n  hello: String\n}\n",
+		".syncup/backups/apiID/20231009T120000.000Z/schema.graphqls": "type Query {\n  hello: String\n}\n",
+		".syncup/backups/apiID/notes/README.md":                      "not a backup",
+	})
+	backupsDir := filepath.Join(baseDir, ".syncup/backups/apiID")
+
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		apiID string
+	}
+
+	type expected struct {
+		res   []model.Backup
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: oldest first",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res: []model.Backup{
+					{APIID: "apiID", Timestamp: "20231009T120000.000Z", Dir: filepath.Join(backupsDir, "20231009T120000.000Z")},
+					{APIID: "apiID", Timestamp: "20231101T090000.000Z", Dir: filepath.Join(backupsDir, "20231101T090000.000Z")},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: no backups",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   []model.Backup{},
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &backupRepositoryForFS{
+				baseDir: tt.fields.baseDir,
+			}
+
+			// Act
+			actual, err := r.List(ctx, tt.args.apiID)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_backupRepositoryForFS_Get(t *testing.T) {
+	baseDir := testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+		".syncup/backups/apiID/20231101T090000.000Z/schema.graphqls": "type Query {\n  hello: String\n}\n",
+	})
+
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		apiID     string
+		timestamp string
+	}
+
+	type expected struct {
+		res   *model.Backup
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				apiID:     "apiID",
+				timestamp: "20231101T090000.000Z",
+			},
+			expected: expected{
+				res: &model.Backup{
+					APIID:     "apiID",
+					Timestamp: "20231101T090000.000Z",
+					Dir:       filepath.Join(baseDir, ".syncup/backups/apiID/20231101T090000.000Z"),
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: non-existing backup",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				apiID:     "apiID",
+				timestamp: "20231009T120000.000Z",
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+		{
+			name: "edge path: invalid timestamp",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				apiID:     "apiID",
+				timestamp: "../../../etc",
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &backupRepositoryForFS{
+				baseDir: tt.fields.baseDir,
+			}
+
+			// Act
+			actual, err := r.Get(ctx, tt.args.apiID, tt.args.timestamp)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_backupRepositoryForFS_Create(t *testing.T) {
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		apiID string
+	}
+
+	type expected struct {
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &backupRepositoryForFS{
+				baseDir: tt.fields.baseDir,
+			}
+
+			// Act
+			actual, err := r.Create(ctx, tt.args.apiID)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+
+				_, err := time.Parse(backupTimestampLayout, actual.Timestamp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.args.apiID, actual.APIID)
+				assert.DirExists(t, actual.Dir)
+
+				backup, err := r.Get(ctx, tt.args.apiID, actual.Timestamp)
+				assert.NoError(t, err)
+				assert.Equal(t, actual, backup)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_backupRepositoryForFS_Delete(t *testing.T) {
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		apiID     string
+		timestamp string
+	}
+
+	type expected struct {
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: existing backup",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					".syncup/backups/apiID/20231101T090000.000Z/schema.graphqls": "type Query {\n  hello: String\n}\n",
+				}),
+			},
+			args: args{
+				apiID:     "apiID",
+				timestamp: "20231101T090000.000Z",
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: non-existing backup",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID:     "apiID",
+				timestamp: "20231101T090000.000Z",
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: invalid timestamp",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID:     "apiID",
+				timestamp: "..",
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &backupRepositoryForFS{
+				baseDir: tt.fields.baseDir,
+			}
+
+			// Act
+			err := r.Delete(ctx, tt.args.apiID, tt.args.timestamp)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+				assert.NoDirExists(t, filepath.Join(tt.fields.baseDir, ".syncup/backups", tt.args.apiID, tt.args.timestamp))
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/xfilepath"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	fileNameConfig = "syncup.yaml"
+)
+
+type configRepositoryForFS struct {
+}
+
+func NewConfigRepositoryForFS() repository.ConfigRepository {
+	return &configRepositoryForFS{}
+}
+
+func (r *configRepositoryForFS) Get(ctx context.Context, path string) (res *model.Config, err error) {
+	defer wrap(&err)
+
+	if path == "" {
+		// NOTE: discover the config file from the working directory
+		if !xfilepath.Exist(fileNameConfig) {
+			return nil, fmt.Errorf("%w: config file %s", model.ErrNotFound, fileNameConfig)
+		}
+
+		path = fileNameConfig
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := new(model.Config)
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, err
+	}
+
+	for name, env := range cfg.Environments {
+		if env.BaseDir != "" && !filepath.IsAbs(env.BaseDir) {
+			// NOTE: base dir is relative to the config file
+			env.BaseDir = filepath.Join(filepath.Dir(path), env.BaseDir)
+			cfg.Environments[name] = env
+		}
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_configRepositoryForFS_Get(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+
+	type args struct {
+		path string
+	}
+
+	type expected struct {
+		res   *model.Config
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				path: filepath.Join(testdataBaseDir, "config/syncup.yaml"),
+			},
+			expected: expected{
+				res: &model.Config{
+					Environments: map[string]model.Environment{
+						"dev": {
+							APIID:   "aaaaaa123123123example123",
+							Region:  "ap-northeast-1",
+							Profile: "dev",
+							BaseDir: filepath.Join(testdataBaseDir, "config/appsync"),
+						},
+						"prod": {
+							APIID:   "bbbbbb456456456example456",
+							Region:  "us-east-1",
+							Profile: "prod",
+							BaseDir: "/var/appsync",
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: not discovered",
+			args: args{
+				path: "",
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+		{
+			name: "edge path: not exist",
+			args: args{
+				path: filepath.Join(testdataBaseDir, "config/missing.yaml"),
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &configRepositoryForFS{}
+
+			// Act
+			actual, err := r.Get(ctx, tt.args.path)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/interface/infrastructure/mapper"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+)
+
+type dataSourceRepositoryForAppSync struct {
+	appsyncClient appsyncClient
+}
+
+var (
+	_ interface {
+		repository.AWSActivator
+	} = (*dataSourceRepositoryForAppSync)(nil)
+)
+
+func NewDataSourceRepositoryForAppSync() repository.DataSourceRepository {
+	return &dataSourceRepositoryForAppSync{}
+}
+
+func (r *dataSourceRepositoryForAppSync) ActivateAWS(ctx context.Context, optFns ...func(o *model.AWSOptions)) (err error) {
+	defer wrap(&err)
+
+	c, err := activatedAWSClients(ctx, optFns...)
+	if err != nil {
+		return err
+	}
+
+	r.appsyncClient = c.appsyncClient
+
+	return nil
+}
+
+func (r *dataSourceRepositoryForAppSync) List(ctx context.Context, apiID string) (res []model.DataSource, err error) {
+	defer wrap(&err)
+
+	dss := make([]model.DataSource, 0)
+
+	var token *string
+	for {
+		out, err := r.appsyncClient.ListDataSources(
+			ctx,
+			&appsync.ListDataSourcesInput{
+				ApiId:     &apiID,
+				NextToken: token,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ds := range out.DataSources {
+			dss = append(dss, *mapper.NewDataSourceMapper().ToModel(ctx, &ds))
+		}
+
+		token = out.NextToken
+		if token == nil {
+			break
+		}
+	}
+
+	return dss, nil
+}
+
+func (r *dataSourceRepositoryForAppSync) Get(ctx context.Context, apiID string, name string) (res *model.DataSource, err error) {
+	defer wrap(&err)
+
+	out, err := r.appsyncClient.GetDataSource(
+		ctx,
+		&appsync.GetDataSourceInput{
+			ApiId: &apiID,
+			Name:  &name,
+		},
+		func(o *appsync.Options) {
+			o.Retryer = retry.AddWithErrorCodes(o.Retryer, (*types.ConcurrentModificationException)(nil).ErrorCode())
+		},
+	)
+	if err != nil {
+		if nfe := new(types.NotFoundException); errors.As(err, &nfe) {
+			return nil, model.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	ds := mapper.NewDataSourceMapper().ToModel(ctx, out.DataSource)
+	if ds == nil {
+		return nil, fmt.Errorf("%w: missing data source in AppSync GetDataSource API response", model.ErrNilValue)
+	}
+
+	return ds, nil
+}
+
+func (r *dataSourceRepositoryForAppSync) Save(ctx context.Context, apiID string, dataSource *model.DataSource) (res *model.DataSource, err error) {
+	defer wrap(&err)
+
+	if dataSource == nil {
+		return nil, fmt.Errorf("%w: missing arguments in save data source method", model.ErrNilValue)
+	}
+
+	if dataSource.Name == nil {
+		return nil, fmt.Errorf("%w: missing name", model.ErrNilValue)
+	}
+
+	save := r.update
+	if _, err := r.Get(ctx, apiID, *dataSource.Name); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			save = r.create
+		} else {
+			return nil, err
+		}
+	}
+
+	ds, err := save(ctx, apiID, dataSource)
+	if err != nil {
+		return nil, err
+	}
+
+	return ds, nil
+}
+
+func (r *dataSourceRepositoryForAppSync) create(ctx context.Context, apiID string, dataSource *model.DataSource) (res *model.DataSource, err error) {
+	defer wrap(&err)
+
+	if dataSource == nil {
+		return nil, fmt.Errorf("%w: missing arguments in create data source method", model.ErrNilValue)
+	}
+
+	dd := mapper.NewDataSourceMapper().FromModel(ctx, dataSource)
+	out, err := r.appsyncClient.CreateDataSource(
+		ctx,
+		&appsync.CreateDataSourceInput{
+			ApiId:                    &apiID,
+			Name:                     dd.Name,
+			Type:                     dd.Type,
+			Description:              dd.Description,
+			DynamodbConfig:           dd.DynamodbConfig,
+			ElasticsearchConfig:      dd.ElasticsearchConfig,
+			EventBridgeConfig:        dd.EventBridgeConfig,
+			HttpConfig:               dd.HttpConfig,
+			LambdaConfig:             dd.LambdaConfig,
+			MetricsConfig:            dd.MetricsConfig,
+			OpenSearchServiceConfig:  dd.OpenSearchServiceConfig,
+			RelationalDatabaseConfig: dd.RelationalDatabaseConfig,
+			ServiceRoleArn:           dd.ServiceRoleArn,
+		},
+		func(o *appsync.Options) {
+			o.Retryer = retry.AddWithErrorCodes(o.Retryer, (*types.ConcurrentModificationException)(nil).ErrorCode())
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := mapper.NewDataSourceMapper().ToModel(ctx, out.DataSource)
+	if ds == nil {
+		return nil, fmt.Errorf("%w: missing data source in AppSync CreateDataSource API response", model.ErrNilValue)
+	}
+
+	return ds, nil
+}
+
+func (r *dataSourceRepositoryForAppSync) update(ctx context.Context, apiID string, dataSource *model.DataSource) (res *model.DataSource, err error) {
+	defer wrap(&err)
+
+	if dataSource == nil {
+		return nil, fmt.Errorf("%w: missing arguments in update data source method", model.ErrNilValue)
+	}
+
+	dd := mapper.NewDataSourceMapper().FromModel(ctx, dataSource)
+	out, err := r.appsyncClient.UpdateDataSource(
+		ctx,
+		&appsync.UpdateDataSourceInput{
+			ApiId:                    &apiID,
+			Name:                     dd.Name,
+			Type:                     dd.Type,
+			Description:              dd.Description,
+			DynamodbConfig:           dd.DynamodbConfig,
+			ElasticsearchConfig:      dd.ElasticsearchConfig,
+			EventBridgeConfig:        dd.EventBridgeConfig,
+			HttpConfig:               dd.HttpConfig,
+			LambdaConfig:             dd.LambdaConfig,
+			MetricsConfig:            dd.MetricsConfig,
+			OpenSearchServiceConfig:  dd.OpenSearchServiceConfig,
+			RelationalDatabaseConfig: dd.RelationalDatabaseConfig,
+			ServiceRoleArn:           dd.ServiceRoleArn,
+		},
+		func(o *appsync.Options) {
+			o.Retryer = retry.AddWithErrorCodes(o.Retryer, (*types.ConcurrentModificationException)(nil).ErrorCode())
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := mapper.NewDataSourceMapper().ToModel(ctx, out.DataSource)
+	if ds == nil {
+		return nil, fmt.Errorf("%w: missing data source in AppSync UpdateDataSource API response", model.ErrNilValue)
+	}
+
+	return ds, nil
+}
+
+func (r *dataSourceRepositoryForAppSync) Delete(ctx context.Context, apiID string, name string) (err error) {
+	defer wrap(&err)
+
+	if _, err := r.appsyncClient.DeleteDataSource(
+		ctx,
+		&appsync.DeleteDataSourceInput{
+			ApiId: &apiID,
+			Name:  &name,
+		},
+		func(o *appsync.Options) {
+			o.Retryer = retry.AddWithErrorCodes(o.Retryer, (*types.ConcurrentModificationException)(nil).ErrorCode())
+		},
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
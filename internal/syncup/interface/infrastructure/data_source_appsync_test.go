@@ -0,0 +1,907 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/interface/infrastructure/mapper"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	smithymiddleware "github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_dataSourceRepositoryForAppSync_List(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	dataSourceAMAZON_DYNAMODB := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AMAZON_DYNAMODB/metadata.json")))
+	dataSourceAWS_LAMBDA := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AWS_LAMBDA/metadata.json")))
+	duration := time.Duration(1) * time.Millisecond
+
+	type args struct {
+		apiID string
+	}
+
+	type mockAppSyncClientListDataSourcesReturn struct {
+		res *appsync.ListDataSourcesOutput
+		err error
+	}
+	type mockAppSyncClientListDataSources struct {
+		calls   int
+		returns []mockAppSyncClientListDataSourcesReturn
+	}
+
+	type expected struct {
+		res   []model.DataSource
+		errIs error
+	}
+
+	tests := []struct {
+		name                             string
+		args                             args
+		mockAppSyncClientListDataSources mockAppSyncClientListDataSources
+		expected                         expected
+	}{
+		{
+			name: "happy path: single page",
+			args: args{
+				apiID: "apiID",
+			},
+			mockAppSyncClientListDataSources: mockAppSyncClientListDataSources{
+				returns: []mockAppSyncClientListDataSourcesReturn{
+					{
+						res: &appsync.ListDataSourcesOutput{
+							DataSources: []types.DataSource{
+								*mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAMAZON_DYNAMODB),
+								*mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAWS_LAMBDA),
+							},
+							NextToken: nil,
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   []model.DataSource{dataSourceAMAZON_DYNAMODB, dataSourceAWS_LAMBDA},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: multiple pages",
+			args: args{
+				apiID: "apiID",
+			},
+			mockAppSyncClientListDataSources: mockAppSyncClientListDataSources{
+				returns: []mockAppSyncClientListDataSourcesReturn{
+					{
+						res: &appsync.ListDataSourcesOutput{
+							DataSources: []types.DataSource{
+								*mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAMAZON_DYNAMODB),
+							},
+							NextToken: ptr.Pointer("NextToken"),
+						},
+						err: nil,
+					},
+					{
+						res: &appsync.ListDataSourcesOutput{
+							DataSources: []types.DataSource{
+								*mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAWS_LAMBDA),
+							},
+							NextToken: nil,
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   []model.DataSource{dataSourceAMAZON_DYNAMODB, dataSourceAWS_LAMBDA},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: appsync.ListDataSources() error",
+			args: args{
+				apiID: "apiID",
+			},
+			mockAppSyncClientListDataSources: mockAppSyncClientListDataSources{
+				returns: []mockAppSyncClientListDataSourcesReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			cfg, err := config.LoadDefaultConfig(
+				ctx,
+				config.WithRegion("region"),
+				config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("key", "secret", "session")),
+				config.WithAPIOptions([]func(stack *smithymiddleware.Stack) error{
+					func(stack *smithymiddleware.Stack) error {
+						return stack.Finalize.Add(
+							smithymiddleware.FinalizeMiddlewareFunc("Mock", func(ctx context.Context, input smithymiddleware.FinalizeInput, next smithymiddleware.FinalizeHandler) (smithymiddleware.FinalizeOutput, smithymiddleware.Metadata, error) {
+								switch awsmiddleware.GetOperationName(ctx) {
+								case "ListDataSources":
+									r := tt.mockAppSyncClientListDataSources.returns[tt.mockAppSyncClientListDataSources.calls]
+									tt.mockAppSyncClientListDataSources.calls++
+									return smithymiddleware.FinalizeOutput{Result: r.res}, smithymiddleware.Metadata{}, r.err
+								default:
+									t.Fatal("unexpected operation")
+									return smithymiddleware.FinalizeOutput{}, smithymiddleware.Metadata{}, nil
+								}
+							}), smithymiddleware.After,
+						)
+					},
+				}),
+				config.WithRetryer(func() aws.Retryer {
+					return retry.AddWithMaxBackoffDelay(retry.NewStandard(), duration)
+				}),
+			)
+			assert.NoError(t, err)
+
+			mockAppSyncClient := appsync.NewFromConfig(cfg)
+
+			r := &dataSourceRepositoryForAppSync{
+				appsyncClient: mockAppSyncClient,
+			}
+
+			// Act
+			actual, err := r.List(ctx, tt.args.apiID)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_dataSourceRepositoryForAppSync_Get(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	dataSourceAMAZON_DYNAMODB := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AMAZON_DYNAMODB/metadata.json")))
+	duration := time.Duration(1) * time.Millisecond
+
+	type args struct {
+		apiID string
+		name  string
+	}
+
+	type mockAppSyncClientGetDataSourceReturn struct {
+		res *appsync.GetDataSourceOutput
+		err error
+	}
+	type mockAppSyncClientGetDataSource struct {
+		calls   int
+		returns []mockAppSyncClientGetDataSourceReturn
+	}
+
+	type expected struct {
+		res   *model.DataSource
+		errIs error
+	}
+
+	tests := []struct {
+		name                           string
+		args                           args
+		mockAppSyncClientGetDataSource mockAppSyncClientGetDataSource
+		expected                       expected
+	}{
+		{
+			name: "happy path: default",
+			args: args{
+				apiID: "apiID",
+				name:  "AMAZON_DYNAMODB",
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{
+					{
+						res: &appsync.GetDataSourceOutput{
+							DataSource: mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAMAZON_DYNAMODB),
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &dataSourceAMAZON_DYNAMODB,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: retries on ConcurrentModificationException",
+			args: args{
+				apiID: "apiID",
+				name:  "AMAZON_DYNAMODB",
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 409}},
+								Err:      &types.ConcurrentModificationException{},
+							},
+						},
+					},
+					{
+						res: &appsync.GetDataSourceOutput{
+							DataSource: mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAMAZON_DYNAMODB),
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &dataSourceAMAZON_DYNAMODB,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: appsync.GetDataSource() NotFoundException",
+			args: args{
+				apiID: "apiID",
+				name:  "AMAZON_DYNAMODB",
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 404}},
+								Err:      &types.NotFoundException{},
+							},
+						},
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+		{
+			name: "edge path: appsync.GetDataSource() except NotFoundException",
+			args: args{
+				apiID: "apiID",
+				name:  "AMAZON_DYNAMODB",
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			cfg, err := config.LoadDefaultConfig(
+				ctx,
+				config.WithRegion("region"),
+				config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("key", "secret", "session")),
+				config.WithAPIOptions([]func(stack *smithymiddleware.Stack) error{
+					func(stack *smithymiddleware.Stack) error {
+						return stack.Finalize.Add(
+							smithymiddleware.FinalizeMiddlewareFunc("Mock", func(ctx context.Context, input smithymiddleware.FinalizeInput, next smithymiddleware.FinalizeHandler) (smithymiddleware.FinalizeOutput, smithymiddleware.Metadata, error) {
+								switch awsmiddleware.GetOperationName(ctx) {
+								case "GetDataSource":
+									r := tt.mockAppSyncClientGetDataSource.returns[tt.mockAppSyncClientGetDataSource.calls]
+									tt.mockAppSyncClientGetDataSource.calls++
+									return smithymiddleware.FinalizeOutput{Result: r.res}, smithymiddleware.Metadata{}, r.err
+								default:
+									t.Fatal("unexpected operation")
+									return smithymiddleware.FinalizeOutput{}, smithymiddleware.Metadata{}, nil
+								}
+							}), smithymiddleware.After,
+						)
+					},
+				}),
+				config.WithRetryer(func() aws.Retryer {
+					return retry.AddWithMaxBackoffDelay(retry.NewStandard(), duration)
+				}),
+			)
+			assert.NoError(t, err)
+
+			mockAppSyncClient := appsync.NewFromConfig(cfg)
+
+			r := &dataSourceRepositoryForAppSync{
+				appsyncClient: mockAppSyncClient,
+			}
+
+			// Act
+			actual, err := r.Get(ctx, tt.args.apiID, tt.args.name)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_dataSourceRepositoryForAppSync_Save(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	dataSourceAMAZON_DYNAMODB := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AMAZON_DYNAMODB/metadata.json")))
+	duration := time.Duration(1) * time.Millisecond
+
+	type args struct {
+		apiID      string
+		dataSource *model.DataSource
+	}
+
+	type mockAppSyncClientGetDataSourceReturn struct {
+		res *appsync.GetDataSourceOutput
+		err error
+	}
+	type mockAppSyncClientGetDataSource struct {
+		calls   int
+		returns []mockAppSyncClientGetDataSourceReturn
+	}
+
+	type mockAppSyncClientCreateDataSourceReturn struct {
+		res *appsync.CreateDataSourceOutput
+		err error
+	}
+	type mockAppSyncClientCreateDataSource struct {
+		calls   int
+		returns []mockAppSyncClientCreateDataSourceReturn
+	}
+
+	type mockAppSyncClientUpdateDataSourceReturn struct {
+		res *appsync.UpdateDataSourceOutput
+		err error
+	}
+	type mockAppSyncClientUpdateDataSource struct {
+		calls   int
+		returns []mockAppSyncClientUpdateDataSourceReturn
+	}
+
+	type expected struct {
+		res   *model.DataSource
+		errIs error
+	}
+
+	tests := []struct {
+		name                              string
+		args                              args
+		mockAppSyncClientGetDataSource    mockAppSyncClientGetDataSource
+		mockAppSyncClientCreateDataSource mockAppSyncClientCreateDataSource
+		mockAppSyncClientUpdateDataSource mockAppSyncClientUpdateDataSource
+		expected                          expected
+	}{
+		{
+			name: "happy path: create",
+			args: args{
+				apiID:      "apiID",
+				dataSource: &dataSourceAMAZON_DYNAMODB,
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 404}},
+								Err:      &types.NotFoundException{},
+							},
+						},
+					},
+				},
+			},
+			mockAppSyncClientCreateDataSource: mockAppSyncClientCreateDataSource{
+				returns: []mockAppSyncClientCreateDataSourceReturn{
+					{
+						res: &appsync.CreateDataSourceOutput{
+							DataSource: mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAMAZON_DYNAMODB),
+						},
+						err: nil,
+					},
+				},
+			},
+			mockAppSyncClientUpdateDataSource: mockAppSyncClientUpdateDataSource{
+				returns: []mockAppSyncClientUpdateDataSourceReturn{},
+			},
+			expected: expected{
+				res:   &dataSourceAMAZON_DYNAMODB,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: update",
+			args: args{
+				apiID:      "apiID",
+				dataSource: &dataSourceAMAZON_DYNAMODB,
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{
+					{
+						res: &appsync.GetDataSourceOutput{
+							DataSource: mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAMAZON_DYNAMODB),
+						},
+						err: nil,
+					},
+				},
+			},
+			mockAppSyncClientCreateDataSource: mockAppSyncClientCreateDataSource{
+				returns: []mockAppSyncClientCreateDataSourceReturn{},
+			},
+			mockAppSyncClientUpdateDataSource: mockAppSyncClientUpdateDataSource{
+				returns: []mockAppSyncClientUpdateDataSourceReturn{
+					{
+						res: &appsync.UpdateDataSourceOutput{
+							DataSource: mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAMAZON_DYNAMODB),
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &dataSourceAMAZON_DYNAMODB,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: update - retries on ConcurrentModificationException",
+			args: args{
+				apiID:      "apiID",
+				dataSource: &dataSourceAMAZON_DYNAMODB,
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{
+					{
+						res: &appsync.GetDataSourceOutput{
+							DataSource: mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAMAZON_DYNAMODB),
+						},
+						err: nil,
+					},
+				},
+			},
+			mockAppSyncClientCreateDataSource: mockAppSyncClientCreateDataSource{
+				returns: []mockAppSyncClientCreateDataSourceReturn{},
+			},
+			mockAppSyncClientUpdateDataSource: mockAppSyncClientUpdateDataSource{
+				returns: []mockAppSyncClientUpdateDataSourceReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 409}},
+								Err:      &types.ConcurrentModificationException{},
+							},
+						},
+					},
+					{
+						res: &appsync.UpdateDataSourceOutput{
+							DataSource: mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAMAZON_DYNAMODB),
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &dataSourceAMAZON_DYNAMODB,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil data source",
+			args: args{
+				apiID:      "apiID",
+				dataSource: nil,
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{},
+			},
+			mockAppSyncClientCreateDataSource: mockAppSyncClientCreateDataSource{
+				returns: []mockAppSyncClientCreateDataSourceReturn{},
+			},
+			mockAppSyncClientUpdateDataSource: mockAppSyncClientUpdateDataSource{
+				returns: []mockAppSyncClientUpdateDataSourceReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: nil name",
+			args: args{
+				apiID:      "apiID",
+				dataSource: &model.DataSource{},
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{},
+			},
+			mockAppSyncClientCreateDataSource: mockAppSyncClientCreateDataSource{
+				returns: []mockAppSyncClientCreateDataSourceReturn{},
+			},
+			mockAppSyncClientUpdateDataSource: mockAppSyncClientUpdateDataSource{
+				returns: []mockAppSyncClientUpdateDataSourceReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: appsync.GetDataSource() error",
+			args: args{
+				apiID:      "apiID",
+				dataSource: &dataSourceAMAZON_DYNAMODB,
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			mockAppSyncClientCreateDataSource: mockAppSyncClientCreateDataSource{
+				returns: []mockAppSyncClientCreateDataSourceReturn{},
+			},
+			mockAppSyncClientUpdateDataSource: mockAppSyncClientUpdateDataSource{
+				returns: []mockAppSyncClientUpdateDataSourceReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: appsync.CreateDataSource() error",
+			args: args{
+				apiID:      "apiID",
+				dataSource: &dataSourceAMAZON_DYNAMODB,
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 404}},
+								Err:      &types.NotFoundException{},
+							},
+						},
+					},
+				},
+			},
+			mockAppSyncClientCreateDataSource: mockAppSyncClientCreateDataSource{
+				returns: []mockAppSyncClientCreateDataSourceReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			mockAppSyncClientUpdateDataSource: mockAppSyncClientUpdateDataSource{
+				returns: []mockAppSyncClientUpdateDataSourceReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: appsync.UpdateDataSource() error",
+			args: args{
+				apiID:      "apiID",
+				dataSource: &dataSourceAMAZON_DYNAMODB,
+			},
+			mockAppSyncClientGetDataSource: mockAppSyncClientGetDataSource{
+				returns: []mockAppSyncClientGetDataSourceReturn{
+					{
+						res: &appsync.GetDataSourceOutput{
+							DataSource: mapper.NewDataSourceMapper().FromModel(context.Background(), &dataSourceAMAZON_DYNAMODB),
+						},
+						err: nil,
+					},
+				},
+			},
+			mockAppSyncClientCreateDataSource: mockAppSyncClientCreateDataSource{
+				returns: []mockAppSyncClientCreateDataSourceReturn{},
+			},
+			mockAppSyncClientUpdateDataSource: mockAppSyncClientUpdateDataSource{
+				returns: []mockAppSyncClientUpdateDataSourceReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			cfg, err := config.LoadDefaultConfig(
+				ctx,
+				config.WithRegion("region"),
+				config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("key", "secret", "session")),
+				config.WithAPIOptions([]func(stack *smithymiddleware.Stack) error{
+					func(stack *smithymiddleware.Stack) error {
+						return stack.Finalize.Add(
+							smithymiddleware.FinalizeMiddlewareFunc("Mock", func(ctx context.Context, input smithymiddleware.FinalizeInput, next smithymiddleware.FinalizeHandler) (smithymiddleware.FinalizeOutput, smithymiddleware.Metadata, error) {
+								switch awsmiddleware.GetOperationName(ctx) {
+								case "GetDataSource":
+									r := tt.mockAppSyncClientGetDataSource.returns[tt.mockAppSyncClientGetDataSource.calls]
+									tt.mockAppSyncClientGetDataSource.calls++
+									return smithymiddleware.FinalizeOutput{Result: r.res}, smithymiddleware.Metadata{}, r.err
+								case "CreateDataSource":
+									r := tt.mockAppSyncClientCreateDataSource.returns[tt.mockAppSyncClientCreateDataSource.calls]
+									tt.mockAppSyncClientCreateDataSource.calls++
+									return smithymiddleware.FinalizeOutput{Result: r.res}, smithymiddleware.Metadata{}, r.err
+								case "UpdateDataSource":
+									r := tt.mockAppSyncClientUpdateDataSource.returns[tt.mockAppSyncClientUpdateDataSource.calls]
+									tt.mockAppSyncClientUpdateDataSource.calls++
+									return smithymiddleware.FinalizeOutput{Result: r.res}, smithymiddleware.Metadata{}, r.err
+								default:
+									t.Fatal("unexpected operation")
+									return smithymiddleware.FinalizeOutput{}, smithymiddleware.Metadata{}, nil
+								}
+							}), smithymiddleware.After,
+						)
+					},
+				}),
+				config.WithRetryer(func() aws.Retryer {
+					return retry.AddWithMaxBackoffDelay(retry.NewStandard(), duration)
+				}),
+			)
+			assert.NoError(t, err)
+
+			mockAppSyncClient := appsync.NewFromConfig(cfg)
+
+			r := &dataSourceRepositoryForAppSync{
+				appsyncClient: mockAppSyncClient,
+			}
+
+			// Act
+			actual, err := r.Save(ctx, tt.args.apiID, tt.args.dataSource)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_dataSourceRepositoryForAppSync_Delete(t *testing.T) {
+	duration := time.Duration(1) * time.Millisecond
+
+	type args struct {
+		apiID string
+		name  string
+	}
+
+	type mockAppSyncClientDeleteDataSourceReturn struct {
+		res *appsync.DeleteDataSourceOutput
+		err error
+	}
+	type mockAppSyncClientDeleteDataSource struct {
+		calls   int
+		returns []mockAppSyncClientDeleteDataSourceReturn
+	}
+
+	type expected struct {
+		errIs error
+	}
+
+	tests := []struct {
+		name                              string
+		args                              args
+		mockAppSyncClientDeleteDataSource mockAppSyncClientDeleteDataSource
+		expected                          expected
+	}{
+		{
+			name: "happy path: default",
+			args: args{
+				apiID: "apiID",
+				name:  "AMAZON_DYNAMODB",
+			},
+			mockAppSyncClientDeleteDataSource: mockAppSyncClientDeleteDataSource{
+				returns: []mockAppSyncClientDeleteDataSourceReturn{
+					{
+						res: &appsync.DeleteDataSourceOutput{},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: retries on ConcurrentModificationException",
+			args: args{
+				apiID: "apiID",
+				name:  "AMAZON_DYNAMODB",
+			},
+			mockAppSyncClientDeleteDataSource: mockAppSyncClientDeleteDataSource{
+				returns: []mockAppSyncClientDeleteDataSourceReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 409}},
+								Err:      &types.ConcurrentModificationException{},
+							},
+						},
+					},
+					{
+						res: &appsync.DeleteDataSourceOutput{},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: appsync.DeleteDataSource() error",
+			args: args{
+				apiID: "apiID",
+				name:  "AMAZON_DYNAMODB",
+			},
+			mockAppSyncClientDeleteDataSource: mockAppSyncClientDeleteDataSource{
+				returns: []mockAppSyncClientDeleteDataSourceReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			cfg, err := config.LoadDefaultConfig(
+				ctx,
+				config.WithRegion("region"),
+				config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("key", "secret", "session")),
+				config.WithAPIOptions([]func(stack *smithymiddleware.Stack) error{
+					func(stack *smithymiddleware.Stack) error {
+						return stack.Finalize.Add(
+							smithymiddleware.FinalizeMiddlewareFunc("Mock", func(ctx context.Context, input smithymiddleware.FinalizeInput, next smithymiddleware.FinalizeHandler) (smithymiddleware.FinalizeOutput, smithymiddleware.Metadata, error) {
+								switch awsmiddleware.GetOperationName(ctx) {
+								case "DeleteDataSource":
+									r := tt.mockAppSyncClientDeleteDataSource.returns[tt.mockAppSyncClientDeleteDataSource.calls]
+									tt.mockAppSyncClientDeleteDataSource.calls++
+									return smithymiddleware.FinalizeOutput{Result: r.res}, smithymiddleware.Metadata{}, r.err
+								default:
+									t.Fatal("unexpected operation")
+									return smithymiddleware.FinalizeOutput{}, smithymiddleware.Metadata{}, nil
+								}
+							}), smithymiddleware.After,
+						)
+					},
+				}),
+				config.WithRetryer(func() aws.Retryer {
+					return retry.AddWithMaxBackoffDelay(retry.NewStandard(), duration)
+				}),
+			)
+			assert.NoError(t, err)
+
+			mockAppSyncClient := appsync.NewFromConfig(cfg)
+
+			r := &dataSourceRepositoryForAppSync{
+				appsyncClient: mockAppSyncClient,
+			}
+
+			// Act
+			err = r.Delete(ctx, tt.args.apiID, tt.args.name)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/xfilepath"
+)
+
+const (
+	dirNameDataSources         = "datasources"
+	fileNameDataSourceMetadata = "metadata.json"
+)
+
+type dataSourceRepositoryForFS struct {
+	baseDir string
+}
+
+var (
+	_ interface {
+		repository.BaseDirProvider
+	} = (*dataSourceRepositoryForFS)(nil)
+)
+
+func NewDataSourceRepositoryForFS() repository.DataSourceRepository {
+	return &dataSourceRepositoryForFS{}
+}
+
+func (r *dataSourceRepositoryForFS) BaseDir(ctx context.Context) string {
+	if dir := syncup.BaseDir(ctx); dir != "" {
+		return dir
+	}
+
+	return r.baseDir
+}
+
+func (r *dataSourceRepositoryForFS) SetBaseDir(ctx context.Context, dir string) {
+	r.baseDir = dir
+}
+
+func (r *dataSourceRepositoryForFS) List(ctx context.Context, apiID string) (res []model.DataSource, err error) {
+	defer wrap(&err)
+
+	es, err := os.ReadDir(filepath.Join(r.BaseDir(ctx), dirNameDataSources))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// NOTE: base dirs pulled before data sources were synced have no data sources dir
+			return []model.DataSource{}, nil
+		}
+
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	g := syncup.WorkerPool(ctx).Group()
+	dss := make([]model.DataSource, 0)
+	errs := make([]error, 0)
+
+	for _, e := range es {
+		if !e.IsDir() {
+			continue
+		}
+
+		name := e.Name()
+		g.Go(func() {
+			ds, err := r.Get(ctx, apiID, name)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+				return
+			}
+
+			mu.Lock()
+			dss = append(dss, *ds)
+			mu.Unlock()
+		})
+	}
+
+	g.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return dss, nil
+}
+
+func (r *dataSourceRepositoryForFS) Get(ctx context.Context, apiID string, name string) (res *model.DataSource, err error) {
+	defer wrap(&err)
+
+	metadata, err := os.ReadFile(filepath.Join(r.BaseDir(ctx), dirNameDataSources, name, fileNameDataSourceMetadata))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %w", model.ErrNotFound, err)
+		}
+
+		return nil, err
+	}
+
+	ds := new(model.DataSource)
+	if err := json.Unmarshal(metadata, ds); err != nil {
+		return nil, err
+	}
+
+	return ds, nil
+}
+
+func (r *dataSourceRepositoryForFS) Save(ctx context.Context, apiID string, dataSource *model.DataSource) (res *model.DataSource, err error) {
+	defer wrap(&err)
+
+	if dataSource == nil {
+		return nil, fmt.Errorf("%w: missing arguments in save data source method", model.ErrNilValue)
+	}
+
+	if dataSource.Name == nil {
+		return nil, fmt.Errorf("%w: missing name", model.ErrNilValue)
+	}
+
+	dir := filepath.Join(r.BaseDir(ctx), dirNameDataSources, *dataSource.Name)
+	if !xfilepath.Exist(dir) {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+
+	metadata, err := json.MarshalIndent(dataSource, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, fileNameDataSourceMetadata), metadata, 0o644); err != nil {
+		return nil, err
+	}
+
+	return dataSource, nil
+}
+
+func (r *dataSourceRepositoryForFS) Delete(ctx context.Context, apiID string, name string) (err error) {
+	defer wrap(&err)
+
+	if err := os.RemoveAll(filepath.Join(r.BaseDir(ctx), dirNameDataSources, name)); err != nil {
+		return err
+	}
+
+	return nil
+}
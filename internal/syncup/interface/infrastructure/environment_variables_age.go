@@ -0,0 +1,265 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"filippo.io/age"
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+const (
+	envVarAgeKeyFile = "SYNCUP_AGE_KEY_FILE"
+
+	encryptedEnvironmentVariablePrefix = "ENC[age:"
+	encryptedEnvironmentVariableSuffix = "]"
+)
+
+type environmentVariablesRepositoryForAge struct {
+	repo repository.EnvironmentVariablesRepository
+}
+
+var (
+	_ interface {
+		repository.BaseDirProvider
+	} = (*environmentVariablesRepositoryForAge)(nil)
+)
+
+// NewEnvironmentVariablesRepositoryForAge returns the repository that encrypts the local values of the environment variables
+// for the age recipients in the context on save, and decrypts them with the identities in $SYNCUP_AGE_KEY_FILE on get.
+func NewEnvironmentVariablesRepositoryForAge(repo repository.EnvironmentVariablesRepository) repository.EnvironmentVariablesRepository {
+	return &environmentVariablesRepositoryForAge{
+		repo: repo,
+	}
+}
+
+func (r *environmentVariablesRepositoryForAge) BaseDir(ctx context.Context) string {
+	if p, ok := r.repo.(repository.BaseDirProvider); ok {
+		return p.BaseDir(ctx)
+	}
+
+	return syncup.BaseDir(ctx)
+}
+
+func (r *environmentVariablesRepositoryForAge) SetBaseDir(ctx context.Context, dir string) {
+	if p, ok := r.repo.(repository.BaseDirProvider); ok {
+		p.SetBaseDir(ctx, dir)
+	}
+}
+
+func (r *environmentVariablesRepositoryForAge) Get(ctx context.Context, apiID string) (res model.EnvironmentVariables, err error) {
+	defer wrap(&err)
+
+	vs, err := r.repo.Get(ctx, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	var identities []age.Identity
+	errs := make([]error, 0)
+	for _, k := range sortedEnvironmentVariableKeys(vs) {
+		if !isEncryptedEnvironmentVariable(vs[k]) {
+			continue
+		}
+
+		if identities == nil {
+			ids, err := readAgeIdentities()
+			if err != nil {
+				return nil, err
+			}
+
+			identities = ids
+		}
+
+		v, err := decryptEnvironmentVariable(vs[k], identities)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%w: environment variable %s: %w", model.ErrInvalidValue, k, err))
+			continue
+		}
+
+		vs[k] = v
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return vs, nil
+}
+
+func (r *environmentVariablesRepositoryForAge) Save(ctx context.Context, apiID string, variables model.EnvironmentVariables) (res model.EnvironmentVariables, err error) {
+	defer wrap(&err)
+
+	if variables == nil {
+		return nil, fmt.Errorf("%w: missing arguments in save environment variables method", model.ErrNilValue)
+	}
+
+	recipients := make([]age.Recipient, 0)
+	for _, s := range syncup.AgeRecipients(ctx) {
+		recipient, err := age.ParseX25519Recipient(s)
+		if err != nil {
+			return nil, fmt.Errorf("%w: age recipient %s: %w", model.ErrInvalidValue, s, err)
+		}
+
+		recipients = append(recipients, recipient)
+	}
+
+	dir := r.BaseDir(ctx)
+	base, overlay, err := readEnvironmentVariablesFiles(dir, syncup.Environment(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	local := mergeEnvironmentVariables(base, overlay)
+
+	var identities []age.Identity
+	if os.Getenv(envVarAgeKeyFile) != "" {
+		ids, err := readAgeIdentities()
+		if err != nil {
+			return nil, err
+		}
+
+		identities = ids
+	}
+
+	vs := make(model.EnvironmentVariables, len(variables))
+	errs := make([]error, 0)
+	for _, k := range sortedEnvironmentVariableKeys(variables) {
+		v := variables[k]
+		lv, ok := local[k]
+
+		switch {
+		case ok && isEncryptedEnvironmentVariable(lv):
+			// NOTE: age encryption is not deterministic, so an unchanged value keeps its ciphertext to leave the file as it is
+			if len(identities) > 0 {
+				if dv, err := decryptEnvironmentVariable(lv, identities); err == nil && dv == v {
+					vs[k] = lv
+					continue
+				}
+			}
+
+			if len(recipients) == 0 {
+				errs = append(errs, fmt.Errorf("%w: environment variable %s is encrypted but no age recipients are configured", model.ErrInvalidValue, k))
+				continue
+			}
+		case ok && environmentVariableReferencePattern.MatchString(lv):
+			// NOTE: the plain value is handed over, so that the underlying repository keeps the secret reference or reports the mismatch
+			vs[k] = v
+			continue
+		}
+
+		if len(recipients) == 0 {
+			vs[k] = v
+			continue
+		}
+
+		ev, err := encryptEnvironmentVariable(v, recipients)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("environment variable %s: %w", k, err))
+			continue
+		}
+
+		vs[k] = ev
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if _, err := r.repo.Save(ctx, apiID, vs); err != nil {
+		return nil, err
+	}
+
+	return variables, nil
+}
+
+func isEncryptedEnvironmentVariable(value string) bool {
+	return strings.HasPrefix(value, encryptedEnvironmentVariablePrefix) && strings.HasSuffix(value, encryptedEnvironmentVariableSuffix)
+}
+
+// encryptEnvironmentVariable encrypts the value for the recipients into ENC[age:<base64>].
+func encryptEnvironmentVariable(value string, recipients []age.Recipient) (string, error) {
+	buf := new(bytes.Buffer)
+	w, err := age.Encrypt(buf, recipients...)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.WriteString(w, value); err != nil {
+		return "", err
+	}
+
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return encryptedEnvironmentVariablePrefix + base64.StdEncoding.EncodeToString(buf.Bytes()) + encryptedEnvironmentVariableSuffix, nil
+}
+
+func decryptEnvironmentVariable(value string, identities []age.Identity) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSuffix(strings.TrimPrefix(value, encryptedEnvironmentVariablePrefix), encryptedEnvironmentVariableSuffix))
+	if err != nil {
+		return "", err
+	}
+
+	rd, err := age.Decrypt(bytes.NewReader(data), identities...)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := io.ReadAll(rd)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// readAgeIdentities reads the identities from the key file named by $SYNCUP_AGE_KEY_FILE.
+func readAgeIdentities() ([]age.Identity, error) {
+	path := os.Getenv(envVarAgeKeyFile)
+	if path == "" {
+		return nil, fmt.Errorf("%w: %s is not set to decrypt the environment variables", model.ErrInvalidValue, envVarAgeKeyFile)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	identities, err := age.ParseIdentities(f)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", model.ErrInvalidValue, envVarAgeKeyFile, err)
+	}
+
+	return identities, nil
+}
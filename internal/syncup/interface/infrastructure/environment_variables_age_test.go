@@ -0,0 +1,485 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_environmentVariablesRepositoryForAge_Get(t *testing.T) {
+	identity := mustGenerateX25519Identity(t)
+	keyFile := filepath.Join(testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"key.txt": identity.String()}), "key.txt")
+	otherKeyFile := filepath.Join(testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"key.txt": mustGenerateX25519Identity(t).String()}), "key.txt")
+	encrypted := mustEncryptEnvironmentVariable(t, "VALUE1", identity.Recipient())
+
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		apiID string
+	}
+
+	type expected struct {
+		res   model.EnvironmentVariables
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		envs     map[string]string
+		expected expected
+	}{
+		{
+			name: "happy path: decrypt encrypted values",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"env.json": mustMarshalEnvironmentVariables(t, model.EnvironmentVariables{"KEY1": encrypted, "KEY2": "VALUE2"})}),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			envs: map[string]string{
+				envVarAgeKeyFile: keyFile,
+			},
+			expected: expected{
+				res: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+					"KEY2": "VALUE2",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: plain values without key file",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"env.json": `{"KEY1":"VALUE1","KEY2":"VALUE2"}`}),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			envs: map[string]string{
+				envVarAgeKeyFile: "",
+			},
+			expected: expected{
+				res: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+					"KEY2": "VALUE2",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: encrypted values without key file",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"env.json": mustMarshalEnvironmentVariables(t, model.EnvironmentVariables{"KEY1": encrypted})}),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			envs: map[string]string{
+				envVarAgeKeyFile: "",
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: encrypted values with another key",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"env.json": mustMarshalEnvironmentVariables(t, model.EnvironmentVariables{"KEY1": encrypted})}),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			envs: map[string]string{
+				envVarAgeKeyFile: otherKeyFile,
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: non-existing file",
+			fields: fields{
+				baseDir: filepath.Join(t.TempDir(), "notExist"),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			for k, v := range tt.envs {
+				t.Setenv(k, v)
+			}
+
+			r := &environmentVariablesRepositoryForAge{
+				repo: &environmentVariablesRepositoryForFS{
+					baseDir: tt.fields.baseDir,
+				},
+			}
+
+			// Act
+			actual, err := r.Get(ctx, tt.args.apiID)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_environmentVariablesRepositoryForAge_Save(t *testing.T) {
+	identity := mustGenerateX25519Identity(t)
+	keyFile := filepath.Join(testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"key.txt": identity.String()}), "key.txt")
+	encrypted := mustEncryptEnvironmentVariable(t, "VALUE1", identity.Recipient())
+
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		recipients []string
+		apiID      string
+		variables  model.EnvironmentVariables
+	}
+
+	type expected struct {
+		res model.EnvironmentVariables
+		// NOTE: encrypted values are compared after decryption, since age encryption is not deterministic
+		local     model.EnvironmentVariables
+		encrypted []string
+		unchanged map[string]string
+		errIs     error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		envs     map[string]string
+		expected expected
+	}{
+		{
+			name: "happy path: encrypt values for recipients",
+			fields: fields{
+				baseDir: filepath.Join(t.TempDir(), "notExist"),
+			},
+			args: args{
+				recipients: []string{identity.Recipient().String()},
+				apiID:      "apiID",
+				variables: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+					"KEY2": "VALUE2",
+				},
+			},
+			envs: map[string]string{
+				envVarAgeKeyFile: "",
+			},
+			expected: expected{
+				res: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+					"KEY2": "VALUE2",
+				},
+				local: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+					"KEY2": "VALUE2",
+				},
+				encrypted: []string{"KEY1", "KEY2"},
+				errIs:     nil,
+			},
+		},
+		{
+			name: "happy path: keep unchanged ciphertexts and secret references",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"env.json": mustMarshalEnvironmentVariables(t, model.EnvironmentVariables{"KEY1": encrypted, "KEY2": "${env:SYNCUP_TEST_SECRET}", "KEY3": "VALUE3"})}),
+			},
+			args: args{
+				recipients: []string{identity.Recipient().String()},
+				apiID:      "apiID",
+				variables: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+					"KEY2": "envSecret",
+					"KEY3": "changedValue",
+				},
+			},
+			envs: map[string]string{
+				envVarAgeKeyFile:     keyFile,
+				"SYNCUP_TEST_SECRET": "envSecret",
+			},
+			expected: expected{
+				res: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+					"KEY2": "envSecret",
+					"KEY3": "changedValue",
+				},
+				local: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+					"KEY2": "${env:SYNCUP_TEST_SECRET}",
+					"KEY3": "changedValue",
+				},
+				encrypted: []string{"KEY1", "KEY3"},
+				unchanged: map[string]string{
+					"KEY1": encrypted,
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: plain values without recipients",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				recipients: nil,
+				apiID:      "apiID",
+				variables: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+				},
+			},
+			envs: map[string]string{
+				envVarAgeKeyFile: "",
+			},
+			expected: expected{
+				res: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+				},
+				local: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+				},
+				encrypted: []string{},
+				errIs:     nil,
+			},
+		},
+		{
+			name: "edge path: changed encrypted values without recipients",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"env.json": mustMarshalEnvironmentVariables(t, model.EnvironmentVariables{"KEY1": encrypted})}),
+			},
+			args: args{
+				recipients: nil,
+				apiID:      "apiID",
+				variables: model.EnvironmentVariables{
+					"KEY1": "changedValue",
+				},
+			},
+			envs: map[string]string{
+				envVarAgeKeyFile: keyFile,
+			},
+			expected: expected{
+				res:   nil,
+				local: model.EnvironmentVariables{"KEY1": "VALUE1"},
+				unchanged: map[string]string{
+					"KEY1": encrypted,
+				},
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: secret references not matching the remote values",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"env.json": mustMarshalEnvironmentVariables(t, model.EnvironmentVariables{"KEY1": "${env:SYNCUP_TEST_SECRET}"})}),
+			},
+			args: args{
+				recipients: []string{identity.Recipient().String()},
+				apiID:      "apiID",
+				variables: model.EnvironmentVariables{
+					"KEY1": "changedSecret",
+				},
+			},
+			envs: map[string]string{
+				envVarAgeKeyFile:     keyFile,
+				"SYNCUP_TEST_SECRET": "envSecret",
+			},
+			expected: expected{
+				res:       nil,
+				local:     model.EnvironmentVariables{"KEY1": "${env:SYNCUP_TEST_SECRET}"},
+				encrypted: []string{},
+				errIs:     model.ErrConflict,
+			},
+		},
+		{
+			name: "edge path: invalid recipients",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				recipients: []string{"invalidRecipient"},
+				apiID:      "apiID",
+				variables: model.EnvironmentVariables{
+					"KEY1": "VALUE1",
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: nil environment variables",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID:     "apiID",
+				variables: nil,
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := syncup.WithAgeRecipients(context.Background(), tt.args.recipients)
+
+			for k, v := range tt.envs {
+				t.Setenv(k, v)
+			}
+
+			r := &environmentVariablesRepositoryForAge{
+				repo: &environmentVariablesRepositoryForFS{
+					baseDir: tt.fields.baseDir,
+				},
+			}
+
+			// Act
+			actual, err := r.Save(ctx, tt.args.apiID, tt.args.variables)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if tt.expected.local != nil {
+				raw := testhelpers.MustUnmarshalJSON[model.EnvironmentVariables](t, testhelpers.MustReadFile(t, filepath.Join(tt.fields.baseDir, fileNameEnvironmentVariables)))
+
+				local := make(model.EnvironmentVariables, len(raw))
+				encrypted := make([]string, 0)
+				for _, k := range sortedEnvironmentVariableKeys(raw) {
+					local[k] = raw[k]
+					if isEncryptedEnvironmentVariable(raw[k]) {
+						encrypted = append(encrypted, k)
+						local[k] = mustDecryptEnvironmentVariable(t, raw[k], identity)
+					}
+				}
+
+				assert.Equal(t, tt.expected.local, local)
+				if tt.expected.encrypted != nil {
+					assert.Equal(t, tt.expected.encrypted, encrypted)
+				}
+
+				for k, v := range tt.expected.unchanged {
+					assert.Equal(t, v, raw[k], k)
+				}
+			}
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func mustGenerateX25519Identity(t *testing.T) *age.X25519Identity {
+	t.Helper()
+
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return identity
+}
+
+func mustEncryptEnvironmentVariable(t *testing.T, value string, recipient age.Recipient) string {
+	t.Helper()
+
+	v, err := encryptEnvironmentVariable(value, []age.Recipient{recipient})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
+
+func mustDecryptEnvironmentVariable(t *testing.T, value string, identity age.Identity) string {
+	t.Helper()
+
+	v, err := decryptEnvironmentVariable(value, []age.Identity{identity})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
+
+func mustMarshalEnvironmentVariables(t *testing.T, variables model.EnvironmentVariables) string {
+	t.Helper()
+
+	data, err := json.Marshal(variables)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
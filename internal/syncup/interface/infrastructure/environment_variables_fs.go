@@ -23,10 +23,17 @@ package infrastructure
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
+	"maps"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
 
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
 	"github.com/Aton-Kish/syncup/internal/xfilepath"
@@ -36,6 +43,10 @@ const (
 	fileNameEnvironmentVariables = "env.json"
 )
 
+var (
+	environmentVariableReferencePattern = regexp.MustCompile(`\$\{(env|file):([^}]+)\}`)
+)
+
 type environmentVariablesRepositoryForFS struct {
 	baseDir string
 }
@@ -51,6 +62,10 @@ func NewEnvironmentVariablesRepositoryForFS() repository.EnvironmentVariablesRep
 }
 
 func (r *environmentVariablesRepositoryForFS) BaseDir(ctx context.Context) string {
+	if dir := syncup.BaseDir(ctx); dir != "" {
+		return dir
+	}
+
 	return r.baseDir
 }
 
@@ -61,13 +76,36 @@ func (r *environmentVariablesRepositoryForFS) SetBaseDir(ctx context.Context, di
 func (r *environmentVariablesRepositoryForFS) Get(ctx context.Context, apiID string) (res model.EnvironmentVariables, err error) {
 	defer wrap(&err)
 
-	data, err := os.ReadFile(filepath.Join(r.BaseDir(ctx), fileNameEnvironmentVariables))
+	dir := r.BaseDir(ctx)
+	env := syncup.Environment(ctx)
+
+	base, overlay, err := readEnvironmentVariablesFiles(dir, env)
 	if err != nil {
 		return nil, err
 	}
 
-	vs := make(model.EnvironmentVariables)
-	if err := json.Unmarshal(data, &vs); err != nil {
+	if base == nil && overlay == nil {
+		if env == "" {
+			return nil, fmt.Errorf("%w: %s", model.ErrNotFound, fileNameEnvironmentVariables)
+		}
+
+		return nil, fmt.Errorf("%w: %s or %s", model.ErrNotFound, fileNameEnvironmentVariables, environmentVariablesOverlayFileName(env))
+	}
+
+	vs := mergeEnvironmentVariables(base, overlay)
+
+	errs := make([]error, 0)
+	for _, k := range sortedEnvironmentVariableKeys(vs) {
+		v, err := resolveEnvironmentVariable(dir, k, vs[k])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		vs[k] = v
+	}
+
+	if err := errors.Join(errs...); err != nil {
 		return nil, err
 	}
 
@@ -88,14 +126,230 @@ func (r *environmentVariablesRepositoryForFS) Save(ctx context.Context, apiID st
 		}
 	}
 
-	data, err := json.MarshalIndent(variables, "", "  ")
+	env := syncup.Environment(ctx)
+
+	base, overlay, err := readEnvironmentVariablesFiles(dir, env)
+	if err != nil {
+		return nil, err
+	}
+
+	vs, err := keepEnvironmentVariableReferences(dir, mergeEnvironmentVariables(base, overlay), variables)
+	if err != nil {
+		return nil, err
+	}
+
+	name := fileNameEnvironmentVariables
+	var v any = vs
+	if env != "" {
+		// NOTE: the base is shared by all the environments, so only the overlay is written while an environment is selected
+		name = environmentVariablesOverlayFileName(env)
+		v = environmentVariablesOverlay(base, vs)
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
 	if err != nil {
 		return nil, err
 	}
 
-	if err := os.WriteFile(filepath.Join(dir, fileNameEnvironmentVariables), data, 0o644); err != nil {
+	if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
 		return nil, err
 	}
 
 	return variables, nil
 }
+
+// readEnvironmentVariablesFiles reads env.json and the overlay file of the environment as they are, without resolving the references.
+func readEnvironmentVariablesFiles(dir string, env string) (base model.EnvironmentVariables, overlay map[string]*string, err error) {
+	base, err = readEnvironmentVariables(filepath.Join(dir, fileNameEnvironmentVariables))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if env == "" {
+		return base, nil, nil
+	}
+
+	overlay, err = readEnvironmentVariablesOverlay(filepath.Join(dir, environmentVariablesOverlayFileName(env)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return base, overlay, nil
+}
+
+func mergeEnvironmentVariables(base model.EnvironmentVariables, overlay map[string]*string) model.EnvironmentVariables {
+	merged := make(model.EnvironmentVariables, len(base)+len(overlay))
+	maps.Copy(merged, base)
+	for k, v := range overlay {
+		// NOTE: null in an overlay removes the variable from the base
+		if v == nil {
+			delete(merged, k)
+			continue
+		}
+
+		merged[k] = *v
+	}
+
+	return merged
+}
+
+// resolveEnvironmentVariable replaces the secret references in the value, i.e. ${env:NAME} with the environment variable
+// and ${file:PATH} with the content of the file, relative to the base dir.
+func resolveEnvironmentVariable(dir string, key string, value string) (string, error) {
+	errs := make([]error, 0)
+	resolved := environmentVariableReferencePattern.ReplaceAllStringFunc(value, func(ref string) string {
+		m := environmentVariableReferencePattern.FindStringSubmatch(ref)
+		switch m[1] {
+		case "env":
+			v, ok := os.LookupEnv(m[2])
+			if !ok {
+				errs = append(errs, fmt.Errorf("%w: %s in environment variable %s: %s is not set", model.ErrInvalidValue, ref, key, m[2]))
+			}
+
+			return v
+		default:
+			path := m[2]
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(dir, path)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%w: %s in environment variable %s: %w", model.ErrInvalidValue, ref, key, err))
+			}
+
+			// NOTE: editors usually end a file with a newline, which is not part of the secret
+			return strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r")
+		}
+	})
+
+	if err := errors.Join(errs...); err != nil {
+		return "", err
+	}
+
+	return resolved, nil
+}
+
+// keepEnvironmentVariableReferences returns the variables with the local values written back in place of the remote ones
+// where the local values are secret references, so that secrets are never written in plain text.
+// A reference no longer resolving to the remote value is reported as a conflict to be fixed by hand.
+func keepEnvironmentVariableReferences(dir string, local model.EnvironmentVariables, variables model.EnvironmentVariables) (model.EnvironmentVariables, error) {
+	vs := make(model.EnvironmentVariables, len(variables))
+	errs := make([]error, 0)
+	for _, k := range sortedEnvironmentVariableKeys(variables) {
+		vs[k] = variables[k]
+
+		lv, ok := local[k]
+		if !ok || !environmentVariableReferencePattern.MatchString(lv) {
+			continue
+		}
+
+		// NOTE: an unresolvable reference may hide a secret, so nothing is written rather than the remote value
+		resolved, err := resolveEnvironmentVariable(dir, k, lv)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if resolved != variables[k] {
+			errs = append(errs, fmt.Errorf("%w: environment variable %s in AWS AppSync no longer matches its reference %s", model.ErrConflict, k, lv))
+			continue
+		}
+
+		vs[k] = lv
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return vs, nil
+}
+
+func sortedEnvironmentVariableKeys(variables model.EnvironmentVariables) []string {
+	keys := make([]string, 0, len(variables))
+	for k := range variables {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// environmentVariablesOverlay returns the variables that differ from the base, with null for those removed from it.
+func environmentVariablesOverlay(base model.EnvironmentVariables, variables model.EnvironmentVariables) map[string]*string {
+	overlay := make(map[string]*string)
+	for k, v := range variables {
+		if bv, ok := base[k]; !ok || bv != v {
+			overlay[k] = &v
+		}
+	}
+
+	for k := range base {
+		if _, ok := variables[k]; !ok {
+			overlay[k] = nil
+		}
+	}
+
+	return overlay
+}
+
+// environmentVariablesOverlayFileName returns the name of the overlay file for the environment, e.g. env.prod.json.
+func environmentVariablesOverlayFileName(env string) string {
+	ext := filepath.Ext(fileNameEnvironmentVariables)
+	return fmt.Sprintf("%s.%s%s", strings.TrimSuffix(fileNameEnvironmentVariables, ext), env, ext)
+}
+
+// isEnvironmentVariablesFileName reports whether the name is env.json or one of its overlay files.
+func isEnvironmentVariablesFileName(name string) bool {
+	if name == fileNameEnvironmentVariables {
+		return true
+	}
+
+	ext := filepath.Ext(fileNameEnvironmentVariables)
+	env, ok := strings.CutPrefix(name, strings.TrimSuffix(fileNameEnvironmentVariables, ext)+".")
+	if !ok {
+		return false
+	}
+
+	env, ok = strings.CutSuffix(env, ext)
+	return ok && env != ""
+}
+
+// readEnvironmentVariables returns nil without error if the file does not exist.
+func readEnvironmentVariables(path string) (model.EnvironmentVariables, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	vs := make(model.EnvironmentVariables)
+	if err := json.Unmarshal(data, &vs); err != nil {
+		return nil, err
+	}
+
+	return vs, nil
+}
+
+// readEnvironmentVariablesOverlay returns nil without error if the file does not exist.
+func readEnvironmentVariablesOverlay(path string) (map[string]*string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	overlay := make(map[string]*string)
+	if err := json.Unmarshal(data, &overlay); err != nil {
+		return nil, err
+	}
+
+	return overlay, nil
+}
@@ -26,6 +26,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/testhelpers"
 	"github.com/stretchr/testify/assert"
@@ -34,12 +35,17 @@ import (
 func Test_environmentVariablesRepositoryForFS_Get(t *testing.T) {
 	testdataBaseDir := "../../../../testdata"
 	variables := testhelpers.MustUnmarshalJSON[model.EnvironmentVariables](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "environment_variables/env.json")))
+	files := map[string]string{
+		"env.json":      `{"KEY1":"VALUE1","KEY2":"VALUE2"}`,
+		"env.prod.json": `{"KEY1":null,"KEY2":"PROD2","KEY3":"PROD3"}`,
+	}
 
 	type fields struct {
 		baseDir string
 	}
 
 	type args struct {
+		env   string
 		apiID string
 	}
 
@@ -52,6 +58,7 @@ func Test_environmentVariablesRepositoryForFS_Get(t *testing.T) {
 		name     string
 		fields   fields
 		args     args
+		envs     map[string]string
 		expected expected
 	}{
 		{
@@ -68,7 +75,77 @@ func Test_environmentVariablesRepositoryForFS_Get(t *testing.T) {
 			},
 		},
 		{
-			name: "edge path",
+			name: "happy path: environment with overlay",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), files),
+			},
+			args: args{
+				env:   "prod",
+				apiID: "apiID",
+			},
+			expected: expected{
+				res: model.EnvironmentVariables{
+					"KEY2": "PROD2",
+					"KEY3": "PROD3",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: environment without overlay",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), files),
+			},
+			args: args{
+				env:   "dev",
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   variables,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: environment without base",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"env.prod.json": files["env.prod.json"]}),
+			},
+			args: args{
+				env:   "prod",
+				apiID: "apiID",
+			},
+			expected: expected{
+				res: model.EnvironmentVariables{
+					"KEY2": "PROD2",
+					"KEY3": "PROD3",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: secret references",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"env.json":        `{"KEY1":"${env:SYNCUP_TEST_SECRET}","KEY2":"prefix-${file:secrets/key.txt}"}`,
+					"secrets/key.txt": "fileSecret\n",
+				}),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			envs: map[string]string{
+				"SYNCUP_TEST_SECRET": "envSecret",
+			},
+			expected: expected{
+				res: model.EnvironmentVariables{
+					"KEY1": "envSecret",
+					"KEY2": "prefix-fileSecret",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: non-existing file",
 			fields: fields{
 				baseDir: filepath.Join(testdataBaseDir, "invalidBaseDir"),
 			},
@@ -80,12 +157,43 @@ func Test_environmentVariablesRepositoryForFS_Get(t *testing.T) {
 				errIs: nil,
 			},
 		},
+		{
+			name: "edge path: non-existing files with environment",
+			fields: fields{
+				baseDir: filepath.Join(testdataBaseDir, "invalidBaseDir"),
+			},
+			args: args{
+				env:   "prod",
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+		{
+			name: "edge path: unresolvable secret references",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"env.json": `{"KEY1":"${env:SYNCUP_TEST_SECRET}","KEY2":"${file:secrets/key.txt}"}`}),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
-			ctx := context.Background()
+			ctx := syncup.WithEnvironment(context.Background(), tt.args.env)
+
+			for k, v := range tt.envs {
+				t.Setenv(k, v)
+			}
 
 			r := &environmentVariablesRepositoryForFS{
 				baseDir: tt.fields.baseDir,
@@ -120,12 +228,14 @@ func Test_environmentVariablesRepositoryForFS_Save(t *testing.T) {
 	}
 
 	type args struct {
+		env       string
 		apiID     string
 		variables model.EnvironmentVariables
 	}
 
 	type expected struct {
 		res   model.EnvironmentVariables
+		files map[string]string
 		errIs error
 	}
 
@@ -133,6 +243,7 @@ func Test_environmentVariablesRepositoryForFS_Save(t *testing.T) {
 		name     string
 		fields   fields
 		args     args
+		envs     map[string]string
 		expected expected
 	}{
 		{
@@ -163,6 +274,88 @@ func Test_environmentVariablesRepositoryForFS_Save(t *testing.T) {
 				errIs: nil,
 			},
 		},
+		{
+			name: "happy path: environment",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"env.json": `{"KEY1":"VALUE1","KEY2":"VALUE2"}`}),
+			},
+			args: args{
+				env:   "prod",
+				apiID: "apiID",
+				variables: model.EnvironmentVariables{
+					"KEY2": "PROD2",
+					"KEY3": "PROD3",
+				},
+			},
+			expected: expected{
+				res: model.EnvironmentVariables{
+					"KEY2": "PROD2",
+					"KEY3": "PROD3",
+				},
+				files: map[string]string{
+					"env.json":      `{"KEY1":"VALUE1","KEY2":"VALUE2"}`,
+					"env.prod.json": "{\n  \"KEY1\": null,\n  \"KEY2\": \"PROD2\",\n  \"KEY3\": \"PROD3\"\n}",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: keep secret references",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"env.json":        `{"KEY1":"${env:SYNCUP_TEST_SECRET}","KEY2":"prefix-${file:secrets/key.txt}"}`,
+					"secrets/key.txt": "fileSecret\n",
+				}),
+			},
+			args: args{
+				apiID: "apiID",
+				variables: model.EnvironmentVariables{
+					"KEY1": "envSecret",
+					"KEY2": "prefix-fileSecret",
+					"KEY3": "VALUE3",
+				},
+			},
+			envs: map[string]string{
+				"SYNCUP_TEST_SECRET": "envSecret",
+			},
+			expected: expected{
+				res: model.EnvironmentVariables{
+					"KEY1": "envSecret",
+					"KEY2": "prefix-fileSecret",
+					"KEY3": "VALUE3",
+				},
+				files: map[string]string{
+					"env.json": "{\n  \"KEY1\": \"${env:SYNCUP_TEST_SECRET}\",\n  \"KEY2\": \"prefix-${file:secrets/key.txt}\",\n  \"KEY3\": \"VALUE3\"\n}",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: secret references not matching the remote values",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"env.json":        `{"KEY1":"${env:SYNCUP_TEST_SECRET}","KEY2":"prefix-${file:secrets/key.txt}"}`,
+					"secrets/key.txt": "fileSecret\n",
+				}),
+			},
+			args: args{
+				apiID: "apiID",
+				variables: model.EnvironmentVariables{
+					"KEY1": "envSecret",
+					"KEY2": "prefix-changedSecret",
+				},
+			},
+			envs: map[string]string{
+				"SYNCUP_TEST_SECRET": "envSecret",
+			},
+			expected: expected{
+				res: nil,
+				files: map[string]string{
+					"env.json": `{"KEY1":"${env:SYNCUP_TEST_SECRET}","KEY2":"prefix-${file:secrets/key.txt}"}`,
+				},
+				errIs: model.ErrConflict,
+			},
+		},
 		{
 			name: "edge path: nil environment variables",
 			fields: fields{
@@ -177,12 +370,32 @@ func Test_environmentVariablesRepositoryForFS_Save(t *testing.T) {
 				errIs: model.ErrNilValue,
 			},
 		},
+		{
+			name: "edge path: unresolvable secret references",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{"env.json": `{"KEY1":"${env:SYNCUP_TEST_SECRET}"}`}),
+			},
+			args: args{
+				apiID: "apiID",
+				variables: model.EnvironmentVariables{
+					"KEY1": "envSecret",
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
-			ctx := context.Background()
+			ctx := syncup.WithEnvironment(context.Background(), tt.args.env)
+
+			for k, v := range tt.envs {
+				t.Setenv(k, v)
+			}
 
 			r := &environmentVariablesRepositoryForFS{
 				baseDir: tt.fields.baseDir,
@@ -194,6 +407,10 @@ func Test_environmentVariablesRepositoryForFS_Save(t *testing.T) {
 			// Assert
 			assert.Equal(t, tt.expected.res, actual)
 
+			for name, content := range tt.expected.files {
+				assert.Equal(t, content, string(testhelpers.MustReadFile(t, filepath.Join(tt.fields.baseDir, name))), name)
+			}
+
 			if strings.HasPrefix(tt.name, "happy") {
 				assert.NoError(t, err)
 			} else {
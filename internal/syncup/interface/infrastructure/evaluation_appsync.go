@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/interface/infrastructure/mapper"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+)
+
+type evaluationRepositoryForAppSync struct {
+	appsyncClient appsyncClient
+}
+
+var (
+	_ interface {
+		repository.AWSActivator
+	} = (*evaluationRepositoryForAppSync)(nil)
+)
+
+func NewEvaluationRepositoryForAppSync() repository.EvaluationRepository {
+	return &evaluationRepositoryForAppSync{}
+}
+
+func (r *evaluationRepositoryForAppSync) ActivateAWS(ctx context.Context, optFns ...func(o *model.AWSOptions)) (err error) {
+	defer wrap(&err)
+
+	c, err := activatedAWSClients(ctx, optFns...)
+	if err != nil {
+		return err
+	}
+
+	r.appsyncClient = c.appsyncClient
+
+	return nil
+}
+
+func (r *evaluationRepositoryForAppSync) Evaluate(ctx context.Context, evaluation *model.Evaluation) (res *model.EvaluationResult, err error) {
+	defer wrap(&err)
+
+	if evaluation == nil {
+		return nil, fmt.Errorf("%w: missing arguments in evaluate method", model.ErrNilValue)
+	}
+
+	switch {
+	case evaluation.Runtime == nil:
+		// VTL runtime
+		if evaluation.Template == nil {
+			return nil, fmt.Errorf("%w: missing mapping template", model.ErrNilValue)
+		}
+
+		out, err := r.appsyncClient.EvaluateMappingTemplate(
+			ctx,
+			&appsync.EvaluateMappingTemplateInput{
+				Template: evaluation.Template,
+				Context:  &evaluation.Context,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res := &model.EvaluationResult{
+			Result: out.EvaluationResult,
+			Logs:   out.Logs,
+		}
+		if out.Error != nil {
+			res.Error = ptr.Pointer(ptr.ToValue(out.Error.Message))
+		}
+
+		return res, nil
+	case evaluation.Runtime.Name == model.RuntimeNameAppsyncJs:
+		// AppSync JS runtime
+		if evaluation.Code == nil {
+			return nil, fmt.Errorf("%w: missing code", model.ErrNilValue)
+		}
+
+		out, err := r.appsyncClient.EvaluateCode(
+			ctx,
+			&appsync.EvaluateCodeInput{
+				Code:     evaluation.Code,
+				Context:  &evaluation.Context,
+				Runtime:  mapper.NewRuntimeMapper().FromModel(ctx, evaluation.Runtime),
+				Function: ptr.Pointer(string(evaluation.Handler)),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		res := &model.EvaluationResult{
+			Result: out.EvaluationResult,
+			Logs:   out.Logs,
+		}
+		if out.Error != nil {
+			res.Error = ptr.Pointer(evaluateCodeErrorMessage(out.Error))
+		}
+
+		return res, nil
+	default:
+		// invalid runtime
+		return nil, fmt.Errorf("%w: runtime %s", model.ErrInvalidValue, evaluation.Runtime.Name)
+	}
+}
+
+// evaluateCodeErrorMessage returns the message followed by the code errors with their locations, one per line.
+func evaluateCodeErrorMessage(detail *types.EvaluateCodeErrorDetail) string {
+	lines := []string{ptr.ToValue(detail.Message)}
+	for _, e := range detail.CodeErrors {
+		if e.Location == nil {
+			lines = append(lines, fmt.Sprintf("%s: %s", ptr.ToValue(e.ErrorType), ptr.ToValue(e.Value)))
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("%s at %d:%d: %s", ptr.ToValue(e.ErrorType), e.Location.Line, e.Location.Column, ptr.ToValue(e.Value)))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,318 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_evaluationRepositoryForAppSync_Evaluate(t *testing.T) {
+	type args struct {
+		evaluation *model.Evaluation
+	}
+
+	type mockServerResponse struct {
+		status int
+		body   string
+	}
+	type mockServer struct {
+		requests  []map[string]any
+		paths     []string
+		responses []mockServerResponse
+	}
+
+	type expected struct {
+		res      *model.EvaluationResult
+		paths    []string
+		requests []map[string]any
+		errIs    error
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		mockServer mockServer
+		expected   expected
+	}{
+		{
+			name: "happy path: APPSYNC_JS runtime",
+			args: args{
+				evaluation: &model.Evaluation{
+					Runtime: &model.Runtime{Name: model.RuntimeNameAppsyncJs, RuntimeVersion: ptr.Pointer("1.0.0")},
+					Code:    ptr.Pointer("export function request(ctx) { return {}; }"),
+					Handler: model.EvaluationHandlerRequest,
+					Context: `{"arguments":{"id":"1"}}`,
+				},
+			},
+			mockServer: mockServer{
+				responses: []mockServerResponse{
+					{
+						status: http.StatusOK,
+						body:   `{"evaluationResult":"{\"id\":\"1\"}","logs":["log"]}`,
+					},
+				},
+			},
+			expected: expected{
+				res: &model.EvaluationResult{
+					Result: ptr.Pointer(`{"id":"1"}`),
+					Logs:   []string{"log"},
+				},
+				paths: []string{"/v1/dataplane-evaluatecode"},
+				requests: []map[string]any{
+					{
+						"code":     "export function request(ctx) { return {}; }",
+						"context":  `{"arguments":{"id":"1"}}`,
+						"function": "request",
+						"runtime":  map[string]any{"name": "APPSYNC_JS", "runtimeVersion": "1.0.0"},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: APPSYNC_JS runtime with code errors",
+			args: args{
+				evaluation: &model.Evaluation{
+					Runtime: &model.Runtime{Name: model.RuntimeNameAppsyncJs, RuntimeVersion: ptr.Pointer("1.0.0")},
+					Code:    ptr.Pointer("export function response(ctx) { return ctx.result.; }"),
+					Handler: model.EvaluationHandlerResponse,
+					Context: `{}`,
+				},
+			},
+			mockServer: mockServer{
+				responses: []mockServerResponse{
+					{
+						status: http.StatusOK,
+						body:   `{"error":{"message":"code is invalid","codeErrors":[{"errorType":"PARSER_ERROR","value":"Unexpected token","location":{"line":1,"column":52,"span":1}}]}}`,
+					},
+				},
+			},
+			expected: expected{
+				res: &model.EvaluationResult{
+					Error: ptr.Pointer("code is invalid\nPARSER_ERROR at 1:52: Unexpected token"),
+				},
+				paths: []string{"/v1/dataplane-evaluatecode"},
+				requests: []map[string]any{
+					{
+						"code":     "export function response(ctx) { return ctx.result.; }",
+						"context":  `{}`,
+						"function": "response",
+						"runtime":  map[string]any{"name": "APPSYNC_JS", "runtimeVersion": "1.0.0"},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: VTL runtime",
+			args: args{
+				evaluation: &model.Evaluation{
+					Runtime:  nil,
+					Template: ptr.Pointer(`$util.toJson($ctx.arguments)`),
+					Handler:  model.EvaluationHandlerRequest,
+					Context:  `{"arguments":{"id":"1"}}`,
+				},
+			},
+			mockServer: mockServer{
+				responses: []mockServerResponse{
+					{
+						status: http.StatusOK,
+						body:   `{"evaluationResult":"{\"id\":\"1\"}"}`,
+					},
+				},
+			},
+			expected: expected{
+				res: &model.EvaluationResult{
+					Result: ptr.Pointer(`{"id":"1"}`),
+				},
+				paths: []string{"/v1/dataplane-evaluatetemplate"},
+				requests: []map[string]any{
+					{
+						"template": `$util.toJson($ctx.arguments)`,
+						"context":  `{"arguments":{"id":"1"}}`,
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: VTL runtime with error",
+			args: args{
+				evaluation: &model.Evaluation{
+					Runtime:  nil,
+					Template: ptr.Pointer(`$util.error("boom")`),
+					Handler:  model.EvaluationHandlerResponse,
+					Context:  `{}`,
+				},
+			},
+			mockServer: mockServer{
+				responses: []mockServerResponse{
+					{
+						status: http.StatusOK,
+						body:   `{"error":{"message":"boom"}}`,
+					},
+				},
+			},
+			expected: expected{
+				res: &model.EvaluationResult{
+					Error: ptr.Pointer("boom"),
+				},
+				paths: []string{"/v1/dataplane-evaluatetemplate"},
+				requests: []map[string]any{
+					{
+						"template": `$util.error("boom")`,
+						"context":  `{}`,
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil evaluation",
+			args: args{
+				evaluation: nil,
+			},
+			mockServer: mockServer{
+				responses: []mockServerResponse{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: invalid runtime",
+			args: args{
+				evaluation: &model.Evaluation{
+					Runtime: &model.Runtime{Name: "invalid"},
+					Code:    ptr.Pointer(""),
+				},
+			},
+			mockServer: mockServer{
+				responses: []mockServerResponse{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: AppSync error",
+			args: args{
+				evaluation: &model.Evaluation{
+					Runtime:  nil,
+					Template: ptr.Pointer(`{}`),
+					Context:  `{}`,
+				},
+			},
+			mockServer: mockServer{
+				responses: []mockServerResponse{
+					{
+						status: http.StatusBadRequest,
+						body:   `{"message":"bad request"}`,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				paths: []string{"/v1/dataplane-evaluatetemplate"},
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+				body, err := io.ReadAll(req.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var v map[string]any
+				if err := json.Unmarshal(body, &v); err != nil {
+					t.Fatal(err)
+				}
+
+				i := len(tt.mockServer.paths)
+				tt.mockServer.paths = append(tt.mockServer.paths, req.URL.Path)
+				tt.mockServer.requests = append(tt.mockServer.requests, v)
+
+				r := tt.mockServer.responses[i]
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(r.status)
+				_, _ = w.Write([]byte(r.body))
+			}))
+			defer server.Close()
+
+			cfg, err := config.LoadDefaultConfig(
+				ctx,
+				config.WithRegion("region"),
+				config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("key", "secret", "session")),
+				config.WithRetryMaxAttempts(1),
+			)
+			assert.NoError(t, err)
+
+			r := &evaluationRepositoryForAppSync{
+				appsyncClient: appsync.NewFromConfig(cfg, func(o *appsync.Options) {
+					o.BaseEndpoint = aws.String(server.URL)
+				}),
+			}
+
+			// Act
+			actual, err := r.Evaluate(ctx, tt.args.evaluation)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+			assert.Equal(t, tt.expected.paths, tt.mockServer.paths)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+
+				assert.Equal(t, tt.expected.requests, tt.mockServer.requests)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
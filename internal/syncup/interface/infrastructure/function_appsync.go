@@ -182,14 +182,21 @@ func (r *functionRepositoryForAppSync) Save(ctx context.Context, apiID string, f
 
 	save := r.update
 	fnToSave := function
-	if fn, err := r.Get(ctx, apiID, *function.Name); err != nil {
-		if errors.Is(err, model.ErrNotFound) {
-			save = r.create
-		} else {
+	remote, err := r.Get(ctx, apiID, *function.Name)
+	if err != nil {
+		if !errors.Is(err, model.ErrNotFound) {
 			return nil, err
 		}
+
+		save = r.create
 	} else {
-		fnToSave = fn
+		f := *function
+		f.FunctionId = remote.FunctionId
+		fnToSave = &f
+	}
+
+	if err := verifyExpectedHash(ctx, model.FunctionHash, remote, fmt.Sprintf("function %s", *function.Name)); err != nil {
+		return nil, err
 	}
 
 	fn, err := save(ctx, apiID, fnToSave)
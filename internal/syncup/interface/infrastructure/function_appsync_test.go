@@ -30,6 +30,7 @@ import (
 	"time"
 
 	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/interface/infrastructure/mapper"
 	"github.com/Aton-Kish/syncup/internal/testhelpers"
@@ -477,11 +478,16 @@ func Test_functionRepositoryForAppSync_Save(t *testing.T) {
 	functionAPPSYNC_JS_1_0_0 := testhelpers.MustUnmarshalJSON[model.Function](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/APPSYNC_JS_1.0.0/metadata.json")))
 	functionAPPSYNC_JS_1_0_0.FunctionId = ptr.Pointer("FunctionId")
 	functionAPPSYNC_JS_1_0_0.Code = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/APPSYNC_JS_1.0.0/code.js"))))
+	functionVTL_2018_05_29Hash, err := model.FunctionHash(&functionVTL_2018_05_29)
+	if err != nil {
+		t.Fatal(err)
+	}
 	duration := time.Duration(1) * time.Millisecond
 
 	type args struct {
-		apiID    string
-		function *model.Function
+		apiID        string
+		function     *model.Function
+		expectedHash string
 	}
 
 	type mockAppSyncClientListFunctionsReturn struct {
@@ -607,6 +613,53 @@ func Test_functionRepositoryForAppSync_Save(t *testing.T) {
 				errIs: nil,
 			},
 		},
+		{
+			name: "happy path: update - unchanged since the last sync",
+			args: args{
+				apiID:        "apiID",
+				function:     &functionVTL_2018_05_29WithoutFunctionId,
+				expectedHash: functionVTL_2018_05_29Hash,
+			},
+			mockAppSyncClientListFunctions: mockAppSyncClientListFunctions{
+				returns: []mockAppSyncClientListFunctionsReturn{
+					{
+						res: &appsync.ListFunctionsOutput{
+							Functions: []types.FunctionConfiguration{
+								*mapper.NewFunctionMapper().FromModel(context.Background(), &functionVTL_2018_05_29),
+							},
+							NextToken: aws.String("NextToken"),
+						},
+						err: nil,
+					},
+					{
+						res: &appsync.ListFunctionsOutput{
+							Functions: []types.FunctionConfiguration{
+								*mapper.NewFunctionMapper().FromModel(context.Background(), &functionAPPSYNC_JS_1_0_0),
+							},
+							NextToken: nil,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockAppSyncClientCreateFunction: mockAppSyncClientCreateFunction{
+				returns: []mockAppSyncClientCreateFunctionReturn{},
+			},
+			mockAppSyncClientUpdateFunction: mockAppSyncClientUpdateFunction{
+				returns: []mockAppSyncClientUpdateFunctionReturn{
+					{
+						res: &appsync.UpdateFunctionOutput{
+							FunctionConfiguration: mapper.NewFunctionMapper().FromModel(context.Background(), &functionVTL_2018_05_29),
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &functionVTL_2018_05_29,
+				errIs: nil,
+			},
+		},
 		{
 			name: "happy path: create - retries on ConcurrentModificationException",
 			args: args{
@@ -986,12 +1039,83 @@ func Test_functionRepositoryForAppSync_Save(t *testing.T) {
 				errIs: nil,
 			},
 		},
+		{
+			name: "edge path: update - changed since the last sync",
+			args: args{
+				apiID:        "apiID",
+				function:     &functionVTL_2018_05_29WithoutFunctionId,
+				expectedHash: "staleHash",
+			},
+			mockAppSyncClientListFunctions: mockAppSyncClientListFunctions{
+				returns: []mockAppSyncClientListFunctionsReturn{
+					{
+						res: &appsync.ListFunctionsOutput{
+							Functions: []types.FunctionConfiguration{
+								*mapper.NewFunctionMapper().FromModel(context.Background(), &functionVTL_2018_05_29),
+							},
+							NextToken: aws.String("NextToken"),
+						},
+						err: nil,
+					},
+					{
+						res: &appsync.ListFunctionsOutput{
+							Functions: []types.FunctionConfiguration{
+								*mapper.NewFunctionMapper().FromModel(context.Background(), &functionAPPSYNC_JS_1_0_0),
+							},
+							NextToken: nil,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockAppSyncClientCreateFunction: mockAppSyncClientCreateFunction{
+				returns: []mockAppSyncClientCreateFunctionReturn{},
+			},
+			mockAppSyncClientUpdateFunction: mockAppSyncClientUpdateFunction{
+				returns: []mockAppSyncClientUpdateFunctionReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrConflict,
+			},
+		},
+		{
+			name: "edge path: create - deleted since the last sync",
+			args: args{
+				apiID:        "apiID",
+				function:     &functionVTL_2018_05_29WithoutFunctionId,
+				expectedHash: functionVTL_2018_05_29Hash,
+			},
+			mockAppSyncClientListFunctions: mockAppSyncClientListFunctions{
+				returns: []mockAppSyncClientListFunctionsReturn{
+					{
+						res: &appsync.ListFunctionsOutput{
+							Functions: []types.FunctionConfiguration{
+								*mapper.NewFunctionMapper().FromModel(context.Background(), &functionAPPSYNC_JS_1_0_0),
+							},
+							NextToken: nil,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockAppSyncClientCreateFunction: mockAppSyncClientCreateFunction{
+				returns: []mockAppSyncClientCreateFunctionReturn{},
+			},
+			mockAppSyncClientUpdateFunction: mockAppSyncClientUpdateFunction{
+				returns: []mockAppSyncClientUpdateFunctionReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrConflict,
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
-			ctx := context.Background()
+			ctx := syncup.WithExpectedHash(context.Background(), tt.args.expectedHash)
 
 			cfg, err := config.LoadDefaultConfig(
 				ctx,
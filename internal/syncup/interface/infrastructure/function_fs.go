@@ -25,11 +25,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"sync"
 
 	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
 	"github.com/Aton-Kish/syncup/internal/xfilepath"
@@ -58,6 +60,10 @@ func NewFunctionRepositoryForFS() repository.FunctionRepository {
 }
 
 func (r *functionRepositoryForFS) BaseDir(ctx context.Context) string {
+	if dir := syncup.BaseDir(ctx); dir != "" {
+		return dir
+	}
+
 	return r.baseDir
 }
 
@@ -70,11 +76,16 @@ func (r *functionRepositoryForFS) List(ctx context.Context, apiID string) (res [
 
 	es, err := os.ReadDir(filepath.Join(r.BaseDir(ctx), dirNameFunctions))
 	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// NOTE: base dirs that have never been pulled have no functions dir
+			return []model.Function{}, nil
+		}
+
 		return nil, err
 	}
 
 	var mu sync.Mutex
-	var wg sync.WaitGroup
+	g := syncup.WorkerPool(ctx).Group()
 	fns := make([]model.Function, 0)
 	errs := make([]error, 0)
 
@@ -84,10 +95,7 @@ func (r *functionRepositoryForFS) List(ctx context.Context, apiID string) (res [
 		}
 
 		name := e.Name()
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-
+		g.Go(func() {
 			fn, err := r.Get(ctx, apiID, name)
 			if err != nil {
 				mu.Lock()
@@ -99,10 +107,10 @@ func (r *functionRepositoryForFS) List(ctx context.Context, apiID string) (res [
 			mu.Lock()
 			fns = append(fns, *fn)
 			mu.Unlock()
-		}()
+		})
 	}
 
-	wg.Wait()
+	g.Wait()
 
 	if err := errors.Join(errs...); err != nil {
 		return nil, err
@@ -117,6 +125,10 @@ func (r *functionRepositoryForFS) Get(ctx context.Context, apiID string, name st
 	dir := filepath.Join(r.BaseDir(ctx), dirNameFunctions, name)
 	metadata, err := os.ReadFile(filepath.Join(dir, fileNameFunctionMetadata))
 	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %w", model.ErrNotFound, err)
+		}
+
 		return nil, err
 	}
 
@@ -187,6 +199,10 @@ func (r *functionRepositoryForFS) Save(ctx context.Context, apiID string, functi
 	switch {
 	case function.Runtime == nil:
 		// VTL runtime
+		if err := removeFiles(dir, fileNameFunctionAppSyncJSCode); err != nil {
+			return nil, err
+		}
+
 		if err := os.WriteFile(filepath.Join(dir, fileNameFunctionVTLRequestMappingTemplate), []byte(ptr.ToValue(function.RequestMappingTemplate)), 0o644); err != nil {
 			return nil, err
 		}
@@ -196,6 +212,10 @@ func (r *functionRepositoryForFS) Save(ctx context.Context, apiID string, functi
 		}
 	case function.Runtime.Name == model.RuntimeNameAppsyncJs:
 		// AppSync JS runtime
+		if err := removeFiles(dir, fileNameFunctionVTLRequestMappingTemplate, fileNameFunctionVTLResponseMappingTemplate); err != nil {
+			return nil, err
+		}
+
 		if err := os.WriteFile(filepath.Join(dir, fileNameFunctionAppSyncJSCode), []byte(ptr.ToValue(function.Code)), 0o644); err != nil {
 			return nil, err
 		}
@@ -22,6 +22,7 @@ package infrastructure
 
 import (
 	"context"
+	"path"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -76,7 +77,7 @@ func Test_functionRepositoryForFS_List(t *testing.T) {
 			},
 		},
 		{
-			name: "edge path: non-existing dir",
+			name: "happy path: non-existing dir",
 			fields: fields{
 				baseDir: filepath.Join(testdataBaseDir, "invalidBaseDir"),
 			},
@@ -175,6 +176,29 @@ func Test_functionRepositoryForFS_Get(t *testing.T) {
 				errIs: nil,
 			},
 		},
+		{
+			name: "happy path: orphan file",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"functions/getPost/metadata.json": `{"name":"getPost"}`,
+					"functions/getPost/request.vtl":   "{}",
+					"functions/getPost/response.vtl":  "$util.toJson($ctx.result)",
+					"functions/getPost/code.js":       "export function request(ctx) {}",
+				}),
+			},
+			args: args{
+				apiID: "apiID",
+				name:  "getPost",
+			},
+			expected: expected{
+				res: &model.Function{
+					Name:                    ptr.Pointer("getPost"),
+					RequestMappingTemplate:  ptr.Pointer("{}"),
+					ResponseMappingTemplate: ptr.Pointer("$util.toJson($ctx.result)"),
+				},
+				errIs: nil,
+			},
+		},
 		{
 			name: "edge path: non-existing dir",
 			fields: fields{
@@ -304,6 +328,23 @@ func Test_functionRepositoryForFS_Save(t *testing.T) {
 				errIs: nil,
 			},
 		},
+		{
+			name: "happy path: AppSync JS runtime - replacing VTL runtime",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					path.Join("functions", *functionAPPSYNC_JS_1_0_0.Name, "request.vtl"):  "{}",
+					path.Join("functions", *functionAPPSYNC_JS_1_0_0.Name, "response.vtl"): "$util.toJson($ctx.result)",
+				}),
+			},
+			args: args{
+				apiID:    "apiID",
+				function: &functionAPPSYNC_JS_1_0_0,
+			},
+			expected: expected{
+				res:   &functionAPPSYNC_JS_1_0_0,
+				errIs: nil,
+			},
+		},
 		{
 			name: "edge path: nil function",
 			fields: fields{
@@ -370,6 +411,10 @@ func Test_functionRepositoryForFS_Save(t *testing.T) {
 
 			if strings.HasPrefix(tt.name, "happy") {
 				assert.NoError(t, err)
+
+				saved, err := r.Get(ctx, tt.args.apiID, *tt.args.function.Name)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.res, saved)
 			} else {
 				var le *model.LibError
 				assert.ErrorAs(t, err, &le)
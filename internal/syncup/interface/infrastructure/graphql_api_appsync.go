@@ -0,0 +1,141 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/interface/infrastructure/mapper"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+)
+
+type graphqlApiRepositoryForAppSync struct {
+	appsyncClient appsyncClient
+}
+
+var (
+	_ interface {
+		repository.AWSActivator
+	} = (*graphqlApiRepositoryForAppSync)(nil)
+)
+
+func NewGraphqlApiRepositoryForAppSync() repository.GraphqlApiRepository {
+	return &graphqlApiRepositoryForAppSync{}
+}
+
+func (r *graphqlApiRepositoryForAppSync) ActivateAWS(ctx context.Context, optFns ...func(o *model.AWSOptions)) (err error) {
+	defer wrap(&err)
+
+	c, err := activatedAWSClients(ctx, optFns...)
+	if err != nil {
+		return err
+	}
+
+	r.appsyncClient = c.appsyncClient
+
+	return nil
+}
+
+func (r *graphqlApiRepositoryForAppSync) Get(ctx context.Context, apiID string) (res *model.GraphqlApi, err error) {
+	defer wrap(&err)
+
+	out, err := r.appsyncClient.GetGraphqlApi(
+		ctx,
+		&appsync.GetGraphqlApiInput{
+			ApiId: &apiID,
+		},
+	)
+	if err != nil {
+		if nfe := new(types.NotFoundException); errors.As(err, &nfe) {
+			return nil, model.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	api := mapper.NewGraphqlApiMapper().ToModel(ctx, out.GraphqlApi)
+	if api == nil {
+		return nil, fmt.Errorf("%w: missing GraphQL API in AppSync GetGraphqlApi API response", model.ErrNilValue)
+	}
+
+	return api, nil
+}
+
+func (r *graphqlApiRepositoryForAppSync) Save(ctx context.Context, apiID string, api *model.GraphqlApi) (res *model.GraphqlApi, err error) {
+	defer wrap(&err)
+
+	if api == nil {
+		return nil, fmt.Errorf("%w: missing arguments in save GraphQL API method", model.ErrNilValue)
+	}
+
+	name := api.Name
+	if name == nil {
+		// NOTE: the name is not synced, so keep the name of the target API
+		current, err := r.Get(ctx, apiID)
+		if err != nil {
+			return nil, err
+		}
+
+		name = current.Name
+	}
+
+	// NOTE: the API type and visibility can only be set when the API is created
+	aa := mapper.NewGraphqlApiMapper().FromModel(ctx, api)
+	out, err := r.appsyncClient.UpdateGraphqlApi(
+		ctx,
+		&appsync.UpdateGraphqlApiInput{
+			ApiId:                             &apiID,
+			Name:                              name,
+			AuthenticationType:                aa.AuthenticationType,
+			AdditionalAuthenticationProviders: aa.AdditionalAuthenticationProviders,
+			LambdaAuthorizerConfig:            aa.LambdaAuthorizerConfig,
+			UserPoolConfig:                    aa.UserPoolConfig,
+			OpenIDConnectConfig:               aa.OpenIDConnectConfig,
+			LogConfig:                         aa.LogConfig,
+			XrayEnabled:                       aa.XrayEnabled,
+			IntrospectionConfig:               aa.IntrospectionConfig,
+			QueryDepthLimit:                   aa.QueryDepthLimit,
+			ResolverCountLimit:                aa.ResolverCountLimit,
+			EnhancedMetricsConfig:             aa.EnhancedMetricsConfig,
+			MergedApiExecutionRoleArn:         aa.MergedApiExecutionRoleArn,
+			OwnerContact:                      aa.OwnerContact,
+		},
+		func(o *appsync.Options) {
+			o.Retryer = retry.AddWithErrorCodes(o.Retryer, (*types.ConcurrentModificationException)(nil).ErrorCode())
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	graphqlApi := mapper.NewGraphqlApiMapper().ToModel(ctx, out.GraphqlApi)
+	if graphqlApi == nil {
+		return nil, fmt.Errorf("%w: missing GraphQL API in AppSync UpdateGraphqlApi API response", model.ErrNilValue)
+	}
+
+	return graphqlApi, nil
+}
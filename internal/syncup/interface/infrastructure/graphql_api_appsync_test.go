@@ -0,0 +1,497 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/interface/infrastructure/mapper"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	smithymiddleware "github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_graphqlApiRepositoryForAppSync_Get(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	graphqlApi := testhelpers.MustUnmarshalJSON[model.GraphqlApi](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "graphql_api/api.json")))
+	duration := time.Duration(1) * time.Millisecond
+
+	type args struct {
+		apiID string
+	}
+
+	type mockAppSyncClientGetGraphqlApiReturn struct {
+		res *appsync.GetGraphqlApiOutput
+		err error
+	}
+	type mockAppSyncClientGetGraphqlApi struct {
+		calls   int
+		returns []mockAppSyncClientGetGraphqlApiReturn
+	}
+
+	type expected struct {
+		res   *model.GraphqlApi
+		errIs error
+	}
+
+	tests := []struct {
+		name                           string
+		args                           args
+		mockAppSyncClientGetGraphqlApi mockAppSyncClientGetGraphqlApi
+		expected                       expected
+	}{
+		{
+			name: "happy path: default",
+			args: args{
+				apiID: "apiID",
+			},
+			mockAppSyncClientGetGraphqlApi: mockAppSyncClientGetGraphqlApi{
+				returns: []mockAppSyncClientGetGraphqlApiReturn{
+					{
+						res: &appsync.GetGraphqlApiOutput{
+							GraphqlApi: mapper.NewGraphqlApiMapper().FromModel(context.Background(), &graphqlApi),
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &graphqlApi,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: not found",
+			args: args{
+				apiID: "apiID",
+			},
+			mockAppSyncClientGetGraphqlApi: mockAppSyncClientGetGraphqlApi{
+				returns: []mockAppSyncClientGetGraphqlApiReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 404}},
+								Err:      &types.NotFoundException{},
+							},
+						},
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+		{
+			name: "edge path: appsync.GetGraphqlApi() error",
+			args: args{
+				apiID: "apiID",
+			},
+			mockAppSyncClientGetGraphqlApi: mockAppSyncClientGetGraphqlApi{
+				returns: []mockAppSyncClientGetGraphqlApiReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			cfg, err := config.LoadDefaultConfig(
+				ctx,
+				config.WithRegion("region"),
+				config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("key", "secret", "session")),
+				config.WithAPIOptions([]func(stack *smithymiddleware.Stack) error{
+					func(stack *smithymiddleware.Stack) error {
+						return stack.Finalize.Add(
+							smithymiddleware.FinalizeMiddlewareFunc("Mock", func(ctx context.Context, input smithymiddleware.FinalizeInput, next smithymiddleware.FinalizeHandler) (smithymiddleware.FinalizeOutput, smithymiddleware.Metadata, error) {
+								switch awsmiddleware.GetOperationName(ctx) {
+								case "GetGraphqlApi":
+									r := tt.mockAppSyncClientGetGraphqlApi.returns[tt.mockAppSyncClientGetGraphqlApi.calls]
+									tt.mockAppSyncClientGetGraphqlApi.calls++
+									return smithymiddleware.FinalizeOutput{Result: r.res}, smithymiddleware.Metadata{}, r.err
+								default:
+									t.Fatal("unexpected operation")
+									return smithymiddleware.FinalizeOutput{}, smithymiddleware.Metadata{}, nil
+								}
+							}), smithymiddleware.After,
+						)
+					},
+				}),
+				config.WithRetryer(func() aws.Retryer {
+					return retry.AddWithMaxBackoffDelay(retry.NewStandard(), duration)
+				}),
+			)
+			assert.NoError(t, err)
+
+			mockAppSyncClient := appsync.NewFromConfig(cfg)
+
+			r := &graphqlApiRepositoryForAppSync{
+				appsyncClient: mockAppSyncClient,
+			}
+
+			// Act
+			actual, err := r.Get(ctx, tt.args.apiID)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_graphqlApiRepositoryForAppSync_Save(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	graphqlApi := testhelpers.MustUnmarshalJSON[model.GraphqlApi](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "graphql_api/api.json")))
+	namedGraphqlApi := graphqlApi
+	namedGraphqlApi.Name = ptr.Pointer("name")
+	duration := time.Duration(1) * time.Millisecond
+
+	type args struct {
+		apiID string
+		api   *model.GraphqlApi
+	}
+
+	type mockAppSyncClientGetGraphqlApiReturn struct {
+		res *appsync.GetGraphqlApiOutput
+		err error
+	}
+	type mockAppSyncClientGetGraphqlApi struct {
+		calls   int
+		returns []mockAppSyncClientGetGraphqlApiReturn
+	}
+
+	type mockAppSyncClientUpdateGraphqlApiReturn struct {
+		res *appsync.UpdateGraphqlApiOutput
+		err error
+	}
+	type mockAppSyncClientUpdateGraphqlApi struct {
+		calls   int
+		returns []mockAppSyncClientUpdateGraphqlApiReturn
+	}
+
+	type expected struct {
+		res   *model.GraphqlApi
+		errIs error
+	}
+
+	tests := []struct {
+		name                              string
+		args                              args
+		mockAppSyncClientGetGraphqlApi    mockAppSyncClientGetGraphqlApi
+		mockAppSyncClientUpdateGraphqlApi mockAppSyncClientUpdateGraphqlApi
+		expected                          expected
+	}{
+		{
+			name: "happy path: without name",
+			args: args{
+				apiID: "apiID",
+				api:   &graphqlApi,
+			},
+			mockAppSyncClientGetGraphqlApi: mockAppSyncClientGetGraphqlApi{
+				returns: []mockAppSyncClientGetGraphqlApiReturn{
+					{
+						res: &appsync.GetGraphqlApiOutput{
+							GraphqlApi: mapper.NewGraphqlApiMapper().FromModel(context.Background(), &namedGraphqlApi),
+						},
+						err: nil,
+					},
+				},
+			},
+			mockAppSyncClientUpdateGraphqlApi: mockAppSyncClientUpdateGraphqlApi{
+				returns: []mockAppSyncClientUpdateGraphqlApiReturn{
+					{
+						res: &appsync.UpdateGraphqlApiOutput{
+							GraphqlApi: mapper.NewGraphqlApiMapper().FromModel(context.Background(), &namedGraphqlApi),
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &namedGraphqlApi,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: with name",
+			args: args{
+				apiID: "apiID",
+				api:   &namedGraphqlApi,
+			},
+			mockAppSyncClientGetGraphqlApi: mockAppSyncClientGetGraphqlApi{
+				returns: []mockAppSyncClientGetGraphqlApiReturn{},
+			},
+			mockAppSyncClientUpdateGraphqlApi: mockAppSyncClientUpdateGraphqlApi{
+				returns: []mockAppSyncClientUpdateGraphqlApiReturn{
+					{
+						res: &appsync.UpdateGraphqlApiOutput{
+							GraphqlApi: mapper.NewGraphqlApiMapper().FromModel(context.Background(), &namedGraphqlApi),
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &namedGraphqlApi,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: retries on ConcurrentModificationException",
+			args: args{
+				apiID: "apiID",
+				api:   &namedGraphqlApi,
+			},
+			mockAppSyncClientGetGraphqlApi: mockAppSyncClientGetGraphqlApi{
+				returns: []mockAppSyncClientGetGraphqlApiReturn{},
+			},
+			mockAppSyncClientUpdateGraphqlApi: mockAppSyncClientUpdateGraphqlApi{
+				returns: []mockAppSyncClientUpdateGraphqlApiReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 409}},
+								Err:      &types.ConcurrentModificationException{},
+							},
+						},
+					},
+					{
+						res: &appsync.UpdateGraphqlApiOutput{
+							GraphqlApi: mapper.NewGraphqlApiMapper().FromModel(context.Background(), &namedGraphqlApi),
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &namedGraphqlApi,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: exceeds max retry count",
+			args: args{
+				apiID: "apiID",
+				api:   &namedGraphqlApi,
+			},
+			mockAppSyncClientGetGraphqlApi: mockAppSyncClientGetGraphqlApi{
+				returns: []mockAppSyncClientGetGraphqlApiReturn{},
+			},
+			mockAppSyncClientUpdateGraphqlApi: mockAppSyncClientUpdateGraphqlApi{
+				returns: []mockAppSyncClientUpdateGraphqlApiReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 409}},
+								Err:      &types.ConcurrentModificationException{},
+							},
+						},
+					},
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 409}},
+								Err:      &types.ConcurrentModificationException{},
+							},
+						},
+					},
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 409}},
+								Err:      &types.ConcurrentModificationException{},
+							},
+						},
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil GraphQL API",
+			args: args{
+				apiID: "apiID",
+				api:   nil,
+			},
+			mockAppSyncClientGetGraphqlApi: mockAppSyncClientGetGraphqlApi{
+				returns: []mockAppSyncClientGetGraphqlApiReturn{},
+			},
+			mockAppSyncClientUpdateGraphqlApi: mockAppSyncClientUpdateGraphqlApi{
+				returns: []mockAppSyncClientUpdateGraphqlApiReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: appsync.GetGraphqlApi() error",
+			args: args{
+				apiID: "apiID",
+				api:   &graphqlApi,
+			},
+			mockAppSyncClientGetGraphqlApi: mockAppSyncClientGetGraphqlApi{
+				returns: []mockAppSyncClientGetGraphqlApiReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			mockAppSyncClientUpdateGraphqlApi: mockAppSyncClientUpdateGraphqlApi{
+				returns: []mockAppSyncClientUpdateGraphqlApiReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: appsync.UpdateGraphqlApi() error",
+			args: args{
+				apiID: "apiID",
+				api:   &namedGraphqlApi,
+			},
+			mockAppSyncClientGetGraphqlApi: mockAppSyncClientGetGraphqlApi{
+				returns: []mockAppSyncClientGetGraphqlApiReturn{},
+			},
+			mockAppSyncClientUpdateGraphqlApi: mockAppSyncClientUpdateGraphqlApi{
+				returns: []mockAppSyncClientUpdateGraphqlApiReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			cfg, err := config.LoadDefaultConfig(
+				ctx,
+				config.WithRegion("region"),
+				config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider("key", "secret", "session")),
+				config.WithAPIOptions([]func(stack *smithymiddleware.Stack) error{
+					func(stack *smithymiddleware.Stack) error {
+						return stack.Finalize.Add(
+							smithymiddleware.FinalizeMiddlewareFunc("Mock", func(ctx context.Context, input smithymiddleware.FinalizeInput, next smithymiddleware.FinalizeHandler) (smithymiddleware.FinalizeOutput, smithymiddleware.Metadata, error) {
+								switch awsmiddleware.GetOperationName(ctx) {
+								case "GetGraphqlApi":
+									r := tt.mockAppSyncClientGetGraphqlApi.returns[tt.mockAppSyncClientGetGraphqlApi.calls]
+									tt.mockAppSyncClientGetGraphqlApi.calls++
+									return smithymiddleware.FinalizeOutput{Result: r.res}, smithymiddleware.Metadata{}, r.err
+								case "UpdateGraphqlApi":
+									r := tt.mockAppSyncClientUpdateGraphqlApi.returns[tt.mockAppSyncClientUpdateGraphqlApi.calls]
+									tt.mockAppSyncClientUpdateGraphqlApi.calls++
+									return smithymiddleware.FinalizeOutput{Result: r.res}, smithymiddleware.Metadata{}, r.err
+								default:
+									t.Fatal("unexpected operation")
+									return smithymiddleware.FinalizeOutput{}, smithymiddleware.Metadata{}, nil
+								}
+							}), smithymiddleware.After,
+						)
+					},
+				}),
+				config.WithRetryer(func() aws.Retryer {
+					return retry.AddWithMaxBackoffDelay(retry.NewStandard(), duration)
+				}),
+			)
+			assert.NoError(t, err)
+
+			mockAppSyncClient := appsync.NewFromConfig(cfg)
+
+			r := &graphqlApiRepositoryForAppSync{
+				appsyncClient: mockAppSyncClient,
+			}
+
+			// Act
+			actual, err := r.Save(ctx, tt.args.apiID, tt.args.api)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/xfilepath"
+)
+
+const (
+	fileNameGraphqlApi = "api.json"
+)
+
+type graphqlApiRepositoryForFS struct {
+	baseDir string
+}
+
+var (
+	_ interface {
+		repository.BaseDirProvider
+	} = (*graphqlApiRepositoryForFS)(nil)
+)
+
+func NewGraphqlApiRepositoryForFS() repository.GraphqlApiRepository {
+	return &graphqlApiRepositoryForFS{}
+}
+
+func (r *graphqlApiRepositoryForFS) BaseDir(ctx context.Context) string {
+	if dir := syncup.BaseDir(ctx); dir != "" {
+		return dir
+	}
+
+	return r.baseDir
+}
+
+func (r *graphqlApiRepositoryForFS) SetBaseDir(ctx context.Context, dir string) {
+	r.baseDir = dir
+}
+
+func (r *graphqlApiRepositoryForFS) Get(ctx context.Context, apiID string) (res *model.GraphqlApi, err error) {
+	defer wrap(&err)
+
+	data, err := os.ReadFile(filepath.Join(r.BaseDir(ctx), fileNameGraphqlApi))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %w", model.ErrNotFound, err)
+		}
+
+		return nil, err
+	}
+
+	api := new(model.GraphqlApi)
+	if err := json.Unmarshal(data, api); err != nil {
+		return nil, err
+	}
+
+	return api, nil
+}
+
+func (r *graphqlApiRepositoryForFS) Save(ctx context.Context, apiID string, api *model.GraphqlApi) (res *model.GraphqlApi, err error) {
+	defer wrap(&err)
+
+	if api == nil {
+		return nil, fmt.Errorf("%w: missing arguments in save GraphQL API method", model.ErrNilValue)
+	}
+
+	dir := r.BaseDir(ctx)
+	if !xfilepath.Exist(dir) {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.MarshalIndent(api, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, fileNameGraphqlApi), data, 0o644); err != nil {
+		return nil, err
+	}
+
+	return api, nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapper
+
+import (
+	"context"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+)
+
+type DataSourceMapper interface {
+	ToModel(ctx context.Context, v *types.DataSource) *model.DataSource
+	FromModel(ctx context.Context, v *model.DataSource) *types.DataSource
+}
+
+type dataSourceMapper struct{}
+
+func NewDataSourceMapper() DataSourceMapper {
+	return (*dataSourceMapper)(nil)
+}
+
+func (*dataSourceMapper) ToModel(ctx context.Context, v *types.DataSource) *model.DataSource {
+	if v == nil {
+		return nil
+	}
+
+	return &model.DataSource{
+		DataSourceArn:            v.DataSourceArn,
+		Name:                     v.Name,
+		Description:              v.Description,
+		Type:                     model.DataSourceType(v.Type),
+		ServiceRoleArn:           v.ServiceRoleArn,
+		DynamodbConfig:           (*dynamodbDataSourceConfigMapper)(nil).ToModel(ctx, v.DynamodbConfig),
+		LambdaConfig:             (*lambdaDataSourceConfigMapper)(nil).ToModel(ctx, v.LambdaConfig),
+		ElasticsearchConfig:      (*elasticsearchDataSourceConfigMapper)(nil).ToModel(ctx, v.ElasticsearchConfig),
+		OpenSearchServiceConfig:  (*openSearchServiceDataSourceConfigMapper)(nil).ToModel(ctx, v.OpenSearchServiceConfig),
+		HttpConfig:               (*httpDataSourceConfigMapper)(nil).ToModel(ctx, v.HttpConfig),
+		RelationalDatabaseConfig: (*relationalDatabaseDataSourceConfigMapper)(nil).ToModel(ctx, v.RelationalDatabaseConfig),
+		EventBridgeConfig:        (*eventBridgeDataSourceConfigMapper)(nil).ToModel(ctx, v.EventBridgeConfig),
+		MetricsConfig:            model.DataSourceLevelMetricsConfig(v.MetricsConfig),
+	}
+}
+
+func (*dataSourceMapper) FromModel(ctx context.Context, v *model.DataSource) *types.DataSource {
+	if v == nil {
+		return nil
+	}
+
+	return &types.DataSource{
+		DataSourceArn:            v.DataSourceArn,
+		Name:                     v.Name,
+		Description:              v.Description,
+		Type:                     types.DataSourceType(v.Type),
+		ServiceRoleArn:           v.ServiceRoleArn,
+		DynamodbConfig:           (*dynamodbDataSourceConfigMapper)(nil).FromModel(ctx, v.DynamodbConfig),
+		LambdaConfig:             (*lambdaDataSourceConfigMapper)(nil).FromModel(ctx, v.LambdaConfig),
+		ElasticsearchConfig:      (*elasticsearchDataSourceConfigMapper)(nil).FromModel(ctx, v.ElasticsearchConfig),
+		OpenSearchServiceConfig:  (*openSearchServiceDataSourceConfigMapper)(nil).FromModel(ctx, v.OpenSearchServiceConfig),
+		HttpConfig:               (*httpDataSourceConfigMapper)(nil).FromModel(ctx, v.HttpConfig),
+		RelationalDatabaseConfig: (*relationalDatabaseDataSourceConfigMapper)(nil).FromModel(ctx, v.RelationalDatabaseConfig),
+		EventBridgeConfig:        (*eventBridgeDataSourceConfigMapper)(nil).FromModel(ctx, v.EventBridgeConfig),
+		MetricsConfig:            types.DataSourceLevelMetricsConfig(v.MetricsConfig),
+	}
+}
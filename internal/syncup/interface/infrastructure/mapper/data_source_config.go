@@ -0,0 +1,379 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapper
+
+import (
+	"context"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+)
+
+type dynamodbDataSourceConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.DynamodbDataSourceConfig) *model.DynamodbDataSourceConfig
+		FromModel(ctx context.Context, v *model.DynamodbDataSourceConfig) *types.DynamodbDataSourceConfig
+	} = (*dynamodbDataSourceConfigMapper)(nil)
+)
+
+func (*dynamodbDataSourceConfigMapper) ToModel(ctx context.Context, v *types.DynamodbDataSourceConfig) *model.DynamodbDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.DynamodbDataSourceConfig{
+		TableName:            v.TableName,
+		AwsRegion:            v.AwsRegion,
+		UseCallerCredentials: v.UseCallerCredentials,
+		DeltaSyncConfig:      (*deltaSyncConfigMapper)(nil).ToModel(ctx, v.DeltaSyncConfig),
+		Versioned:            v.Versioned,
+	}
+}
+
+func (*dynamodbDataSourceConfigMapper) FromModel(ctx context.Context, v *model.DynamodbDataSourceConfig) *types.DynamodbDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.DynamodbDataSourceConfig{
+		TableName:            v.TableName,
+		AwsRegion:            v.AwsRegion,
+		UseCallerCredentials: v.UseCallerCredentials,
+		DeltaSyncConfig:      (*deltaSyncConfigMapper)(nil).FromModel(ctx, v.DeltaSyncConfig),
+		Versioned:            v.Versioned,
+	}
+}
+
+type deltaSyncConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.DeltaSyncConfig) *model.DeltaSyncConfig
+		FromModel(ctx context.Context, v *model.DeltaSyncConfig) *types.DeltaSyncConfig
+	} = (*deltaSyncConfigMapper)(nil)
+)
+
+func (*deltaSyncConfigMapper) ToModel(ctx context.Context, v *types.DeltaSyncConfig) *model.DeltaSyncConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.DeltaSyncConfig{
+		BaseTableTTL:       v.BaseTableTTL,
+		DeltaSyncTableName: v.DeltaSyncTableName,
+		DeltaSyncTableTTL:  v.DeltaSyncTableTTL,
+	}
+}
+
+func (*deltaSyncConfigMapper) FromModel(ctx context.Context, v *model.DeltaSyncConfig) *types.DeltaSyncConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.DeltaSyncConfig{
+		BaseTableTTL:       v.BaseTableTTL,
+		DeltaSyncTableName: v.DeltaSyncTableName,
+		DeltaSyncTableTTL:  v.DeltaSyncTableTTL,
+	}
+}
+
+type lambdaDataSourceConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.LambdaDataSourceConfig) *model.LambdaDataSourceConfig
+		FromModel(ctx context.Context, v *model.LambdaDataSourceConfig) *types.LambdaDataSourceConfig
+	} = (*lambdaDataSourceConfigMapper)(nil)
+)
+
+func (*lambdaDataSourceConfigMapper) ToModel(ctx context.Context, v *types.LambdaDataSourceConfig) *model.LambdaDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.LambdaDataSourceConfig{
+		LambdaFunctionArn: v.LambdaFunctionArn,
+	}
+}
+
+func (*lambdaDataSourceConfigMapper) FromModel(ctx context.Context, v *model.LambdaDataSourceConfig) *types.LambdaDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.LambdaDataSourceConfig{
+		LambdaFunctionArn: v.LambdaFunctionArn,
+	}
+}
+
+type elasticsearchDataSourceConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.ElasticsearchDataSourceConfig) *model.ElasticsearchDataSourceConfig
+		FromModel(ctx context.Context, v *model.ElasticsearchDataSourceConfig) *types.ElasticsearchDataSourceConfig
+	} = (*elasticsearchDataSourceConfigMapper)(nil)
+)
+
+func (*elasticsearchDataSourceConfigMapper) ToModel(ctx context.Context, v *types.ElasticsearchDataSourceConfig) *model.ElasticsearchDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.ElasticsearchDataSourceConfig{
+		Endpoint:  v.Endpoint,
+		AwsRegion: v.AwsRegion,
+	}
+}
+
+func (*elasticsearchDataSourceConfigMapper) FromModel(ctx context.Context, v *model.ElasticsearchDataSourceConfig) *types.ElasticsearchDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.ElasticsearchDataSourceConfig{
+		Endpoint:  v.Endpoint,
+		AwsRegion: v.AwsRegion,
+	}
+}
+
+type openSearchServiceDataSourceConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.OpenSearchServiceDataSourceConfig) *model.OpenSearchServiceDataSourceConfig
+		FromModel(ctx context.Context, v *model.OpenSearchServiceDataSourceConfig) *types.OpenSearchServiceDataSourceConfig
+	} = (*openSearchServiceDataSourceConfigMapper)(nil)
+)
+
+func (*openSearchServiceDataSourceConfigMapper) ToModel(ctx context.Context, v *types.OpenSearchServiceDataSourceConfig) *model.OpenSearchServiceDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.OpenSearchServiceDataSourceConfig{
+		Endpoint:  v.Endpoint,
+		AwsRegion: v.AwsRegion,
+	}
+}
+
+func (*openSearchServiceDataSourceConfigMapper) FromModel(ctx context.Context, v *model.OpenSearchServiceDataSourceConfig) *types.OpenSearchServiceDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.OpenSearchServiceDataSourceConfig{
+		Endpoint:  v.Endpoint,
+		AwsRegion: v.AwsRegion,
+	}
+}
+
+type httpDataSourceConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.HttpDataSourceConfig) *model.HttpDataSourceConfig
+		FromModel(ctx context.Context, v *model.HttpDataSourceConfig) *types.HttpDataSourceConfig
+	} = (*httpDataSourceConfigMapper)(nil)
+)
+
+func (*httpDataSourceConfigMapper) ToModel(ctx context.Context, v *types.HttpDataSourceConfig) *model.HttpDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.HttpDataSourceConfig{
+		Endpoint:            v.Endpoint,
+		AuthorizationConfig: (*authorizationConfigMapper)(nil).ToModel(ctx, v.AuthorizationConfig),
+	}
+}
+
+func (*httpDataSourceConfigMapper) FromModel(ctx context.Context, v *model.HttpDataSourceConfig) *types.HttpDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.HttpDataSourceConfig{
+		Endpoint:            v.Endpoint,
+		AuthorizationConfig: (*authorizationConfigMapper)(nil).FromModel(ctx, v.AuthorizationConfig),
+	}
+}
+
+type authorizationConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.AuthorizationConfig) *model.AuthorizationConfig
+		FromModel(ctx context.Context, v *model.AuthorizationConfig) *types.AuthorizationConfig
+	} = (*authorizationConfigMapper)(nil)
+)
+
+func (*authorizationConfigMapper) ToModel(ctx context.Context, v *types.AuthorizationConfig) *model.AuthorizationConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.AuthorizationConfig{
+		AuthorizationType: model.AuthorizationType(v.AuthorizationType),
+		AwsIamConfig:      (*awsIamConfigMapper)(nil).ToModel(ctx, v.AwsIamConfig),
+	}
+}
+
+func (*authorizationConfigMapper) FromModel(ctx context.Context, v *model.AuthorizationConfig) *types.AuthorizationConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.AuthorizationConfig{
+		AuthorizationType: types.AuthorizationType(v.AuthorizationType),
+		AwsIamConfig:      (*awsIamConfigMapper)(nil).FromModel(ctx, v.AwsIamConfig),
+	}
+}
+
+type awsIamConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.AwsIamConfig) *model.AwsIamConfig
+		FromModel(ctx context.Context, v *model.AwsIamConfig) *types.AwsIamConfig
+	} = (*awsIamConfigMapper)(nil)
+)
+
+func (*awsIamConfigMapper) ToModel(ctx context.Context, v *types.AwsIamConfig) *model.AwsIamConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.AwsIamConfig{
+		SigningRegion:      v.SigningRegion,
+		SigningServiceName: v.SigningServiceName,
+	}
+}
+
+func (*awsIamConfigMapper) FromModel(ctx context.Context, v *model.AwsIamConfig) *types.AwsIamConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.AwsIamConfig{
+		SigningRegion:      v.SigningRegion,
+		SigningServiceName: v.SigningServiceName,
+	}
+}
+
+type relationalDatabaseDataSourceConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.RelationalDatabaseDataSourceConfig) *model.RelationalDatabaseDataSourceConfig
+		FromModel(ctx context.Context, v *model.RelationalDatabaseDataSourceConfig) *types.RelationalDatabaseDataSourceConfig
+	} = (*relationalDatabaseDataSourceConfigMapper)(nil)
+)
+
+func (*relationalDatabaseDataSourceConfigMapper) ToModel(ctx context.Context, v *types.RelationalDatabaseDataSourceConfig) *model.RelationalDatabaseDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.RelationalDatabaseDataSourceConfig{
+		RelationalDatabaseSourceType: model.RelationalDatabaseSourceType(v.RelationalDatabaseSourceType),
+		RdsHttpEndpointConfig:        (*rdsHttpEndpointConfigMapper)(nil).ToModel(ctx, v.RdsHttpEndpointConfig),
+	}
+}
+
+func (*relationalDatabaseDataSourceConfigMapper) FromModel(ctx context.Context, v *model.RelationalDatabaseDataSourceConfig) *types.RelationalDatabaseDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.RelationalDatabaseDataSourceConfig{
+		RelationalDatabaseSourceType: types.RelationalDatabaseSourceType(v.RelationalDatabaseSourceType),
+		RdsHttpEndpointConfig:        (*rdsHttpEndpointConfigMapper)(nil).FromModel(ctx, v.RdsHttpEndpointConfig),
+	}
+}
+
+type rdsHttpEndpointConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.RdsHttpEndpointConfig) *model.RdsHttpEndpointConfig
+		FromModel(ctx context.Context, v *model.RdsHttpEndpointConfig) *types.RdsHttpEndpointConfig
+	} = (*rdsHttpEndpointConfigMapper)(nil)
+)
+
+func (*rdsHttpEndpointConfigMapper) ToModel(ctx context.Context, v *types.RdsHttpEndpointConfig) *model.RdsHttpEndpointConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.RdsHttpEndpointConfig{
+		AwsRegion:           v.AwsRegion,
+		DbClusterIdentifier: v.DbClusterIdentifier,
+		DatabaseName:        v.DatabaseName,
+		Schema:              v.Schema,
+		AwsSecretStoreArn:   v.AwsSecretStoreArn,
+	}
+}
+
+func (*rdsHttpEndpointConfigMapper) FromModel(ctx context.Context, v *model.RdsHttpEndpointConfig) *types.RdsHttpEndpointConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.RdsHttpEndpointConfig{
+		AwsRegion:           v.AwsRegion,
+		DbClusterIdentifier: v.DbClusterIdentifier,
+		DatabaseName:        v.DatabaseName,
+		Schema:              v.Schema,
+		AwsSecretStoreArn:   v.AwsSecretStoreArn,
+	}
+}
+
+type eventBridgeDataSourceConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.EventBridgeDataSourceConfig) *model.EventBridgeDataSourceConfig
+		FromModel(ctx context.Context, v *model.EventBridgeDataSourceConfig) *types.EventBridgeDataSourceConfig
+	} = (*eventBridgeDataSourceConfigMapper)(nil)
+)
+
+func (*eventBridgeDataSourceConfigMapper) ToModel(ctx context.Context, v *types.EventBridgeDataSourceConfig) *model.EventBridgeDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.EventBridgeDataSourceConfig{
+		EventBusArn: v.EventBusArn,
+	}
+}
+
+func (*eventBridgeDataSourceConfigMapper) FromModel(ctx context.Context, v *model.EventBridgeDataSourceConfig) *types.EventBridgeDataSourceConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.EventBridgeDataSourceConfig{
+		EventBusArn: v.EventBusArn,
+	}
+}
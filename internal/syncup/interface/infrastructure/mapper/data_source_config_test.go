@@ -0,0 +1,1316 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapper
+
+import (
+	"context"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_dynamodbDataSourceConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.DynamodbDataSourceConfig
+	}
+
+	type expected struct {
+		res *model.DynamodbDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.DynamodbDataSourceConfig{
+					TableName:            aws.String("TableName"),
+					AwsRegion:            aws.String("AwsRegion"),
+					UseCallerCredentials: true,
+					DeltaSyncConfig: &types.DeltaSyncConfig{
+						BaseTableTTL:       1,
+						DeltaSyncTableName: aws.String("DeltaSyncTableName"),
+						DeltaSyncTableTTL:  1,
+					},
+					Versioned: true,
+				},
+			},
+			expected: expected{
+				res: &model.DynamodbDataSourceConfig{
+					TableName:            ptr.Pointer("TableName"),
+					AwsRegion:            ptr.Pointer("AwsRegion"),
+					UseCallerCredentials: true,
+					DeltaSyncConfig: &model.DeltaSyncConfig{
+						BaseTableTTL:       1,
+						DeltaSyncTableName: ptr.Pointer("DeltaSyncTableName"),
+						DeltaSyncTableTTL:  1,
+					},
+					Versioned: true,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*dynamodbDataSourceConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_dynamodbDataSourceConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.DynamodbDataSourceConfig
+	}
+
+	type expected struct {
+		res *types.DynamodbDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.DynamodbDataSourceConfig{
+					TableName:            ptr.Pointer("TableName"),
+					AwsRegion:            ptr.Pointer("AwsRegion"),
+					UseCallerCredentials: true,
+					DeltaSyncConfig: &model.DeltaSyncConfig{
+						BaseTableTTL:       1,
+						DeltaSyncTableName: ptr.Pointer("DeltaSyncTableName"),
+						DeltaSyncTableTTL:  1,
+					},
+					Versioned: true,
+				},
+			},
+			expected: expected{
+				res: &types.DynamodbDataSourceConfig{
+					TableName:            aws.String("TableName"),
+					AwsRegion:            aws.String("AwsRegion"),
+					UseCallerCredentials: true,
+					DeltaSyncConfig: &types.DeltaSyncConfig{
+						BaseTableTTL:       1,
+						DeltaSyncTableName: aws.String("DeltaSyncTableName"),
+						DeltaSyncTableTTL:  1,
+					},
+					Versioned: true,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*dynamodbDataSourceConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_deltaSyncConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.DeltaSyncConfig
+	}
+
+	type expected struct {
+		res *model.DeltaSyncConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.DeltaSyncConfig{
+					BaseTableTTL:       1,
+					DeltaSyncTableName: aws.String("DeltaSyncTableName"),
+					DeltaSyncTableTTL:  1,
+				},
+			},
+			expected: expected{
+				res: &model.DeltaSyncConfig{
+					BaseTableTTL:       1,
+					DeltaSyncTableName: ptr.Pointer("DeltaSyncTableName"),
+					DeltaSyncTableTTL:  1,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*deltaSyncConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_deltaSyncConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.DeltaSyncConfig
+	}
+
+	type expected struct {
+		res *types.DeltaSyncConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.DeltaSyncConfig{
+					BaseTableTTL:       1,
+					DeltaSyncTableName: ptr.Pointer("DeltaSyncTableName"),
+					DeltaSyncTableTTL:  1,
+				},
+			},
+			expected: expected{
+				res: &types.DeltaSyncConfig{
+					BaseTableTTL:       1,
+					DeltaSyncTableName: aws.String("DeltaSyncTableName"),
+					DeltaSyncTableTTL:  1,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*deltaSyncConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_lambdaDataSourceConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.LambdaDataSourceConfig
+	}
+
+	type expected struct {
+		res *model.LambdaDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.LambdaDataSourceConfig{
+					LambdaFunctionArn: aws.String("LambdaFunctionArn"),
+				},
+			},
+			expected: expected{
+				res: &model.LambdaDataSourceConfig{
+					LambdaFunctionArn: ptr.Pointer("LambdaFunctionArn"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*lambdaDataSourceConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_lambdaDataSourceConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.LambdaDataSourceConfig
+	}
+
+	type expected struct {
+		res *types.LambdaDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.LambdaDataSourceConfig{
+					LambdaFunctionArn: ptr.Pointer("LambdaFunctionArn"),
+				},
+			},
+			expected: expected{
+				res: &types.LambdaDataSourceConfig{
+					LambdaFunctionArn: aws.String("LambdaFunctionArn"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*lambdaDataSourceConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_elasticsearchDataSourceConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.ElasticsearchDataSourceConfig
+	}
+
+	type expected struct {
+		res *model.ElasticsearchDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.ElasticsearchDataSourceConfig{
+					Endpoint:  aws.String("Endpoint"),
+					AwsRegion: aws.String("AwsRegion"),
+				},
+			},
+			expected: expected{
+				res: &model.ElasticsearchDataSourceConfig{
+					Endpoint:  ptr.Pointer("Endpoint"),
+					AwsRegion: ptr.Pointer("AwsRegion"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*elasticsearchDataSourceConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_elasticsearchDataSourceConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.ElasticsearchDataSourceConfig
+	}
+
+	type expected struct {
+		res *types.ElasticsearchDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.ElasticsearchDataSourceConfig{
+					Endpoint:  ptr.Pointer("Endpoint"),
+					AwsRegion: ptr.Pointer("AwsRegion"),
+				},
+			},
+			expected: expected{
+				res: &types.ElasticsearchDataSourceConfig{
+					Endpoint:  aws.String("Endpoint"),
+					AwsRegion: aws.String("AwsRegion"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*elasticsearchDataSourceConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_openSearchServiceDataSourceConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.OpenSearchServiceDataSourceConfig
+	}
+
+	type expected struct {
+		res *model.OpenSearchServiceDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.OpenSearchServiceDataSourceConfig{
+					Endpoint:  aws.String("Endpoint"),
+					AwsRegion: aws.String("AwsRegion"),
+				},
+			},
+			expected: expected{
+				res: &model.OpenSearchServiceDataSourceConfig{
+					Endpoint:  ptr.Pointer("Endpoint"),
+					AwsRegion: ptr.Pointer("AwsRegion"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*openSearchServiceDataSourceConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_openSearchServiceDataSourceConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.OpenSearchServiceDataSourceConfig
+	}
+
+	type expected struct {
+		res *types.OpenSearchServiceDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.OpenSearchServiceDataSourceConfig{
+					Endpoint:  ptr.Pointer("Endpoint"),
+					AwsRegion: ptr.Pointer("AwsRegion"),
+				},
+			},
+			expected: expected{
+				res: &types.OpenSearchServiceDataSourceConfig{
+					Endpoint:  aws.String("Endpoint"),
+					AwsRegion: aws.String("AwsRegion"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*openSearchServiceDataSourceConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_httpDataSourceConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.HttpDataSourceConfig
+	}
+
+	type expected struct {
+		res *model.HttpDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.HttpDataSourceConfig{
+					Endpoint: aws.String("Endpoint"),
+					AuthorizationConfig: &types.AuthorizationConfig{
+						AuthorizationType: types.AuthorizationType("AuthorizationType"),
+						AwsIamConfig: &types.AwsIamConfig{
+							SigningRegion:      aws.String("SigningRegion"),
+							SigningServiceName: aws.String("SigningServiceName"),
+						},
+					},
+				},
+			},
+			expected: expected{
+				res: &model.HttpDataSourceConfig{
+					Endpoint: ptr.Pointer("Endpoint"),
+					AuthorizationConfig: &model.AuthorizationConfig{
+						AuthorizationType: model.AuthorizationType("AuthorizationType"),
+						AwsIamConfig: &model.AwsIamConfig{
+							SigningRegion:      ptr.Pointer("SigningRegion"),
+							SigningServiceName: ptr.Pointer("SigningServiceName"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*httpDataSourceConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_httpDataSourceConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.HttpDataSourceConfig
+	}
+
+	type expected struct {
+		res *types.HttpDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.HttpDataSourceConfig{
+					Endpoint: ptr.Pointer("Endpoint"),
+					AuthorizationConfig: &model.AuthorizationConfig{
+						AuthorizationType: model.AuthorizationType("AuthorizationType"),
+						AwsIamConfig: &model.AwsIamConfig{
+							SigningRegion:      ptr.Pointer("SigningRegion"),
+							SigningServiceName: ptr.Pointer("SigningServiceName"),
+						},
+					},
+				},
+			},
+			expected: expected{
+				res: &types.HttpDataSourceConfig{
+					Endpoint: aws.String("Endpoint"),
+					AuthorizationConfig: &types.AuthorizationConfig{
+						AuthorizationType: types.AuthorizationType("AuthorizationType"),
+						AwsIamConfig: &types.AwsIamConfig{
+							SigningRegion:      aws.String("SigningRegion"),
+							SigningServiceName: aws.String("SigningServiceName"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*httpDataSourceConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_authorizationConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.AuthorizationConfig
+	}
+
+	type expected struct {
+		res *model.AuthorizationConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.AuthorizationConfig{
+					AuthorizationType: types.AuthorizationType("AuthorizationType"),
+					AwsIamConfig: &types.AwsIamConfig{
+						SigningRegion:      aws.String("SigningRegion"),
+						SigningServiceName: aws.String("SigningServiceName"),
+					},
+				},
+			},
+			expected: expected{
+				res: &model.AuthorizationConfig{
+					AuthorizationType: model.AuthorizationType("AuthorizationType"),
+					AwsIamConfig: &model.AwsIamConfig{
+						SigningRegion:      ptr.Pointer("SigningRegion"),
+						SigningServiceName: ptr.Pointer("SigningServiceName"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*authorizationConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_authorizationConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.AuthorizationConfig
+	}
+
+	type expected struct {
+		res *types.AuthorizationConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.AuthorizationConfig{
+					AuthorizationType: model.AuthorizationType("AuthorizationType"),
+					AwsIamConfig: &model.AwsIamConfig{
+						SigningRegion:      ptr.Pointer("SigningRegion"),
+						SigningServiceName: ptr.Pointer("SigningServiceName"),
+					},
+				},
+			},
+			expected: expected{
+				res: &types.AuthorizationConfig{
+					AuthorizationType: types.AuthorizationType("AuthorizationType"),
+					AwsIamConfig: &types.AwsIamConfig{
+						SigningRegion:      aws.String("SigningRegion"),
+						SigningServiceName: aws.String("SigningServiceName"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*authorizationConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_awsIamConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.AwsIamConfig
+	}
+
+	type expected struct {
+		res *model.AwsIamConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.AwsIamConfig{
+					SigningRegion:      aws.String("SigningRegion"),
+					SigningServiceName: aws.String("SigningServiceName"),
+				},
+			},
+			expected: expected{
+				res: &model.AwsIamConfig{
+					SigningRegion:      ptr.Pointer("SigningRegion"),
+					SigningServiceName: ptr.Pointer("SigningServiceName"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*awsIamConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_awsIamConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.AwsIamConfig
+	}
+
+	type expected struct {
+		res *types.AwsIamConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.AwsIamConfig{
+					SigningRegion:      ptr.Pointer("SigningRegion"),
+					SigningServiceName: ptr.Pointer("SigningServiceName"),
+				},
+			},
+			expected: expected{
+				res: &types.AwsIamConfig{
+					SigningRegion:      aws.String("SigningRegion"),
+					SigningServiceName: aws.String("SigningServiceName"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*awsIamConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_relationalDatabaseDataSourceConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.RelationalDatabaseDataSourceConfig
+	}
+
+	type expected struct {
+		res *model.RelationalDatabaseDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.RelationalDatabaseDataSourceConfig{
+					RelationalDatabaseSourceType: types.RelationalDatabaseSourceType("RelationalDatabaseSourceType"),
+					RdsHttpEndpointConfig: &types.RdsHttpEndpointConfig{
+						AwsRegion:           aws.String("AwsRegion"),
+						DbClusterIdentifier: aws.String("DbClusterIdentifier"),
+						DatabaseName:        aws.String("DatabaseName"),
+						Schema:              aws.String("Schema"),
+						AwsSecretStoreArn:   aws.String("AwsSecretStoreArn"),
+					},
+				},
+			},
+			expected: expected{
+				res: &model.RelationalDatabaseDataSourceConfig{
+					RelationalDatabaseSourceType: model.RelationalDatabaseSourceType("RelationalDatabaseSourceType"),
+					RdsHttpEndpointConfig: &model.RdsHttpEndpointConfig{
+						AwsRegion:           ptr.Pointer("AwsRegion"),
+						DbClusterIdentifier: ptr.Pointer("DbClusterIdentifier"),
+						DatabaseName:        ptr.Pointer("DatabaseName"),
+						Schema:              ptr.Pointer("Schema"),
+						AwsSecretStoreArn:   ptr.Pointer("AwsSecretStoreArn"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*relationalDatabaseDataSourceConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_relationalDatabaseDataSourceConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.RelationalDatabaseDataSourceConfig
+	}
+
+	type expected struct {
+		res *types.RelationalDatabaseDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.RelationalDatabaseDataSourceConfig{
+					RelationalDatabaseSourceType: model.RelationalDatabaseSourceType("RelationalDatabaseSourceType"),
+					RdsHttpEndpointConfig: &model.RdsHttpEndpointConfig{
+						AwsRegion:           ptr.Pointer("AwsRegion"),
+						DbClusterIdentifier: ptr.Pointer("DbClusterIdentifier"),
+						DatabaseName:        ptr.Pointer("DatabaseName"),
+						Schema:              ptr.Pointer("Schema"),
+						AwsSecretStoreArn:   ptr.Pointer("AwsSecretStoreArn"),
+					},
+				},
+			},
+			expected: expected{
+				res: &types.RelationalDatabaseDataSourceConfig{
+					RelationalDatabaseSourceType: types.RelationalDatabaseSourceType("RelationalDatabaseSourceType"),
+					RdsHttpEndpointConfig: &types.RdsHttpEndpointConfig{
+						AwsRegion:           aws.String("AwsRegion"),
+						DbClusterIdentifier: aws.String("DbClusterIdentifier"),
+						DatabaseName:        aws.String("DatabaseName"),
+						Schema:              aws.String("Schema"),
+						AwsSecretStoreArn:   aws.String("AwsSecretStoreArn"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*relationalDatabaseDataSourceConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_rdsHttpEndpointConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.RdsHttpEndpointConfig
+	}
+
+	type expected struct {
+		res *model.RdsHttpEndpointConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.RdsHttpEndpointConfig{
+					AwsRegion:           aws.String("AwsRegion"),
+					DbClusterIdentifier: aws.String("DbClusterIdentifier"),
+					DatabaseName:        aws.String("DatabaseName"),
+					Schema:              aws.String("Schema"),
+					AwsSecretStoreArn:   aws.String("AwsSecretStoreArn"),
+				},
+			},
+			expected: expected{
+				res: &model.RdsHttpEndpointConfig{
+					AwsRegion:           ptr.Pointer("AwsRegion"),
+					DbClusterIdentifier: ptr.Pointer("DbClusterIdentifier"),
+					DatabaseName:        ptr.Pointer("DatabaseName"),
+					Schema:              ptr.Pointer("Schema"),
+					AwsSecretStoreArn:   ptr.Pointer("AwsSecretStoreArn"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*rdsHttpEndpointConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_rdsHttpEndpointConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.RdsHttpEndpointConfig
+	}
+
+	type expected struct {
+		res *types.RdsHttpEndpointConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.RdsHttpEndpointConfig{
+					AwsRegion:           ptr.Pointer("AwsRegion"),
+					DbClusterIdentifier: ptr.Pointer("DbClusterIdentifier"),
+					DatabaseName:        ptr.Pointer("DatabaseName"),
+					Schema:              ptr.Pointer("Schema"),
+					AwsSecretStoreArn:   ptr.Pointer("AwsSecretStoreArn"),
+				},
+			},
+			expected: expected{
+				res: &types.RdsHttpEndpointConfig{
+					AwsRegion:           aws.String("AwsRegion"),
+					DbClusterIdentifier: aws.String("DbClusterIdentifier"),
+					DatabaseName:        aws.String("DatabaseName"),
+					Schema:              aws.String("Schema"),
+					AwsSecretStoreArn:   aws.String("AwsSecretStoreArn"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*rdsHttpEndpointConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_eventBridgeDataSourceConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.EventBridgeDataSourceConfig
+	}
+
+	type expected struct {
+		res *model.EventBridgeDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.EventBridgeDataSourceConfig{
+					EventBusArn: aws.String("EventBusArn"),
+				},
+			},
+			expected: expected{
+				res: &model.EventBridgeDataSourceConfig{
+					EventBusArn: ptr.Pointer("EventBusArn"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*eventBridgeDataSourceConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_eventBridgeDataSourceConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.EventBridgeDataSourceConfig
+	}
+
+	type expected struct {
+		res *types.EventBridgeDataSourceConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.EventBridgeDataSourceConfig{
+					EventBusArn: ptr.Pointer("EventBusArn"),
+				},
+			},
+			expected: expected{
+				res: &types.EventBridgeDataSourceConfig{
+					EventBusArn: aws.String("EventBusArn"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*eventBridgeDataSourceConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
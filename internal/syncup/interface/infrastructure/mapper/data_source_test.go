@@ -0,0 +1,336 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapper
+
+import (
+	"context"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_dataSourceMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.DataSource
+	}
+
+	type expected struct {
+		res *model.DataSource
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.DataSource{
+					DataSourceArn:  aws.String("DataSourceArn"),
+					Name:           aws.String("Name"),
+					Description:    aws.String("Description"),
+					Type:           types.DataSourceType("Type"),
+					ServiceRoleArn: aws.String("ServiceRoleArn"),
+					DynamodbConfig: &types.DynamodbDataSourceConfig{
+						TableName:            aws.String("TableName"),
+						AwsRegion:            aws.String("AwsRegion"),
+						UseCallerCredentials: true,
+						DeltaSyncConfig: &types.DeltaSyncConfig{
+							BaseTableTTL:       1,
+							DeltaSyncTableName: aws.String("DeltaSyncTableName"),
+							DeltaSyncTableTTL:  1,
+						},
+						Versioned: true,
+					},
+					LambdaConfig: &types.LambdaDataSourceConfig{
+						LambdaFunctionArn: aws.String("LambdaFunctionArn"),
+					},
+					ElasticsearchConfig: &types.ElasticsearchDataSourceConfig{
+						Endpoint:  aws.String("Endpoint"),
+						AwsRegion: aws.String("AwsRegion"),
+					},
+					OpenSearchServiceConfig: &types.OpenSearchServiceDataSourceConfig{
+						Endpoint:  aws.String("Endpoint"),
+						AwsRegion: aws.String("AwsRegion"),
+					},
+					HttpConfig: &types.HttpDataSourceConfig{
+						Endpoint: aws.String("Endpoint"),
+						AuthorizationConfig: &types.AuthorizationConfig{
+							AuthorizationType: types.AuthorizationType("AuthorizationType"),
+							AwsIamConfig: &types.AwsIamConfig{
+								SigningRegion:      aws.String("SigningRegion"),
+								SigningServiceName: aws.String("SigningServiceName"),
+							},
+						},
+					},
+					RelationalDatabaseConfig: &types.RelationalDatabaseDataSourceConfig{
+						RelationalDatabaseSourceType: types.RelationalDatabaseSourceType("RelationalDatabaseSourceType"),
+						RdsHttpEndpointConfig: &types.RdsHttpEndpointConfig{
+							AwsRegion:           aws.String("AwsRegion"),
+							DbClusterIdentifier: aws.String("DbClusterIdentifier"),
+							DatabaseName:        aws.String("DatabaseName"),
+							Schema:              aws.String("Schema"),
+							AwsSecretStoreArn:   aws.String("AwsSecretStoreArn"),
+						},
+					},
+					EventBridgeConfig: &types.EventBridgeDataSourceConfig{
+						EventBusArn: aws.String("EventBusArn"),
+					},
+					MetricsConfig: types.DataSourceLevelMetricsConfig("MetricsConfig"),
+				},
+			},
+			expected: expected{
+				res: &model.DataSource{
+					DataSourceArn:  ptr.Pointer("DataSourceArn"),
+					Name:           ptr.Pointer("Name"),
+					Description:    ptr.Pointer("Description"),
+					Type:           model.DataSourceType("Type"),
+					ServiceRoleArn: ptr.Pointer("ServiceRoleArn"),
+					DynamodbConfig: &model.DynamodbDataSourceConfig{
+						TableName:            ptr.Pointer("TableName"),
+						AwsRegion:            ptr.Pointer("AwsRegion"),
+						UseCallerCredentials: true,
+						DeltaSyncConfig: &model.DeltaSyncConfig{
+							BaseTableTTL:       1,
+							DeltaSyncTableName: ptr.Pointer("DeltaSyncTableName"),
+							DeltaSyncTableTTL:  1,
+						},
+						Versioned: true,
+					},
+					LambdaConfig: &model.LambdaDataSourceConfig{
+						LambdaFunctionArn: ptr.Pointer("LambdaFunctionArn"),
+					},
+					ElasticsearchConfig: &model.ElasticsearchDataSourceConfig{
+						Endpoint:  ptr.Pointer("Endpoint"),
+						AwsRegion: ptr.Pointer("AwsRegion"),
+					},
+					OpenSearchServiceConfig: &model.OpenSearchServiceDataSourceConfig{
+						Endpoint:  ptr.Pointer("Endpoint"),
+						AwsRegion: ptr.Pointer("AwsRegion"),
+					},
+					HttpConfig: &model.HttpDataSourceConfig{
+						Endpoint: ptr.Pointer("Endpoint"),
+						AuthorizationConfig: &model.AuthorizationConfig{
+							AuthorizationType: model.AuthorizationType("AuthorizationType"),
+							AwsIamConfig: &model.AwsIamConfig{
+								SigningRegion:      ptr.Pointer("SigningRegion"),
+								SigningServiceName: ptr.Pointer("SigningServiceName"),
+							},
+						},
+					},
+					RelationalDatabaseConfig: &model.RelationalDatabaseDataSourceConfig{
+						RelationalDatabaseSourceType: model.RelationalDatabaseSourceType("RelationalDatabaseSourceType"),
+						RdsHttpEndpointConfig: &model.RdsHttpEndpointConfig{
+							AwsRegion:           ptr.Pointer("AwsRegion"),
+							DbClusterIdentifier: ptr.Pointer("DbClusterIdentifier"),
+							DatabaseName:        ptr.Pointer("DatabaseName"),
+							Schema:              ptr.Pointer("Schema"),
+							AwsSecretStoreArn:   ptr.Pointer("AwsSecretStoreArn"),
+						},
+					},
+					EventBridgeConfig: &model.EventBridgeDataSourceConfig{
+						EventBusArn: ptr.Pointer("EventBusArn"),
+					},
+					MetricsConfig: model.DataSourceLevelMetricsConfig("MetricsConfig"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*dataSourceMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_dataSourceMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.DataSource
+	}
+
+	type expected struct {
+		res *types.DataSource
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.DataSource{
+					DataSourceArn:  ptr.Pointer("DataSourceArn"),
+					Name:           ptr.Pointer("Name"),
+					Description:    ptr.Pointer("Description"),
+					Type:           model.DataSourceType("Type"),
+					ServiceRoleArn: ptr.Pointer("ServiceRoleArn"),
+					DynamodbConfig: &model.DynamodbDataSourceConfig{
+						TableName:            ptr.Pointer("TableName"),
+						AwsRegion:            ptr.Pointer("AwsRegion"),
+						UseCallerCredentials: true,
+						DeltaSyncConfig: &model.DeltaSyncConfig{
+							BaseTableTTL:       1,
+							DeltaSyncTableName: ptr.Pointer("DeltaSyncTableName"),
+							DeltaSyncTableTTL:  1,
+						},
+						Versioned: true,
+					},
+					LambdaConfig: &model.LambdaDataSourceConfig{
+						LambdaFunctionArn: ptr.Pointer("LambdaFunctionArn"),
+					},
+					ElasticsearchConfig: &model.ElasticsearchDataSourceConfig{
+						Endpoint:  ptr.Pointer("Endpoint"),
+						AwsRegion: ptr.Pointer("AwsRegion"),
+					},
+					OpenSearchServiceConfig: &model.OpenSearchServiceDataSourceConfig{
+						Endpoint:  ptr.Pointer("Endpoint"),
+						AwsRegion: ptr.Pointer("AwsRegion"),
+					},
+					HttpConfig: &model.HttpDataSourceConfig{
+						Endpoint: ptr.Pointer("Endpoint"),
+						AuthorizationConfig: &model.AuthorizationConfig{
+							AuthorizationType: model.AuthorizationType("AuthorizationType"),
+							AwsIamConfig: &model.AwsIamConfig{
+								SigningRegion:      ptr.Pointer("SigningRegion"),
+								SigningServiceName: ptr.Pointer("SigningServiceName"),
+							},
+						},
+					},
+					RelationalDatabaseConfig: &model.RelationalDatabaseDataSourceConfig{
+						RelationalDatabaseSourceType: model.RelationalDatabaseSourceType("RelationalDatabaseSourceType"),
+						RdsHttpEndpointConfig: &model.RdsHttpEndpointConfig{
+							AwsRegion:           ptr.Pointer("AwsRegion"),
+							DbClusterIdentifier: ptr.Pointer("DbClusterIdentifier"),
+							DatabaseName:        ptr.Pointer("DatabaseName"),
+							Schema:              ptr.Pointer("Schema"),
+							AwsSecretStoreArn:   ptr.Pointer("AwsSecretStoreArn"),
+						},
+					},
+					EventBridgeConfig: &model.EventBridgeDataSourceConfig{
+						EventBusArn: ptr.Pointer("EventBusArn"),
+					},
+					MetricsConfig: model.DataSourceLevelMetricsConfig("MetricsConfig"),
+				},
+			},
+			expected: expected{
+				res: &types.DataSource{
+					DataSourceArn:  aws.String("DataSourceArn"),
+					Name:           aws.String("Name"),
+					Description:    aws.String("Description"),
+					Type:           types.DataSourceType("Type"),
+					ServiceRoleArn: aws.String("ServiceRoleArn"),
+					DynamodbConfig: &types.DynamodbDataSourceConfig{
+						TableName:            aws.String("TableName"),
+						AwsRegion:            aws.String("AwsRegion"),
+						UseCallerCredentials: true,
+						DeltaSyncConfig: &types.DeltaSyncConfig{
+							BaseTableTTL:       1,
+							DeltaSyncTableName: aws.String("DeltaSyncTableName"),
+							DeltaSyncTableTTL:  1,
+						},
+						Versioned: true,
+					},
+					LambdaConfig: &types.LambdaDataSourceConfig{
+						LambdaFunctionArn: aws.String("LambdaFunctionArn"),
+					},
+					ElasticsearchConfig: &types.ElasticsearchDataSourceConfig{
+						Endpoint:  aws.String("Endpoint"),
+						AwsRegion: aws.String("AwsRegion"),
+					},
+					OpenSearchServiceConfig: &types.OpenSearchServiceDataSourceConfig{
+						Endpoint:  aws.String("Endpoint"),
+						AwsRegion: aws.String("AwsRegion"),
+					},
+					HttpConfig: &types.HttpDataSourceConfig{
+						Endpoint: aws.String("Endpoint"),
+						AuthorizationConfig: &types.AuthorizationConfig{
+							AuthorizationType: types.AuthorizationType("AuthorizationType"),
+							AwsIamConfig: &types.AwsIamConfig{
+								SigningRegion:      aws.String("SigningRegion"),
+								SigningServiceName: aws.String("SigningServiceName"),
+							},
+						},
+					},
+					RelationalDatabaseConfig: &types.RelationalDatabaseDataSourceConfig{
+						RelationalDatabaseSourceType: types.RelationalDatabaseSourceType("RelationalDatabaseSourceType"),
+						RdsHttpEndpointConfig: &types.RdsHttpEndpointConfig{
+							AwsRegion:           aws.String("AwsRegion"),
+							DbClusterIdentifier: aws.String("DbClusterIdentifier"),
+							DatabaseName:        aws.String("DatabaseName"),
+							Schema:              aws.String("Schema"),
+							AwsSecretStoreArn:   aws.String("AwsSecretStoreArn"),
+						},
+					},
+					EventBridgeConfig: &types.EventBridgeDataSourceConfig{
+						EventBusArn: aws.String("EventBusArn"),
+					},
+					MetricsConfig: types.DataSourceLevelMetricsConfig("MetricsConfig"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*dataSourceMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapper
+
+import (
+	"context"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+)
+
+type GraphqlApiMapper interface {
+	ToModel(ctx context.Context, v *types.GraphqlApi) *model.GraphqlApi
+	FromModel(ctx context.Context, v *model.GraphqlApi) *types.GraphqlApi
+}
+
+type graphqlApiMapper struct{}
+
+func NewGraphqlApiMapper() GraphqlApiMapper {
+	return (*graphqlApiMapper)(nil)
+}
+
+func (*graphqlApiMapper) ToModel(ctx context.Context, v *types.GraphqlApi) *model.GraphqlApi {
+	if v == nil {
+		return nil
+	}
+
+	return &model.GraphqlApi{
+		ApiId:                             v.ApiId,
+		Arn:                               v.Arn,
+		Name:                              v.Name,
+		ApiType:                           model.GraphQLApiType(v.ApiType),
+		Visibility:                        model.GraphQLApiVisibility(v.Visibility),
+		AuthenticationType:                model.AuthenticationType(v.AuthenticationType),
+		AdditionalAuthenticationProviders: (*additionalAuthenticationProvidersMapper)(nil).ToModel(ctx, v.AdditionalAuthenticationProviders),
+		LambdaAuthorizerConfig:            (*lambdaAuthorizerConfigMapper)(nil).ToModel(ctx, v.LambdaAuthorizerConfig),
+		UserPoolConfig:                    (*userPoolConfigMapper)(nil).ToModel(ctx, v.UserPoolConfig),
+		OpenIDConnectConfig:               (*openIDConnectConfigMapper)(nil).ToModel(ctx, v.OpenIDConnectConfig),
+		LogConfig:                         (*logConfigMapper)(nil).ToModel(ctx, v.LogConfig),
+		XrayEnabled:                       v.XrayEnabled,
+		IntrospectionConfig:               model.GraphQLApiIntrospectionConfig(v.IntrospectionConfig),
+		QueryDepthLimit:                   v.QueryDepthLimit,
+		ResolverCountLimit:                v.ResolverCountLimit,
+		EnhancedMetricsConfig:             (*enhancedMetricsConfigMapper)(nil).ToModel(ctx, v.EnhancedMetricsConfig),
+		MergedApiExecutionRoleArn:         v.MergedApiExecutionRoleArn,
+		OwnerContact:                      v.OwnerContact,
+	}
+}
+
+func (*graphqlApiMapper) FromModel(ctx context.Context, v *model.GraphqlApi) *types.GraphqlApi {
+	if v == nil {
+		return nil
+	}
+
+	return &types.GraphqlApi{
+		ApiId:                             v.ApiId,
+		Arn:                               v.Arn,
+		Name:                              v.Name,
+		ApiType:                           types.GraphQLApiType(v.ApiType),
+		Visibility:                        types.GraphQLApiVisibility(v.Visibility),
+		AuthenticationType:                types.AuthenticationType(v.AuthenticationType),
+		AdditionalAuthenticationProviders: (*additionalAuthenticationProvidersMapper)(nil).FromModel(ctx, v.AdditionalAuthenticationProviders),
+		LambdaAuthorizerConfig:            (*lambdaAuthorizerConfigMapper)(nil).FromModel(ctx, v.LambdaAuthorizerConfig),
+		UserPoolConfig:                    (*userPoolConfigMapper)(nil).FromModel(ctx, v.UserPoolConfig),
+		OpenIDConnectConfig:               (*openIDConnectConfigMapper)(nil).FromModel(ctx, v.OpenIDConnectConfig),
+		LogConfig:                         (*logConfigMapper)(nil).FromModel(ctx, v.LogConfig),
+		XrayEnabled:                       v.XrayEnabled,
+		IntrospectionConfig:               types.GraphQLApiIntrospectionConfig(v.IntrospectionConfig),
+		QueryDepthLimit:                   v.QueryDepthLimit,
+		ResolverCountLimit:                v.ResolverCountLimit,
+		EnhancedMetricsConfig:             (*enhancedMetricsConfigMapper)(nil).FromModel(ctx, v.EnhancedMetricsConfig),
+		MergedApiExecutionRoleArn:         v.MergedApiExecutionRoleArn,
+		OwnerContact:                      v.OwnerContact,
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapper
+
+import (
+	"context"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+)
+
+type additionalAuthenticationProvidersMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v []types.AdditionalAuthenticationProvider) []model.AdditionalAuthenticationProvider
+		FromModel(ctx context.Context, v []model.AdditionalAuthenticationProvider) []types.AdditionalAuthenticationProvider
+	} = (*additionalAuthenticationProvidersMapper)(nil)
+)
+
+func (*additionalAuthenticationProvidersMapper) ToModel(ctx context.Context, v []types.AdditionalAuthenticationProvider) []model.AdditionalAuthenticationProvider {
+	if v == nil {
+		return nil
+	}
+
+	res := make([]model.AdditionalAuthenticationProvider, 0, len(v))
+	for i := range v {
+		res = append(res, *(*additionalAuthenticationProviderMapper)(nil).ToModel(ctx, &v[i]))
+	}
+
+	return res
+}
+
+func (*additionalAuthenticationProvidersMapper) FromModel(ctx context.Context, v []model.AdditionalAuthenticationProvider) []types.AdditionalAuthenticationProvider {
+	if v == nil {
+		return nil
+	}
+
+	res := make([]types.AdditionalAuthenticationProvider, 0, len(v))
+	for i := range v {
+		res = append(res, *(*additionalAuthenticationProviderMapper)(nil).FromModel(ctx, &v[i]))
+	}
+
+	return res
+}
+
+type additionalAuthenticationProviderMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.AdditionalAuthenticationProvider) *model.AdditionalAuthenticationProvider
+		FromModel(ctx context.Context, v *model.AdditionalAuthenticationProvider) *types.AdditionalAuthenticationProvider
+	} = (*additionalAuthenticationProviderMapper)(nil)
+)
+
+func (*additionalAuthenticationProviderMapper) ToModel(ctx context.Context, v *types.AdditionalAuthenticationProvider) *model.AdditionalAuthenticationProvider {
+	if v == nil {
+		return nil
+	}
+
+	return &model.AdditionalAuthenticationProvider{
+		AuthenticationType:     model.AuthenticationType(v.AuthenticationType),
+		LambdaAuthorizerConfig: (*lambdaAuthorizerConfigMapper)(nil).ToModel(ctx, v.LambdaAuthorizerConfig),
+		OpenIDConnectConfig:    (*openIDConnectConfigMapper)(nil).ToModel(ctx, v.OpenIDConnectConfig),
+		UserPoolConfig:         (*cognitoUserPoolConfigMapper)(nil).ToModel(ctx, v.UserPoolConfig),
+	}
+}
+
+func (*additionalAuthenticationProviderMapper) FromModel(ctx context.Context, v *model.AdditionalAuthenticationProvider) *types.AdditionalAuthenticationProvider {
+	if v == nil {
+		return nil
+	}
+
+	return &types.AdditionalAuthenticationProvider{
+		AuthenticationType:     types.AuthenticationType(v.AuthenticationType),
+		LambdaAuthorizerConfig: (*lambdaAuthorizerConfigMapper)(nil).FromModel(ctx, v.LambdaAuthorizerConfig),
+		OpenIDConnectConfig:    (*openIDConnectConfigMapper)(nil).FromModel(ctx, v.OpenIDConnectConfig),
+		UserPoolConfig:         (*cognitoUserPoolConfigMapper)(nil).FromModel(ctx, v.UserPoolConfig),
+	}
+}
+
+type lambdaAuthorizerConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.LambdaAuthorizerConfig) *model.LambdaAuthorizerConfig
+		FromModel(ctx context.Context, v *model.LambdaAuthorizerConfig) *types.LambdaAuthorizerConfig
+	} = (*lambdaAuthorizerConfigMapper)(nil)
+)
+
+func (*lambdaAuthorizerConfigMapper) ToModel(ctx context.Context, v *types.LambdaAuthorizerConfig) *model.LambdaAuthorizerConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.LambdaAuthorizerConfig{
+		AuthorizerUri:                v.AuthorizerUri,
+		AuthorizerResultTtlInSeconds: v.AuthorizerResultTtlInSeconds,
+		IdentityValidationExpression: v.IdentityValidationExpression,
+	}
+}
+
+func (*lambdaAuthorizerConfigMapper) FromModel(ctx context.Context, v *model.LambdaAuthorizerConfig) *types.LambdaAuthorizerConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.LambdaAuthorizerConfig{
+		AuthorizerUri:                v.AuthorizerUri,
+		AuthorizerResultTtlInSeconds: v.AuthorizerResultTtlInSeconds,
+		IdentityValidationExpression: v.IdentityValidationExpression,
+	}
+}
+
+type userPoolConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.UserPoolConfig) *model.UserPoolConfig
+		FromModel(ctx context.Context, v *model.UserPoolConfig) *types.UserPoolConfig
+	} = (*userPoolConfigMapper)(nil)
+)
+
+func (*userPoolConfigMapper) ToModel(ctx context.Context, v *types.UserPoolConfig) *model.UserPoolConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.UserPoolConfig{
+		AwsRegion:        v.AwsRegion,
+		DefaultAction:    model.DefaultAction(v.DefaultAction),
+		UserPoolId:       v.UserPoolId,
+		AppIdClientRegex: v.AppIdClientRegex,
+	}
+}
+
+func (*userPoolConfigMapper) FromModel(ctx context.Context, v *model.UserPoolConfig) *types.UserPoolConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.UserPoolConfig{
+		AwsRegion:        v.AwsRegion,
+		DefaultAction:    types.DefaultAction(v.DefaultAction),
+		UserPoolId:       v.UserPoolId,
+		AppIdClientRegex: v.AppIdClientRegex,
+	}
+}
+
+type cognitoUserPoolConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.CognitoUserPoolConfig) *model.CognitoUserPoolConfig
+		FromModel(ctx context.Context, v *model.CognitoUserPoolConfig) *types.CognitoUserPoolConfig
+	} = (*cognitoUserPoolConfigMapper)(nil)
+)
+
+func (*cognitoUserPoolConfigMapper) ToModel(ctx context.Context, v *types.CognitoUserPoolConfig) *model.CognitoUserPoolConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.CognitoUserPoolConfig{
+		AwsRegion:        v.AwsRegion,
+		UserPoolId:       v.UserPoolId,
+		AppIdClientRegex: v.AppIdClientRegex,
+	}
+}
+
+func (*cognitoUserPoolConfigMapper) FromModel(ctx context.Context, v *model.CognitoUserPoolConfig) *types.CognitoUserPoolConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.CognitoUserPoolConfig{
+		AwsRegion:        v.AwsRegion,
+		UserPoolId:       v.UserPoolId,
+		AppIdClientRegex: v.AppIdClientRegex,
+	}
+}
+
+type openIDConnectConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.OpenIDConnectConfig) *model.OpenIDConnectConfig
+		FromModel(ctx context.Context, v *model.OpenIDConnectConfig) *types.OpenIDConnectConfig
+	} = (*openIDConnectConfigMapper)(nil)
+)
+
+func (*openIDConnectConfigMapper) ToModel(ctx context.Context, v *types.OpenIDConnectConfig) *model.OpenIDConnectConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.OpenIDConnectConfig{
+		Issuer:   v.Issuer,
+		ClientId: v.ClientId,
+		IatTTL:   v.IatTTL,
+		AuthTTL:  v.AuthTTL,
+	}
+}
+
+func (*openIDConnectConfigMapper) FromModel(ctx context.Context, v *model.OpenIDConnectConfig) *types.OpenIDConnectConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.OpenIDConnectConfig{
+		Issuer:   v.Issuer,
+		ClientId: v.ClientId,
+		IatTTL:   v.IatTTL,
+		AuthTTL:  v.AuthTTL,
+	}
+}
+
+type logConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.LogConfig) *model.LogConfig
+		FromModel(ctx context.Context, v *model.LogConfig) *types.LogConfig
+	} = (*logConfigMapper)(nil)
+)
+
+func (*logConfigMapper) ToModel(ctx context.Context, v *types.LogConfig) *model.LogConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.LogConfig{
+		CloudWatchLogsRoleArn: v.CloudWatchLogsRoleArn,
+		FieldLogLevel:         model.FieldLogLevel(v.FieldLogLevel),
+		ExcludeVerboseContent: v.ExcludeVerboseContent,
+	}
+}
+
+func (*logConfigMapper) FromModel(ctx context.Context, v *model.LogConfig) *types.LogConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.LogConfig{
+		CloudWatchLogsRoleArn: v.CloudWatchLogsRoleArn,
+		FieldLogLevel:         types.FieldLogLevel(v.FieldLogLevel),
+		ExcludeVerboseContent: v.ExcludeVerboseContent,
+	}
+}
+
+type enhancedMetricsConfigMapper struct{}
+
+var (
+	_ interface {
+		ToModel(ctx context.Context, v *types.EnhancedMetricsConfig) *model.EnhancedMetricsConfig
+		FromModel(ctx context.Context, v *model.EnhancedMetricsConfig) *types.EnhancedMetricsConfig
+	} = (*enhancedMetricsConfigMapper)(nil)
+)
+
+func (*enhancedMetricsConfigMapper) ToModel(ctx context.Context, v *types.EnhancedMetricsConfig) *model.EnhancedMetricsConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &model.EnhancedMetricsConfig{
+		DataSourceLevelMetricsBehavior: model.DataSourceLevelMetricsBehavior(v.DataSourceLevelMetricsBehavior),
+		OperationLevelMetricsConfig:    model.OperationLevelMetricsConfig(v.OperationLevelMetricsConfig),
+		ResolverLevelMetricsBehavior:   model.ResolverLevelMetricsBehavior(v.ResolverLevelMetricsBehavior),
+	}
+}
+
+func (*enhancedMetricsConfigMapper) FromModel(ctx context.Context, v *model.EnhancedMetricsConfig) *types.EnhancedMetricsConfig {
+	if v == nil {
+		return nil
+	}
+
+	return &types.EnhancedMetricsConfig{
+		DataSourceLevelMetricsBehavior: types.DataSourceLevelMetricsBehavior(v.DataSourceLevelMetricsBehavior),
+		OperationLevelMetricsConfig:    types.OperationLevelMetricsConfig(v.OperationLevelMetricsConfig),
+		ResolverLevelMetricsBehavior:   types.ResolverLevelMetricsBehavior(v.ResolverLevelMetricsBehavior),
+	}
+}
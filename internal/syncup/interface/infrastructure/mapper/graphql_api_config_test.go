@@ -0,0 +1,1056 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapper
+
+import (
+	"context"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_additionalAuthenticationProvidersMapper_ToModel(t *testing.T) {
+	type args struct {
+		v []types.AdditionalAuthenticationProvider
+	}
+
+	type expected struct {
+		res []model.AdditionalAuthenticationProvider
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: []types.AdditionalAuthenticationProvider{
+					types.AdditionalAuthenticationProvider{
+						AuthenticationType: types.AuthenticationType("AuthenticationType"),
+						LambdaAuthorizerConfig: &types.LambdaAuthorizerConfig{
+							AuthorizerUri:                aws.String("AuthorizerUri"),
+							AuthorizerResultTtlInSeconds: 1,
+							IdentityValidationExpression: aws.String("IdentityValidationExpression"),
+						},
+						OpenIDConnectConfig: &types.OpenIDConnectConfig{
+							Issuer:   aws.String("Issuer"),
+							ClientId: aws.String("ClientId"),
+							IatTTL:   1,
+							AuthTTL:  1,
+						},
+						UserPoolConfig: &types.CognitoUserPoolConfig{
+							AwsRegion:        aws.String("AwsRegion"),
+							UserPoolId:       aws.String("UserPoolId"),
+							AppIdClientRegex: aws.String("AppIdClientRegex"),
+						},
+					},
+				},
+			},
+			expected: expected{
+				res: []model.AdditionalAuthenticationProvider{
+					model.AdditionalAuthenticationProvider{
+						AuthenticationType: model.AuthenticationType("AuthenticationType"),
+						LambdaAuthorizerConfig: &model.LambdaAuthorizerConfig{
+							AuthorizerUri:                ptr.Pointer("AuthorizerUri"),
+							AuthorizerResultTtlInSeconds: 1,
+							IdentityValidationExpression: ptr.Pointer("IdentityValidationExpression"),
+						},
+						OpenIDConnectConfig: &model.OpenIDConnectConfig{
+							Issuer:   ptr.Pointer("Issuer"),
+							ClientId: ptr.Pointer("ClientId"),
+							IatTTL:   1,
+							AuthTTL:  1,
+						},
+						UserPoolConfig: &model.CognitoUserPoolConfig{
+							AwsRegion:        ptr.Pointer("AwsRegion"),
+							UserPoolId:       ptr.Pointer("UserPoolId"),
+							AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*additionalAuthenticationProvidersMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_additionalAuthenticationProvidersMapper_FromModel(t *testing.T) {
+	type args struct {
+		v []model.AdditionalAuthenticationProvider
+	}
+
+	type expected struct {
+		res []types.AdditionalAuthenticationProvider
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: []model.AdditionalAuthenticationProvider{
+					model.AdditionalAuthenticationProvider{
+						AuthenticationType: model.AuthenticationType("AuthenticationType"),
+						LambdaAuthorizerConfig: &model.LambdaAuthorizerConfig{
+							AuthorizerUri:                ptr.Pointer("AuthorizerUri"),
+							AuthorizerResultTtlInSeconds: 1,
+							IdentityValidationExpression: ptr.Pointer("IdentityValidationExpression"),
+						},
+						OpenIDConnectConfig: &model.OpenIDConnectConfig{
+							Issuer:   ptr.Pointer("Issuer"),
+							ClientId: ptr.Pointer("ClientId"),
+							IatTTL:   1,
+							AuthTTL:  1,
+						},
+						UserPoolConfig: &model.CognitoUserPoolConfig{
+							AwsRegion:        ptr.Pointer("AwsRegion"),
+							UserPoolId:       ptr.Pointer("UserPoolId"),
+							AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+						},
+					},
+				},
+			},
+			expected: expected{
+				res: []types.AdditionalAuthenticationProvider{
+					types.AdditionalAuthenticationProvider{
+						AuthenticationType: types.AuthenticationType("AuthenticationType"),
+						LambdaAuthorizerConfig: &types.LambdaAuthorizerConfig{
+							AuthorizerUri:                aws.String("AuthorizerUri"),
+							AuthorizerResultTtlInSeconds: 1,
+							IdentityValidationExpression: aws.String("IdentityValidationExpression"),
+						},
+						OpenIDConnectConfig: &types.OpenIDConnectConfig{
+							Issuer:   aws.String("Issuer"),
+							ClientId: aws.String("ClientId"),
+							IatTTL:   1,
+							AuthTTL:  1,
+						},
+						UserPoolConfig: &types.CognitoUserPoolConfig{
+							AwsRegion:        aws.String("AwsRegion"),
+							UserPoolId:       aws.String("UserPoolId"),
+							AppIdClientRegex: aws.String("AppIdClientRegex"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*additionalAuthenticationProvidersMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_additionalAuthenticationProviderMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.AdditionalAuthenticationProvider
+	}
+
+	type expected struct {
+		res *model.AdditionalAuthenticationProvider
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.AdditionalAuthenticationProvider{
+					AuthenticationType: types.AuthenticationType("AuthenticationType"),
+					LambdaAuthorizerConfig: &types.LambdaAuthorizerConfig{
+						AuthorizerUri:                aws.String("AuthorizerUri"),
+						AuthorizerResultTtlInSeconds: 1,
+						IdentityValidationExpression: aws.String("IdentityValidationExpression"),
+					},
+					OpenIDConnectConfig: &types.OpenIDConnectConfig{
+						Issuer:   aws.String("Issuer"),
+						ClientId: aws.String("ClientId"),
+						IatTTL:   1,
+						AuthTTL:  1,
+					},
+					UserPoolConfig: &types.CognitoUserPoolConfig{
+						AwsRegion:        aws.String("AwsRegion"),
+						UserPoolId:       aws.String("UserPoolId"),
+						AppIdClientRegex: aws.String("AppIdClientRegex"),
+					},
+				},
+			},
+			expected: expected{
+				res: &model.AdditionalAuthenticationProvider{
+					AuthenticationType: model.AuthenticationType("AuthenticationType"),
+					LambdaAuthorizerConfig: &model.LambdaAuthorizerConfig{
+						AuthorizerUri:                ptr.Pointer("AuthorizerUri"),
+						AuthorizerResultTtlInSeconds: 1,
+						IdentityValidationExpression: ptr.Pointer("IdentityValidationExpression"),
+					},
+					OpenIDConnectConfig: &model.OpenIDConnectConfig{
+						Issuer:   ptr.Pointer("Issuer"),
+						ClientId: ptr.Pointer("ClientId"),
+						IatTTL:   1,
+						AuthTTL:  1,
+					},
+					UserPoolConfig: &model.CognitoUserPoolConfig{
+						AwsRegion:        ptr.Pointer("AwsRegion"),
+						UserPoolId:       ptr.Pointer("UserPoolId"),
+						AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*additionalAuthenticationProviderMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_additionalAuthenticationProviderMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.AdditionalAuthenticationProvider
+	}
+
+	type expected struct {
+		res *types.AdditionalAuthenticationProvider
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.AdditionalAuthenticationProvider{
+					AuthenticationType: model.AuthenticationType("AuthenticationType"),
+					LambdaAuthorizerConfig: &model.LambdaAuthorizerConfig{
+						AuthorizerUri:                ptr.Pointer("AuthorizerUri"),
+						AuthorizerResultTtlInSeconds: 1,
+						IdentityValidationExpression: ptr.Pointer("IdentityValidationExpression"),
+					},
+					OpenIDConnectConfig: &model.OpenIDConnectConfig{
+						Issuer:   ptr.Pointer("Issuer"),
+						ClientId: ptr.Pointer("ClientId"),
+						IatTTL:   1,
+						AuthTTL:  1,
+					},
+					UserPoolConfig: &model.CognitoUserPoolConfig{
+						AwsRegion:        ptr.Pointer("AwsRegion"),
+						UserPoolId:       ptr.Pointer("UserPoolId"),
+						AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+					},
+				},
+			},
+			expected: expected{
+				res: &types.AdditionalAuthenticationProvider{
+					AuthenticationType: types.AuthenticationType("AuthenticationType"),
+					LambdaAuthorizerConfig: &types.LambdaAuthorizerConfig{
+						AuthorizerUri:                aws.String("AuthorizerUri"),
+						AuthorizerResultTtlInSeconds: 1,
+						IdentityValidationExpression: aws.String("IdentityValidationExpression"),
+					},
+					OpenIDConnectConfig: &types.OpenIDConnectConfig{
+						Issuer:   aws.String("Issuer"),
+						ClientId: aws.String("ClientId"),
+						IatTTL:   1,
+						AuthTTL:  1,
+					},
+					UserPoolConfig: &types.CognitoUserPoolConfig{
+						AwsRegion:        aws.String("AwsRegion"),
+						UserPoolId:       aws.String("UserPoolId"),
+						AppIdClientRegex: aws.String("AppIdClientRegex"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*additionalAuthenticationProviderMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_lambdaAuthorizerConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.LambdaAuthorizerConfig
+	}
+
+	type expected struct {
+		res *model.LambdaAuthorizerConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.LambdaAuthorizerConfig{
+					AuthorizerUri:                aws.String("AuthorizerUri"),
+					AuthorizerResultTtlInSeconds: 1,
+					IdentityValidationExpression: aws.String("IdentityValidationExpression"),
+				},
+			},
+			expected: expected{
+				res: &model.LambdaAuthorizerConfig{
+					AuthorizerUri:                ptr.Pointer("AuthorizerUri"),
+					AuthorizerResultTtlInSeconds: 1,
+					IdentityValidationExpression: ptr.Pointer("IdentityValidationExpression"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*lambdaAuthorizerConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_lambdaAuthorizerConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.LambdaAuthorizerConfig
+	}
+
+	type expected struct {
+		res *types.LambdaAuthorizerConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.LambdaAuthorizerConfig{
+					AuthorizerUri:                ptr.Pointer("AuthorizerUri"),
+					AuthorizerResultTtlInSeconds: 1,
+					IdentityValidationExpression: ptr.Pointer("IdentityValidationExpression"),
+				},
+			},
+			expected: expected{
+				res: &types.LambdaAuthorizerConfig{
+					AuthorizerUri:                aws.String("AuthorizerUri"),
+					AuthorizerResultTtlInSeconds: 1,
+					IdentityValidationExpression: aws.String("IdentityValidationExpression"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*lambdaAuthorizerConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_userPoolConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.UserPoolConfig
+	}
+
+	type expected struct {
+		res *model.UserPoolConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.UserPoolConfig{
+					AwsRegion:        aws.String("AwsRegion"),
+					DefaultAction:    types.DefaultAction("DefaultAction"),
+					UserPoolId:       aws.String("UserPoolId"),
+					AppIdClientRegex: aws.String("AppIdClientRegex"),
+				},
+			},
+			expected: expected{
+				res: &model.UserPoolConfig{
+					AwsRegion:        ptr.Pointer("AwsRegion"),
+					DefaultAction:    model.DefaultAction("DefaultAction"),
+					UserPoolId:       ptr.Pointer("UserPoolId"),
+					AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*userPoolConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_userPoolConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.UserPoolConfig
+	}
+
+	type expected struct {
+		res *types.UserPoolConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.UserPoolConfig{
+					AwsRegion:        ptr.Pointer("AwsRegion"),
+					DefaultAction:    model.DefaultAction("DefaultAction"),
+					UserPoolId:       ptr.Pointer("UserPoolId"),
+					AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+				},
+			},
+			expected: expected{
+				res: &types.UserPoolConfig{
+					AwsRegion:        aws.String("AwsRegion"),
+					DefaultAction:    types.DefaultAction("DefaultAction"),
+					UserPoolId:       aws.String("UserPoolId"),
+					AppIdClientRegex: aws.String("AppIdClientRegex"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*userPoolConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_cognitoUserPoolConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.CognitoUserPoolConfig
+	}
+
+	type expected struct {
+		res *model.CognitoUserPoolConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.CognitoUserPoolConfig{
+					AwsRegion:        aws.String("AwsRegion"),
+					UserPoolId:       aws.String("UserPoolId"),
+					AppIdClientRegex: aws.String("AppIdClientRegex"),
+				},
+			},
+			expected: expected{
+				res: &model.CognitoUserPoolConfig{
+					AwsRegion:        ptr.Pointer("AwsRegion"),
+					UserPoolId:       ptr.Pointer("UserPoolId"),
+					AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*cognitoUserPoolConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_cognitoUserPoolConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.CognitoUserPoolConfig
+	}
+
+	type expected struct {
+		res *types.CognitoUserPoolConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.CognitoUserPoolConfig{
+					AwsRegion:        ptr.Pointer("AwsRegion"),
+					UserPoolId:       ptr.Pointer("UserPoolId"),
+					AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+				},
+			},
+			expected: expected{
+				res: &types.CognitoUserPoolConfig{
+					AwsRegion:        aws.String("AwsRegion"),
+					UserPoolId:       aws.String("UserPoolId"),
+					AppIdClientRegex: aws.String("AppIdClientRegex"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*cognitoUserPoolConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_openIDConnectConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.OpenIDConnectConfig
+	}
+
+	type expected struct {
+		res *model.OpenIDConnectConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.OpenIDConnectConfig{
+					Issuer:   aws.String("Issuer"),
+					ClientId: aws.String("ClientId"),
+					IatTTL:   1,
+					AuthTTL:  1,
+				},
+			},
+			expected: expected{
+				res: &model.OpenIDConnectConfig{
+					Issuer:   ptr.Pointer("Issuer"),
+					ClientId: ptr.Pointer("ClientId"),
+					IatTTL:   1,
+					AuthTTL:  1,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*openIDConnectConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_openIDConnectConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.OpenIDConnectConfig
+	}
+
+	type expected struct {
+		res *types.OpenIDConnectConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.OpenIDConnectConfig{
+					Issuer:   ptr.Pointer("Issuer"),
+					ClientId: ptr.Pointer("ClientId"),
+					IatTTL:   1,
+					AuthTTL:  1,
+				},
+			},
+			expected: expected{
+				res: &types.OpenIDConnectConfig{
+					Issuer:   aws.String("Issuer"),
+					ClientId: aws.String("ClientId"),
+					IatTTL:   1,
+					AuthTTL:  1,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*openIDConnectConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_logConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.LogConfig
+	}
+
+	type expected struct {
+		res *model.LogConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.LogConfig{
+					CloudWatchLogsRoleArn: aws.String("CloudWatchLogsRoleArn"),
+					FieldLogLevel:         types.FieldLogLevel("FieldLogLevel"),
+					ExcludeVerboseContent: true,
+				},
+			},
+			expected: expected{
+				res: &model.LogConfig{
+					CloudWatchLogsRoleArn: ptr.Pointer("CloudWatchLogsRoleArn"),
+					FieldLogLevel:         model.FieldLogLevel("FieldLogLevel"),
+					ExcludeVerboseContent: true,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*logConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_logConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.LogConfig
+	}
+
+	type expected struct {
+		res *types.LogConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.LogConfig{
+					CloudWatchLogsRoleArn: ptr.Pointer("CloudWatchLogsRoleArn"),
+					FieldLogLevel:         model.FieldLogLevel("FieldLogLevel"),
+					ExcludeVerboseContent: true,
+				},
+			},
+			expected: expected{
+				res: &types.LogConfig{
+					CloudWatchLogsRoleArn: aws.String("CloudWatchLogsRoleArn"),
+					FieldLogLevel:         types.FieldLogLevel("FieldLogLevel"),
+					ExcludeVerboseContent: true,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*logConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_enhancedMetricsConfigMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.EnhancedMetricsConfig
+	}
+
+	type expected struct {
+		res *model.EnhancedMetricsConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.EnhancedMetricsConfig{
+					DataSourceLevelMetricsBehavior: types.DataSourceLevelMetricsBehavior("DataSourceLevelMetricsBehavior"),
+					OperationLevelMetricsConfig:    types.OperationLevelMetricsConfig("OperationLevelMetricsConfig"),
+					ResolverLevelMetricsBehavior:   types.ResolverLevelMetricsBehavior("ResolverLevelMetricsBehavior"),
+				},
+			},
+			expected: expected{
+				res: &model.EnhancedMetricsConfig{
+					DataSourceLevelMetricsBehavior: model.DataSourceLevelMetricsBehavior("DataSourceLevelMetricsBehavior"),
+					OperationLevelMetricsConfig:    model.OperationLevelMetricsConfig("OperationLevelMetricsConfig"),
+					ResolverLevelMetricsBehavior:   model.ResolverLevelMetricsBehavior("ResolverLevelMetricsBehavior"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*enhancedMetricsConfigMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_enhancedMetricsConfigMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.EnhancedMetricsConfig
+	}
+
+	type expected struct {
+		res *types.EnhancedMetricsConfig
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.EnhancedMetricsConfig{
+					DataSourceLevelMetricsBehavior: model.DataSourceLevelMetricsBehavior("DataSourceLevelMetricsBehavior"),
+					OperationLevelMetricsConfig:    model.OperationLevelMetricsConfig("OperationLevelMetricsConfig"),
+					ResolverLevelMetricsBehavior:   model.ResolverLevelMetricsBehavior("ResolverLevelMetricsBehavior"),
+				},
+			},
+			expected: expected{
+				res: &types.EnhancedMetricsConfig{
+					DataSourceLevelMetricsBehavior: types.DataSourceLevelMetricsBehavior("DataSourceLevelMetricsBehavior"),
+					OperationLevelMetricsConfig:    types.OperationLevelMetricsConfig("OperationLevelMetricsConfig"),
+					ResolverLevelMetricsBehavior:   types.ResolverLevelMetricsBehavior("ResolverLevelMetricsBehavior"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*enhancedMetricsConfigMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package mapper
+
+import (
+	"context"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_graphqlApiMapper_ToModel(t *testing.T) {
+	type args struct {
+		v *types.GraphqlApi
+	}
+
+	type expected struct {
+		res *model.GraphqlApi
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &types.GraphqlApi{
+					ApiId:              aws.String("ApiId"),
+					Arn:                aws.String("Arn"),
+					Name:               aws.String("Name"),
+					ApiType:            types.GraphQLApiType("ApiType"),
+					Visibility:         types.GraphQLApiVisibility("Visibility"),
+					AuthenticationType: types.AuthenticationType("AuthenticationType"),
+					AdditionalAuthenticationProviders: []types.AdditionalAuthenticationProvider{
+						types.AdditionalAuthenticationProvider{
+							AuthenticationType: types.AuthenticationType("AuthenticationType"),
+							LambdaAuthorizerConfig: &types.LambdaAuthorizerConfig{
+								AuthorizerUri:                aws.String("AuthorizerUri"),
+								AuthorizerResultTtlInSeconds: 1,
+								IdentityValidationExpression: aws.String("IdentityValidationExpression"),
+							},
+							OpenIDConnectConfig: &types.OpenIDConnectConfig{
+								Issuer:   aws.String("Issuer"),
+								ClientId: aws.String("ClientId"),
+								IatTTL:   1,
+								AuthTTL:  1,
+							},
+							UserPoolConfig: &types.CognitoUserPoolConfig{
+								AwsRegion:        aws.String("AwsRegion"),
+								UserPoolId:       aws.String("UserPoolId"),
+								AppIdClientRegex: aws.String("AppIdClientRegex"),
+							},
+						},
+					},
+					LambdaAuthorizerConfig: &types.LambdaAuthorizerConfig{
+						AuthorizerUri:                aws.String("AuthorizerUri"),
+						AuthorizerResultTtlInSeconds: 1,
+						IdentityValidationExpression: aws.String("IdentityValidationExpression"),
+					},
+					UserPoolConfig: &types.UserPoolConfig{
+						AwsRegion:        aws.String("AwsRegion"),
+						DefaultAction:    types.DefaultAction("DefaultAction"),
+						UserPoolId:       aws.String("UserPoolId"),
+						AppIdClientRegex: aws.String("AppIdClientRegex"),
+					},
+					OpenIDConnectConfig: &types.OpenIDConnectConfig{
+						Issuer:   aws.String("Issuer"),
+						ClientId: aws.String("ClientId"),
+						IatTTL:   1,
+						AuthTTL:  1,
+					},
+					LogConfig: &types.LogConfig{
+						CloudWatchLogsRoleArn: aws.String("CloudWatchLogsRoleArn"),
+						FieldLogLevel:         types.FieldLogLevel("FieldLogLevel"),
+						ExcludeVerboseContent: true,
+					},
+					XrayEnabled:         true,
+					IntrospectionConfig: types.GraphQLApiIntrospectionConfig("IntrospectionConfig"),
+					QueryDepthLimit:     1,
+					ResolverCountLimit:  1,
+					EnhancedMetricsConfig: &types.EnhancedMetricsConfig{
+						DataSourceLevelMetricsBehavior: types.DataSourceLevelMetricsBehavior("DataSourceLevelMetricsBehavior"),
+						OperationLevelMetricsConfig:    types.OperationLevelMetricsConfig("OperationLevelMetricsConfig"),
+						ResolverLevelMetricsBehavior:   types.ResolverLevelMetricsBehavior("ResolverLevelMetricsBehavior"),
+					},
+					MergedApiExecutionRoleArn: aws.String("MergedApiExecutionRoleArn"),
+					OwnerContact:              aws.String("OwnerContact"),
+				},
+			},
+			expected: expected{
+				res: &model.GraphqlApi{
+					ApiId:              ptr.Pointer("ApiId"),
+					Arn:                ptr.Pointer("Arn"),
+					Name:               ptr.Pointer("Name"),
+					ApiType:            model.GraphQLApiType("ApiType"),
+					Visibility:         model.GraphQLApiVisibility("Visibility"),
+					AuthenticationType: model.AuthenticationType("AuthenticationType"),
+					AdditionalAuthenticationProviders: []model.AdditionalAuthenticationProvider{
+						model.AdditionalAuthenticationProvider{
+							AuthenticationType: model.AuthenticationType("AuthenticationType"),
+							LambdaAuthorizerConfig: &model.LambdaAuthorizerConfig{
+								AuthorizerUri:                ptr.Pointer("AuthorizerUri"),
+								AuthorizerResultTtlInSeconds: 1,
+								IdentityValidationExpression: ptr.Pointer("IdentityValidationExpression"),
+							},
+							OpenIDConnectConfig: &model.OpenIDConnectConfig{
+								Issuer:   ptr.Pointer("Issuer"),
+								ClientId: ptr.Pointer("ClientId"),
+								IatTTL:   1,
+								AuthTTL:  1,
+							},
+							UserPoolConfig: &model.CognitoUserPoolConfig{
+								AwsRegion:        ptr.Pointer("AwsRegion"),
+								UserPoolId:       ptr.Pointer("UserPoolId"),
+								AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+							},
+						},
+					},
+					LambdaAuthorizerConfig: &model.LambdaAuthorizerConfig{
+						AuthorizerUri:                ptr.Pointer("AuthorizerUri"),
+						AuthorizerResultTtlInSeconds: 1,
+						IdentityValidationExpression: ptr.Pointer("IdentityValidationExpression"),
+					},
+					UserPoolConfig: &model.UserPoolConfig{
+						AwsRegion:        ptr.Pointer("AwsRegion"),
+						DefaultAction:    model.DefaultAction("DefaultAction"),
+						UserPoolId:       ptr.Pointer("UserPoolId"),
+						AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+					},
+					OpenIDConnectConfig: &model.OpenIDConnectConfig{
+						Issuer:   ptr.Pointer("Issuer"),
+						ClientId: ptr.Pointer("ClientId"),
+						IatTTL:   1,
+						AuthTTL:  1,
+					},
+					LogConfig: &model.LogConfig{
+						CloudWatchLogsRoleArn: ptr.Pointer("CloudWatchLogsRoleArn"),
+						FieldLogLevel:         model.FieldLogLevel("FieldLogLevel"),
+						ExcludeVerboseContent: true,
+					},
+					XrayEnabled:         true,
+					IntrospectionConfig: model.GraphQLApiIntrospectionConfig("IntrospectionConfig"),
+					QueryDepthLimit:     1,
+					ResolverCountLimit:  1,
+					EnhancedMetricsConfig: &model.EnhancedMetricsConfig{
+						DataSourceLevelMetricsBehavior: model.DataSourceLevelMetricsBehavior("DataSourceLevelMetricsBehavior"),
+						OperationLevelMetricsConfig:    model.OperationLevelMetricsConfig("OperationLevelMetricsConfig"),
+						ResolverLevelMetricsBehavior:   model.ResolverLevelMetricsBehavior("ResolverLevelMetricsBehavior"),
+					},
+					MergedApiExecutionRoleArn: ptr.Pointer("MergedApiExecutionRoleArn"),
+					OwnerContact:              ptr.Pointer("OwnerContact"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*graphqlApiMapper)(nil).ToModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
+
+func Test_graphqlApiMapper_FromModel(t *testing.T) {
+	type args struct {
+		v *model.GraphqlApi
+	}
+
+	type expected struct {
+		res *types.GraphqlApi
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: nil",
+			args: args{
+				v: nil,
+			},
+			expected: expected{
+				res: nil,
+			},
+		},
+		{
+			name: "happy path: not nil",
+			args: args{
+				v: &model.GraphqlApi{
+					ApiId:              ptr.Pointer("ApiId"),
+					Arn:                ptr.Pointer("Arn"),
+					Name:               ptr.Pointer("Name"),
+					ApiType:            model.GraphQLApiType("ApiType"),
+					Visibility:         model.GraphQLApiVisibility("Visibility"),
+					AuthenticationType: model.AuthenticationType("AuthenticationType"),
+					AdditionalAuthenticationProviders: []model.AdditionalAuthenticationProvider{
+						model.AdditionalAuthenticationProvider{
+							AuthenticationType: model.AuthenticationType("AuthenticationType"),
+							LambdaAuthorizerConfig: &model.LambdaAuthorizerConfig{
+								AuthorizerUri:                ptr.Pointer("AuthorizerUri"),
+								AuthorizerResultTtlInSeconds: 1,
+								IdentityValidationExpression: ptr.Pointer("IdentityValidationExpression"),
+							},
+							OpenIDConnectConfig: &model.OpenIDConnectConfig{
+								Issuer:   ptr.Pointer("Issuer"),
+								ClientId: ptr.Pointer("ClientId"),
+								IatTTL:   1,
+								AuthTTL:  1,
+							},
+							UserPoolConfig: &model.CognitoUserPoolConfig{
+								AwsRegion:        ptr.Pointer("AwsRegion"),
+								UserPoolId:       ptr.Pointer("UserPoolId"),
+								AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+							},
+						},
+					},
+					LambdaAuthorizerConfig: &model.LambdaAuthorizerConfig{
+						AuthorizerUri:                ptr.Pointer("AuthorizerUri"),
+						AuthorizerResultTtlInSeconds: 1,
+						IdentityValidationExpression: ptr.Pointer("IdentityValidationExpression"),
+					},
+					UserPoolConfig: &model.UserPoolConfig{
+						AwsRegion:        ptr.Pointer("AwsRegion"),
+						DefaultAction:    model.DefaultAction("DefaultAction"),
+						UserPoolId:       ptr.Pointer("UserPoolId"),
+						AppIdClientRegex: ptr.Pointer("AppIdClientRegex"),
+					},
+					OpenIDConnectConfig: &model.OpenIDConnectConfig{
+						Issuer:   ptr.Pointer("Issuer"),
+						ClientId: ptr.Pointer("ClientId"),
+						IatTTL:   1,
+						AuthTTL:  1,
+					},
+					LogConfig: &model.LogConfig{
+						CloudWatchLogsRoleArn: ptr.Pointer("CloudWatchLogsRoleArn"),
+						FieldLogLevel:         model.FieldLogLevel("FieldLogLevel"),
+						ExcludeVerboseContent: true,
+					},
+					XrayEnabled:         true,
+					IntrospectionConfig: model.GraphQLApiIntrospectionConfig("IntrospectionConfig"),
+					QueryDepthLimit:     1,
+					ResolverCountLimit:  1,
+					EnhancedMetricsConfig: &model.EnhancedMetricsConfig{
+						DataSourceLevelMetricsBehavior: model.DataSourceLevelMetricsBehavior("DataSourceLevelMetricsBehavior"),
+						OperationLevelMetricsConfig:    model.OperationLevelMetricsConfig("OperationLevelMetricsConfig"),
+						ResolverLevelMetricsBehavior:   model.ResolverLevelMetricsBehavior("ResolverLevelMetricsBehavior"),
+					},
+					MergedApiExecutionRoleArn: ptr.Pointer("MergedApiExecutionRoleArn"),
+					OwnerContact:              ptr.Pointer("OwnerContact"),
+				},
+			},
+			expected: expected{
+				res: &types.GraphqlApi{
+					ApiId:              aws.String("ApiId"),
+					Arn:                aws.String("Arn"),
+					Name:               aws.String("Name"),
+					ApiType:            types.GraphQLApiType("ApiType"),
+					Visibility:         types.GraphQLApiVisibility("Visibility"),
+					AuthenticationType: types.AuthenticationType("AuthenticationType"),
+					AdditionalAuthenticationProviders: []types.AdditionalAuthenticationProvider{
+						types.AdditionalAuthenticationProvider{
+							AuthenticationType: types.AuthenticationType("AuthenticationType"),
+							LambdaAuthorizerConfig: &types.LambdaAuthorizerConfig{
+								AuthorizerUri:                aws.String("AuthorizerUri"),
+								AuthorizerResultTtlInSeconds: 1,
+								IdentityValidationExpression: aws.String("IdentityValidationExpression"),
+							},
+							OpenIDConnectConfig: &types.OpenIDConnectConfig{
+								Issuer:   aws.String("Issuer"),
+								ClientId: aws.String("ClientId"),
+								IatTTL:   1,
+								AuthTTL:  1,
+							},
+							UserPoolConfig: &types.CognitoUserPoolConfig{
+								AwsRegion:        aws.String("AwsRegion"),
+								UserPoolId:       aws.String("UserPoolId"),
+								AppIdClientRegex: aws.String("AppIdClientRegex"),
+							},
+						},
+					},
+					LambdaAuthorizerConfig: &types.LambdaAuthorizerConfig{
+						AuthorizerUri:                aws.String("AuthorizerUri"),
+						AuthorizerResultTtlInSeconds: 1,
+						IdentityValidationExpression: aws.String("IdentityValidationExpression"),
+					},
+					UserPoolConfig: &types.UserPoolConfig{
+						AwsRegion:        aws.String("AwsRegion"),
+						DefaultAction:    types.DefaultAction("DefaultAction"),
+						UserPoolId:       aws.String("UserPoolId"),
+						AppIdClientRegex: aws.String("AppIdClientRegex"),
+					},
+					OpenIDConnectConfig: &types.OpenIDConnectConfig{
+						Issuer:   aws.String("Issuer"),
+						ClientId: aws.String("ClientId"),
+						IatTTL:   1,
+						AuthTTL:  1,
+					},
+					LogConfig: &types.LogConfig{
+						CloudWatchLogsRoleArn: aws.String("CloudWatchLogsRoleArn"),
+						FieldLogLevel:         types.FieldLogLevel("FieldLogLevel"),
+						ExcludeVerboseContent: true,
+					},
+					XrayEnabled:         true,
+					IntrospectionConfig: types.GraphQLApiIntrospectionConfig("IntrospectionConfig"),
+					QueryDepthLimit:     1,
+					ResolverCountLimit:  1,
+					EnhancedMetricsConfig: &types.EnhancedMetricsConfig{
+						DataSourceLevelMetricsBehavior: types.DataSourceLevelMetricsBehavior("DataSourceLevelMetricsBehavior"),
+						OperationLevelMetricsConfig:    types.OperationLevelMetricsConfig("OperationLevelMetricsConfig"),
+						ResolverLevelMetricsBehavior:   types.ResolverLevelMetricsBehavior("ResolverLevelMetricsBehavior"),
+					},
+					MergedApiExecutionRoleArn: aws.String("MergedApiExecutionRoleArn"),
+					OwnerContact:              aws.String("OwnerContact"),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := (*graphqlApiMapper)(nil).FromModel(ctx, tt.args.v)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+		})
+	}
+}
@@ -27,14 +27,16 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
 )
 
+type RuntimeMapper interface {
+	ToModel(ctx context.Context, v *types.AppSyncRuntime) *model.Runtime
+	FromModel(ctx context.Context, v *model.Runtime) *types.AppSyncRuntime
+}
+
 type runtimeMapper struct{}
 
-var (
-	_ interface {
-		ToModel(ctx context.Context, v *types.AppSyncRuntime) *model.Runtime
-		FromModel(ctx context.Context, v *model.Runtime) *types.AppSyncRuntime
-	} = (*runtimeMapper)(nil)
-)
+func NewRuntimeMapper() RuntimeMapper {
+	return (*runtimeMapper)(nil)
+}
 
 func (*runtimeMapper) ToModel(ctx context.Context, v *types.AppSyncRuntime) *model.Runtime {
 	if v == nil {
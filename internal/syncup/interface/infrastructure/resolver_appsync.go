@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
 	"github.com/Aton-Kish/syncup/internal/syncup/interface/infrastructure/mapper"
@@ -70,16 +71,13 @@ func (r *resolverRepositoryForAppSync) List(ctx context.Context, apiID string) (
 	}
 
 	var mu sync.Mutex
-	var wg sync.WaitGroup
+	g := syncup.WorkerPool(ctx).Group()
 	rslvs := make([]model.Resolver, 0)
 	errs := make([]error, 0)
 
 	for _, n := range ns {
 		n := n
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-
+		g.Go(func() {
 			resolvers, err := r.ListByTypeName(ctx, apiID, n)
 			if err != nil {
 				mu.Lock()
@@ -91,10 +89,10 @@ func (r *resolverRepositoryForAppSync) List(ctx context.Context, apiID string) (
 			mu.Lock()
 			rslvs = append(rslvs, resolvers...)
 			mu.Unlock()
-		}()
+		})
 	}
 
-	wg.Wait()
+	g.Wait()
 
 	if err := errors.Join(errs...); err != nil {
 		return nil, err
@@ -220,12 +218,17 @@ func (r *resolverRepositoryForAppSync) Save(ctx context.Context, apiID string, r
 	}
 
 	save := r.update
-	if _, err := r.Get(ctx, apiID, *resolver.TypeName, *resolver.FieldName); err != nil {
-		if errors.Is(err, model.ErrNotFound) {
-			save = r.create
-		} else {
+	remote, err := r.Get(ctx, apiID, *resolver.TypeName, *resolver.FieldName)
+	if err != nil {
+		if !errors.Is(err, model.ErrNotFound) {
 			return nil, err
 		}
+
+		save = r.create
+	}
+
+	if err := verifyExpectedHash(ctx, model.ResolverHash, remote, fmt.Sprintf("resolver %s.%s", *resolver.TypeName, *resolver.FieldName)); err != nil {
+		return nil, err
 	}
 
 	rslv, err := save(ctx, apiID, resolver)
@@ -332,6 +335,10 @@ func (r *resolverRepositoryForAppSync) Delete(ctx context.Context, apiID string,
 			o.Retryer = retry.AddWithErrorCodes(o.Retryer, (*types.ConcurrentModificationException)(nil).ErrorCode())
 		},
 	); err != nil {
+		if nfe := new(types.NotFoundException); errors.As(err, &nfe) {
+			return model.ErrNotFound
+		}
+
 		return err
 	}
 
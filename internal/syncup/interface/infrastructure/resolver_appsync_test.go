@@ -31,6 +31,7 @@ import (
 	"time"
 
 	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/interface/infrastructure/mapper"
 	"github.com/Aton-Kish/syncup/internal/testhelpers"
@@ -878,11 +879,16 @@ func Test_resolverRepositoryForAppSync_Save(t *testing.T) {
 	resolverUNIT_VTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Resolver](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/UNIT/VTL_2018-05-29/metadata.json")))
 	resolverUNIT_VTL_2018_05_29.RequestMappingTemplate = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/UNIT/VTL_2018-05-29/request.vtl"))))
 	resolverUNIT_VTL_2018_05_29.ResponseMappingTemplate = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/UNIT/VTL_2018-05-29/response.vtl"))))
+	resolverUNIT_VTL_2018_05_29Hash, err := model.ResolverHash(&resolverUNIT_VTL_2018_05_29)
+	if err != nil {
+		t.Fatal(err)
+	}
 	duration := time.Duration(1) * time.Millisecond
 
 	type args struct {
-		apiID    string
-		resolver *model.Resolver
+		apiID        string
+		resolver     *model.Resolver
+		expectedHash string
 	}
 
 	type mockAppSyncClientGetResolverReturn struct {
@@ -996,6 +1002,41 @@ func Test_resolverRepositoryForAppSync_Save(t *testing.T) {
 				errIs: nil,
 			},
 		},
+		{
+			name: "happy path: update - unchanged since the last sync",
+			args: args{
+				apiID:        "apiID",
+				resolver:     &resolverUNIT_VTL_2018_05_29,
+				expectedHash: resolverUNIT_VTL_2018_05_29Hash,
+			},
+			mockAppSyncClientGetResolver: mockAppSyncClientGetResolver{
+				returns: []mockAppSyncClientGetResolverReturn{
+					{
+						res: &appsync.GetResolverOutput{
+							Resolver: mapper.NewResolverMapper().FromModel(context.Background(), &resolverUNIT_VTL_2018_05_29),
+						},
+						err: nil,
+					},
+				},
+			},
+			mockAppSyncClientCreateResolver: mockAppSyncClientCreateResolver{
+				returns: []mockAppSyncClientCreateResolverReturn{},
+			},
+			mockAppSyncClientUpdateResolver: mockAppSyncClientUpdateResolver{
+				returns: []mockAppSyncClientUpdateResolverReturn{
+					{
+						res: &appsync.UpdateResolverOutput{
+							Resolver: mapper.NewResolverMapper().FromModel(context.Background(), &resolverUNIT_VTL_2018_05_29),
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &resolverUNIT_VTL_2018_05_29,
+				errIs: nil,
+			},
+		},
 		{
 			name: "happy path: create - retries on ConcurrentModificationException",
 			args: args{
@@ -1354,12 +1395,71 @@ func Test_resolverRepositoryForAppSync_Save(t *testing.T) {
 				errIs: nil,
 			},
 		},
+		{
+			name: "edge path: update - changed since the last sync",
+			args: args{
+				apiID:        "apiID",
+				resolver:     &resolverUNIT_VTL_2018_05_29,
+				expectedHash: "staleHash",
+			},
+			mockAppSyncClientGetResolver: mockAppSyncClientGetResolver{
+				returns: []mockAppSyncClientGetResolverReturn{
+					{
+						res: &appsync.GetResolverOutput{
+							Resolver: mapper.NewResolverMapper().FromModel(context.Background(), &resolverUNIT_VTL_2018_05_29),
+						},
+						err: nil,
+					},
+				},
+			},
+			mockAppSyncClientCreateResolver: mockAppSyncClientCreateResolver{
+				returns: []mockAppSyncClientCreateResolverReturn{},
+			},
+			mockAppSyncClientUpdateResolver: mockAppSyncClientUpdateResolver{
+				returns: []mockAppSyncClientUpdateResolverReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrConflict,
+			},
+		},
+		{
+			name: "edge path: create - deleted since the last sync",
+			args: args{
+				apiID:        "apiID",
+				resolver:     &resolverUNIT_VTL_2018_05_29,
+				expectedHash: resolverUNIT_VTL_2018_05_29Hash,
+			},
+			mockAppSyncClientGetResolver: mockAppSyncClientGetResolver{
+				returns: []mockAppSyncClientGetResolverReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 404}},
+								Err:      &types.NotFoundException{},
+							},
+						},
+					},
+				},
+			},
+			mockAppSyncClientCreateResolver: mockAppSyncClientCreateResolver{
+				returns: []mockAppSyncClientCreateResolverReturn{},
+			},
+			mockAppSyncClientUpdateResolver: mockAppSyncClientUpdateResolver{
+				returns: []mockAppSyncClientUpdateResolverReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrConflict,
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
-			ctx := context.Background()
+			ctx := syncup.WithExpectedHash(context.Background(), tt.args.expectedHash)
 
 			cfg, err := config.LoadDefaultConfig(
 				ctx,
@@ -1539,6 +1639,30 @@ func Test_resolverRepositoryForAppSync_Delete(t *testing.T) {
 				errIs: nil,
 			},
 		},
+		{
+			name: "edge path: not found",
+			args: args{
+				apiID:     "apiID",
+				typeName:  "UNIT",
+				fieldName: "VTL_2018_05_29",
+			},
+			mockAppSyncClientDeleteResolver: mockAppSyncClientDeleteResolver{
+				returns: []mockAppSyncClientDeleteResolverReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 404}},
+								Err:      &types.NotFoundException{},
+							},
+						},
+					},
+				},
+			},
+			expected: expected{
+				errIs: model.ErrNotFound,
+			},
+		},
 		{
 			name: "edge path: appsync.DeleteResolver()",
 			args: args{
@@ -25,11 +25,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"sync"
 
 	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
 	"github.com/Aton-Kish/syncup/internal/xfilepath"
@@ -58,6 +60,10 @@ func NewResolverRepositoryForFS() repository.ResolverRepository {
 }
 
 func (r *resolverRepositoryForFS) BaseDir(ctx context.Context) string {
+	if dir := syncup.BaseDir(ctx); dir != "" {
+		return dir
+	}
+
 	return r.baseDir
 }
 
@@ -70,11 +76,14 @@ func (r *resolverRepositoryForFS) List(ctx context.Context, apiID string) (res [
 
 	es, err := os.ReadDir(filepath.Join(r.BaseDir(ctx), dirNameResolvers))
 	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			// NOTE: base dirs that have never been pulled have no resolvers dir
+			return []model.Resolver{}, nil
+		}
+
 		return nil, err
 	}
 
-	var mu sync.Mutex
-	var wg sync.WaitGroup
 	rslvs := make([]model.Resolver, 0)
 	errs := make([]error, 0)
 
@@ -83,27 +92,16 @@ func (r *resolverRepositoryForFS) List(ctx context.Context, apiID string) (res [
 			continue
 		}
 
-		typeName := e.Name()
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-
-			resolvers, err := r.ListByTypeName(ctx, apiID, typeName)
-			if err != nil {
-				mu.Lock()
-				errs = append(errs, err)
-				mu.Unlock()
-				return
-			}
+		// NOTE: list the types one by one since ListByTypeName already reads the fields in parallel
+		resolvers, err := r.ListByTypeName(ctx, apiID, e.Name())
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
 
-			mu.Lock()
-			rslvs = append(rslvs, resolvers...)
-			mu.Unlock()
-		}()
+		rslvs = append(rslvs, resolvers...)
 	}
 
-	wg.Wait()
-
 	if err := errors.Join(errs...); err != nil {
 		return nil, err
 	}
@@ -120,7 +118,7 @@ func (r *resolverRepositoryForFS) ListByTypeName(ctx context.Context, apiID stri
 	}
 
 	var mu sync.Mutex
-	var wg sync.WaitGroup
+	g := syncup.WorkerPool(ctx).Group()
 	rslvs := make([]model.Resolver, 0)
 	errs := make([]error, 0)
 
@@ -130,10 +128,7 @@ func (r *resolverRepositoryForFS) ListByTypeName(ctx context.Context, apiID stri
 		}
 
 		fieldName := e.Name()
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-
+		g.Go(func() {
 			rslv, err := r.Get(ctx, apiID, typeName, fieldName)
 			if err != nil {
 				mu.Lock()
@@ -145,10 +140,10 @@ func (r *resolverRepositoryForFS) ListByTypeName(ctx context.Context, apiID stri
 			mu.Lock()
 			rslvs = append(rslvs, *rslv)
 			mu.Unlock()
-		}()
+		})
 	}
 
-	wg.Wait()
+	g.Wait()
 
 	if err := errors.Join(errs...); err != nil {
 		return nil, err
@@ -163,6 +158,10 @@ func (r *resolverRepositoryForFS) Get(ctx context.Context, apiID string, typeNam
 	dir := filepath.Join(r.BaseDir(ctx), dirNameResolvers, typeName, fieldName)
 	metadata, err := os.ReadFile(filepath.Join(dir, fileNameResolverMetadata))
 	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %w", model.ErrNotFound, err)
+		}
+
 		return nil, err
 	}
 
@@ -237,6 +236,10 @@ func (r *resolverRepositoryForFS) Save(ctx context.Context, apiID string, resolv
 	switch {
 	case resolver.Runtime == nil:
 		// VTL runtime
+		if err := removeFiles(dir, fileNameResolverAppSyncJSCode); err != nil {
+			return nil, err
+		}
+
 		if err := os.WriteFile(filepath.Join(dir, fileNameResolverVTLRequestMappingTemplate), []byte(ptr.ToValue(resolver.RequestMappingTemplate)), 0o644); err != nil {
 			return nil, err
 		}
@@ -246,6 +249,10 @@ func (r *resolverRepositoryForFS) Save(ctx context.Context, apiID string, resolv
 		}
 	case resolver.Runtime.Name == model.RuntimeNameAppsyncJs:
 		// AppSync JS runtime
+		if err := removeFiles(dir, fileNameResolverVTLRequestMappingTemplate, fileNameResolverVTLResponseMappingTemplate); err != nil {
+			return nil, err
+		}
+
 		if err := os.WriteFile(filepath.Join(dir, fileNameResolverAppSyncJSCode), []byte(ptr.ToValue(resolver.Code)), 0o644); err != nil {
 			return nil, err
 		}
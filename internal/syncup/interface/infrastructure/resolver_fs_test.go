@@ -22,6 +22,7 @@ package infrastructure
 
 import (
 	"context"
+	"path"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -83,7 +84,7 @@ func Test_resolverRepositoryForFS_List(t *testing.T) {
 			},
 		},
 		{
-			name: "edge path: non-existing dir",
+			name: "happy path: non-existing dir",
 			fields: fields{
 				baseDir: filepath.Join(testdataBaseDir, "invalidBaseDir"),
 			},
@@ -275,6 +276,56 @@ func Test_resolverRepositoryForFS_Get(t *testing.T) {
 				errIs: nil,
 			},
 		},
+		{
+			name: "happy path: tests dir",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"resolvers/Query/getPost/metadata.json":    `{"typeName":"Query","fieldName":"getPost"}`,
+					"resolvers/Query/getPost/request.vtl":      "{}",
+					"resolvers/Query/getPost/response.vtl":     "$util.toJson($ctx.result)",
+					"resolvers/Query/getPost/tests/found.json": `{"expected":{"request":{}}}`,
+				}),
+			},
+			args: args{
+				apiID:     "apiID",
+				typeName:  "Query",
+				fieldName: "getPost",
+			},
+			expected: expected{
+				res: &model.Resolver{
+					TypeName:                ptr.Pointer("Query"),
+					FieldName:               ptr.Pointer("getPost"),
+					RequestMappingTemplate:  ptr.Pointer("{}"),
+					ResponseMappingTemplate: ptr.Pointer("$util.toJson($ctx.result)"),
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: orphan file",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"resolvers/Query/getPost/metadata.json": `{"typeName":"Query","fieldName":"getPost"}`,
+					"resolvers/Query/getPost/request.vtl":   "{}",
+					"resolvers/Query/getPost/response.vtl":  "$util.toJson($ctx.result)",
+					"resolvers/Query/getPost/code.js":       "export function request(ctx) {}",
+				}),
+			},
+			args: args{
+				apiID:     "apiID",
+				typeName:  "Query",
+				fieldName: "getPost",
+			},
+			expected: expected{
+				res: &model.Resolver{
+					TypeName:                ptr.Pointer("Query"),
+					FieldName:               ptr.Pointer("getPost"),
+					RequestMappingTemplate:  ptr.Pointer("{}"),
+					ResponseMappingTemplate: ptr.Pointer("$util.toJson($ctx.result)"),
+				},
+				errIs: nil,
+			},
+		},
 		{
 			name: "edge path: non-existing dir",
 			fields: fields{
@@ -405,6 +456,23 @@ func Test_resolverRepositoryForFS_Save(t *testing.T) {
 				errIs: nil,
 			},
 		},
+		{
+			name: "happy path: AppSync JS runtime - replacing VTL runtime",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					path.Join("resolvers", *resolverUNIT_APPSYNC_JS_1_0_0.TypeName, *resolverUNIT_APPSYNC_JS_1_0_0.FieldName, "request.vtl"):  "{}",
+					path.Join("resolvers", *resolverUNIT_APPSYNC_JS_1_0_0.TypeName, *resolverUNIT_APPSYNC_JS_1_0_0.FieldName, "response.vtl"): "$util.toJson($ctx.result)",
+				}),
+			},
+			args: args{
+				apiID:    "apiID",
+				resolver: &resolverUNIT_APPSYNC_JS_1_0_0,
+			},
+			expected: expected{
+				res:   &resolverUNIT_APPSYNC_JS_1_0_0,
+				errIs: nil,
+			},
+		},
 		{
 			name: "edge path: nil resolver",
 			fields: fields{
@@ -490,6 +558,10 @@ func Test_resolverRepositoryForFS_Save(t *testing.T) {
 
 			if strings.HasPrefix(tt.name, "happy") {
 				assert.NoError(t, err)
+
+				saved, err := r.Get(ctx, tt.args.apiID, *tt.args.resolver.TypeName, *tt.args.resolver.FieldName)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.res, saved)
 			} else {
 				var le *model.LibError
 				assert.ErrorAs(t, err, &le)
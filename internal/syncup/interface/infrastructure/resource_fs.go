@@ -0,0 +1,196 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+type resourceRepositoryForFS struct {
+	baseDir string
+}
+
+var (
+	_ interface {
+		repository.BaseDirProvider
+	} = (*resourceRepositoryForFS)(nil)
+)
+
+func NewResourceRepositoryForFS() repository.ResourceRepository {
+	return &resourceRepositoryForFS{}
+}
+
+func (r *resourceRepositoryForFS) BaseDir(ctx context.Context) string {
+	if dir := syncup.BaseDir(ctx); dir != "" {
+		return dir
+	}
+
+	return r.baseDir
+}
+
+func (r *resourceRepositoryForFS) SetBaseDir(ctx context.Context, dir string) {
+	r.baseDir = dir
+}
+
+func (r *resourceRepositoryForFS) Exists(ctx context.Context, apiID string, kind model.ResourceKind, identifier string) (res bool, err error) {
+	defer wrap(&err)
+
+	baseDir := r.BaseDir(ctx)
+
+	var paths []string
+	switch kind {
+	case model.ResourceKindGraphqlApi:
+		paths = []string{filepath.Join(baseDir, fileNameGraphqlApi)}
+	case model.ResourceKindEnvironmentVariables:
+		paths = []string{filepath.Join(baseDir, fileNameEnvironmentVariables)}
+	case model.ResourceKindSchema:
+		paths = []string{filepath.Join(baseDir, fileNameSchema), filepath.Join(baseDir, dirNameSchema)}
+	case model.ResourceKindDataSource:
+		paths = []string{filepath.Join(baseDir, dirNameDataSources, identifier, fileNameDataSourceMetadata)}
+	case model.ResourceKindFunction:
+		paths = []string{filepath.Join(baseDir, dirNameFunctions, identifier, fileNameFunctionMetadata)}
+	case model.ResourceKindResolver:
+		typeName, fieldName, ok := strings.Cut(identifier, ".")
+		if !ok {
+			return false, fmt.Errorf("%w: invalid resolver identifier %s", model.ErrInvalidValue, identifier)
+		}
+
+		paths = []string{filepath.Join(baseDir, dirNameResolvers, typeName, fieldName, fileNameResolverMetadata)}
+	default:
+		return false, fmt.Errorf("%w: unknown resource kind %s", model.ErrInvalidValue, kind)
+	}
+
+	// NOTE: only stat the files, so that the existence check neither parses nor decrypts them
+	for _, path := range paths {
+		if _, err := os.Stat(path); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return false, err
+		}
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (r *resourceRepositoryForFS) CheckFiles(ctx context.Context, apiID string) (err error) {
+	defer wrap(&err)
+
+	baseDir := r.BaseDir(ctx)
+	errs := make([]error, 0)
+
+	fnDirs, err := listResourceDirs(filepath.Join(baseDir, dirNameFunctions), &errs)
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range fnDirs {
+		errs = append(errs, checkRuntimeFiles(dir, fileNameFunctionMetadata, []string{fileNameFunctionVTLRequestMappingTemplate, fileNameFunctionVTLResponseMappingTemplate}, []string{fileNameFunctionAppSyncJSCode}))
+	}
+
+	typeDirs, err := listResourceDirs(filepath.Join(baseDir, dirNameResolvers), &errs)
+	if err != nil {
+		return err
+	}
+
+	for _, typeDir := range typeDirs {
+		fieldDirs, err := listResourceDirs(typeDir, &errs)
+		if err != nil {
+			return err
+		}
+
+		for _, dir := range fieldDirs {
+			errs = append(errs, checkRuntimeFiles(dir, fileNameResolverMetadata, []string{fileNameResolverVTLRequestMappingTemplate, fileNameResolverVTLResponseMappingTemplate}, []string{fileNameResolverAppSyncJSCode}))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// listResourceDirs returns the subdirs of dir and reports the files next to them as orphans.
+func listResourceDirs(dir string, errs *[]error) ([]string, error) {
+	es, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return []string{}, nil
+		}
+
+		return nil, err
+	}
+
+	dirs := make([]string, 0, len(es))
+	for _, e := range es {
+		if isHiddenFile(e.Name()) {
+			continue
+		}
+
+		if !e.IsDir() {
+			*errs = append(*errs, fmt.Errorf("%w: orphan file %s", model.ErrInvalidValue, filepath.Join(dir, e.Name())))
+			continue
+		}
+
+		dirs = append(dirs, filepath.Join(dir, e.Name()))
+	}
+
+	return dirs, nil
+}
+
+// checkRuntimeFiles checks the files in dir against the runtime in its metadata.
+func checkRuntimeFiles(dir string, metadataName string, vtlNames []string, jsNames []string) error {
+	data, err := os.ReadFile(filepath.Join(dir, metadataName))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("%w: missing file %s", model.ErrInvalidValue, filepath.Join(dir, metadataName))
+		}
+
+		return err
+	}
+
+	var metadata struct {
+		Runtime *model.Runtime `json:"runtime,omitempty"`
+	}
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return err
+	}
+
+	switch {
+	case metadata.Runtime == nil:
+		return checkResourceFiles(dir, metadata.Runtime, append([]string{metadataName}, vtlNames...)...)
+	case metadata.Runtime.Name == model.RuntimeNameAppsyncJs:
+		return checkResourceFiles(dir, metadata.Runtime, append([]string{metadataName}, jsNames...)...)
+	default:
+		return fmt.Errorf("%w: runtime %s", model.ErrInvalidValue, metadata.Runtime.Name)
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_resourceRepositoryForFS_Exists(t *testing.T) {
+	baseDir := testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+		"api.json":                              `{}`,
+		"env.json":                              `{"key":"age:broken"}`,
+		"schema/schema.graphql":                 `schema { query: Query }`,
+		"datasources/table/metadata.json":       `{}`,
+		"functions/getPost/metadata.json":       `{}`,
+		"functions/noMetadata/code.js":          ``,
+		"resolvers/Query/getPost/metadata.json": `{}`,
+	})
+
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		kind       model.ResourceKind
+		identifier string
+	}
+
+	type expected struct {
+		res   bool
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: GraphQL API settings",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				kind: model.ResourceKindGraphqlApi,
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: environment variables",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				kind: model.ResourceKindEnvironmentVariables,
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: schema dir",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				kind: model.ResourceKindSchema,
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: data source",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				kind:       model.ResourceKindDataSource,
+				identifier: "table",
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: function",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				kind:       model.ResourceKindFunction,
+				identifier: "getPost",
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: function without metadata",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				kind:       model.ResourceKindFunction,
+				identifier: "noMetadata",
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: resolver",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				kind:       model.ResourceKindResolver,
+				identifier: "Query.getPost",
+			},
+			expected: expected{
+				res:   true,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: nothing saved locally yet",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				kind: model.ResourceKindSchema,
+			},
+			expected: expected{
+				res:   false,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: invalid resolver identifier",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				kind:       model.ResourceKindResolver,
+				identifier: "getPost",
+			},
+			expected: expected{
+				res:   false,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: unknown resource kind",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				kind:       model.ResourceKind("unknown"),
+				identifier: "getPost",
+			},
+			expected: expected{
+				res:   false,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &resourceRepositoryForFS{
+				baseDir: tt.fields.baseDir,
+			}
+
+			// Act
+			actual, err := r.Exists(ctx, "apiID", tt.args.kind, tt.args.identifier)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_resourceRepositoryForFS_CheckFiles(t *testing.T) {
+	type fields struct {
+		baseDir string
+	}
+
+	type expected struct {
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		expected expected
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"functions/.DS_Store":                     "",
+					"functions/getPost/metadata.json":         `{"name":"getPost"}`,
+					"functions/getPost/request.vtl":           "{}",
+					"functions/getPost/response.vtl":          "$util.toJson($ctx.result)",
+					"functions/getPost/tests/case.json":       "{}",
+					"resolvers/Query/getPost/metadata.json":   `{"typeName":"Query","fieldName":"getPost","runtime":{"name":"APPSYNC_JS","runtimeVersion":"1.0.0"}}`,
+					"resolvers/Query/getPost/code.js":         "export function request(ctx) {}",
+					"resolvers/Query/listPosts/metadata.json": `{"typeName":"Query","fieldName":"listPosts"}`,
+					"resolvers/Query/listPosts/request.vtl":   "{}",
+					"resolvers/Query/listPosts/response.vtl":  "$util.toJson($ctx.result)",
+				}),
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: nothing saved locally yet",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: runtime mismatch",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"functions/getPost/metadata.json": `{"name":"getPost","runtime":{"name":"APPSYNC_JS","runtimeVersion":"1.0.0"}}`,
+					"functions/getPost/request.vtl":   "{}",
+					"functions/getPost/response.vtl":  "$util.toJson($ctx.result)",
+				}),
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: orphan file in resource dir",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"resolvers/Query/getPost/metadata.json": `{"typeName":"Query","fieldName":"getPost"}`,
+					"resolvers/Query/getPost/request.vtl":   "{}",
+					"resolvers/Query/getPost/response.vtl":  "$util.toJson($ctx.result)",
+					"resolvers/Query/getPost/code.js":       "export function request(ctx) {}",
+				}),
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: orphan file in functions dir",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"functions/code.js": "export function request(ctx) {}",
+				}),
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: orphan file in resolver type dir",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"resolvers/Query/code.js": "export function request(ctx) {}",
+				}),
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: missing metadata",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"functions/getPost/code.js": "export function request(ctx) {}",
+				}),
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: invalid runtime",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"functions/getPost/metadata.json": `{"name":"getPost","runtime":{"name":"INVALID","runtimeVersion":"1.0.0"}}`,
+				}),
+			},
+			expected: expected{
+				errIs: model.ErrInvalidValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &resourceRepositoryForFS{
+				baseDir: tt.fields.baseDir,
+			}
+
+			// Act
+			err := r.CheckFiles(ctx, "apiID")
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
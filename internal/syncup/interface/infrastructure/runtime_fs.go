@@ -0,0 +1,89 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+)
+
+const (
+	runtimeLabelVTL = "VTL"
+)
+
+func runtimeLabel(runtime *model.Runtime) string {
+	if runtime == nil {
+		return runtimeLabelVTL
+	}
+
+	return string(runtime.Name)
+}
+
+func isHiddenFile(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
+
+// checkResourceFiles reports files in dir that do not belong to the runtime and files the runtime needs but are missing.
+func checkResourceFiles(dir string, runtime *model.Runtime, names ...string) error {
+	es, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, 0)
+	present := make(map[string]bool)
+	for _, e := range es {
+		// NOTE: the tests dir holds the fixtures of syncup test, which are not part of the resource
+		if isHiddenFile(e.Name()) || (e.IsDir() && e.Name() == dirNameTests) {
+			continue
+		}
+
+		present[e.Name()] = true
+		if !slices.Contains(names, e.Name()) {
+			errs = append(errs, fmt.Errorf("%w: orphan file %s for %s runtime", model.ErrInvalidValue, filepath.Join(dir, e.Name()), runtimeLabel(runtime)))
+		}
+	}
+
+	for _, name := range names {
+		if !present[name] {
+			errs = append(errs, fmt.Errorf("%w: missing file %s for %s runtime", model.ErrInvalidValue, filepath.Join(dir, name), runtimeLabel(runtime)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func removeFiles(dir string, names ...string) error {
+	for _, name := range names {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	return nil
+}
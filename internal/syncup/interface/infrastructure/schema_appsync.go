@@ -22,6 +22,7 @@ package infrastructure
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -78,6 +79,10 @@ func (r *schemaRepositoryForAppSync) Get(ctx context.Context, apiID string) (res
 		},
 	)
 	if err != nil {
+		if nfe := new(types.NotFoundException); errors.As(err, &nfe) {
+			return nil, model.ErrNotFound
+		}
+
 		return nil, err
 	}
 
@@ -93,7 +93,30 @@ func Test_schemaRepositoryForAppSync_Get(t *testing.T) {
 			},
 		},
 		{
-			name: "edge path",
+			name: "edge path: not found",
+			args: args{
+				apiID: "apiID",
+			},
+			mockAppSyncClientGetIntrospectionSchema: mockAppSyncClientGetIntrospectionSchema{
+				returns: []mockAppSyncClientGetIntrospectionSchemaReturn{
+					{
+						res: nil,
+						err: &awshttp.ResponseError{
+							ResponseError: &smithyhttp.ResponseError{
+								Response: &smithyhttp.Response{Response: &http.Response{StatusCode: 404}},
+								Err:      &types.NotFoundException{},
+							},
+						},
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+		{
+			name: "edge path: appsync.GetIntrospectionSchema() error",
 			args: args{
 				apiID: "apiID",
 			},
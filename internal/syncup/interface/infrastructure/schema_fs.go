@@ -21,35 +21,64 @@
 package infrastructure
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
 	"github.com/Aton-Kish/syncup/internal/xfilepath"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
 )
 
 const (
-	fileNameSchema = "schema.graphqls"
+	fileNameSchema           = "schema.graphqls"
+	dirNameSchema            = "schema"
+	dirNameSchemaTypes       = "types"
+	fileNameSchemaDefinition = "schema.graphql"
+	fileNameSchemaDirectives = "directives.graphql"
+	fileExtGraphql           = ".graphql"
 )
 
 type schemaRepositoryForFS struct {
 	baseDir string
 }
 
+// NOTE: the files are listed the same way as the schema is loaded, but saved one by one
+type schemaFileRepositoryForFS struct {
+	schemaRepositoryForFS
+}
+
 var (
 	_ interface {
 		repository.BaseDirProvider
 	} = (*schemaRepositoryForFS)(nil)
+	_ interface {
+		repository.BaseDirProvider
+	} = (*schemaFileRepositoryForFS)(nil)
 )
 
 func NewSchemaRepositoryForFS() repository.SchemaRepository {
 	return &schemaRepositoryForFS{}
 }
 
+func NewSchemaFileRepositoryForFS() repository.SchemaFileRepository {
+	return &schemaFileRepositoryForFS{}
+}
+
 func (r *schemaRepositoryForFS) BaseDir(ctx context.Context) string {
+	if dir := syncup.BaseDir(ctx); dir != "" {
+		return dir
+	}
+
 	return r.baseDir
 }
 
@@ -60,15 +89,46 @@ func (r *schemaRepositoryForFS) SetBaseDir(ctx context.Context, dir string) {
 func (r *schemaRepositoryForFS) Get(ctx context.Context, apiID string) (res *model.Schema, err error) {
 	defer wrap(&err)
 
-	data, err := os.ReadFile(filepath.Join(r.BaseDir(ctx), fileNameSchema))
+	files, err := r.List(ctx, apiID)
 	if err != nil {
 		return nil, err
 	}
 
-	s := model.Schema(data)
+	s := joinSchemaFiles(files)
 	return &s, nil
 }
 
+func (r *schemaRepositoryForFS) List(ctx context.Context, apiID string) (res []model.SchemaFile, err error) {
+	defer wrap(&err)
+
+	path := filepath.Join(r.BaseDir(ctx), fileNameSchema)
+	dir := filepath.Join(r.BaseDir(ctx), dirNameSchema)
+
+	if xfilepath.Exist(dir) {
+		if xfilepath.Exist(path) {
+			return nil, fmt.Errorf("%w: both %s and %s exist", model.ErrInvalidValue, path, dir)
+		}
+
+		return listSchemaFiles(dir)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %w", model.ErrNotFound, err)
+		}
+
+		return nil, err
+	}
+
+	return []model.SchemaFile{
+		{
+			Path:    path,
+			Content: string(data),
+		},
+	}, nil
+}
+
 func (r *schemaRepositoryForFS) Save(ctx context.Context, apiID string, schema *model.Schema) (res *model.Schema, err error) {
 	defer wrap(&err)
 
@@ -76,16 +136,234 @@ func (r *schemaRepositoryForFS) Save(ctx context.Context, apiID string, schema *
 		return nil, fmt.Errorf("%w: missing arguments in save schema method", model.ErrNilValue)
 	}
 
-	dir := r.BaseDir(ctx)
-	if !xfilepath.Exist(dir) {
-		if err := os.MkdirAll(dir, 0o755); err != nil {
+	baseDir := r.BaseDir(ctx)
+	path := filepath.Join(baseDir, fileNameSchema)
+	dir := filepath.Join(baseDir, dirNameSchema)
+
+	layout := syncup.SchemaLayout(ctx)
+	if layout == "" {
+		// NOTE: the layout of the existing local schema is kept unless another one is chosen
+		layout = model.SchemaLayoutFile
+		if xfilepath.Exist(dir) {
+			layout = model.SchemaLayoutDirectory
+		}
+	}
+
+	switch layout {
+	case model.SchemaLayoutFile:
+		if !xfilepath.Exist(baseDir) {
+			if err := os.MkdirAll(baseDir, 0o755); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := os.WriteFile(path, []byte(*schema), 0o644); err != nil {
 			return nil, err
 		}
+
+		if err := removeStaleSchemaFiles(dir, nil); err != nil {
+			return nil, err
+		}
+	case model.SchemaLayoutDirectory:
+		files, err := splitSchema(schema)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, content := range files {
+			p := filepath.Join(dir, name)
+			if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+				return nil, err
+			}
+
+			if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := removeStaleSchemaFiles(dir, files); err != nil {
+			return nil, err
+		}
+
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%w: unsupported schema layout %q", model.ErrInvalidValue, layout)
+	}
+
+	return schema, nil
+}
+
+func (r *schemaFileRepositoryForFS) Save(ctx context.Context, apiID string, file *model.SchemaFile) (res *model.SchemaFile, err error) {
+	defer wrap(&err)
+
+	if file == nil {
+		return nil, fmt.Errorf("%w: missing arguments in save schema file method", model.ErrNilValue)
 	}
 
-	if err := os.WriteFile(filepath.Join(dir, fileNameSchema), []byte(*schema), 0o644); err != nil {
+	if err := os.WriteFile(file.Path, []byte(file.Content), 0o644); err != nil {
 		return nil, err
 	}
 
-	return schema, nil
+	return file, nil
+}
+
+// listSchemaFiles reads the *.graphql files in the schema dir in lexical order, so that they are joined deterministically.
+func listSchemaFiles(dir string) ([]model.SchemaFile, error) {
+	files := make([]model.SchemaFile, 0)
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || filepath.Ext(path) != fileExtGraphql {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, model.SchemaFile{Path: path, Content: string(data)})
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("%w: no %s files in %s", model.ErrNotFound, fileExtGraphql, dir)
+	}
+
+	return files, nil
+}
+
+func joinSchemaFiles(files []model.SchemaFile) model.Schema {
+	if len(files) == 1 {
+		return model.Schema(files[0].Content)
+	}
+
+	contents := make([]string, 0, len(files))
+	for _, file := range files {
+		if c := strings.Trim(file.Content, "\n"); c != "" {
+			contents = append(contents, c)
+		}
+	}
+
+	return model.Schema(strings.Join(contents, "\n\n") + "\n")
+}
+
+// splitSchema splits the schema into one file per type, keyed by the path relative to the schema dir.
+// The root operation types are put at the top of the schema dir, and the other types in its types dir.
+func splitSchema(schema *model.Schema) (map[string]string, error) {
+	doc, err := parser.ParseSchema(&ast.Source{Name: fileNameSchema, Input: string(*schema)})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", model.ErrInvalidValue, err)
+	}
+
+	roots := map[string]bool{"Query": true, "Mutation": true, "Subscription": true}
+	if len(doc.Schema) > 0 || len(doc.SchemaExtension) > 0 {
+		roots = make(map[string]bool)
+		for _, def := range append(doc.Schema, doc.SchemaExtension...) {
+			for _, op := range def.OperationTypes {
+				roots[op.Type] = true
+			}
+		}
+	}
+
+	nameOf := func(def *ast.Definition) string {
+		if roots[def.Name] {
+			return def.Name + fileExtGraphql
+		}
+
+		return filepath.Join(dirNameSchemaTypes, def.Name+fileExtGraphql)
+	}
+
+	docs := make(map[string]*ast.SchemaDocument)
+	docOf := func(name string) *ast.SchemaDocument {
+		if _, ok := docs[name]; !ok {
+			docs[name] = new(ast.SchemaDocument)
+		}
+
+		return docs[name]
+	}
+
+	if len(doc.Schema) > 0 || len(doc.SchemaExtension) > 0 {
+		d := docOf(fileNameSchemaDefinition)
+		d.Schema = doc.Schema
+		d.SchemaExtension = doc.SchemaExtension
+	}
+
+	if len(doc.Directives) > 0 {
+		docOf(fileNameSchemaDirectives).Directives = doc.Directives
+	}
+
+	for _, def := range doc.Definitions {
+		d := docOf(nameOf(def))
+		d.Definitions = append(d.Definitions, def)
+	}
+
+	for _, def := range doc.Extensions {
+		d := docOf(nameOf(def))
+		d.Extensions = append(d.Extensions, def)
+	}
+
+	files := make(map[string]string, len(docs))
+	for name, d := range docs {
+		var buf bytes.Buffer
+		formatter.NewFormatter(&buf, formatter.WithComments(), formatter.WithIndent("  ")).FormatSchemaDocument(d)
+		files[name] = buf.String()
+	}
+
+	return files, nil
+}
+
+// removeStaleSchemaFiles removes the *.graphql files in the schema dir other than the kept ones, and then the dirs left empty.
+func removeStaleSchemaFiles(dir string, keep map[string]string) error {
+	if !xfilepath.Exist(dir) {
+		return nil
+	}
+
+	dirs := make([]string, 0)
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			dirs = append(dirs, path)
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := keep[rel]; ok || filepath.Ext(path) != fileExtGraphql {
+			return nil
+		}
+
+		return os.Remove(path)
+	}); err != nil {
+		return err
+	}
+
+	// NOTE: dirs are removed deepest first, and those that still hold other files are kept
+	for i := len(dirs) - 1; i >= 0; i-- {
+		entries, err := os.ReadDir(dirs[i])
+		if err != nil {
+			return err
+		}
+
+		if len(entries) == 0 {
+			if err := os.Remove(dirs[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
@@ -22,10 +22,14 @@ package infrastructure
 
 import (
 	"context"
+	"maps"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/testhelpers"
 	"github.com/stretchr/testify/assert"
@@ -34,6 +38,15 @@ import (
 func Test_schemaRepositoryForFS_Get(t *testing.T) {
 	testdataBaseDir := "../../../../testdata"
 	schema := model.Schema(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema/schema.graphqls")))
+	joined := model.Schema(strings.Join(
+		[]string{
+			string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/Mutation.graphql"))),
+			string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/Query.graphql"))),
+			string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/schema.graphql"))),
+			string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/types/Post.graphql"))),
+		},
+		"\n",
+	))
 
 	type fields struct {
 		baseDir string
@@ -68,7 +81,20 @@ func Test_schemaRepositoryForFS_Get(t *testing.T) {
 			},
 		},
 		{
-			name: "edge path",
+			name: "happy path: directory layout",
+			fields: fields{
+				baseDir: filepath.Join(testdataBaseDir, "schema_directory"),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   &joined,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: non-existing file",
 			fields: fields{
 				baseDir: filepath.Join(testdataBaseDir, "invalidBaseDir"),
 			},
@@ -111,9 +137,130 @@ func Test_schemaRepositoryForFS_Get(t *testing.T) {
 	}
 }
 
+func Test_schemaRepositoryForFS_List(t *testing.T) {
+	testdataBaseDir := "../../../../testdata"
+	schema := testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema/schema.graphqls"))
+
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		apiID string
+	}
+
+	type expected struct {
+		res   []model.SchemaFile
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				baseDir: filepath.Join(testdataBaseDir, "schema"),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res: []model.SchemaFile{
+					{
+						Path:    filepath.Join(testdataBaseDir, "schema", "schema.graphqls"),
+						Content: string(schema),
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: directory layout",
+			fields: fields{
+				baseDir: filepath.Join(testdataBaseDir, "schema_directory"),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res: []model.SchemaFile{
+					{
+						Path:    filepath.Join(testdataBaseDir, "schema_directory", "schema", "Mutation.graphql"),
+						Content: string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/Mutation.graphql"))),
+					},
+					{
+						Path:    filepath.Join(testdataBaseDir, "schema_directory", "schema", "Query.graphql"),
+						Content: string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/Query.graphql"))),
+					},
+					{
+						Path:    filepath.Join(testdataBaseDir, "schema_directory", "schema", "schema.graphql"),
+						Content: string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/schema.graphql"))),
+					},
+					{
+						Path:    filepath.Join(testdataBaseDir, "schema_directory", "schema", "types", "Post.graphql"),
+						Content: string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/types/Post.graphql"))),
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: non-existing file",
+			fields: fields{
+				baseDir: filepath.Join(testdataBaseDir, "invalidBaseDir"),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &schemaRepositoryForFS{
+				baseDir: tt.fields.baseDir,
+			}
+
+			// Act
+			actual, err := r.List(ctx, tt.args.apiID)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
 func Test_schemaRepositoryForFS_Save(t *testing.T) {
 	testdataBaseDir := "../../../../testdata"
 	schema := model.Schema(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema/schema.graphqls")))
+	splitFiles := map[string]string{
+		"schema/schema.graphql":     string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/schema.graphql"))),
+		"schema/Query.graphql":      string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/Query.graphql"))),
+		"schema/Mutation.graphql":   string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/Mutation.graphql"))),
+		"schema/types/Post.graphql": string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema_directory/schema/types/Post.graphql"))),
+	}
 
 	type fields struct {
 		baseDir string
@@ -125,14 +272,18 @@ func Test_schemaRepositoryForFS_Save(t *testing.T) {
 	}
 
 	type expected struct {
-		res   *model.Schema
-		errIs error
+		res      *model.Schema
+		files    map[string]string
+		notExist []string
+		errIs    error
 	}
 
 	tests := []struct {
 		name     string
 		fields   fields
 		args     args
+		layout   model.SchemaLayout
+		existing map[string]string
 		expected expected
 	}{
 		{
@@ -146,6 +297,7 @@ func Test_schemaRepositoryForFS_Save(t *testing.T) {
 			},
 			expected: expected{
 				res:   &schema,
+				files: map[string]string{"schema.graphqls": string(schema)},
 				errIs: nil,
 			},
 		},
@@ -160,9 +312,69 @@ func Test_schemaRepositoryForFS_Save(t *testing.T) {
 			},
 			expected: expected{
 				res:   &schema,
+				files: map[string]string{"schema.graphqls": string(schema)},
 				errIs: nil,
 			},
 		},
+		{
+			name: "happy path: directory layout",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID:  "apiID",
+				schema: &schema,
+			},
+			layout: model.SchemaLayoutDirectory,
+			existing: map[string]string{
+				"schema.graphqls":              string(schema),
+				"schema/types/Comment.graphql": "type Comment {\n  id: ID!\n}\n",
+				"schema/README.md":             "# Schema\n",
+			},
+			expected: expected{
+				res:      &schema,
+				files:    maps.Clone(splitFiles),
+				notExist: []string{"schema.graphqls", "schema/types/Comment.graphql"},
+				errIs:    nil,
+			},
+		},
+		{
+			name: "happy path: keep directory layout",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID:  "apiID",
+				schema: &schema,
+			},
+			existing: map[string]string{
+				"schema/Query.graphql": "type Query {\n  ping: String\n}\n",
+			},
+			expected: expected{
+				res:      &schema,
+				files:    maps.Clone(splitFiles),
+				notExist: []string{"schema.graphqls"},
+				errIs:    nil,
+			},
+		},
+		{
+			name: "happy path: file layout",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID:  "apiID",
+				schema: &schema,
+			},
+			layout:   model.SchemaLayoutFile,
+			existing: maps.Clone(splitFiles),
+			expected: expected{
+				res:      &schema,
+				files:    map[string]string{"schema.graphqls": string(schema)},
+				notExist: []string{"schema"},
+				errIs:    nil,
+			},
+		},
 		{
 			name: "edge path: nil schema",
 			fields: fields{
@@ -177,12 +389,53 @@ func Test_schemaRepositoryForFS_Save(t *testing.T) {
 				errIs: model.ErrNilValue,
 			},
 		},
+		{
+			name: "edge path: unsupported layout",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID:  "apiID",
+				schema: &schema,
+			},
+			layout: model.SchemaLayout("invalid"),
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: invalid schema in directory layout",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID:  "apiID",
+				schema: ptr.Pointer(model.Schema("type Query {")),
+			},
+			layout: model.SchemaLayoutDirectory,
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange
-			ctx := context.Background()
+			ctx := syncup.WithSchemaLayout(context.Background(), tt.layout)
+
+			for name, content := range tt.existing {
+				path := filepath.Join(tt.fields.baseDir, name)
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+
+				if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
 
 			r := &schemaRepositoryForFS{
 				baseDir: tt.fields.baseDir,
@@ -196,7 +449,98 @@ func Test_schemaRepositoryForFS_Save(t *testing.T) {
 
 			if strings.HasPrefix(tt.name, "happy") {
 				assert.NoError(t, err)
+
+				for name, content := range tt.expected.files {
+					assert.Equal(t, content, string(testhelpers.MustReadFile(t, filepath.Join(tt.fields.baseDir, name))), name)
+				}
+
+				for _, name := range tt.expected.notExist {
+					assert.NoFileExists(t, filepath.Join(tt.fields.baseDir, name))
+					assert.NoDirExists(t, filepath.Join(tt.fields.baseDir, name))
+				}
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_schemaFileRepositoryForFS_Save(t *testing.T) {
+	type args struct {
+		apiID string
+		file  *model.SchemaFile
+	}
+
+	type expected struct {
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				apiID: "apiID",
+				file: &model.SchemaFile{
+					Path:    filepath.Join(t.TempDir(), "schema.graphqls"),
+					Content: "type Query {\n  getPost: String\n}\n",
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil file",
+			args: args{
+				apiID: "apiID",
+				file:  nil,
+			},
+			expected: expected{
+				errIs: model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: non-existing dir",
+			args: args{
+				apiID: "apiID",
+				file: &model.SchemaFile{
+					Path:    filepath.Join(t.TempDir(), "notExist", "schema.graphqls"),
+					Content: "type Query {\n  getPost: String\n}\n",
+				},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &schemaFileRepositoryForFS{}
+
+			// Act
+			actual, err := r.Save(ctx, tt.args.apiID, tt.args.file)
+
+			// Assert
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.args.file, actual)
+				assert.Equal(t, tt.args.file.Content, string(testhelpers.MustReadFile(t, tt.args.file.Path)))
 			} else {
+				assert.Nil(t, actual)
+
 				var le *model.LibError
 				assert.ErrorAs(t, err, &le)
 
@@ -0,0 +1,138 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+const (
+	fileNameState = "state.json"
+)
+
+type stateRepositoryForFS struct {
+	baseDir string
+}
+
+var (
+	_ interface {
+		repository.BaseDirProvider
+	} = (*stateRepositoryForFS)(nil)
+)
+
+func NewStateRepositoryForFS() repository.StateRepository {
+	return &stateRepositoryForFS{}
+}
+
+// NOTE: the state always lives in the project base dir, even while a backup is used as the base dir
+func (r *stateRepositoryForFS) BaseDir(ctx context.Context) string {
+	return r.baseDir
+}
+
+func (r *stateRepositoryForFS) SetBaseDir(ctx context.Context, dir string) {
+	r.baseDir = dir
+}
+
+func (r *stateRepositoryForFS) Get(ctx context.Context, apiID string) (res *model.State, err error) {
+	defer wrap(&err)
+
+	states, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	state, ok := states[apiID]
+	if !ok || state == nil {
+		// NOTE: nothing has been synced with the API yet
+		return model.NewState(), nil
+	}
+
+	if state.Functions == nil {
+		state.Functions = make(map[string]string)
+	}
+
+	if state.Resolvers == nil {
+		state.Resolvers = make(map[string]string)
+	}
+
+	return state, nil
+}
+
+func (r *stateRepositoryForFS) Save(ctx context.Context, apiID string, state *model.State) (res *model.State, err error) {
+	defer wrap(&err)
+
+	if state == nil {
+		return nil, fmt.Errorf("%w: missing arguments in save state method", model.ErrNilValue)
+	}
+
+	states, err := r.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	states[apiID] = state
+
+	dir := filepath.Join(r.BaseDir(ctx), dirNameSyncup)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, fileNameState), data, 0o644); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// NOTE: the states of all the APIs synced from the base dir are kept in the same file
+func (r *stateRepositoryForFS) load(ctx context.Context) (res map[string]*model.State, err error) {
+	defer wrap(&err)
+
+	data, err := os.ReadFile(filepath.Join(r.BaseDir(ctx), dirNameSyncup, fileNameState))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return make(map[string]*model.State), nil
+		}
+
+		return nil, err
+	}
+
+	states := make(map[string]*model.State)
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
@@ -0,0 +1,279 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_stateRepositoryForFS_Get(t *testing.T) {
+	baseDir := testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+		".syncup/state.json": `{"apiID":{"functions":{"getPost":"hash1"},"resolvers":{"Query.getPost":"hash2"}},"otherAPIID":{}}`,
+	})
+
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		apiID string
+	}
+
+	type expected struct {
+		res   *model.State
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res: &model.State{
+					Functions: map[string]string{"getPost": "hash1"},
+					Resolvers: map[string]string{"Query.getPost": "hash2"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: API without hashes",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				apiID: "otherAPIID",
+			},
+			expected: expected{
+				res:   model.NewState(),
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: API never synced",
+			fields: fields{
+				baseDir: baseDir,
+			},
+			args: args{
+				apiID: "unknownAPIID",
+			},
+			expected: expected{
+				res:   model.NewState(),
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: state file not found",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   model.NewState(),
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: broken state file",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					".syncup/state.json": `{"apiID":`,
+				}),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &stateRepositoryForFS{
+				baseDir: tt.fields.baseDir,
+			}
+
+			// Act
+			actual, err := r.Get(ctx, tt.args.apiID)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_stateRepositoryForFS_Save(t *testing.T) {
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		apiID string
+		state *model.State
+	}
+
+	type expected struct {
+		res   *model.State
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID: "apiID",
+				state: &model.State{
+					Functions: map[string]string{"getPost": "hash1"},
+					Resolvers: map[string]string{"Query.getPost": "hash2"},
+				},
+			},
+			expected: expected{
+				res: &model.State{
+					Functions: map[string]string{"getPost": "hash1"},
+					Resolvers: map[string]string{"Query.getPost": "hash2"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: keep the states of other APIs",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					".syncup/state.json": `{"otherAPIID":{"functions":{"listPosts":"hash3"},"resolvers":{}}}`,
+				}),
+			},
+			args: args{
+				apiID: "apiID",
+				state: &model.State{
+					Functions: map[string]string{"getPost": "hash1"},
+					Resolvers: map[string]string{},
+				},
+			},
+			expected: expected{
+				res: &model.State{
+					Functions: map[string]string{"getPost": "hash1"},
+					Resolvers: map[string]string{},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil state",
+			fields: fields{
+				baseDir: t.TempDir(),
+			},
+			args: args{
+				apiID: "apiID",
+				state: nil,
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &stateRepositoryForFS{
+				baseDir: tt.fields.baseDir,
+			}
+
+			before, err := r.load(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Act
+			actual, err := r.Save(ctx, tt.args.apiID, tt.args.state)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+
+				state, err := r.Get(ctx, tt.args.apiID)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected.res, state)
+
+				for apiID, s := range before {
+					other, err := r.Get(ctx, apiID)
+					assert.NoError(t, err)
+					assert.Equal(t, s, other)
+				}
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+const (
+	dirNameTests = "tests"
+)
+
+type testRepositoryForFS struct {
+	baseDir string
+}
+
+var (
+	_ interface {
+		repository.BaseDirProvider
+	} = (*testRepositoryForFS)(nil)
+)
+
+func NewTestRepositoryForFS() repository.TestRepository {
+	return &testRepositoryForFS{}
+}
+
+func (r *testRepositoryForFS) BaseDir(ctx context.Context) string {
+	if dir := syncup.BaseDir(ctx); dir != "" {
+		return dir
+	}
+
+	return r.baseDir
+}
+
+func (r *testRepositoryForFS) SetBaseDir(ctx context.Context, dir string) {
+	r.baseDir = dir
+}
+
+func (r *testRepositoryForFS) List(ctx context.Context, apiID string) (res []model.Test, err error) {
+	defer wrap(&err)
+
+	dir := r.BaseDir(ctx)
+
+	fnPaths, err := filepath.Glob(filepath.Join(dir, dirNameFunctions, "*", dirNameTests, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	rslvPaths, err := filepath.Glob(filepath.Join(dir, dirNameResolvers, "*", "*", dirNameTests, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	tests := make([]model.Test, 0, len(fnPaths)+len(rslvPaths))
+	errs := make([]error, 0)
+
+	for _, path := range fnPaths {
+		// NOTE: functions/<name>/tests/<test>.json
+		name := filepath.Base(filepath.Dir(filepath.Dir(path)))
+		test, err := readTest(path, model.ResourceKindFunction, name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		tests = append(tests, *test)
+	}
+
+	for _, path := range rslvPaths {
+		// NOTE: resolvers/<type name>/<field name>/tests/<test>.json
+		fieldDir := filepath.Dir(filepath.Dir(path))
+		identifier := fmt.Sprintf("%s.%s", filepath.Base(filepath.Dir(fieldDir)), filepath.Base(fieldDir))
+		test, err := readTest(path, model.ResourceKindResolver, identifier)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		tests = append(tests, *test)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return tests, nil
+}
+
+func readTest(path string, kind model.ResourceKind, identifier string) (*model.Test, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	test := new(model.Test)
+	if err := json.Unmarshal(data, test); err != nil {
+		return nil, fmt.Errorf("%w: test %s: %w", model.ErrInvalidValue, path, err)
+	}
+
+	if test.Expected.Request == nil && test.Expected.Response == nil {
+		return nil, fmt.Errorf("%w: test %s expects neither request nor response", model.ErrInvalidValue, path)
+	}
+
+	if test.Context == nil {
+		test.Context = json.RawMessage("{}")
+	}
+
+	test.Kind = kind
+	test.Identifier = identifier
+	test.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	return test, nil
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_testRepositoryForFS_List(t *testing.T) {
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		apiID string
+	}
+
+	type expected struct {
+		res   []model.Test
+		errIs error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"functions/getPost/tests/found.json":           `{"context":{"arguments":{"id":"1"}},"expected":{"request":{"id":"1"}}}`,
+					"resolvers/Query/getPost/tests/notFound.json":  `{"context":{"result":null},"expected":{"response":null}}`,
+					"resolvers/Query/getPost/tests/noContext.json": `{"expected":{"request":{},"response":{}}}`,
+				}),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res: []model.Test{
+					{
+						Kind:       model.ResourceKindFunction,
+						Identifier: "getPost",
+						Name:       "found",
+						Context:    json.RawMessage(`{"arguments":{"id":"1"}}`),
+						Expected: model.TestExpected{
+							Request: json.RawMessage(`{"id":"1"}`),
+						},
+					},
+					{
+						Kind:       model.ResourceKindResolver,
+						Identifier: "Query.getPost",
+						Name:       "noContext",
+						Context:    json.RawMessage(`{}`),
+						Expected: model.TestExpected{
+							Request:  json.RawMessage(`{}`),
+							Response: json.RawMessage(`{}`),
+						},
+					},
+					{
+						Kind:       model.ResourceKindResolver,
+						Identifier: "Query.getPost",
+						Name:       "notFound",
+						Context:    json.RawMessage(`{"result":null}`),
+						Expected: model.TestExpected{
+							Response: json.RawMessage(`null`),
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: no tests",
+			fields: fields{
+				baseDir: filepath.Join(t.TempDir(), "notExist"),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   []model.Test{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: invalid JSON",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"functions/getPost/tests/invalid.json": `{`,
+				}),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: nothing expected",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), map[string]string{
+					"resolvers/Query/getPost/tests/empty.json": `{"context":{}}`,
+				}),
+			},
+			args: args{
+				apiID: "apiID",
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			r := &testRepositoryForFS{
+				baseDir: tt.fields.baseDir,
+			}
+
+			// Act
+			actual, err := r.List(ctx, tt.args.apiID)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
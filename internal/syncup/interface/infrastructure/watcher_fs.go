@@ -0,0 +1,190 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/fsnotify/fsnotify"
+)
+
+type watcherRepositoryForFS struct {
+	baseDir string
+}
+
+var (
+	_ interface {
+		repository.BaseDirProvider
+	} = (*watcherRepositoryForFS)(nil)
+)
+
+func NewWatcherRepositoryForFS() repository.WatcherRepository {
+	return &watcherRepositoryForFS{}
+}
+
+func (r *watcherRepositoryForFS) BaseDir(ctx context.Context) string {
+	if dir := syncup.BaseDir(ctx); dir != "" {
+		return dir
+	}
+
+	return r.baseDir
+}
+
+func (r *watcherRepositoryForFS) SetBaseDir(ctx context.Context, dir string) {
+	r.baseDir = dir
+}
+
+func (r *watcherRepositoryForFS) Watch(ctx context.Context, debounce time.Duration, fn func(changes []model.ResourceChange)) (err error) {
+	defer wrap(&err)
+
+	if fn == nil {
+		return fmt.Errorf("%w: missing arguments in watch method", model.ErrNilValue)
+	}
+
+	root := r.BaseDir(ctx)
+	if root == "" {
+		root = "."
+	}
+
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	if err := addWatchDirs(w, root); err != nil {
+		return err
+	}
+
+	changes := make([]model.ResourceChange, 0)
+	var flush <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+
+			return err
+		case e, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+
+			if e.Op == fsnotify.Chmod {
+				continue
+			}
+
+			// NOTE: fsnotify is not recursive, so dirs created while watching (e.g. a new function) are added here
+			if e.Has(fsnotify.Create) {
+				if info, err := os.Stat(e.Name); err == nil && info.IsDir() {
+					if err := addWatchDirs(w, e.Name); err != nil {
+						return err
+					}
+				}
+			}
+
+			change, ok := resourceChangeOf(root, e.Name)
+			if !ok {
+				continue
+			}
+
+			if !slices.Contains(changes, *change) {
+				changes = append(changes, *change)
+			}
+
+			// NOTE: editors often write a file in several steps, so changes are flushed once the files settle
+			flush = time.After(debounce)
+		case <-flush:
+			fn(changes)
+
+			changes = make([]model.ResourceChange, 0)
+			flush = nil
+		}
+	}
+}
+
+func addWatchDirs(w *fsnotify.Watcher, root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		if path != root && isHiddenFile(d.Name()) {
+			return filepath.SkipDir
+		}
+
+		return w.Add(path)
+	})
+}
+
+// resourceChangeOf maps a path in the base dir to the resource it belongs to.
+func resourceChangeOf(root string, path string) (*model.ResourceChange, bool) {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return nil, false
+	}
+
+	// NOTE: hidden files include the .syncup dir as well as editor swap files
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	if slices.ContainsFunc(parts, isHiddenFile) {
+		return nil, false
+	}
+
+	switch {
+	case len(parts) >= 3 && parts[0] == dirNameFunctions && parts[2] == dirNameTests,
+		len(parts) >= 4 && parts[0] == dirNameResolvers && parts[3] == dirNameTests:
+		// NOTE: test fixtures are not pushed
+		return nil, false
+	case len(parts) == 1 && parts[0] == fileNameGraphqlApi:
+		return &model.ResourceChange{Kind: model.ResourceKindGraphqlApi}, true
+	case len(parts) == 1 && isEnvironmentVariablesFileName(parts[0]):
+		return &model.ResourceChange{Kind: model.ResourceKindEnvironmentVariables}, true
+	case len(parts) == 1 && parts[0] == fileNameSchema,
+		parts[0] == dirNameSchema:
+		return &model.ResourceChange{Kind: model.ResourceKindSchema}, true
+	case len(parts) >= 2 && parts[0] == dirNameDataSources:
+		return &model.ResourceChange{Kind: model.ResourceKindDataSource, Identifier: parts[1]}, true
+	case len(parts) >= 2 && parts[0] == dirNameFunctions:
+		return &model.ResourceChange{Kind: model.ResourceKindFunction, Identifier: parts[1]}, true
+	case len(parts) >= 3 && parts[0] == dirNameResolvers:
+		return &model.ResourceChange{Kind: model.ResourceKindResolver, Identifier: fmt.Sprintf("%s.%s", parts[1], parts[2])}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package infrastructure
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_watcherRepositoryForFS_Watch(t *testing.T) {
+	files := map[string]string{
+		"api.json":                              `{"name":"API"}`,
+		"env.json":                              `{"key":"value"}`,
+		"schema.graphqls":                       "type Query {\n  getPost: Post\n}\n",
+		"datasources/PostTable/metadata.json":   `{"name":"PostTable"}`,
+		"functions/getPost/metadata.json":       `{"name":"getPost"}`,
+		"functions/getPost/code.js":             "export function request(ctx) {}\n",
+		"resolvers/Query/getPost/metadata.json": `{"typeName":"Query","fieldName":"getPost"}`,
+		"resolvers/Query/getPost/code.js":       "export function request(ctx) {}\n",
+	}
+
+	type fields struct {
+		baseDir string
+	}
+
+	type args struct {
+		debounce time.Duration
+		nilFn    bool
+	}
+
+	type expected struct {
+		changes []model.ResourceChange
+		errIs   error
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		writes   map[string]string
+		expected expected
+	}{
+		{
+			name: "happy path: resolver code",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), files),
+			},
+			args: args{
+				debounce: 100 * time.Millisecond,
+			},
+			writes: map[string]string{
+				"resolvers/Query/getPost/code.js": "export function request(ctx) { return {} }\n",
+			},
+			expected: expected{
+				changes: []model.ResourceChange{
+					{Kind: model.ResourceKindResolver, Identifier: "Query.getPost"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: debounce several changes",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), files),
+			},
+			args: args{
+				debounce: 100 * time.Millisecond,
+			},
+			writes: map[string]string{
+				"api.json":                            `{"name":"NewAPI"}`,
+				"env.json":                            `{"key":"newValue"}`,
+				"schema.graphqls":                     "type Query {\n  getPost(id: ID!): Post\n}\n",
+				"datasources/PostTable/metadata.json": `{"name":"PostTable","description":"posts"}`,
+				"functions/getPost/metadata.json":     `{"name":"getPost","description":"get a post"}`,
+				"functions/getPost/code.js":           "export function request(ctx) { return {} }\n",
+			},
+			expected: expected{
+				changes: []model.ResourceChange{
+					{Kind: model.ResourceKindGraphqlApi},
+					{Kind: model.ResourceKindEnvironmentVariables},
+					{Kind: model.ResourceKindSchema},
+					{Kind: model.ResourceKindDataSource, Identifier: "PostTable"},
+					{Kind: model.ResourceKindFunction, Identifier: "getPost"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: new function",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), files),
+			},
+			args: args{
+				debounce: 100 * time.Millisecond,
+			},
+			writes: map[string]string{
+				"functions/listPosts/metadata.json": `{"name":"listPosts"}`,
+				"functions/listPosts/code.js":       "export function request(ctx) {}\n",
+			},
+			expected: expected{
+				changes: []model.ResourceChange{
+					{Kind: model.ResourceKindFunction, Identifier: "listPosts"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: environment variables overlay",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), files),
+			},
+			args: args{
+				debounce: 100 * time.Millisecond,
+			},
+			writes: map[string]string{
+				"env.prod.json": `{"key":"prodValue"}`,
+			},
+			expected: expected{
+				changes: []model.ResourceChange{
+					{Kind: model.ResourceKindEnvironmentVariables},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: ignore hidden and unknown files",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), files),
+			},
+			args: args{
+				debounce: 100 * time.Millisecond,
+			},
+			writes: map[string]string{
+				".syncup/backups/apiID/20231101T090000.000Z/schema.graphqls": "type Query {\n  hello: String\n}\n",
+				"resolvers/Query/getPost/.code.js.swp":                       "swap",
+				"README.md":                                                  "notes",
+				"env.json":                                                   `{"key":"newValue"}`,
+			},
+			expected: expected{
+				changes: []model.ResourceChange{
+					{Kind: model.ResourceKindEnvironmentVariables},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: ignore test fixtures",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), files),
+			},
+			args: args{
+				debounce: 100 * time.Millisecond,
+			},
+			writes: map[string]string{
+				"functions/getPost/tests/found.json":       `{"expected":{"request":{}}}`,
+				"resolvers/Query/getPost/tests/found.json": `{"expected":{"request":{}}}`,
+				"env.json": `{"key":"newValue"}`,
+			},
+			expected: expected{
+				changes: []model.ResourceChange{
+					{Kind: model.ResourceKindEnvironmentVariables},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: schema dir",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), files),
+			},
+			args: args{
+				debounce: 100 * time.Millisecond,
+			},
+			writes: map[string]string{
+				"schema/Query.graphql":      "type Query {\n  getPost: Post\n}\n",
+				"schema/types/Post.graphql": "type Post {\n  id: ID!\n}\n",
+			},
+			expected: expected{
+				changes: []model.ResourceChange{
+					{Kind: model.ResourceKindSchema},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: nil fn",
+			fields: fields{
+				baseDir: testhelpers.MustWriteFiles(t, t.TempDir(), files),
+			},
+			args: args{
+				debounce: 100 * time.Millisecond,
+				nilFn:    true,
+			},
+			expected: expected{
+				changes: nil,
+				errIs:   model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: base dir not found",
+			fields: fields{
+				baseDir: filepath.Join(t.TempDir(), "notFound"),
+			},
+			args: args{
+				debounce: 100 * time.Millisecond,
+			},
+			expected: expected{
+				changes: nil,
+				errIs:   nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			r := &watcherRepositoryForFS{
+				baseDir: tt.fields.baseDir,
+			}
+
+			var actual []model.ResourceChange
+			fn := func(changes []model.ResourceChange) {
+				actual = changes
+				cancel()
+			}
+			if tt.args.nilFn {
+				fn = nil
+			}
+
+			done := make(chan error)
+
+			// Act
+			go func() {
+				done <- r.Watch(ctx, tt.args.debounce, fn)
+			}()
+
+			// NOTE: give the watcher time to register the dirs before writing
+			time.Sleep(100 * time.Millisecond)
+			testhelpers.MustWriteFiles(t, tt.fields.baseDir, tt.writes)
+
+			err := <-done
+
+			// Assert
+			assert.ElementsMatch(t, tt.expected.changes, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
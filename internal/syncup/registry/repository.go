@@ -44,14 +44,39 @@ type repo struct {
 	version *model.Version
 
 	trackerRepository repository.TrackerRepository
+	reportRepository  repository.ReportRepository
 
 	mfaTokenProviderRepository repository.MFATokenProviderRepository
 
+	confirmationRepository repository.ConfirmationRepository
+
+	editorRepository repository.EditorRepository
+
+	configRepositoryForFS repository.ConfigRepository
+
+	backupRepositoryForFS repository.BackupRepository
+
+	stateRepositoryForFS repository.StateRepository
+
+	resourceRepositoryForFS repository.ResourceRepository
+
+	watcherRepositoryForFS repository.WatcherRepository
+
+	evaluationRepositoryForAppSync repository.EvaluationRepository
+	testRepositoryForFS            repository.TestRepository
+
+	graphqlApiRepositoryForAppSync repository.GraphqlApiRepository
+	graphqlApiRepositoryForFS      repository.GraphqlApiRepository
+
 	environmentVariablesRepositoryForAppSync repository.EnvironmentVariablesRepository
 	environmentVariablesRepositoryForFS      repository.EnvironmentVariablesRepository
 
 	schemaRepositoryForAppSync repository.SchemaRepository
 	schemaRepositoryForFS      repository.SchemaRepository
+	schemaFileRepositoryForFS  repository.SchemaFileRepository
+
+	dataSourceRepositoryForAppSync repository.DataSourceRepository
+	dataSourceRepositoryForFS      repository.DataSourceRepository
 
 	functionRepositoryForAppSync repository.FunctionRepository
 	functionRepositoryForFS      repository.FunctionRepository
@@ -77,13 +102,39 @@ func NewRepository() repository.Repository {
 		trackerRepository = console.NewTrackerRepositoryForTerminal(os.Stderr)
 	}
 
+	trackerReportRepository := console.NewTrackerRepositoryForReport(trackerRepository)
+
 	mfaTokenProviderRepository := console.NewMFATokenProviderRepository()
 
+	confirmationRepository := console.NewConfirmationRepository()
+
+	editorRepository := console.NewEditorRepository()
+
+	configRepositoryForFS := infrastructure.NewConfigRepositoryForFS()
+
+	backupRepositoryForFS := infrastructure.NewBackupRepositoryForFS()
+
+	stateRepositoryForFS := infrastructure.NewStateRepositoryForFS()
+
+	resourceRepositoryForFS := infrastructure.NewResourceRepositoryForFS()
+
+	watcherRepositoryForFS := infrastructure.NewWatcherRepositoryForFS()
+
+	evaluationRepositoryForAppSync := infrastructure.NewEvaluationRepositoryForAppSync()
+	testRepositoryForFS := infrastructure.NewTestRepositoryForFS()
+
+	graphqlApiRepositoryForAppSync := infrastructure.NewGraphqlApiRepositoryForAppSync()
+	graphqlApiRepositoryForFS := infrastructure.NewGraphqlApiRepositoryForFS()
+
 	environmentVariablesRepositoryForAppSync := infrastructure.NewEnvironmentVariablesRepositoryForAppSync()
-	environmentVariablesRepositoryForFS := infrastructure.NewEnvironmentVariablesRepositoryForFS()
+	environmentVariablesRepositoryForFS := infrastructure.NewEnvironmentVariablesRepositoryForAge(infrastructure.NewEnvironmentVariablesRepositoryForFS())
 
 	schemaRepositoryForAppSync := infrastructure.NewSchemaRepositoryForAppSync()
 	schemaRepositoryForFS := infrastructure.NewSchemaRepositoryForFS()
+	schemaFileRepositoryForFS := infrastructure.NewSchemaFileRepositoryForFS()
+
+	dataSourceRepositoryForAppSync := infrastructure.NewDataSourceRepositoryForAppSync()
+	dataSourceRepositoryForFS := infrastructure.NewDataSourceRepositoryForFS()
 
 	functionRepositoryForAppSync := infrastructure.NewFunctionRepositoryForAppSync()
 	functionRepositoryForFS := infrastructure.NewFunctionRepositoryForFS()
@@ -94,15 +145,40 @@ func NewRepository() repository.Repository {
 	return &repo{
 		version: version,
 
-		trackerRepository: trackerRepository,
+		trackerRepository: trackerReportRepository,
+		reportRepository:  trackerReportRepository,
 
 		mfaTokenProviderRepository: mfaTokenProviderRepository,
 
+		confirmationRepository: confirmationRepository,
+
+		editorRepository: editorRepository,
+
+		configRepositoryForFS: configRepositoryForFS,
+
+		backupRepositoryForFS: backupRepositoryForFS,
+
+		stateRepositoryForFS: stateRepositoryForFS,
+
+		resourceRepositoryForFS: resourceRepositoryForFS,
+
+		watcherRepositoryForFS: watcherRepositoryForFS,
+
+		evaluationRepositoryForAppSync: evaluationRepositoryForAppSync,
+		testRepositoryForFS:            testRepositoryForFS,
+
+		graphqlApiRepositoryForAppSync: graphqlApiRepositoryForAppSync,
+		graphqlApiRepositoryForFS:      graphqlApiRepositoryForFS,
+
 		environmentVariablesRepositoryForAppSync: environmentVariablesRepositoryForAppSync,
 		environmentVariablesRepositoryForFS:      environmentVariablesRepositoryForFS,
 
 		schemaRepositoryForAppSync: schemaRepositoryForAppSync,
 		schemaRepositoryForFS:      schemaRepositoryForFS,
+		schemaFileRepositoryForFS:  schemaFileRepositoryForFS,
+
+		dataSourceRepositoryForAppSync: dataSourceRepositoryForAppSync,
+		dataSourceRepositoryForFS:      dataSourceRepositoryForFS,
 
 		functionRepositoryForAppSync: functionRepositoryForAppSync,
 		functionRepositoryForFS:      functionRepositoryForFS,
@@ -118,17 +194,41 @@ func (r *repo) repositories() []any {
 
 		r.MFATokenProviderRepository(),
 
+		r.ConfirmationRepository(),
+
+		r.EditorRepository(),
+
+		r.ConfigRepositoryForFS(),
+
+		r.GraphqlApiRepositoryForAppSync(),
+		r.GraphqlApiRepositoryForFS(),
+
 		r.EnvironmentVariablesRepositoryForAppSync(),
 		r.EnvironmentVariablesRepositoryForFS(),
 
 		r.SchemaRepositoryForAppSync(),
 		r.SchemaRepositoryForFS(),
+		r.SchemaFileRepositoryForFS(),
+
+		r.DataSourceRepositoryForAppSync(),
+		r.DataSourceRepositoryForFS(),
 
 		r.FunctionRepositoryForAppSync(),
 		r.FunctionRepositoryForFS(),
 
 		r.ResolverRepositoryForAppSync(),
 		r.ResolverRepositoryForFS(),
+
+		r.BackupRepositoryForFS(),
+
+		r.StateRepositoryForFS(),
+
+		r.ResourceRepositoryForFS(),
+
+		r.WatcherRepositoryForFS(),
+
+		r.EvaluationRepositoryForAppSync(),
+		r.TestRepositoryForFS(),
 	}
 }
 
@@ -170,10 +270,58 @@ func (r *repo) TrackerRepository() repository.TrackerRepository {
 	return r.trackerRepository
 }
 
+func (r *repo) ReportRepository() repository.ReportRepository {
+	return r.reportRepository
+}
+
 func (r *repo) MFATokenProviderRepository() repository.MFATokenProviderRepository {
 	return r.mfaTokenProviderRepository
 }
 
+func (r *repo) ConfirmationRepository() repository.ConfirmationRepository {
+	return r.confirmationRepository
+}
+
+func (r *repo) EditorRepository() repository.EditorRepository {
+	return r.editorRepository
+}
+
+func (r *repo) ConfigRepositoryForFS() repository.ConfigRepository {
+	return r.configRepositoryForFS
+}
+
+func (r *repo) BackupRepositoryForFS() repository.BackupRepository {
+	return r.backupRepositoryForFS
+}
+
+func (r *repo) StateRepositoryForFS() repository.StateRepository {
+	return r.stateRepositoryForFS
+}
+
+func (r *repo) ResourceRepositoryForFS() repository.ResourceRepository {
+	return r.resourceRepositoryForFS
+}
+
+func (r *repo) WatcherRepositoryForFS() repository.WatcherRepository {
+	return r.watcherRepositoryForFS
+}
+
+func (r *repo) EvaluationRepositoryForAppSync() repository.EvaluationRepository {
+	return r.evaluationRepositoryForAppSync
+}
+
+func (r *repo) TestRepositoryForFS() repository.TestRepository {
+	return r.testRepositoryForFS
+}
+
+func (r *repo) GraphqlApiRepositoryForAppSync() repository.GraphqlApiRepository {
+	return r.graphqlApiRepositoryForAppSync
+}
+
+func (r *repo) GraphqlApiRepositoryForFS() repository.GraphqlApiRepository {
+	return r.graphqlApiRepositoryForFS
+}
+
 func (r *repo) EnvironmentVariablesRepositoryForAppSync() repository.EnvironmentVariablesRepository {
 	return r.environmentVariablesRepositoryForAppSync
 }
@@ -190,6 +338,18 @@ func (r *repo) SchemaRepositoryForFS() repository.SchemaRepository {
 	return r.schemaRepositoryForFS
 }
 
+func (r *repo) SchemaFileRepositoryForFS() repository.SchemaFileRepository {
+	return r.schemaFileRepositoryForFS
+}
+
+func (r *repo) DataSourceRepositoryForAppSync() repository.DataSourceRepository {
+	return r.dataSourceRepositoryForAppSync
+}
+
+func (r *repo) DataSourceRepositoryForFS() repository.DataSourceRepository {
+	return r.dataSourceRepositoryForFS
+}
+
 func (r *repo) FunctionRepositoryForAppSync() repository.FunctionRepository {
 	return r.functionRepositoryForAppSync
 }
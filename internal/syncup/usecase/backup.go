@@ -0,0 +1,249 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/service"
+)
+
+type BackupInput struct {
+	APIID string
+	Keep  int
+}
+
+type BackupOutput struct {
+	Backup *model.Backup
+}
+
+type BackupUseCase interface {
+	Execute(ctx context.Context, params *BackupInput) (*BackupOutput, error)
+}
+
+type backupUseCase struct {
+	resolverService                          service.ResolverService
+	trackerRepository                        repository.TrackerRepository
+	backupRepositoryForFS                    repository.BackupRepository
+	graphqlApiRepositoryForAppSync           repository.GraphqlApiRepository
+	graphqlApiRepositoryForFS                repository.GraphqlApiRepository
+	environmentVariablesRepositoryForAppSync repository.EnvironmentVariablesRepository
+	environmentVariablesRepositoryForFS      repository.EnvironmentVariablesRepository
+	schemaRepositoryForAppSync               repository.SchemaRepository
+	schemaRepositoryForFS                    repository.SchemaRepository
+	dataSourceRepositoryForAppSync           repository.DataSourceRepository
+	dataSourceRepositoryForFS                repository.DataSourceRepository
+	functionRepositoryForAppSync             repository.FunctionRepository
+	functionRepositoryForFS                  repository.FunctionRepository
+	resolverRepositoryForAppSync             repository.ResolverRepository
+	resolverRepositoryForFS                  repository.ResolverRepository
+}
+
+func NewBackupUseCase(repo repository.Repository) BackupUseCase {
+	return &backupUseCase{
+		resolverService:                          service.NewResolverService(repo),
+		trackerRepository:                        repo.TrackerRepository(),
+		backupRepositoryForFS:                    repo.BackupRepositoryForFS(),
+		graphqlApiRepositoryForAppSync:           repo.GraphqlApiRepositoryForAppSync(),
+		graphqlApiRepositoryForFS:                repo.GraphqlApiRepositoryForFS(),
+		environmentVariablesRepositoryForAppSync: repo.EnvironmentVariablesRepositoryForAppSync(),
+		environmentVariablesRepositoryForFS:      repo.EnvironmentVariablesRepositoryForFS(),
+		schemaRepositoryForAppSync:               repo.SchemaRepositoryForAppSync(),
+		schemaRepositoryForFS:                    repo.SchemaRepositoryForFS(),
+		dataSourceRepositoryForAppSync:           repo.DataSourceRepositoryForAppSync(),
+		dataSourceRepositoryForFS:                repo.DataSourceRepositoryForFS(),
+		functionRepositoryForAppSync:             repo.FunctionRepositoryForAppSync(),
+		functionRepositoryForFS:                  repo.FunctionRepositoryForFS(),
+		resolverRepositoryForAppSync:             repo.ResolverRepositoryForAppSync(),
+		resolverRepositoryForFS:                  repo.ResolverRepositoryForFS(),
+	}
+}
+
+func (uc *backupUseCase) Execute(ctx context.Context, params *BackupInput) (res *BackupOutput, err error) {
+	defer wrap(&err)
+
+	if params.Keep < 1 {
+		return nil, fmt.Errorf("%w: number of backups to keep %d", model.ErrInvalidValue, params.Keep)
+	}
+
+	uc.trackerRepository.InProgress(ctx, "backing up remote resources")
+
+	backup, err := uc.backupRepositoryForFS.Create(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to create backup")
+		return nil, err
+	}
+
+	// NOTE: the FS repositories write into the backup while the snapshot is taken, with the environment variables in full rather than as an overlay
+	if err := uc.snapshot(syncup.WithEnvironment(syncup.WithBaseDir(ctx, backup.Dir), ""), params); err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to back up remote resources")
+
+		// NOTE: an incomplete backup must not be restored later
+		if derr := uc.backupRepositoryForFS.Delete(ctx, params.APIID, backup.Timestamp); derr != nil {
+			return nil, errors.Join(err, derr)
+		}
+
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "pruning old backups")
+
+	if err := uc.prune(ctx, params); err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to prune old backups")
+		return nil, err
+	}
+
+	uc.trackerRepository.Success(ctx, fmt.Sprintf("backed up remote resources to %s", backup.Dir))
+
+	return &BackupOutput{Backup: backup}, nil
+}
+
+func (uc *backupUseCase) snapshot(ctx context.Context, params *BackupInput) (err error) {
+	defer wrap(&err)
+
+	api, err := uc.graphqlApiRepositoryForAppSync.Get(ctx, params.APIID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := uc.graphqlApiRepositoryForFS.Save(ctx, params.APIID, api); err != nil {
+		return err
+	}
+
+	// NOTE: the values may be secrets, so they are backed up only if they can be encrypted and left out otherwise
+	if len(syncup.AgeRecipients(ctx)) > 0 {
+		variables, err := uc.environmentVariablesRepositoryForAppSync.Get(ctx, params.APIID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := uc.environmentVariablesRepositoryForFS.Save(ctx, params.APIID, variables); err != nil {
+			return err
+		}
+	}
+
+	schema, err := uc.schemaRepositoryForAppSync.Get(ctx, params.APIID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := uc.schemaRepositoryForFS.Save(ctx, params.APIID, schema); err != nil {
+		return err
+	}
+
+	dataSources, err := uc.dataSourceRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		return err
+	}
+
+	functions, err := uc.functionRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		return err
+	}
+
+	resolvers, err := uc.resolverRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+	g := syncup.WorkerPool(ctx).Group()
+	errs := make([]error, 0)
+
+	appendErr := func(err error) {
+		mu.Lock()
+		errs = append(errs, err)
+		mu.Unlock()
+	}
+
+	for _, ds := range dataSources {
+		ds := ds
+		g.Go(func() {
+			if _, err := uc.dataSourceRepositoryForFS.Save(ctx, params.APIID, &ds); err != nil {
+				appendErr(err)
+			}
+		})
+	}
+
+	for _, fn := range functions {
+		fn := fn
+		g.Go(func() {
+			if _, err := uc.functionRepositoryForFS.Save(ctx, params.APIID, &fn); err != nil {
+				appendErr(err)
+			}
+		})
+	}
+
+	for _, rslv := range resolvers {
+		rslv := rslv
+		g.Go(func() {
+			if err := uc.resolverService.ResolvePipelineConfigFunctionNames(ctx, &rslv, functions); err != nil {
+				appendErr(err)
+				return
+			}
+
+			if _, err := uc.resolverRepositoryForFS.Save(ctx, params.APIID, &rslv); err != nil {
+				appendErr(err)
+			}
+		})
+	}
+
+	g.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (uc *backupUseCase) prune(ctx context.Context, params *BackupInput) (err error) {
+	defer wrap(&err)
+
+	backups, err := uc.backupRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: a backup being restored is still read after this backup, so it must survive the pruning
+	inUse := syncup.BaseDir(ctx)
+
+	// NOTE: backups are listed oldest first
+	for i := 0; i < len(backups)-params.Keep; i++ {
+		if backups[i].Dir == inUse {
+			continue
+		}
+
+		if err := uc.backupRepositoryForFS.Delete(ctx, params.APIID, backups[i].Timestamp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,1326 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	mock_service "github.com/Aton-Kish/syncup/internal/syncup/domain/service/mock"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_backupUseCase_Execute(t *testing.T) {
+	testdataBaseDir := "../../../testdata"
+	graphqlApi := testhelpers.MustUnmarshalJSON[model.GraphqlApi](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "graphql_api/api.json")))
+	variables := testhelpers.MustUnmarshalJSON[model.EnvironmentVariables](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "environment_variables/env.json")))
+	schema := model.Schema(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema/schema.graphqls")))
+	dataSourceAMAZON_DYNAMODB := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AMAZON_DYNAMODB/metadata.json")))
+	dataSourceAWS_LAMBDA := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AWS_LAMBDA/metadata.json")))
+	functionVTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Function](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/VTL_2018-05-29/metadata.json")))
+	functionVTL_2018_05_29.FunctionId = ptr.Pointer("VTL_2018-05-29")
+	functionAPPSYNC_JS_1_0_0 := testhelpers.MustUnmarshalJSON[model.Function](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/APPSYNC_JS_1.0.0/metadata.json")))
+	functionAPPSYNC_JS_1_0_0.FunctionId = ptr.Pointer("APPSYNC_JS_1.0.0")
+	resolverUNIT_VTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Resolver](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/UNIT/VTL_2018-05-29/metadata.json")))
+	resolverPIPELINE_VTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Resolver](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/PIPELINE/VTL_2018-05-29/metadata.json")))
+	resolverPIPELINE_VTL_2018_05_29.PipelineConfig.FunctionNames = nil
+	resolverPIPELINE_VTL_2018_05_29.PipelineConfig.Functions = []string{"VTL_2018-05-29", "APPSYNC_JS_1.0.0"}
+	oldBackup := model.Backup{APIID: "apiID", Timestamp: "20231009T120000.000Z", Dir: ".syncup/backups/apiID/20231009T120000.000Z"}
+	backup := model.Backup{APIID: "apiID", Timestamp: "20231101T090000.000Z", Dir: ".syncup/backups/apiID/20231101T090000.000Z"}
+
+	type args struct {
+		params *BackupInput
+	}
+
+	type mockBackupRepositoryForFSCreateReturn struct {
+		res *model.Backup
+		err error
+	}
+	type mockBackupRepositoryForFSCreate struct {
+		calls   int
+		returns []mockBackupRepositoryForFSCreateReturn
+	}
+
+	type mockGraphqlApiRepositoryForAppSyncGetReturn struct {
+		res *model.GraphqlApi
+		err error
+	}
+	type mockGraphqlApiRepositoryForAppSyncGet struct {
+		calls   int
+		returns []mockGraphqlApiRepositoryForAppSyncGetReturn
+	}
+
+	type mockGraphqlApiRepositoryForFSSaveReturn struct {
+		res *model.GraphqlApi
+		err error
+	}
+	type mockGraphqlApiRepositoryForFSSave struct {
+		calls   int
+		returns []mockGraphqlApiRepositoryForFSSaveReturn
+	}
+
+	type mockEnvironmentVariablesRepositoryForAppSyncGetReturn struct {
+		res model.EnvironmentVariables
+		err error
+	}
+	type mockEnvironmentVariablesRepositoryForAppSyncGet struct {
+		calls   int
+		returns []mockEnvironmentVariablesRepositoryForAppSyncGetReturn
+	}
+
+	type mockEnvironmentVariablesRepositoryForFSSaveReturn struct {
+		res model.EnvironmentVariables
+		err error
+	}
+	type mockEnvironmentVariablesRepositoryForFSSave struct {
+		calls   int
+		returns []mockEnvironmentVariablesRepositoryForFSSaveReturn
+	}
+
+	type mockSchemaRepositoryForAppSyncGetReturn struct {
+		res *model.Schema
+		err error
+	}
+	type mockSchemaRepositoryForAppSyncGet struct {
+		calls   int
+		returns []mockSchemaRepositoryForAppSyncGetReturn
+	}
+
+	type mockSchemaRepositoryForFSSaveReturn struct {
+		res *model.Schema
+		err error
+	}
+	type mockSchemaRepositoryForFSSave struct {
+		calls   int
+		returns []mockSchemaRepositoryForFSSaveReturn
+	}
+
+	type mockDataSourceRepositoryForAppSyncListReturn struct {
+		res []model.DataSource
+		err error
+	}
+	type mockDataSourceRepositoryForAppSyncList struct {
+		calls   int
+		returns []mockDataSourceRepositoryForAppSyncListReturn
+	}
+
+	type mockFunctionRepositoryForAppSyncListReturn struct {
+		res []model.Function
+		err error
+	}
+	type mockFunctionRepositoryForAppSyncList struct {
+		calls   int
+		returns []mockFunctionRepositoryForAppSyncListReturn
+	}
+
+	type mockResolverRepositoryForAppSyncListReturn struct {
+		res []model.Resolver
+		err error
+	}
+	type mockResolverRepositoryForAppSyncList struct {
+		calls   int
+		returns []mockResolverRepositoryForAppSyncListReturn
+	}
+
+	type mockDataSourceRepositoryForFSSaveReturn struct {
+		res *model.DataSource
+		err error
+	}
+	type mockDataSourceRepositoryForFSSave struct {
+		calls   int
+		returns []mockDataSourceRepositoryForFSSaveReturn
+	}
+
+	type mockFunctionRepositoryForFSSaveReturn struct {
+		res *model.Function
+		err error
+	}
+	type mockFunctionRepositoryForFSSave struct {
+		calls   int
+		returns []mockFunctionRepositoryForFSSaveReturn
+	}
+
+	type mockResolverServiceResolvePipelineConfigFunctionNamesReturn struct {
+		err error
+	}
+	type mockResolverServiceResolvePipelineConfigFunctionNames struct {
+		calls   int
+		returns []mockResolverServiceResolvePipelineConfigFunctionNamesReturn
+	}
+
+	type mockResolverRepositoryForFSSaveReturn struct {
+		res *model.Resolver
+		err error
+	}
+	type mockResolverRepositoryForFSSave struct {
+		calls   int
+		returns []mockResolverRepositoryForFSSaveReturn
+	}
+
+	type mockBackupRepositoryForFSListReturn struct {
+		res []model.Backup
+		err error
+	}
+	type mockBackupRepositoryForFSList struct {
+		calls   int
+		returns []mockBackupRepositoryForFSListReturn
+	}
+
+	type mockBackupRepositoryForFSDeleteReturn struct {
+		err error
+	}
+	type mockBackupRepositoryForFSDelete struct {
+		calls   int
+		returns []mockBackupRepositoryForFSDeleteReturn
+	}
+
+	type expected struct {
+		res   *BackupOutput
+		errIs error
+	}
+
+	tests := []struct {
+		name                                                  string
+		baseDir                                               string
+		ageRecipients                                         []string
+		args                                                  args
+		mockBackupRepositoryForFSCreate                       mockBackupRepositoryForFSCreate
+		mockGraphqlApiRepositoryForAppSyncGet                 mockGraphqlApiRepositoryForAppSyncGet
+		mockGraphqlApiRepositoryForFSSave                     mockGraphqlApiRepositoryForFSSave
+		mockEnvironmentVariablesRepositoryForAppSyncGet       mockEnvironmentVariablesRepositoryForAppSyncGet
+		mockEnvironmentVariablesRepositoryForFSSave           mockEnvironmentVariablesRepositoryForFSSave
+		mockSchemaRepositoryForAppSyncGet                     mockSchemaRepositoryForAppSyncGet
+		mockSchemaRepositoryForFSSave                         mockSchemaRepositoryForFSSave
+		mockDataSourceRepositoryForAppSyncList                mockDataSourceRepositoryForAppSyncList
+		mockFunctionRepositoryForAppSyncList                  mockFunctionRepositoryForAppSyncList
+		mockResolverRepositoryForAppSyncList                  mockResolverRepositoryForAppSyncList
+		mockDataSourceRepositoryForFSSave                     mockDataSourceRepositoryForFSSave
+		mockFunctionRepositoryForFSSave                       mockFunctionRepositoryForFSSave
+		mockResolverServiceResolvePipelineConfigFunctionNames mockResolverServiceResolvePipelineConfigFunctionNames
+		mockResolverRepositoryForFSSave                       mockResolverRepositoryForFSSave
+		mockBackupRepositoryForFSList                         mockBackupRepositoryForFSList
+		mockBackupRepositoryForFSDelete                       mockBackupRepositoryForFSDelete
+		expected                                              expected
+	}{
+		{
+			name:          "happy path: no backups to prune",
+			ageRecipients: []string{"age1alice"},
+			args: args{
+				params: &BackupInput{
+					APIID: "apiID",
+					Keep:  2,
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{
+					{res: &backup, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{res: []model.DataSource{dataSourceAMAZON_DYNAMODB, dataSourceAWS_LAMBDA}, err: nil},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{res: []model.Function{functionVTL_2018_05_29, functionAPPSYNC_JS_1_0_0}, err: nil},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{res: []model.Resolver{resolverUNIT_VTL_2018_05_29, resolverPIPELINE_VTL_2018_05_29}, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{res: &dataSourceAMAZON_DYNAMODB, err: nil},
+					{res: &dataSourceAWS_LAMBDA, err: nil},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{
+					{res: &functionVTL_2018_05_29, err: nil},
+					{res: &functionAPPSYNC_JS_1_0_0, err: nil},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{err: nil},
+					{err: nil},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{
+					{res: &resolverUNIT_VTL_2018_05_29, err: nil},
+					{res: &resolverPIPELINE_VTL_2018_05_29, err: nil},
+				},
+			},
+			mockBackupRepositoryForFSList: mockBackupRepositoryForFSList{
+				returns: []mockBackupRepositoryForFSListReturn{
+					{res: []model.Backup{oldBackup, backup}, err: nil},
+				},
+			},
+			mockBackupRepositoryForFSDelete: mockBackupRepositoryForFSDelete{
+				returns: []mockBackupRepositoryForFSDeleteReturn{},
+			},
+			expected: expected{
+				res:   &BackupOutput{Backup: &backup},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: leave out the environment variables without age recipients",
+			args: args{
+				params: &BackupInput{
+					APIID: "apiID",
+					Keep:  2,
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{
+					{res: &backup, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{res: []model.DataSource{dataSourceAMAZON_DYNAMODB, dataSourceAWS_LAMBDA}, err: nil},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{res: []model.Function{functionVTL_2018_05_29, functionAPPSYNC_JS_1_0_0}, err: nil},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{res: []model.Resolver{resolverUNIT_VTL_2018_05_29, resolverPIPELINE_VTL_2018_05_29}, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{res: &dataSourceAMAZON_DYNAMODB, err: nil},
+					{res: &dataSourceAWS_LAMBDA, err: nil},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{
+					{res: &functionVTL_2018_05_29, err: nil},
+					{res: &functionAPPSYNC_JS_1_0_0, err: nil},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{err: nil},
+					{err: nil},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{
+					{res: &resolverUNIT_VTL_2018_05_29, err: nil},
+					{res: &resolverPIPELINE_VTL_2018_05_29, err: nil},
+				},
+			},
+			mockBackupRepositoryForFSList: mockBackupRepositoryForFSList{
+				returns: []mockBackupRepositoryForFSListReturn{
+					{res: []model.Backup{oldBackup, backup}, err: nil},
+				},
+			},
+			mockBackupRepositoryForFSDelete: mockBackupRepositoryForFSDelete{
+				returns: []mockBackupRepositoryForFSDeleteReturn{},
+			},
+			expected: expected{
+				res:   &BackupOutput{Backup: &backup},
+				errIs: nil,
+			},
+		},
+		{
+			name:          "happy path: prune backups beyond the retention",
+			ageRecipients: []string{"age1alice"},
+			args: args{
+				params: &BackupInput{
+					APIID: "apiID",
+					Keep:  1,
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{
+					{res: &backup, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{res: []model.DataSource{dataSourceAMAZON_DYNAMODB, dataSourceAWS_LAMBDA}, err: nil},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{res: []model.Function{functionVTL_2018_05_29, functionAPPSYNC_JS_1_0_0}, err: nil},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{res: []model.Resolver{resolverUNIT_VTL_2018_05_29, resolverPIPELINE_VTL_2018_05_29}, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{res: &dataSourceAMAZON_DYNAMODB, err: nil},
+					{res: &dataSourceAWS_LAMBDA, err: nil},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{
+					{res: &functionVTL_2018_05_29, err: nil},
+					{res: &functionAPPSYNC_JS_1_0_0, err: nil},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{err: nil},
+					{err: nil},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{
+					{res: &resolverUNIT_VTL_2018_05_29, err: nil},
+					{res: &resolverPIPELINE_VTL_2018_05_29, err: nil},
+				},
+			},
+			mockBackupRepositoryForFSList: mockBackupRepositoryForFSList{
+				returns: []mockBackupRepositoryForFSListReturn{
+					{res: []model.Backup{oldBackup, backup}, err: nil},
+				},
+			},
+			mockBackupRepositoryForFSDelete: mockBackupRepositoryForFSDelete{
+				returns: []mockBackupRepositoryForFSDeleteReturn{
+					{err: nil},
+				},
+			},
+			expected: expected{
+				res:   &BackupOutput{Backup: &backup},
+				errIs: nil,
+			},
+		},
+		{
+			name:          "happy path: keep the backup being restored",
+			baseDir:       oldBackup.Dir,
+			ageRecipients: []string{"age1alice"},
+			args: args{
+				params: &BackupInput{
+					APIID: "apiID",
+					Keep:  1,
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{
+					{res: &backup, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{res: []model.DataSource{dataSourceAMAZON_DYNAMODB, dataSourceAWS_LAMBDA}, err: nil},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{res: []model.Function{functionVTL_2018_05_29, functionAPPSYNC_JS_1_0_0}, err: nil},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{res: []model.Resolver{resolverUNIT_VTL_2018_05_29, resolverPIPELINE_VTL_2018_05_29}, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{res: &dataSourceAMAZON_DYNAMODB, err: nil},
+					{res: &dataSourceAWS_LAMBDA, err: nil},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{
+					{res: &functionVTL_2018_05_29, err: nil},
+					{res: &functionAPPSYNC_JS_1_0_0, err: nil},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{err: nil},
+					{err: nil},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{
+					{res: &resolverUNIT_VTL_2018_05_29, err: nil},
+					{res: &resolverPIPELINE_VTL_2018_05_29, err: nil},
+				},
+			},
+			mockBackupRepositoryForFSList: mockBackupRepositoryForFSList{
+				returns: []mockBackupRepositoryForFSListReturn{
+					{res: []model.Backup{oldBackup, backup}, err: nil},
+				},
+			},
+			mockBackupRepositoryForFSDelete: mockBackupRepositoryForFSDelete{
+				returns: []mockBackupRepositoryForFSDeleteReturn{},
+			},
+			expected: expected{
+				res:   &BackupOutput{Backup: &backup},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: invalid retention",
+			args: args{
+				params: &BackupInput{
+					APIID: "apiID",
+					Keep:  0,
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockBackupRepositoryForFSList: mockBackupRepositoryForFSList{
+				returns: []mockBackupRepositoryForFSListReturn{},
+			},
+			mockBackupRepositoryForFSDelete: mockBackupRepositoryForFSDelete{
+				returns: []mockBackupRepositoryForFSDeleteReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: BackupRepositoryForFS.Create error",
+			args: args{
+				params: &BackupInput{
+					APIID: "apiID",
+					Keep:  2,
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{
+					{res: nil, err: errors.New("test")},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockBackupRepositoryForFSList: mockBackupRepositoryForFSList{
+				returns: []mockBackupRepositoryForFSListReturn{},
+			},
+			mockBackupRepositoryForFSDelete: mockBackupRepositoryForFSDelete{
+				returns: []mockBackupRepositoryForFSDeleteReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: GraphqlApiRepositoryForAppSync.Get error",
+			args: args{
+				params: &BackupInput{
+					APIID: "apiID",
+					Keep:  2,
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{
+					{res: &backup, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{res: nil, err: errors.New("test")},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockBackupRepositoryForFSList: mockBackupRepositoryForFSList{
+				returns: []mockBackupRepositoryForFSListReturn{},
+			},
+			mockBackupRepositoryForFSDelete: mockBackupRepositoryForFSDelete{
+				returns: []mockBackupRepositoryForFSDeleteReturn{
+					{err: nil},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name:          "edge path: FunctionRepositoryForFS.Save error",
+			ageRecipients: []string{"age1alice"},
+			args: args{
+				params: &BackupInput{
+					APIID: "apiID",
+					Keep:  2,
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{
+					{res: &backup, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{res: []model.DataSource{dataSourceAMAZON_DYNAMODB, dataSourceAWS_LAMBDA}, err: nil},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{res: []model.Function{functionVTL_2018_05_29, functionAPPSYNC_JS_1_0_0}, err: nil},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{res: []model.Resolver{resolverUNIT_VTL_2018_05_29, resolverPIPELINE_VTL_2018_05_29}, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{res: &dataSourceAMAZON_DYNAMODB, err: nil},
+					{res: &dataSourceAWS_LAMBDA, err: nil},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{
+					{res: &functionVTL_2018_05_29, err: nil},
+					{res: nil, err: errors.New("test")},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{err: nil},
+					{err: nil},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{
+					{res: &resolverUNIT_VTL_2018_05_29, err: nil},
+					{res: &resolverPIPELINE_VTL_2018_05_29, err: nil},
+				},
+			},
+			mockBackupRepositoryForFSList: mockBackupRepositoryForFSList{
+				returns: []mockBackupRepositoryForFSListReturn{},
+			},
+			mockBackupRepositoryForFSDelete: mockBackupRepositoryForFSDelete{
+				returns: []mockBackupRepositoryForFSDeleteReturn{
+					{err: nil},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name:          "edge path: BackupRepositoryForFS.Delete error after a failed snapshot",
+			ageRecipients: []string{"age1alice"},
+			args: args{
+				params: &BackupInput{
+					APIID: "apiID",
+					Keep:  2,
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{
+					{res: &backup, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{res: nil, err: errors.New("test")},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockBackupRepositoryForFSList: mockBackupRepositoryForFSList{
+				returns: []mockBackupRepositoryForFSListReturn{},
+			},
+			mockBackupRepositoryForFSDelete: mockBackupRepositoryForFSDelete{
+				returns: []mockBackupRepositoryForFSDeleteReturn{
+					{err: errors.New("test")},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name:          "edge path: BackupRepositoryForFS.List error",
+			ageRecipients: []string{"age1alice"},
+			args: args{
+				params: &BackupInput{
+					APIID: "apiID",
+					Keep:  2,
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{
+					{res: &backup, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{res: []model.DataSource{dataSourceAMAZON_DYNAMODB, dataSourceAWS_LAMBDA}, err: nil},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{res: []model.Function{functionVTL_2018_05_29, functionAPPSYNC_JS_1_0_0}, err: nil},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{res: []model.Resolver{resolverUNIT_VTL_2018_05_29, resolverPIPELINE_VTL_2018_05_29}, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{res: &dataSourceAMAZON_DYNAMODB, err: nil},
+					{res: &dataSourceAWS_LAMBDA, err: nil},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{
+					{res: &functionVTL_2018_05_29, err: nil},
+					{res: &functionAPPSYNC_JS_1_0_0, err: nil},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{err: nil},
+					{err: nil},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{
+					{res: &resolverUNIT_VTL_2018_05_29, err: nil},
+					{res: &resolverPIPELINE_VTL_2018_05_29, err: nil},
+				},
+			},
+			mockBackupRepositoryForFSList: mockBackupRepositoryForFSList{
+				returns: []mockBackupRepositoryForFSListReturn{
+					{res: nil, err: errors.New("test")},
+				},
+			},
+			mockBackupRepositoryForFSDelete: mockBackupRepositoryForFSDelete{
+				returns: []mockBackupRepositoryForFSDeleteReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			if tt.baseDir != "" {
+				ctx = syncup.WithBaseDir(ctx, tt.baseDir)
+			}
+			ctx = syncup.WithAgeRecipients(ctx, tt.ageRecipients)
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var mu sync.Mutex
+
+			mockResolverService := mock_service.NewMockResolverService(ctrl)
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockBackupRepositoryForFS := mock_repository.NewMockBackupRepository(ctrl)
+			mockGraphqlApiRepositoryForAppSync := mock_repository.NewMockGraphqlApiRepository(ctrl)
+			mockGraphqlApiRepositoryForFS := mock_repository.NewMockGraphqlApiRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForAppSync := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForFS := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockSchemaRepositoryForAppSync := mock_repository.NewMockSchemaRepository(ctrl)
+			mockSchemaRepositoryForFS := mock_repository.NewMockSchemaRepository(ctrl)
+			mockDataSourceRepositoryForAppSync := mock_repository.NewMockDataSourceRepository(ctrl)
+			mockDataSourceRepositoryForFS := mock_repository.NewMockDataSourceRepository(ctrl)
+			mockFunctionRepositoryForAppSync := mock_repository.NewMockFunctionRepository(ctrl)
+			mockFunctionRepositoryForFS := mock_repository.NewMockFunctionRepository(ctrl)
+			mockResolverRepositoryForAppSync := mock_repository.NewMockResolverRepository(ctrl)
+			mockResolverRepositoryForFS := mock_repository.NewMockResolverRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockBackupRepositoryForFS.
+				EXPECT().
+				Create(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.Backup, error) {
+					r := tt.mockBackupRepositoryForFSCreate.returns[tt.mockBackupRepositoryForFSCreate.calls]
+					tt.mockBackupRepositoryForFSCreate.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockBackupRepositoryForFSCreate.returns))
+
+			// NOTE: the snapshot is taken with a context pointing at the backup dir
+			mockGraphqlApiRepositoryForAppSync.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.GraphqlApi, error) {
+					r := tt.mockGraphqlApiRepositoryForAppSyncGet.returns[tt.mockGraphqlApiRepositoryForAppSyncGet.calls]
+					tt.mockGraphqlApiRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForAppSyncGet.returns))
+
+			mockGraphqlApiRepositoryForFS.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, api *model.GraphqlApi) (*model.GraphqlApi, error) {
+					r := tt.mockGraphqlApiRepositoryForFSSave.returns[tt.mockGraphqlApiRepositoryForFSSave.calls]
+					tt.mockGraphqlApiRepositoryForFSSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForFSSave.returns))
+
+			mockEnvironmentVariablesRepositoryForAppSync.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForAppSyncGet.returns[tt.mockEnvironmentVariablesRepositoryForAppSyncGet.calls]
+					tt.mockEnvironmentVariablesRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForAppSyncGet.returns))
+
+			mockEnvironmentVariablesRepositoryForFS.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, variables model.EnvironmentVariables) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForFSSave.returns[tt.mockEnvironmentVariablesRepositoryForFSSave.calls]
+					tt.mockEnvironmentVariablesRepositoryForFSSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForFSSave.returns))
+
+			mockSchemaRepositoryForAppSync.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForAppSyncGet.returns[tt.mockSchemaRepositoryForAppSyncGet.calls]
+					tt.mockSchemaRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForAppSyncGet.returns))
+
+			mockSchemaRepositoryForFS.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, schema *model.Schema) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForFSSave.returns[tt.mockSchemaRepositoryForFSSave.calls]
+					tt.mockSchemaRepositoryForFSSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForFSSave.returns))
+
+			mockDataSourceRepositoryForAppSync.
+				EXPECT().
+				List(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.DataSource, error) {
+					r := tt.mockDataSourceRepositoryForAppSyncList.returns[tt.mockDataSourceRepositoryForAppSyncList.calls]
+					tt.mockDataSourceRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDataSourceRepositoryForAppSyncList.returns))
+
+			mockFunctionRepositoryForAppSync.
+				EXPECT().
+				List(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
+					r := tt.mockFunctionRepositoryForAppSyncList.returns[tt.mockFunctionRepositoryForAppSyncList.calls]
+					tt.mockFunctionRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForAppSyncList.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				List(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForAppSyncList.returns[tt.mockResolverRepositoryForAppSyncList.calls]
+					tt.mockResolverRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForAppSyncList.returns))
+
+			mockDataSourceRepositoryForFS.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, dataSource *model.DataSource) (*model.DataSource, error) {
+					mu.Lock()
+					r := tt.mockDataSourceRepositoryForFSSave.returns[tt.mockDataSourceRepositoryForFSSave.calls]
+					tt.mockDataSourceRepositoryForFSSave.calls++
+					mu.Unlock()
+					return r.res, r.err
+				}).
+				MaxTimes(len(tt.mockDataSourceRepositoryForFSSave.returns))
+
+			mockFunctionRepositoryForFS.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, function *model.Function) (*model.Function, error) {
+					mu.Lock()
+					r := tt.mockFunctionRepositoryForFSSave.returns[tt.mockFunctionRepositoryForFSSave.calls]
+					tt.mockFunctionRepositoryForFSSave.calls++
+					mu.Unlock()
+					return r.res, r.err
+				}).
+				MaxTimes(len(tt.mockFunctionRepositoryForFSSave.returns))
+
+			mockResolverService.
+				EXPECT().
+				ResolvePipelineConfigFunctionNames(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, resolver *model.Resolver, functions []model.Function) error {
+					mu.Lock()
+					r := tt.mockResolverServiceResolvePipelineConfigFunctionNames.returns[tt.mockResolverServiceResolvePipelineConfigFunctionNames.calls]
+					tt.mockResolverServiceResolvePipelineConfigFunctionNames.calls++
+					mu.Unlock()
+					return r.err
+				}).
+				MaxTimes(len(tt.mockResolverServiceResolvePipelineConfigFunctionNames.returns))
+
+			mockResolverRepositoryForFS.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, resolver *model.Resolver) (*model.Resolver, error) {
+					mu.Lock()
+					r := tt.mockResolverRepositoryForFSSave.returns[tt.mockResolverRepositoryForFSSave.calls]
+					tt.mockResolverRepositoryForFSSave.calls++
+					mu.Unlock()
+					return r.res, r.err
+				}).
+				MaxTimes(len(tt.mockResolverRepositoryForFSSave.returns))
+
+			mockBackupRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Backup, error) {
+					r := tt.mockBackupRepositoryForFSList.returns[tt.mockBackupRepositoryForFSList.calls]
+					tt.mockBackupRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockBackupRepositoryForFSList.returns))
+
+			mockBackupRepositoryForFS.
+				EXPECT().
+				Delete(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, timestamp string) error {
+					r := tt.mockBackupRepositoryForFSDelete.returns[tt.mockBackupRepositoryForFSDelete.calls]
+					tt.mockBackupRepositoryForFSDelete.calls++
+					return r.err
+				}).
+				Times(len(tt.mockBackupRepositoryForFSDelete.returns))
+
+			uc := &backupUseCase{
+				resolverService:                          mockResolverService,
+				trackerRepository:                        mockTrackerRepository,
+				backupRepositoryForFS:                    mockBackupRepositoryForFS,
+				graphqlApiRepositoryForAppSync:           mockGraphqlApiRepositoryForAppSync,
+				graphqlApiRepositoryForFS:                mockGraphqlApiRepositoryForFS,
+				environmentVariablesRepositoryForAppSync: mockEnvironmentVariablesRepositoryForAppSync,
+				environmentVariablesRepositoryForFS:      mockEnvironmentVariablesRepositoryForFS,
+				schemaRepositoryForAppSync:               mockSchemaRepositoryForAppSync,
+				schemaRepositoryForFS:                    mockSchemaRepositoryForFS,
+				dataSourceRepositoryForAppSync:           mockDataSourceRepositoryForAppSync,
+				dataSourceRepositoryForFS:                mockDataSourceRepositoryForFS,
+				functionRepositoryForAppSync:             mockFunctionRepositoryForAppSync,
+				functionRepositoryForFS:                  mockFunctionRepositoryForFS,
+				resolverRepositoryForAppSync:             mockResolverRepositoryForAppSync,
+				resolverRepositoryForFS:                  mockResolverRepositoryForFS,
+			}
+
+			// Act
+			actual, err := uc.Execute(ctx, tt.args.params)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
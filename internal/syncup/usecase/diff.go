@@ -0,0 +1,417 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/service"
+)
+
+type DiffInput struct {
+	APIID                     string
+	Direction                 model.DiffDirection
+	DeleteExtraneousResources bool
+	SortSchema                bool
+	Filter                    *model.Filter
+}
+
+type DiffOutput struct {
+	Diffs []model.Diff
+}
+
+type DiffUseCase interface {
+	Execute(ctx context.Context, params *DiffInput) (*DiffOutput, error)
+}
+
+type diffUseCase struct {
+	diffService                              service.DiffService
+	filterService                            service.FilterService
+	resolverService                          service.ResolverService
+	schemaService                            service.SchemaService
+	trackerRepository                        repository.TrackerRepository
+	graphqlApiRepositoryForAppSync           repository.GraphqlApiRepository
+	graphqlApiRepositoryForFS                repository.GraphqlApiRepository
+	environmentVariablesRepositoryForAppSync repository.EnvironmentVariablesRepository
+	environmentVariablesRepositoryForFS      repository.EnvironmentVariablesRepository
+	schemaRepositoryForAppSync               repository.SchemaRepository
+	schemaRepositoryForFS                    repository.SchemaRepository
+	dataSourceRepositoryForAppSync           repository.DataSourceRepository
+	dataSourceRepositoryForFS                repository.DataSourceRepository
+	functionRepositoryForAppSync             repository.FunctionRepository
+	functionRepositoryForFS                  repository.FunctionRepository
+	resolverRepositoryForAppSync             repository.ResolverRepository
+	resolverRepositoryForFS                  repository.ResolverRepository
+}
+
+func NewDiffUseCase(repo repository.Repository) DiffUseCase {
+	return &diffUseCase{
+		diffService:                              service.NewDiffService(repo),
+		filterService:                            service.NewFilterService(repo),
+		resolverService:                          service.NewResolverService(repo),
+		schemaService:                            service.NewSchemaService(repo),
+		trackerRepository:                        repo.TrackerRepository(),
+		graphqlApiRepositoryForAppSync:           repo.GraphqlApiRepositoryForAppSync(),
+		graphqlApiRepositoryForFS:                repo.GraphqlApiRepositoryForFS(),
+		environmentVariablesRepositoryForAppSync: repo.EnvironmentVariablesRepositoryForAppSync(),
+		environmentVariablesRepositoryForFS:      repo.EnvironmentVariablesRepositoryForFS(),
+		schemaRepositoryForAppSync:               repo.SchemaRepositoryForAppSync(),
+		schemaRepositoryForFS:                    repo.SchemaRepositoryForFS(),
+		dataSourceRepositoryForAppSync:           repo.DataSourceRepositoryForAppSync(),
+		dataSourceRepositoryForFS:                repo.DataSourceRepositoryForFS(),
+		functionRepositoryForAppSync:             repo.FunctionRepositoryForAppSync(),
+		functionRepositoryForFS:                  repo.FunctionRepositoryForFS(),
+		resolverRepositoryForAppSync:             repo.ResolverRepositoryForAppSync(),
+		resolverRepositoryForFS:                  repo.ResolverRepositoryForFS(),
+	}
+}
+
+func (uc *diffUseCase) Execute(ctx context.Context, params *DiffInput) (res *DiffOutput, err error) {
+	defer wrap(&err)
+
+	switch params.Direction {
+	case model.DiffDirectionPush, model.DiffDirectionPull:
+	default:
+		return nil, fmt.Errorf("%w: direction %s", model.ErrInvalidValue, params.Direction)
+	}
+
+	if params.Filter != nil {
+		if err := uc.filterService.Validate(ctx, params.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	diffs := make([]model.Diff, 0)
+
+	if matchKind(ctx, uc.filterService, params.Filter, model.ResourceKindGraphqlApi) {
+		apiDiff, err := uc.diffGraphqlApi(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		if apiDiff != nil {
+			diffs = append(diffs, *apiDiff)
+		}
+	}
+
+	if matchKind(ctx, uc.filterService, params.Filter, model.ResourceKindEnvironmentVariables) {
+		envDiff, err := uc.diffEnvironmentVariables(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		if envDiff != nil {
+			diffs = append(diffs, *envDiff)
+		}
+	}
+
+	if matchKind(ctx, uc.filterService, params.Filter, model.ResourceKindSchema) {
+		schemaDiff, err := uc.diffSchema(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		if schemaDiff != nil {
+			diffs = append(diffs, *schemaDiff)
+		}
+	}
+
+	dsDiffs, err := uc.diffDataSources(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	dsDiffs, _ = filterResources(ctx, uc.filterService, params.Filter, model.ResourceKindDataSource, dsDiffs, diffIdentifier)
+	diffs = append(diffs, dsDiffs...)
+
+	fnDiffs, fns, err := uc.diffFunctions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	fnDiffs, _ = filterResources(ctx, uc.filterService, params.Filter, model.ResourceKindFunction, fnDiffs, diffIdentifier)
+	diffs = append(diffs, fnDiffs...)
+
+	rslvDiffs, err := uc.diffResolvers(ctx, params, fns)
+	if err != nil {
+		return nil, err
+	}
+
+	rslvDiffs, _ = filterResources(ctx, uc.filterService, params.Filter, model.ResourceKindResolver, rslvDiffs, diffIdentifier)
+	diffs = append(diffs, rslvDiffs...)
+
+	if !params.DeleteExtraneousResources {
+		diffs = excludeDeletions(diffs)
+	}
+
+	return &DiffOutput{Diffs: diffs}, nil
+}
+
+func (uc *diffUseCase) diffGraphqlApi(ctx context.Context, params *DiffInput) (res *model.Diff, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "loading GraphQL API settings")
+
+	local, err := uc.graphqlApiRepositoryForFS.Get(ctx, params.APIID)
+	if err != nil {
+		// NOTE: base dirs pulled before GraphQL API settings were synced have no api.json
+		if errors.Is(err, model.ErrNotFound) {
+			uc.trackerRepository.Success(ctx, "there were no GraphQL API settings")
+			return nil, nil
+		}
+
+		uc.trackerRepository.Failed(ctx, "failed to load GraphQL API settings")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "fetching GraphQL API settings")
+
+	remote, err := uc.graphqlApiRepositoryForAppSync.Get(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch GraphQL API settings")
+		return nil, err
+	}
+
+	src, dst := orient(params.Direction, remote, local)
+	d, err := uc.diffService.DiffGraphqlApi(ctx, src, dst)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to compare GraphQL API settings")
+		return nil, err
+	}
+
+	uc.trackerRepository.Success(ctx, "compared GraphQL API settings")
+
+	return d, nil
+}
+
+func (uc *diffUseCase) diffEnvironmentVariables(ctx context.Context, params *DiffInput) (res *model.Diff, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "fetching environment variables")
+
+	remote, err := uc.environmentVariablesRepositoryForAppSync.Get(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch environment variables")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading environment variables")
+
+	local, err := uc.environmentVariablesRepositoryForFS.Get(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load environment variables")
+		return nil, err
+	}
+
+	src, dst := orient(params.Direction, remote, local)
+	d, err := uc.diffService.DiffEnvironmentVariables(ctx, src, dst)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to compare environment variables")
+		return nil, err
+	}
+
+	uc.trackerRepository.Success(ctx, "compared environment variables")
+
+	return d, nil
+}
+
+func (uc *diffUseCase) diffSchema(ctx context.Context, params *DiffInput) (res *model.Diff, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "fetching schema")
+
+	remote, err := uc.schemaRepositoryForAppSync.Get(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch schema")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading schema")
+
+	local, err := uc.schemaRepositoryForFS.Get(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load schema")
+		return nil, err
+	}
+
+	// NOTE: the local schema may be joined from the files of the schema dir in another order, so that drift is decided as push does
+	equal, err := uc.schemaService.Equal(ctx, remote, local)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to compare schema")
+		return nil, err
+	}
+
+	if equal {
+		uc.trackerRepository.Success(ctx, "compared schema")
+		return nil, nil
+	}
+
+	// NOTE: both schemas are formatted the same way as they are pulled, so that only actual changes are shown
+	files, err := uc.schemaService.Format(ctx, []model.SchemaFile{{Content: string(*remote)}, {Content: string(*local)}}, model.SchemaFormatOptionsWithSort(params.SortSchema))
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to format schema")
+		return nil, err
+	}
+
+	remote = ptr.Pointer(model.Schema(files[0].Content))
+	local = ptr.Pointer(model.Schema(files[1].Content))
+
+	src, dst := orient(params.Direction, remote, local)
+	d, err := uc.diffService.DiffSchema(ctx, src, dst)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to compare schema")
+		return nil, err
+	}
+
+	uc.trackerRepository.Success(ctx, "compared schema")
+
+	return d, nil
+}
+
+func (uc *diffUseCase) diffDataSources(ctx context.Context, params *DiffInput) (res []model.Diff, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "fetching data sources")
+
+	remote, err := uc.dataSourceRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch data sources")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading data sources")
+
+	local, err := uc.dataSourceRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load data sources")
+		return nil, err
+	}
+
+	src, dst := orient(params.Direction, remote, local)
+	diffs, err := uc.diffService.DiffDataSources(ctx, src, dst)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to compare data sources")
+		return nil, err
+	}
+
+	uc.trackerRepository.Success(ctx, "compared data sources")
+
+	return diffs, nil
+}
+
+func (uc *diffUseCase) diffFunctions(ctx context.Context, params *DiffInput) (res []model.Diff, remoteFunctions []model.Function, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "fetching functions")
+
+	remote, err := uc.functionRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch functions")
+		return nil, nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading functions")
+
+	local, err := uc.functionRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load functions")
+		return nil, nil, err
+	}
+
+	src, dst := orient(params.Direction, remote, local)
+	diffs, err := uc.diffService.DiffFunctions(ctx, src, dst)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to compare functions")
+		return nil, nil, err
+	}
+
+	uc.trackerRepository.Success(ctx, "compared functions")
+
+	return diffs, remote, nil
+}
+
+func (uc *diffUseCase) diffResolvers(ctx context.Context, params *DiffInput, remoteFunctions []model.Function) (res []model.Diff, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "fetching resolvers")
+
+	remote, err := uc.resolverRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch resolvers")
+		return nil, err
+	}
+
+	for i := range remote {
+		if err := uc.resolverService.ResolvePipelineConfigFunctionNames(ctx, &remote[i], remoteFunctions); err != nil {
+			uc.trackerRepository.Failed(ctx, "failed to fetch resolvers")
+			return nil, err
+		}
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading resolvers")
+
+	local, err := uc.resolverRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load resolvers")
+		return nil, err
+	}
+
+	src, dst := orient(params.Direction, remote, local)
+	diffs, err := uc.diffService.DiffResolvers(ctx, src, dst)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to compare resolvers")
+		return nil, err
+	}
+
+	uc.trackerRepository.Success(ctx, "compared resolvers")
+
+	return diffs, nil
+}
+
+func orient[T any](direction model.DiffDirection, remote, local T) (src, dst T) {
+	if direction == model.DiffDirectionPull {
+		return local, remote
+	}
+
+	return remote, local
+}
+
+func diffIdentifier(d *model.Diff) string {
+	return d.Identifier
+}
+
+func excludeDeletions(diffs []model.Diff) []model.Diff {
+	res := make([]model.Diff, 0, len(diffs))
+	for _, d := range diffs {
+		if d.Action != model.DiffActionDelete {
+			res = append(res, d)
+		}
+	}
+
+	return res
+}
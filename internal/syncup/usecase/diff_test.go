@@ -0,0 +1,1600 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/service"
+	mock_service "github.com/Aton-Kish/syncup/internal/syncup/domain/service/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_diffUseCase_Execute(t *testing.T) {
+	variables := model.EnvironmentVariables{"key": "value"}
+	graphqlApi := model.GraphqlApi{ApiId: ptr.Pointer("apiID"), AuthenticationType: model.AuthenticationType("API_KEY")}
+	schema := model.Schema("type Query {\n  hello: String\n}\n")
+	dataSource := model.DataSource{Name: ptr.Pointer("PostTable"), Type: model.DataSourceType("AMAZON_DYNAMODB")}
+	function := model.Function{FunctionId: ptr.Pointer("functionID"), Name: ptr.Pointer("getPost")}
+	resolver := model.Resolver{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("getPost")}
+	dataSourceUpdateDiff := model.Diff{Kind: model.ResourceKindDataSource, Identifier: "PostTable", Action: model.DiffActionUpdate}
+	envDiff := model.Diff{Kind: model.ResourceKindEnvironmentVariables, Action: model.DiffActionUpdate}
+	schemaDiff := model.Diff{Kind: model.ResourceKindSchema, Action: model.DiffActionUpdate}
+	functionCreateDiff := model.Diff{Kind: model.ResourceKindFunction, Identifier: "getPost", Action: model.DiffActionCreate}
+	resolverDeleteDiff := model.Diff{Kind: model.ResourceKindResolver, Identifier: "Query.getPost", Action: model.DiffActionDelete}
+
+	type args struct {
+		params *DiffInput
+	}
+
+	type mockFilterServiceValidateReturn struct {
+		err error
+	}
+	type mockFilterServiceValidate struct {
+		calls   int
+		returns []mockFilterServiceValidateReturn
+	}
+
+	type mockFilterServiceMatchReturn struct {
+		res bool
+	}
+	type mockFilterServiceMatch struct {
+		calls   int
+		returns []mockFilterServiceMatchReturn
+	}
+
+	type mockGraphqlApiRepositoryGetReturn struct {
+		res *model.GraphqlApi
+		err error
+	}
+	type mockGraphqlApiRepositoryGet struct {
+		calls   int
+		returns []mockGraphqlApiRepositoryGetReturn
+	}
+
+	type mockEnvironmentVariablesRepositoryGetReturn struct {
+		res model.EnvironmentVariables
+		err error
+	}
+	type mockEnvironmentVariablesRepositoryGet struct {
+		calls   int
+		returns []mockEnvironmentVariablesRepositoryGetReturn
+	}
+
+	type mockSchemaRepositoryGetReturn struct {
+		res *model.Schema
+		err error
+	}
+	type mockSchemaRepositoryGet struct {
+		calls   int
+		returns []mockSchemaRepositoryGetReturn
+	}
+
+	type mockSchemaServiceEqualReturn struct {
+		res bool
+		err error
+	}
+	type mockSchemaServiceEqual struct {
+		calls   int
+		returns []mockSchemaServiceEqualReturn
+	}
+
+	type mockSchemaServiceFormatReturn struct {
+		res []model.SchemaFile
+		err error
+	}
+	type mockSchemaServiceFormat struct {
+		calls   int
+		returns []mockSchemaServiceFormatReturn
+	}
+
+	type mockDataSourceRepositoryListReturn struct {
+		res []model.DataSource
+		err error
+	}
+	type mockDataSourceRepositoryList struct {
+		calls   int
+		returns []mockDataSourceRepositoryListReturn
+	}
+
+	type mockFunctionRepositoryListReturn struct {
+		res []model.Function
+		err error
+	}
+	type mockFunctionRepositoryList struct {
+		calls   int
+		returns []mockFunctionRepositoryListReturn
+	}
+
+	type mockResolverRepositoryListReturn struct {
+		res []model.Resolver
+		err error
+	}
+	type mockResolverRepositoryList struct {
+		calls   int
+		returns []mockResolverRepositoryListReturn
+	}
+
+	type mockResolverServiceResolvePipelineConfigFunctionNamesReturn struct {
+		err error
+	}
+	type mockResolverServiceResolvePipelineConfigFunctionNames struct {
+		calls   int
+		returns []mockResolverServiceResolvePipelineConfigFunctionNamesReturn
+	}
+
+	type mockDiffServiceDiffReturn struct {
+		res *model.Diff
+		err error
+	}
+	type mockDiffServiceDiff struct {
+		calls   int
+		returns []mockDiffServiceDiffReturn
+	}
+
+	type mockDiffServiceDiffsReturn struct {
+		res []model.Diff
+		err error
+	}
+	type mockDiffServiceDiffs struct {
+		calls   int
+		returns []mockDiffServiceDiffsReturn
+	}
+
+	type expected struct {
+		res   *DiffOutput
+		errIs error
+	}
+
+	tests := []struct {
+		name                                                  string
+		args                                                  args
+		mockFilterServiceValidate                             mockFilterServiceValidate
+		mockFilterServiceMatch                                mockFilterServiceMatch
+		mockGraphqlApiRepositoryForAppSyncGet                 mockGraphqlApiRepositoryGet
+		mockGraphqlApiRepositoryForFSGet                      mockGraphqlApiRepositoryGet
+		mockEnvironmentVariablesRepositoryForAppSyncGet       mockEnvironmentVariablesRepositoryGet
+		mockEnvironmentVariablesRepositoryForFSGet            mockEnvironmentVariablesRepositoryGet
+		mockSchemaRepositoryForAppSyncGet                     mockSchemaRepositoryGet
+		mockSchemaRepositoryForFSGet                          mockSchemaRepositoryGet
+		mockSchemaServiceEqual                                mockSchemaServiceEqual
+		mockSchemaServiceFormat                               mockSchemaServiceFormat
+		mockDataSourceRepositoryForAppSyncList                mockDataSourceRepositoryList
+		mockDataSourceRepositoryForFSList                     mockDataSourceRepositoryList
+		mockFunctionRepositoryForAppSyncList                  mockFunctionRepositoryList
+		mockFunctionRepositoryForFSList                       mockFunctionRepositoryList
+		mockResolverRepositoryForAppSyncList                  mockResolverRepositoryList
+		mockResolverRepositoryForFSList                       mockResolverRepositoryList
+		mockResolverServiceResolvePipelineConfigFunctionNames mockResolverServiceResolvePipelineConfigFunctionNames
+		mockDiffServiceDiffGraphqlApi                         mockDiffServiceDiff
+		mockDiffServiceDiffEnvironmentVariables               mockDiffServiceDiff
+		mockDiffServiceDiffSchema                             mockDiffServiceDiff
+		mockDiffServiceDiffDataSources                        mockDiffServiceDiffs
+		mockDiffServiceDiffFunctions                          mockDiffServiceDiffs
+		mockDiffServiceDiffResolvers                          mockDiffServiceDiffs
+		expected                                              expected
+	}{
+		{
+			name: "happy path: without deletions",
+			args: args{
+				params: &DiffInput{
+					APIID:                     "apiID",
+					Direction:                 model.DiffDirectionPush,
+					DeleteExtraneousResources: false,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{{res: &graphqlApi}},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{{res: &graphqlApi}},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{{res: true}},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{}}},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{function}}},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{resolver}}},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{}}},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{{err: nil}},
+			},
+			mockDiffServiceDiffGraphqlApi: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: nil}},
+			},
+			mockDiffServiceDiffEnvironmentVariables: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: &envDiff}},
+			},
+			mockDiffServiceDiffSchema: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffDataSources: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{}}},
+			},
+			mockDiffServiceDiffFunctions: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{functionCreateDiff}}},
+			},
+			mockDiffServiceDiffResolvers: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{resolverDeleteDiff}}},
+			},
+			expected: expected{
+				res: &DiffOutput{
+					Diffs: []model.Diff{envDiff, functionCreateDiff},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: schema changes",
+			args: args{
+				params: &DiffInput{
+					APIID:                     "apiID",
+					Direction:                 model.DiffDirectionPush,
+					DeleteExtraneousResources: false,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{{res: &graphqlApi}},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{{res: &graphqlApi}},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{{res: false}},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{{res: []model.SchemaFile{{Content: string(schema)}, {Content: string(schema)}}}},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{}}},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{function}}},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{resolver}}},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{}}},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{{err: nil}},
+			},
+			mockDiffServiceDiffGraphqlApi: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: nil}},
+			},
+			mockDiffServiceDiffEnvironmentVariables: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: &envDiff}},
+			},
+			mockDiffServiceDiffSchema: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: &schemaDiff}},
+			},
+			mockDiffServiceDiffDataSources: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{}}},
+			},
+			mockDiffServiceDiffFunctions: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{functionCreateDiff}}},
+			},
+			mockDiffServiceDiffResolvers: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{resolverDeleteDiff}}},
+			},
+			expected: expected{
+				res: &DiffOutput{
+					Diffs: []model.Diff{envDiff, schemaDiff, functionCreateDiff},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: with deletions",
+			args: args{
+				params: &DiffInput{
+					APIID:                     "apiID",
+					Direction:                 model.DiffDirectionPull,
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{{res: &graphqlApi}},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{{res: &graphqlApi}},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{{res: true}},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{}}},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{function}}},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{resolver}}},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{}}},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{{err: nil}},
+			},
+			mockDiffServiceDiffGraphqlApi: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: nil}},
+			},
+			mockDiffServiceDiffEnvironmentVariables: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: &envDiff}},
+			},
+			mockDiffServiceDiffSchema: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffDataSources: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{dataSourceUpdateDiff}}},
+			},
+			mockDiffServiceDiffFunctions: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{functionCreateDiff}}},
+			},
+			mockDiffServiceDiffResolvers: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{resolverDeleteDiff}}},
+			},
+			expected: expected{
+				res: &DiffOutput{
+					Diffs: []model.Diff{envDiff, dataSourceUpdateDiff, functionCreateDiff, resolverDeleteDiff},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: filter resources",
+			args: args{
+				params: &DiffInput{
+					APIID:                     "apiID",
+					Direction:                 model.DiffDirectionPull,
+					DeleteExtraneousResources: true,
+					Filter: &model.Filter{
+						Include: []string{"Query.*"},
+					},
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{{err: nil}},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{{res: false}, {res: false}, {res: false}, {res: false}, {res: true}},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{}}},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{function}}},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{resolver}}},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{}}},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{{err: nil}},
+			},
+			mockDiffServiceDiffGraphqlApi: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffEnvironmentVariables: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffSchema: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffDataSources: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{}}},
+			},
+			mockDiffServiceDiffFunctions: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{functionCreateDiff}}},
+			},
+			mockDiffServiceDiffResolvers: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{resolverDeleteDiff}}},
+			},
+			expected: expected{
+				res: &DiffOutput{
+					Diffs: []model.Diff{resolverDeleteDiff},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: without GraphQL API settings",
+			args: args{
+				params: &DiffInput{
+					APIID:                     "apiID",
+					Direction:                 model.DiffDirectionPush,
+					DeleteExtraneousResources: false,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{{err: &model.LibError{Err: model.ErrNotFound}}},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{{res: true}},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{}}},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{function}}},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{resolver}}},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{}}},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{{err: nil}},
+			},
+			mockDiffServiceDiffGraphqlApi: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffEnvironmentVariables: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: &envDiff}},
+			},
+			mockDiffServiceDiffSchema: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffDataSources: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{}}},
+			},
+			mockDiffServiceDiffFunctions: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{functionCreateDiff}}},
+			},
+			mockDiffServiceDiffResolvers: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{resolverDeleteDiff}}},
+			},
+			expected: expected{
+				res: &DiffOutput{
+					Diffs: []model.Diff{envDiff, functionCreateDiff},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: invalid direction",
+			args: args{
+				params: &DiffInput{
+					APIID:     "apiID",
+					Direction: "invalid",
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: filter service validate error",
+			args: args{
+				params: &DiffInput{
+					APIID:                     "apiID",
+					Direction:                 model.DiffDirectionPull,
+					DeleteExtraneousResources: true,
+					Filter: &model.Filter{
+						Include: []string{"Query.["},
+					},
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{{err: &model.LibError{}}},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockDiffServiceDiffGraphqlApi: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffEnvironmentVariables: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffSchema: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffDataSources: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{},
+			},
+			mockDiffServiceDiffFunctions: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{},
+			},
+			mockDiffServiceDiffResolvers: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: function repository for AppSync list error",
+			args: args{
+				params: &DiffInput{
+					APIID:     "apiID",
+					Direction: model.DiffDirectionPush,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{{res: &graphqlApi}},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{{res: &graphqlApi}},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{{res: true}},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{err: &model.LibError{}}},
+			},
+			mockDiffServiceDiffGraphqlApi: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: nil}},
+			},
+			mockDiffServiceDiffEnvironmentVariables: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: nil}},
+			},
+			mockDiffServiceDiffSchema: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffDataSources: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{}}},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: diff service diff resolvers error",
+			args: args{
+				params: &DiffInput{
+					APIID:     "apiID",
+					Direction: model.DiffDirectionPush,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{{res: &graphqlApi}},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryGet{
+				returns: []mockGraphqlApiRepositoryGetReturn{{res: &graphqlApi}},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: variables}},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &schema}},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{{res: true}},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryList{
+				returns: []mockDataSourceRepositoryListReturn{{res: []model.DataSource{dataSource}}},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{function}}},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{{res: []model.Function{function}}},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{}}},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{{res: []model.Resolver{resolver}}},
+			},
+			mockDiffServiceDiffGraphqlApi: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: nil}},
+			},
+			mockDiffServiceDiffEnvironmentVariables: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{{res: nil}},
+			},
+			mockDiffServiceDiffSchema: mockDiffServiceDiff{
+				returns: []mockDiffServiceDiffReturn{},
+			},
+			mockDiffServiceDiffDataSources: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{}}},
+			},
+			mockDiffServiceDiffFunctions: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{res: []model.Diff{}}},
+			},
+			mockDiffServiceDiffResolvers: mockDiffServiceDiffs{
+				returns: []mockDiffServiceDiffsReturn{{err: model.ErrInvalidValue}},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFilterService := mock_service.NewMockFilterService(ctrl)
+			mockDiffService := mock_service.NewMockDiffService(ctrl)
+			mockResolverService := mock_service.NewMockResolverService(ctrl)
+			mockSchemaService := mock_service.NewMockSchemaService(ctrl)
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockGraphqlApiRepositoryForAppSync := mock_repository.NewMockGraphqlApiRepository(ctrl)
+			mockGraphqlApiRepositoryForFS := mock_repository.NewMockGraphqlApiRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForAppSync := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForFS := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockSchemaRepositoryForAppSync := mock_repository.NewMockSchemaRepository(ctrl)
+			mockSchemaRepositoryForFS := mock_repository.NewMockSchemaRepository(ctrl)
+			mockDataSourceRepositoryForAppSync := mock_repository.NewMockDataSourceRepository(ctrl)
+			mockDataSourceRepositoryForFS := mock_repository.NewMockDataSourceRepository(ctrl)
+			mockFunctionRepositoryForAppSync := mock_repository.NewMockFunctionRepository(ctrl)
+			mockFunctionRepositoryForFS := mock_repository.NewMockFunctionRepository(ctrl)
+			mockResolverRepositoryForAppSync := mock_repository.NewMockResolverRepository(ctrl)
+			mockResolverRepositoryForFS := mock_repository.NewMockResolverRepository(ctrl)
+
+			mockFilterService.
+				EXPECT().
+				Validate(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, filter *model.Filter) error {
+					r := tt.mockFilterServiceValidate.returns[tt.mockFilterServiceValidate.calls]
+					tt.mockFilterServiceValidate.calls++
+					return r.err
+				}).
+				Times(len(tt.mockFilterServiceValidate.returns))
+
+			mockFilterService.
+				EXPECT().
+				Match(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, filter *model.Filter, kind model.ResourceKind, identifier string) bool {
+					r := tt.mockFilterServiceMatch.returns[tt.mockFilterServiceMatch.calls]
+					tt.mockFilterServiceMatch.calls++
+					return r.res
+				}).
+				Times(len(tt.mockFilterServiceMatch.returns))
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockGraphqlApiRepositoryForAppSync.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.GraphqlApi, error) {
+					r := tt.mockGraphqlApiRepositoryForAppSyncGet.returns[tt.mockGraphqlApiRepositoryForAppSyncGet.calls]
+					tt.mockGraphqlApiRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForAppSyncGet.returns))
+
+			mockGraphqlApiRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.GraphqlApi, error) {
+					r := tt.mockGraphqlApiRepositoryForFSGet.returns[tt.mockGraphqlApiRepositoryForFSGet.calls]
+					tt.mockGraphqlApiRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForFSGet.returns))
+
+			mockEnvironmentVariablesRepositoryForAppSync.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForAppSyncGet.returns[tt.mockEnvironmentVariablesRepositoryForAppSyncGet.calls]
+					tt.mockEnvironmentVariablesRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForAppSyncGet.returns))
+
+			mockEnvironmentVariablesRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForFSGet.returns[tt.mockEnvironmentVariablesRepositoryForFSGet.calls]
+					tt.mockEnvironmentVariablesRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForFSGet.returns))
+
+			mockSchemaRepositoryForAppSync.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForAppSyncGet.returns[tt.mockSchemaRepositoryForAppSyncGet.calls]
+					tt.mockSchemaRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForAppSyncGet.returns))
+
+			mockSchemaRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForFSGet.returns[tt.mockSchemaRepositoryForFSGet.calls]
+					tt.mockSchemaRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForFSGet.returns))
+
+			mockSchemaService.
+				EXPECT().
+				Equal(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, schema1, schema2 *model.Schema) (bool, error) {
+					r := tt.mockSchemaServiceEqual.returns[tt.mockSchemaServiceEqual.calls]
+					tt.mockSchemaServiceEqual.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaServiceEqual.returns))
+
+			mockSchemaService.
+				EXPECT().
+				Format(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, files []model.SchemaFile, optFns ...func(o *model.SchemaFormatOptions)) ([]model.SchemaFile, error) {
+					r := tt.mockSchemaServiceFormat.returns[tt.mockSchemaServiceFormat.calls]
+					tt.mockSchemaServiceFormat.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaServiceFormat.returns))
+
+			mockDataSourceRepositoryForAppSync.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.DataSource, error) {
+					r := tt.mockDataSourceRepositoryForAppSyncList.returns[tt.mockDataSourceRepositoryForAppSyncList.calls]
+					tt.mockDataSourceRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDataSourceRepositoryForAppSyncList.returns))
+
+			mockDataSourceRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.DataSource, error) {
+					r := tt.mockDataSourceRepositoryForFSList.returns[tt.mockDataSourceRepositoryForFSList.calls]
+					tt.mockDataSourceRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDataSourceRepositoryForFSList.returns))
+
+			mockFunctionRepositoryForAppSync.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
+					r := tt.mockFunctionRepositoryForAppSyncList.returns[tt.mockFunctionRepositoryForAppSyncList.calls]
+					tt.mockFunctionRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForAppSyncList.returns))
+
+			mockFunctionRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
+					r := tt.mockFunctionRepositoryForFSList.returns[tt.mockFunctionRepositoryForFSList.calls]
+					tt.mockFunctionRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForFSList.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForAppSyncList.returns[tt.mockResolverRepositoryForAppSyncList.calls]
+					tt.mockResolverRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForAppSyncList.returns))
+
+			mockResolverRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForFSList.returns[tt.mockResolverRepositoryForFSList.calls]
+					tt.mockResolverRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForFSList.returns))
+
+			mockResolverService.
+				EXPECT().
+				ResolvePipelineConfigFunctionNames(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, resolver *model.Resolver, functions []model.Function) error {
+					r := tt.mockResolverServiceResolvePipelineConfigFunctionNames.returns[tt.mockResolverServiceResolvePipelineConfigFunctionNames.calls]
+					tt.mockResolverServiceResolvePipelineConfigFunctionNames.calls++
+					return r.err
+				}).
+				Times(len(tt.mockResolverServiceResolvePipelineConfigFunctionNames.returns))
+
+			mockDiffService.
+				EXPECT().
+				DiffGraphqlApi(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, src, dst *model.GraphqlApi) (*model.Diff, error) {
+					r := tt.mockDiffServiceDiffGraphqlApi.returns[tt.mockDiffServiceDiffGraphqlApi.calls]
+					tt.mockDiffServiceDiffGraphqlApi.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDiffServiceDiffGraphqlApi.returns))
+
+			mockDiffService.
+				EXPECT().
+				DiffEnvironmentVariables(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, src, dst model.EnvironmentVariables) (*model.Diff, error) {
+					r := tt.mockDiffServiceDiffEnvironmentVariables.returns[tt.mockDiffServiceDiffEnvironmentVariables.calls]
+					tt.mockDiffServiceDiffEnvironmentVariables.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDiffServiceDiffEnvironmentVariables.returns))
+
+			mockDiffService.
+				EXPECT().
+				DiffSchema(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, src, dst *model.Schema) (*model.Diff, error) {
+					r := tt.mockDiffServiceDiffSchema.returns[tt.mockDiffServiceDiffSchema.calls]
+					tt.mockDiffServiceDiffSchema.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDiffServiceDiffSchema.returns))
+
+			mockDiffService.
+				EXPECT().
+				DiffDataSources(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, src, dst []model.DataSource) ([]model.Diff, error) {
+					r := tt.mockDiffServiceDiffDataSources.returns[tt.mockDiffServiceDiffDataSources.calls]
+					tt.mockDiffServiceDiffDataSources.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDiffServiceDiffDataSources.returns))
+
+			mockDiffService.
+				EXPECT().
+				DiffFunctions(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, src, dst []model.Function) ([]model.Diff, error) {
+					r := tt.mockDiffServiceDiffFunctions.returns[tt.mockDiffServiceDiffFunctions.calls]
+					tt.mockDiffServiceDiffFunctions.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDiffServiceDiffFunctions.returns))
+
+			mockDiffService.
+				EXPECT().
+				DiffResolvers(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, src, dst []model.Resolver) ([]model.Diff, error) {
+					r := tt.mockDiffServiceDiffResolvers.returns[tt.mockDiffServiceDiffResolvers.calls]
+					tt.mockDiffServiceDiffResolvers.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDiffServiceDiffResolvers.returns))
+
+			uc := &diffUseCase{
+				filterService:                            mockFilterService,
+				diffService:                              mockDiffService,
+				resolverService:                          mockResolverService,
+				schemaService:                            mockSchemaService,
+				trackerRepository:                        mockTrackerRepository,
+				graphqlApiRepositoryForAppSync:           mockGraphqlApiRepositoryForAppSync,
+				graphqlApiRepositoryForFS:                mockGraphqlApiRepositoryForFS,
+				environmentVariablesRepositoryForAppSync: mockEnvironmentVariablesRepositoryForAppSync,
+				environmentVariablesRepositoryForFS:      mockEnvironmentVariablesRepositoryForFS,
+				schemaRepositoryForAppSync:               mockSchemaRepositoryForAppSync,
+				schemaRepositoryForFS:                    mockSchemaRepositoryForFS,
+				dataSourceRepositoryForAppSync:           mockDataSourceRepositoryForAppSync,
+				dataSourceRepositoryForFS:                mockDataSourceRepositoryForFS,
+				functionRepositoryForAppSync:             mockFunctionRepositoryForAppSync,
+				functionRepositoryForFS:                  mockFunctionRepositoryForFS,
+				resolverRepositoryForAppSync:             mockResolverRepositoryForAppSync,
+				resolverRepositoryForFS:                  mockResolverRepositoryForFS,
+			}
+
+			// Act
+			actual, err := uc.Execute(ctx, tt.args.params)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_diffUseCase_diffSchema(t *testing.T) {
+	remote := model.Schema(`schema {
+  query: Query
+  mutation: Mutation
+}
+
+type Query {
+  getPost(id: ID!): Post
+}
+
+type Mutation {
+  createPost(title: String!): Post
+}
+
+type Post {
+  id: ID!
+  title: String
+}
+`)
+
+	// NOTE: the schema dir joins its files in lexical path order, i.e. Mutation.graphql, Query.graphql, schema.graphql and types/Post.graphql
+	local := model.Schema(`type Mutation {
+  createPost(title: String!): Post
+}
+
+type Query {
+  getPost(id: ID!): Post
+}
+
+schema {
+  query: Query
+  mutation: Mutation
+}
+
+type Post {
+  id: ID!
+  title: String
+}
+`)
+
+	changed := model.Schema(`type Mutation {
+  createPost(title: String!): Post
+}
+
+type Query {
+  getPost(id: ID!): Post
+}
+
+schema {
+  query: Query
+  mutation: Mutation
+}
+
+type Post {
+  id: ID!
+  title: String
+  content: String
+}
+`)
+
+	type args struct {
+		params *DiffInput
+	}
+
+	type mockSchemaRepositoryGetReturn struct {
+		res *model.Schema
+		err error
+	}
+	type mockSchemaRepositoryGet struct {
+		calls   int
+		returns []mockSchemaRepositoryGetReturn
+	}
+
+	type expected struct {
+		changed bool
+		errIs   error
+	}
+
+	tests := []struct {
+		name                              string
+		args                              args
+		mockSchemaRepositoryForAppSyncGet mockSchemaRepositoryGet
+		mockSchemaRepositoryForFSGet      mockSchemaRepositoryGet
+		expected                          expected
+	}{
+		{
+			name: "happy path: schema dir pulled from the remote schema",
+			args: args{
+				params: &DiffInput{
+					APIID:     "apiID",
+					Direction: model.DiffDirectionPush,
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &remote}},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &local}},
+			},
+			expected: expected{
+				changed: false,
+				errIs:   nil,
+			},
+		},
+		{
+			name: "happy path: schema dir with a new field",
+			args: args{
+				params: &DiffInput{
+					APIID:     "apiID",
+					Direction: model.DiffDirectionPush,
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &remote}},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &changed}},
+			},
+			expected: expected{
+				changed: true,
+				errIs:   nil,
+			},
+		},
+		{
+			name: "edge path: SchemaRepositoryForFS.Get() error",
+			args: args{
+				params: &DiffInput{
+					APIID:     "apiID",
+					Direction: model.DiffDirectionPush,
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{res: &remote}},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryGet{
+				returns: []mockSchemaRepositoryGetReturn{{err: &model.LibError{Err: model.ErrNotFound}}},
+			},
+			expected: expected{
+				changed: false,
+				errIs:   model.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockSchemaRepositoryForAppSync := mock_repository.NewMockSchemaRepository(ctrl)
+			mockSchemaRepositoryForFS := mock_repository.NewMockSchemaRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockSchemaRepositoryForAppSync.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForAppSyncGet.returns[tt.mockSchemaRepositoryForAppSyncGet.calls]
+					tt.mockSchemaRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForAppSyncGet.returns))
+
+			mockSchemaRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForFSGet.returns[tt.mockSchemaRepositoryForFSGet.calls]
+					tt.mockSchemaRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForFSGet.returns))
+
+			uc := &diffUseCase{
+				diffService:                service.NewDiffService(nil),
+				schemaService:              service.NewSchemaService(nil),
+				trackerRepository:          mockTrackerRepository,
+				schemaRepositoryForAppSync: mockSchemaRepositoryForAppSync,
+				schemaRepositoryForFS:      mockSchemaRepositoryForFS,
+			}
+
+			// Act
+			actual, err := uc.diffSchema(ctx, tt.args.params)
+
+			// Assert
+			assert.Equal(t, tt.expected.changed, actual != nil)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_diffUseCase_diffEnvironmentVariables(t *testing.T) {
+	type args struct {
+		params *DiffInput
+	}
+
+	type mockEnvironmentVariablesRepositoryGetReturn struct {
+		res model.EnvironmentVariables
+		err error
+	}
+	type mockEnvironmentVariablesRepositoryGet struct {
+		calls   int
+		returns []mockEnvironmentVariablesRepositoryGetReturn
+	}
+
+	type expected struct {
+		changed bool
+		hidden  []string
+		errIs   error
+	}
+
+	tests := []struct {
+		name                                            string
+		args                                            args
+		mockEnvironmentVariablesRepositoryForAppSyncGet mockEnvironmentVariablesRepositoryGet
+		mockEnvironmentVariablesRepositoryForFSGet      mockEnvironmentVariablesRepositoryGet
+		expected                                        expected
+	}{
+		{
+			name: "happy path: resolved secret changed",
+			args: args{
+				params: &DiffInput{
+					APIID:     "apiID",
+					Direction: model.DiffDirectionPush,
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: model.EnvironmentVariables{"STRIPE_KEY": "sk_live_old", "REGION": "us-east-1"}}},
+			},
+			// NOTE: the local repository resolves `${env:STRIPE_KEY}` before returning the environment variables
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: model.EnvironmentVariables{"STRIPE_KEY": "sk_live_new", "REGION": "us-east-1"}}},
+			},
+			expected: expected{
+				changed: true,
+				hidden:  []string{"sk_live_old", "sk_live_new", "us-east-1"},
+				errIs:   nil,
+			},
+		},
+		{
+			name: "happy path: decrypted value changed",
+			args: args{
+				params: &DiffInput{
+					APIID:     "apiID",
+					Direction: model.DiffDirectionPull,
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: model.EnvironmentVariables{"DB_PASSWORD": "remote-password"}}},
+			},
+			// NOTE: the local repository decrypts `ENC[age:...]` before returning the environment variables
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: model.EnvironmentVariables{"DB_PASSWORD": "local-password", "DB_USER": "admin"}}},
+			},
+			expected: expected{
+				changed: true,
+				hidden:  []string{"remote-password", "local-password", "admin"},
+				errIs:   nil,
+			},
+		},
+		{
+			name: "happy path: resolved secret unchanged",
+			args: args{
+				params: &DiffInput{
+					APIID:     "apiID",
+					Direction: model.DiffDirectionPull,
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: model.EnvironmentVariables{"STRIPE_KEY": "sk_live_old"}}},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: model.EnvironmentVariables{"STRIPE_KEY": "sk_live_old"}}},
+			},
+			expected: expected{
+				changed: false,
+				hidden:  nil,
+				errIs:   nil,
+			},
+		},
+		{
+			name: "edge path: EnvironmentVariablesRepositoryForFS.Get() error",
+			args: args{
+				params: &DiffInput{
+					APIID:     "apiID",
+					Direction: model.DiffDirectionPush,
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{res: model.EnvironmentVariables{"STRIPE_KEY": "sk_live_old"}}},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryGet{
+				returns: []mockEnvironmentVariablesRepositoryGetReturn{{err: &model.LibError{Err: model.ErrNotFound}}},
+			},
+			expected: expected{
+				changed: false,
+				hidden:  nil,
+				errIs:   model.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForAppSync := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForFS := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockEnvironmentVariablesRepositoryForAppSync.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForAppSyncGet.returns[tt.mockEnvironmentVariablesRepositoryForAppSyncGet.calls]
+					tt.mockEnvironmentVariablesRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForAppSyncGet.returns))
+
+			mockEnvironmentVariablesRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForFSGet.returns[tt.mockEnvironmentVariablesRepositoryForFSGet.calls]
+					tt.mockEnvironmentVariablesRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForFSGet.returns))
+
+			uc := &diffUseCase{
+				diffService:                              service.NewDiffService(nil),
+				trackerRepository:                        mockTrackerRepository,
+				environmentVariablesRepositoryForAppSync: mockEnvironmentVariablesRepositoryForAppSync,
+				environmentVariablesRepositoryForFS:      mockEnvironmentVariablesRepositoryForFS,
+			}
+
+			// Act
+			actual, err := uc.diffEnvironmentVariables(ctx, tt.args.params)
+
+			// Assert
+			assert.Equal(t, tt.expected.changed, actual != nil)
+
+			if actual != nil {
+				for _, fd := range actual.FileDiffs {
+					for _, v := range tt.expected.hidden {
+						assert.NotContains(t, fd.UnifiedDiff, v)
+					}
+				}
+			}
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+const (
+	envEditFileName = "env.json"
+)
+
+type EnvEditInput struct {
+	APIID string
+}
+
+type EnvEditOutput struct {
+	Changed bool
+}
+
+type EnvEditUseCase interface {
+	Execute(ctx context.Context, params *EnvEditInput) (*EnvEditOutput, error)
+}
+
+type envEditUseCase struct {
+	trackerRepository                   repository.TrackerRepository
+	editorRepository                    repository.EditorRepository
+	environmentVariablesRepositoryForFS repository.EnvironmentVariablesRepository
+}
+
+func NewEnvEditUseCase(repo repository.Repository) EnvEditUseCase {
+	return &envEditUseCase{
+		trackerRepository:                   repo.TrackerRepository(),
+		editorRepository:                    repo.EditorRepository(),
+		environmentVariablesRepositoryForFS: repo.EnvironmentVariablesRepositoryForFS(),
+	}
+}
+
+func (uc *envEditUseCase) Execute(ctx context.Context, params *EnvEditInput) (res *EnvEditOutput, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "loading environment variables")
+
+	vs, err := uc.environmentVariablesRepositoryForFS.Get(ctx, params.APIID)
+	if err != nil {
+		if !errors.Is(err, model.ErrNotFound) {
+			uc.trackerRepository.Failed(ctx, "failed to load environment variables")
+			return nil, err
+		}
+
+		vs = make(model.EnvironmentVariables)
+	}
+
+	data, err := json.MarshalIndent(vs, "", "  ")
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load environment variables")
+		return nil, err
+	}
+
+	// NOTE: the progress is settled before the editor opens, so that it does not draw over the editor
+	uc.trackerRepository.Success(ctx, "loaded environment variables")
+
+	edited, err := uc.editorRepository.Edit(ctx, envEditFileName, append(data, '\n'))
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to edit environment variables")
+		return nil, err
+	}
+
+	var evs model.EnvironmentVariables
+	if err := json.Unmarshal(edited, &evs); err != nil {
+		uc.trackerRepository.Failed(ctx, "invalid environment variables")
+		return nil, fmt.Errorf("%w: %w", model.ErrInvalidValue, err)
+	}
+
+	if evs == nil {
+		evs = make(model.EnvironmentVariables)
+	}
+
+	if maps.Equal(vs, evs) {
+		uc.trackerRepository.Success(ctx, "environment variables were not changed")
+		return &EnvEditOutput{Changed: false}, nil
+	}
+
+	uc.trackerRepository.InProgress(ctx, "saving environment variables")
+
+	if _, err := uc.environmentVariablesRepositoryForFS.Save(ctx, params.APIID, evs); err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to save environment variables")
+		return nil, err
+	}
+
+	uc.trackerRepository.Success(ctx, "saved environment variables")
+
+	return &EnvEditOutput{Changed: true}, nil
+}
@@ -0,0 +1,415 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_envEditUseCase_Execute(t *testing.T) {
+	variables := model.EnvironmentVariables{
+		"KEY1": "VALUE1",
+	}
+
+	type args struct {
+		params *EnvEditInput
+	}
+
+	type mockEnvironmentVariablesRepositoryForFSGetReturn struct {
+		res model.EnvironmentVariables
+		err error
+	}
+	type mockEnvironmentVariablesRepositoryForFSGet struct {
+		calls   int
+		returns []mockEnvironmentVariablesRepositoryForFSGetReturn
+	}
+
+	type mockEditorRepositoryEditReturn struct {
+		res []byte
+		err error
+	}
+	type mockEditorRepositoryEdit struct {
+		calls   int
+		args    [][]byte
+		returns []mockEditorRepositoryEditReturn
+	}
+
+	type mockEnvironmentVariablesRepositoryForFSSaveReturn struct {
+		res model.EnvironmentVariables
+		err error
+	}
+	type mockEnvironmentVariablesRepositoryForFSSave struct {
+		calls   int
+		args    []model.EnvironmentVariables
+		returns []mockEnvironmentVariablesRepositoryForFSSaveReturn
+	}
+
+	type expected struct {
+		res      *EnvEditOutput
+		contents [][]byte
+		saved    []model.EnvironmentVariables
+		errIs    error
+	}
+
+	tests := []struct {
+		name                                        string
+		args                                        args
+		mockEnvironmentVariablesRepositoryForFSGet  mockEnvironmentVariablesRepositoryForFSGet
+		mockEditorRepositoryEdit                    mockEditorRepositoryEdit
+		mockEnvironmentVariablesRepositoryForFSSave mockEnvironmentVariablesRepositoryForFSSave
+		expected                                    expected
+	}{
+		{
+			name: "happy path: changed",
+			args: args{
+				params: &EnvEditInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEditorRepositoryEdit: mockEditorRepositoryEdit{
+				returns: []mockEditorRepositoryEditReturn{
+					{
+						res: []byte(`{"KEY1":"VALUE1","KEY2":"VALUE2"}`),
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{
+						res: model.EnvironmentVariables{"KEY1": "VALUE1", "KEY2": "VALUE2"},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res: &EnvEditOutput{
+					Changed: true,
+				},
+				contents: [][]byte{[]byte("{\n  \"KEY1\": \"VALUE1\"\n}\n")},
+				saved:    []model.EnvironmentVariables{{"KEY1": "VALUE1", "KEY2": "VALUE2"}},
+				errIs:    nil,
+			},
+		},
+		{
+			name: "happy path: not changed",
+			args: args{
+				params: &EnvEditInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEditorRepositoryEdit: mockEditorRepositoryEdit{
+				returns: []mockEditorRepositoryEditReturn{
+					{
+						res: []byte("{\n  \"KEY1\": \"VALUE1\"\n}\n"),
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res: &EnvEditOutput{
+					Changed: false,
+				},
+				contents: [][]byte{[]byte("{\n  \"KEY1\": \"VALUE1\"\n}\n")},
+				saved:    nil,
+				errIs:    nil,
+			},
+		},
+		{
+			name: "happy path: environment variables not found",
+			args: args{
+				params: &EnvEditInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrNotFound},
+					},
+				},
+			},
+			mockEditorRepositoryEdit: mockEditorRepositoryEdit{
+				returns: []mockEditorRepositoryEditReturn{
+					{
+						res: []byte(`{"KEY1":"VALUE1"}`),
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res: &EnvEditOutput{
+					Changed: true,
+				},
+				contents: [][]byte{[]byte("{}\n")},
+				saved:    []model.EnvironmentVariables{variables},
+				errIs:    nil,
+			},
+		},
+		{
+			name: "edge path: EnvironmentVariablesRepositoryForFS.Get() error",
+			args: args{
+				params: &EnvEditInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrInvalidValue},
+					},
+				},
+			},
+			mockEditorRepositoryEdit: mockEditorRepositoryEdit{
+				returns: []mockEditorRepositoryEditReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: EditorRepository.Edit() error",
+			args: args{
+				params: &EnvEditInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEditorRepositoryEdit: mockEditorRepositoryEdit{
+				returns: []mockEditorRepositoryEditReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrNotConfirmed},
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNotConfirmed,
+			},
+		},
+		{
+			name: "edge path: invalid JSON",
+			args: args{
+				params: &EnvEditInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEditorRepositoryEdit: mockEditorRepositoryEdit{
+				returns: []mockEditorRepositoryEditReturn{
+					{
+						res: []byte(`{"KEY1":1}`),
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: EnvironmentVariablesRepositoryForFS.Save() error",
+			args: args{
+				params: &EnvEditInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEditorRepositoryEdit: mockEditorRepositoryEdit{
+				returns: []mockEditorRepositoryEditReturn{
+					{
+						res: []byte(`{"KEY1":"VALUE2"}`),
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockEditorRepository := mock_repository.NewMockEditorRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForFS := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockEnvironmentVariablesRepositoryForFS.
+				EXPECT().
+				Get(ctx, tt.args.params.APIID).
+				DoAndReturn(func(ctx context.Context, apiID string) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForFSGet.returns[tt.mockEnvironmentVariablesRepositoryForFSGet.calls]
+					tt.mockEnvironmentVariablesRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForFSGet.returns))
+
+			mockEditorRepository.
+				EXPECT().
+				Edit(ctx, envEditFileName, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, name string, content []byte) ([]byte, error) {
+					tt.mockEditorRepositoryEdit.args = append(tt.mockEditorRepositoryEdit.args, content)
+					r := tt.mockEditorRepositoryEdit.returns[tt.mockEditorRepositoryEdit.calls]
+					tt.mockEditorRepositoryEdit.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEditorRepositoryEdit.returns))
+
+			mockEnvironmentVariablesRepositoryForFS.
+				EXPECT().
+				Save(ctx, tt.args.params.APIID, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, variables model.EnvironmentVariables) (model.EnvironmentVariables, error) {
+					tt.mockEnvironmentVariablesRepositoryForFSSave.args = append(tt.mockEnvironmentVariablesRepositoryForFSSave.args, variables)
+					r := tt.mockEnvironmentVariablesRepositoryForFSSave.returns[tt.mockEnvironmentVariablesRepositoryForFSSave.calls]
+					tt.mockEnvironmentVariablesRepositoryForFSSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForFSSave.returns))
+
+			uc := &envEditUseCase{
+				trackerRepository:                   mockTrackerRepository,
+				editorRepository:                    mockEditorRepository,
+				environmentVariablesRepositoryForFS: mockEnvironmentVariablesRepositoryForFS,
+			}
+
+			// Act
+			actual, err := uc.Execute(ctx, tt.args.params)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+
+				assert.Equal(t, tt.expected.contents, tt.mockEditorRepositoryEdit.args)
+				assert.Equal(t, tt.expected.saved, tt.mockEnvironmentVariablesRepositoryForFSSave.args)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
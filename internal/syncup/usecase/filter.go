@@ -0,0 +1,97 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/service"
+)
+
+func matchKind(ctx context.Context, s service.FilterService, filter *model.Filter, kind model.ResourceKind) bool {
+	if filter == nil {
+		return true
+	}
+
+	return s.Match(ctx, filter, kind, "")
+}
+
+func filterDataSources(ctx context.Context, s service.FilterService, filter *model.Filter, dataSources []model.DataSource) (matched, unmatched []model.DataSource) {
+	return filterResources(ctx, s, filter, model.ResourceKindDataSource, dataSources, func(ds *model.DataSource) string {
+		return ptr.ToValue(ds.Name)
+	})
+}
+
+func filterFunctions(ctx context.Context, s service.FilterService, filter *model.Filter, functions []model.Function) (matched, unmatched []model.Function) {
+	return filterResources(ctx, s, filter, model.ResourceKindFunction, functions, func(fn *model.Function) string {
+		return ptr.ToValue(fn.Name)
+	})
+}
+
+func filterResolvers(ctx context.Context, s service.FilterService, filter *model.Filter, resolvers []model.Resolver) (matched, unmatched []model.Resolver) {
+	return filterResources(ctx, s, filter, model.ResourceKindResolver, resolvers, func(rslv *model.Resolver) string {
+		return fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))
+	})
+}
+
+func filterResources[T any](ctx context.Context, s service.FilterService, filter *model.Filter, kind model.ResourceKind, resources []T, identifier func(r *T) string) (matched, unmatched []T) {
+	if filter == nil {
+		return resources, nil
+	}
+
+	matched = make([]T, 0, len(resources))
+	unmatched = make([]T, 0)
+	for _, r := range resources {
+		if s.Match(ctx, filter, kind, identifier(&r)) {
+			matched = append(matched, r)
+		} else {
+			unmatched = append(unmatched, r)
+		}
+	}
+
+	return matched, unmatched
+}
+
+// excludeResolvers returns the resolvers except the excluded ones, which are identified by their type and field names.
+func excludeResolvers(resolvers, excluded []model.Resolver) []model.Resolver {
+	if len(excluded) == 0 {
+		return resolvers
+	}
+
+	identifiers := make(map[string]struct{}, len(excluded))
+	for _, rslv := range excluded {
+		identifiers[fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))] = struct{}{}
+	}
+
+	res := make([]model.Resolver, 0, len(resolvers))
+	for _, rslv := range resolvers {
+		if _, ok := identifiers[fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))]; ok {
+			continue
+		}
+
+		res = append(res, rslv)
+	}
+
+	return res
+}
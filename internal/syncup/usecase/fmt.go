@@ -0,0 +1,110 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/service"
+)
+
+type FmtInput struct {
+	APIID      string
+	SortSchema bool
+	Check      bool
+}
+
+type FmtOutput struct {
+	// Paths are the paths of the files that are not formatted, which have been rewritten unless checking.
+	Paths []string
+}
+
+type FmtUseCase interface {
+	Execute(ctx context.Context, params *FmtInput) (*FmtOutput, error)
+}
+
+type fmtUseCase struct {
+	schemaService             service.SchemaService
+	trackerRepository         repository.TrackerRepository
+	schemaFileRepositoryForFS repository.SchemaFileRepository
+}
+
+func NewFmtUseCase(repo repository.Repository) FmtUseCase {
+	return &fmtUseCase{
+		schemaService:             service.NewSchemaService(repo),
+		trackerRepository:         repo.TrackerRepository(),
+		schemaFileRepositoryForFS: repo.SchemaFileRepositoryForFS(),
+	}
+}
+
+func (uc *fmtUseCase) Execute(ctx context.Context, params *FmtInput) (res *FmtOutput, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "loading schema")
+
+	files, err := uc.schemaFileRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load schema")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "formatting schema")
+
+	formatted, err := uc.schemaService.Format(ctx, files, model.SchemaFormatOptionsWithSort(params.SortSchema))
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to format schema")
+		return nil, err
+	}
+
+	paths := make([]string, 0)
+	for i, file := range formatted {
+		if file.Content == files[i].Content {
+			continue
+		}
+
+		paths = append(paths, file.Path)
+
+		if params.Check {
+			continue
+		}
+
+		if _, err := uc.schemaFileRepositoryForFS.Save(ctx, params.APIID, &file); err != nil {
+			uc.trackerRepository.Failed(ctx, fmt.Sprintf("failed to save %s", file.Path))
+			return nil, err
+		}
+
+		uc.trackerRepository.Success(ctx, fmt.Sprintf("formatted %s", file.Path))
+	}
+
+	switch {
+	case len(paths) == 0:
+		uc.trackerRepository.Success(ctx, "schema is formatted")
+	case params.Check:
+		uc.trackerRepository.Failed(ctx, fmt.Sprintf("%d schema files are not formatted", len(paths)))
+	}
+
+	return &FmtOutput{Paths: paths}, nil
+}
@@ -0,0 +1,370 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	mock_service "github.com/Aton-Kish/syncup/internal/syncup/domain/service/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_fmtUseCase_Execute(t *testing.T) {
+	files := []model.SchemaFile{
+		{Path: "schema/Query.graphql", Content: "type Query {\n\tgetPost: Post\n}\n"},
+		{Path: "schema/types/Post.graphql", Content: "type Post {\n  id: ID!\n}\n"},
+	}
+	formatted := []model.SchemaFile{
+		{Path: "schema/Query.graphql", Content: "type Query {\n  getPost: Post\n}\n"},
+		{Path: "schema/types/Post.graphql", Content: "type Post {\n  id: ID!\n}\n"},
+	}
+
+	type args struct {
+		params *FmtInput
+	}
+
+	type mockSchemaFileRepositoryForFSListReturn struct {
+		res []model.SchemaFile
+		err error
+	}
+	type mockSchemaFileRepositoryForFSList struct {
+		calls   int
+		returns []mockSchemaFileRepositoryForFSListReturn
+	}
+
+	type mockSchemaServiceFormatReturn struct {
+		res []model.SchemaFile
+		err error
+	}
+	type mockSchemaServiceFormat struct {
+		calls   int
+		returns []mockSchemaServiceFormatReturn
+	}
+
+	type mockSchemaFileRepositoryForFSSaveReturn struct {
+		res *model.SchemaFile
+		err error
+	}
+	type mockSchemaFileRepositoryForFSSave struct {
+		calls   int
+		returns []mockSchemaFileRepositoryForFSSaveReturn
+	}
+
+	type expected struct {
+		res   *FmtOutput
+		errIs error
+	}
+
+	tests := []struct {
+		name                              string
+		args                              args
+		mockSchemaFileRepositoryForFSList mockSchemaFileRepositoryForFSList
+		mockSchemaServiceFormat           mockSchemaServiceFormat
+		mockSchemaFileRepositoryForFSSave mockSchemaFileRepositoryForFSSave
+		expected                          expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				params: &FmtInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: formatted,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSSave: mockSchemaFileRepositoryForFSSave{
+				returns: []mockSchemaFileRepositoryForFSSaveReturn{
+					{
+						res: &formatted[0],
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res: &FmtOutput{
+					Paths: []string{"schema/Query.graphql"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: check",
+			args: args{
+				params: &FmtInput{
+					APIID: "APIID",
+					Check: true,
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: formatted,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSSave: mockSchemaFileRepositoryForFSSave{
+				returns: []mockSchemaFileRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res: &FmtOutput{
+					Paths: []string{"schema/Query.graphql"},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: already formatted",
+			args: args{
+				params: &FmtInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: formatted,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: formatted,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSSave: mockSchemaFileRepositoryForFSSave{
+				returns: []mockSchemaFileRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res: &FmtOutput{
+					Paths: []string{},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: SchemaFileRepositoryForFS.List() error",
+			args: args{
+				params: &FmtInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrNotFound},
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockSchemaFileRepositoryForFSSave: mockSchemaFileRepositoryForFSSave{
+				returns: []mockSchemaFileRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+		{
+			name: "edge path: SchemaService.Format() error",
+			args: args{
+				params: &FmtInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: &model.SchemaError{Path: "schema/Query.graphql", Line: 1, Column: 1}},
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSSave: mockSchemaFileRepositoryForFSSave{
+				returns: []mockSchemaFileRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: SchemaFileRepositoryForFS.Save() error",
+			args: args{
+				params: &FmtInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: formatted,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSSave: mockSchemaFileRepositoryForFSSave{
+				returns: []mockSchemaFileRepositoryForFSSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSchemaService := mock_service.NewMockSchemaService(ctrl)
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockSchemaFileRepositoryForFS := mock_repository.NewMockSchemaFileRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockSchemaFileRepositoryForFS.
+				EXPECT().
+				List(ctx, tt.args.params.APIID).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.SchemaFile, error) {
+					r := tt.mockSchemaFileRepositoryForFSList.returns[tt.mockSchemaFileRepositoryForFSList.calls]
+					tt.mockSchemaFileRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaFileRepositoryForFSList.returns))
+
+			mockSchemaService.
+				EXPECT().
+				Format(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, files []model.SchemaFile, optFns ...func(o *model.SchemaFormatOptions)) ([]model.SchemaFile, error) {
+					r := tt.mockSchemaServiceFormat.returns[tt.mockSchemaServiceFormat.calls]
+					tt.mockSchemaServiceFormat.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaServiceFormat.returns))
+
+			mockSchemaFileRepositoryForFS.
+				EXPECT().
+				Save(ctx, tt.args.params.APIID, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, file *model.SchemaFile) (*model.SchemaFile, error) {
+					r := tt.mockSchemaFileRepositoryForFSSave.returns[tt.mockSchemaFileRepositoryForFSSave.calls]
+					tt.mockSchemaFileRepositoryForFSSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaFileRepositoryForFSSave.returns))
+
+			uc := &fmtUseCase{
+				schemaService:             mockSchemaService,
+				trackerRepository:         mockTrackerRepository,
+				schemaFileRepositoryForFS: mockSchemaFileRepositoryForFS,
+			}
+
+			// Act
+			actual, err := uc.Execute(ctx, tt.args.params)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
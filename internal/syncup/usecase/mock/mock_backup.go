@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: backup.go
+//
+// Generated by this command:
+//
+//	mockgen -source=backup.go -destination=./mock/mock_backup.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBackupUseCase is a mock of BackupUseCase interface.
+type MockBackupUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockBackupUseCaseMockRecorder
+}
+
+// MockBackupUseCaseMockRecorder is the mock recorder for MockBackupUseCase.
+type MockBackupUseCaseMockRecorder struct {
+	mock *MockBackupUseCase
+}
+
+// NewMockBackupUseCase creates a new mock instance.
+func NewMockBackupUseCase(ctrl *gomock.Controller) *MockBackupUseCase {
+	mock := &MockBackupUseCase{ctrl: ctrl}
+	mock.recorder = &MockBackupUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBackupUseCase) EXPECT() *MockBackupUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockBackupUseCase) Execute(ctx context.Context, params *usecase.BackupInput) (*usecase.BackupOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.BackupOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockBackupUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockBackupUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: diff.go
+//
+// Generated by this command:
+//
+//	mockgen -source=diff.go -destination=./mock/mock_diff.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDiffUseCase is a mock of DiffUseCase interface.
+type MockDiffUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiffUseCaseMockRecorder
+}
+
+// MockDiffUseCaseMockRecorder is the mock recorder for MockDiffUseCase.
+type MockDiffUseCaseMockRecorder struct {
+	mock *MockDiffUseCase
+}
+
+// NewMockDiffUseCase creates a new mock instance.
+func NewMockDiffUseCase(ctrl *gomock.Controller) *MockDiffUseCase {
+	mock := &MockDiffUseCase{ctrl: ctrl}
+	mock.recorder = &MockDiffUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiffUseCase) EXPECT() *MockDiffUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDiffUseCase) Execute(ctx context.Context, params *usecase.DiffInput) (*usecase.DiffOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.DiffOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDiffUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDiffUseCase)(nil).Execute), ctx, params)
+}
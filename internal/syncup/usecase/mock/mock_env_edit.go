@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: env_edit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=env_edit.go -destination=./mock/mock_env_edit.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEnvEditUseCase is a mock of EnvEditUseCase interface.
+type MockEnvEditUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnvEditUseCaseMockRecorder
+}
+
+// MockEnvEditUseCaseMockRecorder is the mock recorder for MockEnvEditUseCase.
+type MockEnvEditUseCaseMockRecorder struct {
+	mock *MockEnvEditUseCase
+}
+
+// NewMockEnvEditUseCase creates a new mock instance.
+func NewMockEnvEditUseCase(ctrl *gomock.Controller) *MockEnvEditUseCase {
+	mock := &MockEnvEditUseCase{ctrl: ctrl}
+	mock.recorder = &MockEnvEditUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnvEditUseCase) EXPECT() *MockEnvEditUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockEnvEditUseCase) Execute(ctx context.Context, params *usecase.EnvEditInput) (*usecase.EnvEditOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.EnvEditOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockEnvEditUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEnvEditUseCase)(nil).Execute), ctx, params)
+}
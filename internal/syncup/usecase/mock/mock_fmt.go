@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: fmt.go
+//
+// Generated by this command:
+//
+//	mockgen -source=fmt.go -destination=./mock/mock_fmt.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFmtUseCase is a mock of FmtUseCase interface.
+type MockFmtUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockFmtUseCaseMockRecorder
+}
+
+// MockFmtUseCaseMockRecorder is the mock recorder for MockFmtUseCase.
+type MockFmtUseCaseMockRecorder struct {
+	mock *MockFmtUseCase
+}
+
+// NewMockFmtUseCase creates a new mock instance.
+func NewMockFmtUseCase(ctrl *gomock.Controller) *MockFmtUseCase {
+	mock := &MockFmtUseCase{ctrl: ctrl}
+	mock.recorder = &MockFmtUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFmtUseCase) EXPECT() *MockFmtUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockFmtUseCase) Execute(ctx context.Context, params *usecase.FmtInput) (*usecase.FmtOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.FmtOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockFmtUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockFmtUseCase)(nil).Execute), ctx, params)
+}
@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tests.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tests.go -destination=./mock/mock_tests.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTestUseCase is a mock of TestUseCase interface.
+type MockTestUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTestUseCaseMockRecorder
+}
+
+// MockTestUseCaseMockRecorder is the mock recorder for MockTestUseCase.
+type MockTestUseCaseMockRecorder struct {
+	mock *MockTestUseCase
+}
+
+// NewMockTestUseCase creates a new mock instance.
+func NewMockTestUseCase(ctrl *gomock.Controller) *MockTestUseCase {
+	mock := &MockTestUseCase{ctrl: ctrl}
+	mock.recorder = &MockTestUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTestUseCase) EXPECT() *MockTestUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockTestUseCase) Execute(ctx context.Context, params *usecase.TestInput) (*usecase.TestOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.TestOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockTestUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockTestUseCase)(nil).Execute), ctx, params)
+}
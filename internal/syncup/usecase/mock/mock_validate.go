@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: validate.go
+//
+// Generated by this command:
+//
+//	mockgen -source=validate.go -destination=./mock/mock_validate.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockValidateUseCase is a mock of ValidateUseCase interface.
+type MockValidateUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockValidateUseCaseMockRecorder
+}
+
+// MockValidateUseCaseMockRecorder is the mock recorder for MockValidateUseCase.
+type MockValidateUseCaseMockRecorder struct {
+	mock *MockValidateUseCase
+}
+
+// NewMockValidateUseCase creates a new mock instance.
+func NewMockValidateUseCase(ctrl *gomock.Controller) *MockValidateUseCase {
+	mock := &MockValidateUseCase{ctrl: ctrl}
+	mock.recorder = &MockValidateUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidateUseCase) EXPECT() *MockValidateUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockValidateUseCase) Execute(ctx context.Context, params *usecase.ValidateInput) (*usecase.ValidateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.ValidateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockValidateUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockValidateUseCase)(nil).Execute), ctx, params)
+}
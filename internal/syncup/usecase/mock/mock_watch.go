@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: watch.go
+//
+// Generated by this command:
+//
+//	mockgen -source=watch.go -destination=./mock/mock_watch.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/Aton-Kish/syncup/internal/syncup/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWatchUseCase is a mock of WatchUseCase interface.
+type MockWatchUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchUseCaseMockRecorder
+}
+
+// MockWatchUseCaseMockRecorder is the mock recorder for MockWatchUseCase.
+type MockWatchUseCaseMockRecorder struct {
+	mock *MockWatchUseCase
+}
+
+// NewMockWatchUseCase creates a new mock instance.
+func NewMockWatchUseCase(ctrl *gomock.Controller) *MockWatchUseCase {
+	mock := &MockWatchUseCase{ctrl: ctrl}
+	mock.recorder = &MockWatchUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchUseCase) EXPECT() *MockWatchUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockWatchUseCase) Execute(ctx context.Context, params *usecase.WatchInput) (*usecase.WatchOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, params)
+	ret0, _ := ret[0].(*usecase.WatchOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockWatchUseCaseMockRecorder) Execute(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockWatchUseCase)(nil).Execute), ctx, params)
+}
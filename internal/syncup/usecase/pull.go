@@ -27,8 +27,10 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/service"
@@ -37,6 +39,9 @@ import (
 type PullInput struct {
 	APIID                     string
 	DeleteExtraneousResources bool
+	DryRun                    bool
+	SortSchema                bool
+	Filter                    *model.Filter
 }
 
 type PullOutput struct {
@@ -47,13 +52,21 @@ type PullUseCase interface {
 }
 
 type pullUseCase struct {
+	filterService                            service.FilterService
 	functionService                          service.FunctionService
 	resolverService                          service.ResolverService
+	schemaService                            service.SchemaService
 	trackerRepository                        repository.TrackerRepository
+	stateRepositoryForFS                     repository.StateRepository
+	resourceRepositoryForFS                  repository.ResourceRepository
+	graphqlApiRepositoryForAppSync           repository.GraphqlApiRepository
+	graphqlApiRepositoryForFS                repository.GraphqlApiRepository
 	environmentVariablesRepositoryForAppSync repository.EnvironmentVariablesRepository
 	environmentVariablesRepositoryForFS      repository.EnvironmentVariablesRepository
 	schemaRepositoryForAppSync               repository.SchemaRepository
 	schemaRepositoryForFS                    repository.SchemaRepository
+	dataSourceRepositoryForAppSync           repository.DataSourceRepository
+	dataSourceRepositoryForFS                repository.DataSourceRepository
 	functionRepositoryForAppSync             repository.FunctionRepository
 	functionRepositoryForFS                  repository.FunctionRepository
 	resolverRepositoryForAppSync             repository.ResolverRepository
@@ -62,13 +75,21 @@ type pullUseCase struct {
 
 func NewPullUseCase(repo repository.Repository) PullUseCase {
 	return &pullUseCase{
+		filterService:                            service.NewFilterService(repo),
 		functionService:                          service.NewFunctionService(repo),
 		resolverService:                          service.NewResolverService(repo),
+		schemaService:                            service.NewSchemaService(repo),
 		trackerRepository:                        repo.TrackerRepository(),
+		stateRepositoryForFS:                     repo.StateRepositoryForFS(),
+		resourceRepositoryForFS:                  repo.ResourceRepositoryForFS(),
+		graphqlApiRepositoryForAppSync:           repo.GraphqlApiRepositoryForAppSync(),
+		graphqlApiRepositoryForFS:                repo.GraphqlApiRepositoryForFS(),
 		environmentVariablesRepositoryForAppSync: repo.EnvironmentVariablesRepositoryForAppSync(),
 		environmentVariablesRepositoryForFS:      repo.EnvironmentVariablesRepositoryForFS(),
 		schemaRepositoryForAppSync:               repo.SchemaRepositoryForAppSync(),
 		schemaRepositoryForFS:                    repo.SchemaRepositoryForFS(),
+		dataSourceRepositoryForAppSync:           repo.DataSourceRepositoryForAppSync(),
+		dataSourceRepositoryForFS:                repo.DataSourceRepositoryForFS(),
 		functionRepositoryForAppSync:             repo.FunctionRepositoryForAppSync(),
 		functionRepositoryForFS:                  repo.FunctionRepositoryForFS(),
 		resolverRepositoryForAppSync:             repo.ResolverRepositoryForAppSync(),
@@ -79,197 +100,639 @@ func NewPullUseCase(repo repository.Repository) PullUseCase {
 func (uc *pullUseCase) Execute(ctx context.Context, params *PullInput) (res *PullOutput, err error) {
 	defer wrap(&err)
 
-	if _, err := uc.pullEnvironmentVariables(ctx, params.APIID); err != nil {
+	if params.Filter != nil {
+		if err := uc.filterService.Validate(ctx, params.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	var st *syncState
+	if !params.DryRun {
+		uc.trackerRepository.InProgress(ctx, "loading sync state")
+
+		state, err := uc.stateRepositoryForFS.Get(ctx, params.APIID)
+		if err != nil {
+			uc.trackerRepository.Failed(ctx, "failed to load sync state")
+			return nil, err
+		}
+
+		st = newSyncState(state, false)
+	}
+
+	if err := uc.pull(ctx, params, st); err != nil {
+		// NOTE: the resources pulled before the failure are recorded as well
+		if serr := uc.saveState(ctx, params, st); serr != nil {
+			return nil, errors.Join(err, serr)
+		}
+
 		return nil, err
 	}
 
-	if _, err := uc.pullSchema(ctx, params.APIID); err != nil {
+	if err := uc.saveState(ctx, params, st); err != nil {
 		return nil, err
 	}
 
-	fns, err := uc.pullFunctions(ctx, params.APIID)
+	return &PullOutput{}, nil
+}
+
+func (uc *pullUseCase) saveState(ctx context.Context, params *PullInput, st *syncState) (err error) {
+	defer wrap(&err)
+
+	if st == nil {
+		return nil
+	}
+
+	uc.trackerRepository.InProgress(ctx, "saving sync state")
+
+	if _, err := uc.stateRepositoryForFS.Save(ctx, params.APIID, st.state); err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to save sync state")
+		return err
+	}
+
+	uc.trackerRepository.Success(ctx, "saved sync state")
+
+	return nil
+}
+
+func (uc *pullUseCase) pull(ctx context.Context, params *PullInput, st *syncState) (err error) {
+	defer wrap(&err)
+
+	if matchKind(ctx, uc.filterService, params.Filter, model.ResourceKindGraphqlApi) {
+		if _, err := uc.pullGraphqlApi(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	if matchKind(ctx, uc.filterService, params.Filter, model.ResourceKindEnvironmentVariables) {
+		if _, err := uc.pullEnvironmentVariables(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	if matchKind(ctx, uc.filterService, params.Filter, model.ResourceKindSchema) {
+		if _, err := uc.pullSchema(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	if _, err := uc.pullDataSources(ctx, params); err != nil {
+		return err
+	}
+
+	fns, err := uc.pullFunctions(ctx, params, st)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	rslvs, err := uc.pullResolvers(ctx, params.APIID, fns)
+	rslvs, err := uc.pullResolvers(ctx, params, st, fns)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	if params.DeleteExtraneousResources {
-		if err := uc.deleteExtraneousFunctions(ctx, params.APIID, fns); err != nil {
-			return nil, err
+		if err := uc.deleteExtraneousFunctions(ctx, params, st, fns); err != nil {
+			return err
 		}
 
-		if err := uc.deleteExtraneousResolvers(ctx, params.APIID, rslvs); err != nil {
-			return nil, err
+		if err := uc.deleteExtraneousResolvers(ctx, params, st, rslvs); err != nil {
+			return err
 		}
 	}
 
-	return &PullOutput{}, nil
+	return nil
 }
 
-func (uc *pullUseCase) pullEnvironmentVariables(ctx context.Context, apiID string) (res model.EnvironmentVariables, err error) {
+func (uc *pullUseCase) pullGraphqlApi(ctx context.Context, params *PullInput) (res *model.GraphqlApi, err error) {
 	defer wrap(&err)
 
+	start := time.Now()
+
+	uc.trackerRepository.InProgress(ctx, "fetching GraphQL API settings")
+
+	api, err := uc.graphqlApiRepositoryForAppSync.Get(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch GraphQL API settings")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading GraphQL API settings")
+
+	exists, err := uc.resourceRepositoryForFS.Exists(ctx, params.APIID, model.ResourceKindGraphqlApi, "")
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load GraphQL API settings")
+		return nil, err
+	}
+
+	action := model.TrackerActionCreated
+	if exists {
+		action = model.TrackerActionUpdated
+	}
+
+	if params.DryRun {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindGraphqlApi,
+			Action:   action,
+			Duration: time.Since(start),
+			Message:  "would save GraphQL API settings",
+		})
+		return api, nil
+	}
+
+	uc.trackerRepository.InProgress(ctx, "saving GraphQL API settings")
+
+	if _, err := uc.graphqlApiRepositoryForFS.Save(ctx, params.APIID, api); err != nil {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindGraphqlApi,
+			Action:   model.TrackerActionFailed,
+			Duration: time.Since(start),
+			Err:      err,
+			Message:  "failed to save GraphQL API settings",
+		})
+		return nil, err
+	}
+
+	uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+		Kind:     model.ResourceKindGraphqlApi,
+		Action:   action,
+		Duration: time.Since(start),
+		Message:  "saved GraphQL API settings",
+	})
+
+	return api, nil
+}
+
+func (uc *pullUseCase) pullEnvironmentVariables(ctx context.Context, params *PullInput) (res model.EnvironmentVariables, err error) {
+	defer wrap(&err)
+
+	start := time.Now()
+
 	uc.trackerRepository.InProgress(ctx, "fetching environment variables")
 
-	variables, err := uc.environmentVariablesRepositoryForAppSync.Get(ctx, apiID)
+	variables, err := uc.environmentVariablesRepositoryForAppSync.Get(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to fetch environment variables")
 		return nil, err
 	}
 
+	uc.trackerRepository.InProgress(ctx, "loading environment variables")
+
+	// NOTE: the local values may be encrypted or refer to unset variables, so that only the existence is checked
+	exists, err := uc.resourceRepositoryForFS.Exists(ctx, params.APIID, model.ResourceKindEnvironmentVariables, "")
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load environment variables")
+		return nil, err
+	}
+
+	action := model.TrackerActionCreated
+	if exists {
+		action = model.TrackerActionUpdated
+	}
+
+	if params.DryRun {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindEnvironmentVariables,
+			Action:   action,
+			Duration: time.Since(start),
+			Message:  "would save environment variables",
+		})
+		return variables, nil
+	}
+
 	uc.trackerRepository.InProgress(ctx, "saving environment variables")
 
-	if _, err := uc.environmentVariablesRepositoryForFS.Save(ctx, apiID, variables); err != nil {
-		uc.trackerRepository.Failed(ctx, "failed to save environment variables")
+	if _, err := uc.environmentVariablesRepositoryForFS.Save(ctx, params.APIID, variables); err != nil {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindEnvironmentVariables,
+			Action:   model.TrackerActionFailed,
+			Duration: time.Since(start),
+			Err:      err,
+			Message:  "failed to save environment variables",
+		})
 		return nil, err
 	}
 
-	uc.trackerRepository.Success(ctx, "saved environment variables")
+	uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+		Kind:     model.ResourceKindEnvironmentVariables,
+		Action:   action,
+		Duration: time.Since(start),
+		Message:  "saved environment variables",
+	})
 
 	return variables, nil
 }
 
-func (uc *pullUseCase) pullSchema(ctx context.Context, apiID string) (res *model.Schema, err error) {
+func (uc *pullUseCase) pullSchema(ctx context.Context, params *PullInput) (res *model.Schema, err error) {
 	defer wrap(&err)
 
+	start := time.Now()
+
 	uc.trackerRepository.InProgress(ctx, "fetching schema")
 
-	schema, err := uc.schemaRepositoryForAppSync.Get(ctx, apiID)
+	remote, err := uc.schemaRepositoryForAppSync.Get(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to fetch schema")
 		return nil, err
 	}
 
+	uc.trackerRepository.InProgress(ctx, "formatting schema")
+
+	// NOTE: AppSync may reorder and reindent the schema between pulls, so that it is formatted before it is saved
+	files, err := uc.schemaService.Format(ctx, []model.SchemaFile{{Content: string(*remote)}}, model.SchemaFormatOptionsWithSort(params.SortSchema))
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to format schema")
+		return nil, err
+	}
+
+	schema := ptr.Pointer(model.Schema(files[0].Content))
+
+	uc.trackerRepository.InProgress(ctx, "loading schema")
+
+	exists, err := uc.resourceRepositoryForFS.Exists(ctx, params.APIID, model.ResourceKindSchema, "")
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load schema")
+		return nil, err
+	}
+
+	action := model.TrackerActionCreated
+	if exists {
+		action = model.TrackerActionUpdated
+	}
+
+	if params.DryRun {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindSchema,
+			Action:   action,
+			Duration: time.Since(start),
+			Message:  "would save schema",
+		})
+		return schema, nil
+	}
+
 	uc.trackerRepository.InProgress(ctx, "saving schema")
 
-	if _, err := uc.schemaRepositoryForFS.Save(ctx, apiID, schema); err != nil {
-		uc.trackerRepository.Failed(ctx, "failed to save schema")
+	if _, err := uc.schemaRepositoryForFS.Save(ctx, params.APIID, schema); err != nil {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindSchema,
+			Action:   model.TrackerActionFailed,
+			Duration: time.Since(start),
+			Err:      err,
+			Message:  "failed to save schema",
+		})
 		return nil, err
 	}
 
-	uc.trackerRepository.Success(ctx, "saved schema")
+	uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+		Kind:     model.ResourceKindSchema,
+		Action:   action,
+		Duration: time.Since(start),
+		Message:  "saved schema",
+	})
 
 	return schema, nil
 }
 
-func (uc *pullUseCase) pullFunctions(ctx context.Context, apiID string) (res []model.Function, err error) {
+func (uc *pullUseCase) pullDataSources(ctx context.Context, params *PullInput) (res []model.DataSource, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "fetching data sources")
+
+	dataSources, err := uc.dataSourceRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch data sources")
+		return nil, err
+	}
+
+	dataSources, _ = filterDataSources(ctx, uc.filterService, params.Filter, dataSources)
+
+	uc.trackerRepository.InProgress(ctx, "saving data sources")
+
+	var mu sync.Mutex
+	g := syncup.WorkerPool(ctx).Group()
+	errs := make([]error, 0)
+
+	for _, ds := range dataSources {
+		ds := ds
+		g.Go(func() {
+			start := time.Now()
+
+			exists, err := uc.resourceRepositoryForFS.Exists(ctx, params.APIID, model.ResourceKindDataSource, ptr.ToValue(ds.Name))
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindDataSource,
+					Identifier: ptr.ToValue(ds.Name),
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to load data source %s", ptr.ToValue(ds.Name)),
+				})
+				return
+			}
+
+			action := model.TrackerActionCreated
+			if exists {
+				action = model.TrackerActionUpdated
+			}
+
+			if params.DryRun {
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindDataSource,
+					Identifier: ptr.ToValue(ds.Name),
+					Action:     action,
+					Duration:   time.Since(start),
+					Message:    fmt.Sprintf("would save data source %s", ptr.ToValue(ds.Name)),
+				})
+				return
+			}
+
+			if _, err := uc.dataSourceRepositoryForFS.Save(ctx, params.APIID, &ds); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindDataSource,
+					Identifier: ptr.ToValue(ds.Name),
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to save data source %s", ptr.ToValue(ds.Name)),
+				})
+				return
+			}
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindDataSource,
+				Identifier: ptr.ToValue(ds.Name),
+				Action:     action,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("saved data source %s", ptr.ToValue(ds.Name)),
+			})
+		})
+	}
+
+	g.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if params.DryRun {
+		uc.trackerRepository.Success(ctx, "would save all data sources")
+		return dataSources, nil
+	}
+
+	uc.trackerRepository.Success(ctx, "saved all data sources")
+
+	return dataSources, nil
+}
+
+func (uc *pullUseCase) pullFunctions(ctx context.Context, params *PullInput, st *syncState) (res []model.Function, err error) {
 	defer wrap(&err)
 
 	uc.trackerRepository.InProgress(ctx, "fetching functions")
 
-	functions, err := uc.functionRepositoryForAppSync.List(ctx, apiID)
+	functions, err := uc.functionRepositoryForAppSync.List(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to fetch functions")
 		return nil, err
 	}
 
+	// NOTE: resolvers may refer to functions excluded by the filter, so all the functions are returned
+	fns, _ := filterFunctions(ctx, uc.filterService, params.Filter, functions)
+
 	uc.trackerRepository.InProgress(ctx, "saving functions")
 
 	var mu sync.Mutex
-	var wg sync.WaitGroup
+	g := syncup.WorkerPool(ctx).Group()
 	errs := make([]error, 0)
 
-	for _, fn := range functions {
+	for _, fn := range fns {
 		fn := fn
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
+		g.Go(func() {
+			start := time.Now()
+
+			exists, err := uc.resourceRepositoryForFS.Exists(ctx, params.APIID, model.ResourceKindFunction, ptr.ToValue(fn.Name))
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: ptr.ToValue(fn.Name),
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to load function %s", ptr.ToValue(fn.Name)),
+				})
+				return
+			}
+
+			action := model.TrackerActionCreated
+			if exists {
+				action = model.TrackerActionUpdated
+			}
+
+			if params.DryRun {
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: ptr.ToValue(fn.Name),
+					Action:     action,
+					Duration:   time.Since(start),
+					Message:    fmt.Sprintf("would save function %s", ptr.ToValue(fn.Name)),
+				})
+				return
+			}
 
-			if _, err := uc.functionRepositoryForFS.Save(ctx, apiID, &fn); err != nil {
+			if _, err := uc.functionRepositoryForFS.Save(ctx, params.APIID, &fn); err != nil {
 				mu.Lock()
 				errs = append(errs, err)
 				mu.Unlock()
 
-				uc.trackerRepository.Failed(ctx, fmt.Sprintf("failed to save function %s", ptr.ToValue(fn.Name)))
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: ptr.ToValue(fn.Name),
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to save function %s", ptr.ToValue(fn.Name)),
+				})
+				return
+			}
+
+			if err := st.recordFunction(&fn); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
 				return
 			}
 
-			uc.trackerRepository.Success(ctx, fmt.Sprintf("saved function %s", ptr.ToValue(fn.Name)))
-		}()
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindFunction,
+				Identifier: ptr.ToValue(fn.Name),
+				Action:     action,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("saved function %s", ptr.ToValue(fn.Name)),
+			})
+		})
 	}
 
-	wg.Wait()
+	g.Wait()
 
 	if err := errors.Join(errs...); err != nil {
 		return nil, err
 	}
 
+	if params.DryRun {
+		uc.trackerRepository.Success(ctx, "would save all functions")
+		return functions, nil
+	}
+
 	uc.trackerRepository.Success(ctx, "saved all functions")
 
 	return functions, nil
 }
 
-func (uc *pullUseCase) pullResolvers(ctx context.Context, apiID string, functions []model.Function) (res []model.Resolver, err error) {
+func (uc *pullUseCase) pullResolvers(ctx context.Context, params *PullInput, st *syncState, functions []model.Function) (res []model.Resolver, err error) {
 	defer wrap(&err)
 
 	uc.trackerRepository.InProgress(ctx, "fetching resolvers")
 
-	resolvers, err := uc.resolverRepositoryForAppSync.List(ctx, apiID)
+	resolvers, err := uc.resolverRepositoryForAppSync.List(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to fetch resolvers")
 		return nil, err
 	}
 
+	resolvers, _ = filterResolvers(ctx, uc.filterService, params.Filter, resolvers)
+
 	uc.trackerRepository.InProgress(ctx, "saving resolvers")
 
 	var mu sync.Mutex
-	var wg sync.WaitGroup
+	g := syncup.WorkerPool(ctx).Group()
 	errs := make([]error, 0)
 
 	for _, rslv := range resolvers {
 		rslv := rslv
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
+		g.Go(func() {
+			start := time.Now()
+			identifier := fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))
+
+			exists, err := uc.resourceRepositoryForFS.Exists(ctx, params.APIID, model.ResourceKindResolver, identifier)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindResolver,
+					Identifier: identifier,
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to load resolver %s", identifier),
+				})
+				return
+			}
+
+			action := model.TrackerActionCreated
+			if exists {
+				action = model.TrackerActionUpdated
+			}
 
 			if err := uc.resolverService.ResolvePipelineConfigFunctionNames(ctx, &rslv, functions); err != nil {
 				mu.Lock()
 				errs = append(errs, err)
 				mu.Unlock()
 
-				uc.trackerRepository.Failed(ctx, fmt.Sprintf("failed to save resolver %s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName)))
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindResolver,
+					Identifier: identifier,
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to save resolver %s", identifier),
+				})
 				return
 			}
 
-			if _, err := uc.resolverRepositoryForFS.Save(ctx, apiID, &rslv); err != nil {
+			if params.DryRun {
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindResolver,
+					Identifier: identifier,
+					Action:     action,
+					Duration:   time.Since(start),
+					Message:    fmt.Sprintf("would save resolver %s", identifier),
+				})
+				return
+			}
+
+			if _, err := uc.resolverRepositoryForFS.Save(ctx, params.APIID, &rslv); err != nil {
 				mu.Lock()
 				errs = append(errs, err)
 				mu.Unlock()
 
-				uc.trackerRepository.Failed(ctx, fmt.Sprintf("failed to save resolver %s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName)))
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindResolver,
+					Identifier: identifier,
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to save resolver %s", identifier),
+				})
 				return
 			}
 
-			uc.trackerRepository.Success(ctx, fmt.Sprintf("saved resolver %s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName)))
-		}()
+			if err := st.recordResolver(&rslv); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+				return
+			}
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindResolver,
+				Identifier: identifier,
+				Action:     action,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("saved resolver %s", identifier),
+			})
+		})
 	}
 
-	wg.Wait()
+	g.Wait()
 
 	if err := errors.Join(errs...); err != nil {
 		return nil, err
 	}
 
+	if params.DryRun {
+		uc.trackerRepository.Success(ctx, "would save all resolvers")
+		return resolvers, nil
+	}
+
 	uc.trackerRepository.Success(ctx, "saved all resolvers")
 
 	return resolvers, nil
 }
 
-func (uc *pullUseCase) deleteExtraneousFunctions(ctx context.Context, apiID string, functions []model.Function) (err error) {
+func (uc *pullUseCase) deleteExtraneousFunctions(ctx context.Context, params *PullInput, st *syncState, functions []model.Function) (err error) {
 	defer wrap(&err)
 
 	uc.trackerRepository.InProgress(ctx, "loading functions")
 
-	fns, err := uc.functionRepositoryForFS.List(ctx, apiID)
+	fns, err := uc.functionRepositoryForFS.List(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to load functions")
 		return err
 	}
 
+	fns, _ = filterFunctions(ctx, uc.filterService, params.Filter, fns)
+
 	extraneousFns, err := uc.functionService.Difference(ctx, fns, functions)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to retrieve extraneous functions")
@@ -284,50 +747,82 @@ func (uc *pullUseCase) deleteExtraneousFunctions(ctx context.Context, apiID stri
 	uc.trackerRepository.InProgress(ctx, "deleting extraneous functions")
 
 	var mu sync.Mutex
-	var wg sync.WaitGroup
+	g := syncup.WorkerPool(ctx).Group()
 	errs := make([]error, 0)
 
 	for _, fn := range extraneousFns {
 		fn := fn
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
+		g.Go(func() {
+			start := time.Now()
+
+			if params.DryRun {
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: ptr.ToValue(fn.Name),
+					Action:     model.TrackerActionDeleted,
+					Duration:   time.Since(start),
+					Message:    fmt.Sprintf("would delete extraneous function %s", ptr.ToValue(fn.Name)),
+				})
+				return
+			}
 
-			if err := uc.functionRepositoryForFS.Delete(ctx, apiID, *fn.Name); err != nil {
+			if err := uc.functionRepositoryForFS.Delete(ctx, params.APIID, *fn.Name); err != nil {
 				mu.Lock()
 				errs = append(errs, err)
 				mu.Unlock()
 
-				uc.trackerRepository.Failed(ctx, fmt.Sprintf("failed to delete extraneous function %s", ptr.ToValue(fn.Name)))
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: ptr.ToValue(fn.Name),
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to delete extraneous function %s", ptr.ToValue(fn.Name)),
+				})
 				return
 			}
 
-			uc.trackerRepository.Success(ctx, fmt.Sprintf("deleted extraneous function %s", ptr.ToValue(fn.Name)))
-		}()
+			st.forgetFunction(ptr.ToValue(fn.Name))
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindFunction,
+				Identifier: ptr.ToValue(fn.Name),
+				Action:     model.TrackerActionDeleted,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("deleted extraneous function %s", ptr.ToValue(fn.Name)),
+			})
+		})
 	}
 
-	wg.Wait()
+	g.Wait()
 
 	if err := errors.Join(errs...); err != nil {
 		return err
 	}
 
+	if params.DryRun {
+		uc.trackerRepository.Success(ctx, "would delete all extraneous functions")
+		return nil
+	}
+
 	uc.trackerRepository.Success(ctx, "deleted all extraneous functions")
 
 	return nil
 }
 
-func (uc *pullUseCase) deleteExtraneousResolvers(ctx context.Context, apiID string, resolvers []model.Resolver) (err error) {
+func (uc *pullUseCase) deleteExtraneousResolvers(ctx context.Context, params *PullInput, st *syncState, resolvers []model.Resolver) (err error) {
 	defer wrap(&err)
 
 	uc.trackerRepository.InProgress(ctx, "loading resolvers")
 
-	rslvs, err := uc.resolverRepositoryForFS.List(ctx, apiID)
+	rslvs, err := uc.resolverRepositoryForFS.List(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to load resolvers")
 		return err
 	}
 
+	rslvs, _ = filterResolvers(ctx, uc.filterService, params.Filter, rslvs)
+
 	extraneousRslvs, err := uc.resolverService.Difference(ctx, rslvs, resolvers)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to retrieve extraneous resolvers")
@@ -342,34 +837,65 @@ func (uc *pullUseCase) deleteExtraneousResolvers(ctx context.Context, apiID stri
 	uc.trackerRepository.InProgress(ctx, "deleting extraneous resolvers")
 
 	var mu sync.Mutex
-	var wg sync.WaitGroup
+	g := syncup.WorkerPool(ctx).Group()
 	errs := make([]error, 0)
 
 	for _, rslv := range extraneousRslvs {
 		rslv := rslv
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
+		g.Go(func() {
+			start := time.Now()
+			identifier := fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))
+
+			if params.DryRun {
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindResolver,
+					Identifier: identifier,
+					Action:     model.TrackerActionDeleted,
+					Duration:   time.Since(start),
+					Message:    fmt.Sprintf("would delete extraneous resolver %s", identifier),
+				})
+				return
+			}
 
-			if err := uc.resolverRepositoryForFS.Delete(ctx, apiID, *rslv.TypeName, *rslv.FieldName); err != nil {
+			if err := uc.resolverRepositoryForFS.Delete(ctx, params.APIID, *rslv.TypeName, *rslv.FieldName); err != nil {
 				mu.Lock()
 				errs = append(errs, err)
 				mu.Unlock()
 
-				uc.trackerRepository.Failed(ctx, fmt.Sprintf("failed to delete extraneous resolver %s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName)))
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindResolver,
+					Identifier: identifier,
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to delete extraneous resolver %s", identifier),
+				})
 				return
 			}
 
-			uc.trackerRepository.Success(ctx, fmt.Sprintf("deleted extraneous resolver %s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName)))
-		}()
+			st.forgetResolver(identifier)
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindResolver,
+				Identifier: identifier,
+				Action:     model.TrackerActionDeleted,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("deleted extraneous resolver %s", identifier),
+			})
+		})
 	}
 
-	wg.Wait()
+	g.Wait()
 
 	if err := errors.Join(errs...); err != nil {
 		return err
 	}
 
+	if params.DryRun {
+		uc.trackerRepository.Success(ctx, "would delete all extraneous resolvers")
+		return nil
+	}
+
 	uc.trackerRepository.Success(ctx, "deleted all extraneous resolvers")
 
 	return nil
@@ -38,8 +38,11 @@ import (
 
 func Test_pullUseCase_Execute(t *testing.T) {
 	testdataBaseDir := "../../../testdata"
+	graphqlApi := testhelpers.MustUnmarshalJSON[model.GraphqlApi](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "graphql_api/api.json")))
 	variables := testhelpers.MustUnmarshalJSON[model.EnvironmentVariables](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "environment_variables/env.json")))
 	schema := model.Schema(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema/schema.graphqls")))
+	dataSourceAMAZON_DYNAMODB := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AMAZON_DYNAMODB/metadata.json")))
+	dataSourceAWS_LAMBDA := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AWS_LAMBDA/metadata.json")))
 	functionVTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Function](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/VTL_2018-05-29/metadata.json")))
 	functionVTL_2018_05_29.FunctionId = ptr.Pointer("VTL_2018-05-29")
 	functionVTL_2018_05_29.RequestMappingTemplate = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/VTL_2018-05-29/request.vtl"))))
@@ -62,10 +65,60 @@ func Test_pullUseCase_Execute(t *testing.T) {
 	resolverPIPELINE_APPSYNC_JS_1_0_0.PipelineConfig.Functions = []string{"VTL_2018-05-29", "APPSYNC_JS_1.0.0"}
 	resolverPIPELINE_APPSYNC_JS_1_0_0.Code = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/PIPELINE/APPSYNC_JS_1.0.0/code.js"))))
 
+	mustHash := func(hash string, err error) string {
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+
 	type args struct {
 		params *PullInput
 	}
 
+	type mockFilterServiceValidateReturn struct {
+		err error
+	}
+	type mockFilterServiceValidate struct {
+		calls   int
+		returns []mockFilterServiceValidateReturn
+	}
+
+	type mockFilterServiceMatchReturn struct {
+		res bool
+	}
+	type mockFilterServiceMatch struct {
+		calls   int
+		returns []mockFilterServiceMatchReturn
+	}
+
+	type mockResourceRepositoryForFSExistsReturn struct {
+		res bool
+		err error
+	}
+	type mockResourceRepositoryForFSExists struct {
+		calls   int
+		returns []mockResourceRepositoryForFSExistsReturn
+	}
+
+	type mockGraphqlApiRepositoryForAppSyncGetReturn struct {
+		res *model.GraphqlApi
+		err error
+	}
+	type mockGraphqlApiRepositoryForAppSyncGet struct {
+		calls   int
+		returns []mockGraphqlApiRepositoryForAppSyncGetReturn
+	}
+
+	type mockGraphqlApiRepositoryForFSSaveReturn struct {
+		res *model.GraphqlApi
+		err error
+	}
+	type mockGraphqlApiRepositoryForFSSave struct {
+		calls   int
+		returns []mockGraphqlApiRepositoryForFSSaveReturn
+	}
+
 	type mockEnvironmentVariablesRepositoryForAppSyncGetReturn struct {
 		res model.EnvironmentVariables
 		err error
@@ -93,6 +146,15 @@ func Test_pullUseCase_Execute(t *testing.T) {
 		returns []mockSchemaRepositoryForAppSyncGetReturn
 	}
 
+	type mockSchemaServiceFormatReturn struct {
+		res []model.SchemaFile
+		err error
+	}
+	type mockSchemaServiceFormat struct {
+		calls   int
+		returns []mockSchemaServiceFormatReturn
+	}
+
 	type mockSchemaRepositoryForFSSaveReturn struct {
 		res *model.Schema
 		err error
@@ -102,6 +164,24 @@ func Test_pullUseCase_Execute(t *testing.T) {
 		returns []mockSchemaRepositoryForFSSaveReturn
 	}
 
+	type mockDataSourceRepositoryForAppSyncListReturn struct {
+		res []model.DataSource
+		err error
+	}
+	type mockDataSourceRepositoryForAppSyncList struct {
+		calls   int
+		returns []mockDataSourceRepositoryForAppSyncListReturn
+	}
+
+	type mockDataSourceRepositoryForFSSaveReturn struct {
+		res *model.DataSource
+		err error
+	}
+	type mockDataSourceRepositoryForFSSave struct {
+		calls   int
+		returns []mockDataSourceRepositoryForFSSaveReturn
+	}
+
 	type mockFunctionRepositoryForAppSyncListReturn struct {
 		res []model.Function
 		err error
@@ -198,7 +278,26 @@ func Test_pullUseCase_Execute(t *testing.T) {
 		returns []mockResolverRepositoryForFSDeleteReturn
 	}
 
+	type mockStateRepositoryForFSGetReturn struct {
+		res *model.State
+		err error
+	}
+	type mockStateRepositoryForFSGet struct {
+		calls   int
+		returns []mockStateRepositoryForFSGetReturn
+	}
+
+	type mockStateRepositoryForFSSaveReturn struct {
+		res *model.State
+		err error
+	}
+	type mockStateRepositoryForFSSave struct {
+		calls   int
+		returns []mockStateRepositoryForFSSaveReturn
+	}
+
 	type expected struct {
+		state *model.State
 		res   *PullOutput
 		errIs error
 	}
@@ -206,10 +305,19 @@ func Test_pullUseCase_Execute(t *testing.T) {
 	tests := []struct {
 		name                                                  string
 		args                                                  args
+		mockFilterServiceValidate                             mockFilterServiceValidate
+		mockFilterServiceMatch                                mockFilterServiceMatch
+		mockStateRepositoryForFSGet                           mockStateRepositoryForFSGet
+		mockResourceRepositoryForFSExists                     mockResourceRepositoryForFSExists
+		mockGraphqlApiRepositoryForAppSyncGet                 mockGraphqlApiRepositoryForAppSyncGet
+		mockGraphqlApiRepositoryForFSSave                     mockGraphqlApiRepositoryForFSSave
 		mockEnvironmentVariablesRepositoryForAppSyncGet       mockEnvironmentVariablesRepositoryForAppSyncGet
 		mockEnvironmentVariablesRepositoryForFSSave           mockEnvironmentVariablesRepositoryForFSSave
 		mockSchemaRepositoryForAppSyncGet                     mockSchemaRepositoryForAppSyncGet
+		mockSchemaServiceFormat                               mockSchemaServiceFormat
 		mockSchemaRepositoryForFSSave                         mockSchemaRepositoryForFSSave
+		mockDataSourceRepositoryForAppSyncList                mockDataSourceRepositoryForAppSyncList
+		mockDataSourceRepositoryForFSSave                     mockDataSourceRepositoryForFSSave
 		mockFunctionRepositoryForAppSyncList                  mockFunctionRepositoryForAppSyncList
 		mockFunctionRepositoryForFSSave                       mockFunctionRepositoryForFSSave
 		mockResolverRepositoryForAppSyncList                  mockResolverRepositoryForAppSyncList
@@ -221,6 +329,7 @@ func Test_pullUseCase_Execute(t *testing.T) {
 		mockResolverRepositoryForFSList                       mockResolverRepositoryForFSList
 		mockResolverServiceDifference                         mockResolverServiceDifference
 		mockResolverRepositoryForFSDelete                     mockResolverRepositoryForFSDelete
+		mockStateRepositoryForFSSave                          mockStateRepositoryForFSSave
 		expected                                              expected
 	}{
 		{
@@ -231,6 +340,84 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -255,6 +442,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -263,6 +458,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -381,19 +599,105 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   &PullOutput{},
 				errIs: nil,
 			},
 		},
 		{
-			name: "happy path: delete extraneous files",
+			name: "happy path: record hashes of pulled functions and resolvers",
 			args: args{
 				params: &PullInput{
 					APIID:                     "APIID",
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -418,6 +722,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -426,6 +738,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -504,8 +839,6 @@ func Test_pullUseCase_Execute(t *testing.T) {
 						res: []model.Function{
 							functionVTL_2018_05_29,
 							functionAPPSYNC_JS_1_0_0,
-							{Name: ptr.Pointer("ExtraneousFunction1")},
-							{Name: ptr.Pointer("ExtraneousFunction2")},
 						},
 						err: nil,
 					},
@@ -514,23 +847,13 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockFunctionServiceDifference: mockFunctionServiceDifference{
 				returns: []mockFunctionServiceDifferenceReturn{
 					{
-						res: []model.Function{
-							{Name: ptr.Pointer("ExtraneousFunction1")},
-							{Name: ptr.Pointer("ExtraneousFunction2")},
-						},
+						res: []model.Function{},
 						err: nil,
 					},
 				},
 			},
 			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
-				returns: []mockFunctionRepositoryForFSDeleteReturn{
-					{
-						err: nil,
-					},
-					{
-						err: nil,
-					},
-				},
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
 			},
 			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
 				returns: []mockResolverRepositoryForFSListReturn{
@@ -540,8 +863,6 @@ func Test_pullUseCase_Execute(t *testing.T) {
 							resolverUNIT_APPSYNC_JS_1_0_0,
 							resolverPIPELINE_VTL_2018_05_29,
 							resolverPIPELINE_APPSYNC_JS_1_0_0,
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
 						},
 						err: nil,
 					},
@@ -550,71 +871,262 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverServiceDifference: mockResolverServiceDifference{
 				returns: []mockResolverServiceDifferenceReturn{
 					{
-						res: []model.Resolver{
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
-						},
+						res: []model.Resolver{},
 						err: nil,
 					},
 				},
 			},
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
-				returns: []mockResolverRepositoryForFSDeleteReturn{
-					{
-						err: nil,
-					},
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
 					{
+						res: model.NewState(),
 						err: nil,
 					},
 				},
 			},
 			expected: expected{
+				state: &model.State{
+					Functions: map[string]string{
+						"VTL_2018-05-29":   mustHash(model.FunctionHash(&functionVTL_2018_05_29)),
+						"APPSYNC_JS_1.0.0": mustHash(model.FunctionHash(&functionAPPSYNC_JS_1_0_0)),
+					},
+					Resolvers: map[string]string{
+						"UNIT.VTL_2018-05-29":       mustHash(model.ResolverHash(&resolverUNIT_VTL_2018_05_29)),
+						"UNIT.APPSYNC_JS_1.0.0":     mustHash(model.ResolverHash(&resolverUNIT_APPSYNC_JS_1_0_0)),
+						"PIPELINE.VTL_2018-05-29":   mustHash(model.ResolverHash(&resolverPIPELINE_VTL_2018_05_29)),
+						"PIPELINE.APPSYNC_JS_1.0.0": mustHash(model.ResolverHash(&resolverPIPELINE_APPSYNC_JS_1_0_0)),
+					},
+				},
 				res:   &PullOutput{},
 				errIs: nil,
 			},
 		},
 		{
-			name: "happy path: skip deleting extraneous files",
+			name: "happy path: nothing saved locally yet",
 			args: args{
 				params: &PullInput{
 					APIID:                     "APIID",
-					DeleteExtraneousResources: false,
+					DeleteExtraneousResources: true,
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
 					{
-						res: variables,
+						res: model.NewState(),
 						err: nil,
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
-				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
 					{
-						res: variables,
+						res: false,
 						err: nil,
 					},
-				},
-			},
-			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
-				returns: []mockSchemaRepositoryForAppSyncGetReturn{
 					{
-						res: &schema,
+						res: false,
 						err: nil,
 					},
-				},
-			},
-			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
-				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
-						res: &schema,
+						res: false,
 						err: nil,
 					},
-				},
-			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
 					{
 						res: []model.Function{
 							functionVTL_2018_05_29,
@@ -624,67 +1136,2668 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
-			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
-				returns: []mockFunctionRepositoryForFSSaveReturn{
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &PullOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: delete extraneous files",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &PullOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: dry run",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					DryRun:                    true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   &PullOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: skip deleting extraneous files",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: false,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &PullOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: filter resources",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					Filter: &model.Filter{
+						Kinds: []model.ResourceKind{model.ResourceKindResolver},
+					},
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &PullOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: FilterService.Validate() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					Filter: &model.Filter{
+						Include: []string{"Query.["},
+					},
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{
+					{
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: StateRepositoryForFS.Get() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: nil,
+						err: model.ErrNilValue,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: StateRepositoryForFS.Save() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: nil,
+						err: model.ErrNilValue,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: GraphqlApiRepositoryForAppSync.Get() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: GraphqlApiRepositoryForFS.Save() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResourceRepositoryForFS.Exists() error for GraphQL API settings",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: EnvironmentVariablesRepositoryForAppSync.Get() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: EnvironmentVariablesRepositoryForFS.Save() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResourceRepositoryForFS.Exists() error for environment variables",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: SchemaRepositoryForAppSync.Get() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: SchemaService.Format() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrInvalidValue},
+					},
+				},
+			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForFSDelete: mockFunctionRepositoryForFSDelete{
+				returns: []mockFunctionRepositoryForFSDeleteReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
 					{
-						res: &functionVTL_2018_05_29,
+						res: model.NewState(),
 						err: nil,
 					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: SchemaRepositoryForFS.Save() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
 					{
-						res: &functionAPPSYNC_JS_1_0_0,
+						res: model.NewState(),
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
 					{
-						res: []model.Resolver{
-							resolverUNIT_VTL_2018_05_29,
-							resolverUNIT_APPSYNC_JS_1_0_0,
-							resolverPIPELINE_VTL_2018_05_29,
-							resolverPIPELINE_APPSYNC_JS_1_0_0,
-						},
+						res: true,
 						err: nil,
 					},
-				},
-			},
-			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{
 					{
+						res: true,
 						err: nil,
 					},
 					{
+						res: true,
 						err: nil,
 					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
 					{
+						res: &graphqlApi,
 						err: nil,
 					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
 					{
+						res: &graphqlApi,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
-				returns: []mockResolverRepositoryForFSSaveReturn{
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
-						res: &resolverUNIT_VTL_2018_05_29,
+						res: variables,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
 					{
-						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						res: variables,
 						err: nil,
 					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
 					{
-						res: &resolverPIPELINE_VTL_2018_05_29,
+						res: &schema,
 						err: nil,
 					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
 					{
-						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						res: []model.SchemaFile{{Content: string(schema)}},
 						err: nil,
 					},
 				},
 			},
+			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionRepositoryForFSSave: mockFunctionRepositoryForFSSave{
+				returns: []mockFunctionRepositoryForFSSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionNames: mockResolverServiceResolvePipelineConfigFunctionNames{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionNamesReturn{},
+			},
+			mockResolverRepositoryForFSSave: mockResolverRepositoryForFSSave{
+				returns: []mockResolverRepositoryForFSSaveReturn{},
+			},
 			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
 				returns: []mockFunctionRepositoryForFSListReturn{},
 			},
@@ -703,36 +3816,114 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
-				res:   &PullOutput{},
+				res:   nil,
 				errIs: nil,
 			},
 		},
 		{
-			name: "edge path: EnvironmentVariablesRepositoryForAppSync.Get() error",
+			name: "edge path: ResourceRepositoryForFS.Exists() error for schema",
 			args: args{
 				params: &PullInput{
 					APIID:                     "APIID",
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: variables,
+						err: nil,
 					},
 				},
 			},
 			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
-				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{},
+				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
 			},
 			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
-				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
 			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{},
 			},
@@ -766,19 +3957,73 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
 			},
 		},
 		{
-			name: "edge path: EnvironmentVariablesRepositoryForFS.Save() error",
+			name: "edge path: DataSourceRepositoryForAppSync.List() error",
 			args: args{
 				params: &PullInput{
 					APIID:                     "APIID",
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -790,16 +4035,45 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockEnvironmentVariablesRepositoryForFSSave: mockEnvironmentVariablesRepositoryForFSSave{
 				returns: []mockEnvironmentVariablesRepositoryForFSSaveReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: variables,
+						err: nil,
 					},
 				},
 			},
 			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
-				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
 			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
-				returns: []mockSchemaRepositoryForFSSaveReturn{},
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
 			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{},
@@ -834,19 +4108,81 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
 			},
 		},
 		{
-			name: "edge path: SchemaRepositoryForAppSync.Get() error",
+			name: "edge path: DataSourceRepositoryForFS.Save() error",
 			args: args{
 				params: &PullInput{
 					APIID:                     "APIID",
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -866,13 +4202,49 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
 				returns: []mockSchemaRepositoryForAppSyncGetReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
 					},
 				},
 			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
-				returns: []mockSchemaRepositoryForFSSaveReturn{},
+				returns: []mockSchemaRepositoryForFSSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
 			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{},
@@ -907,19 +4279,81 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
 			},
 		},
 		{
-			name: "edge path: SchemaRepositoryForFS.Save() error",
+			name: "edge path: ResourceRepositoryForFS.Exists() error for data sources",
 			args: args{
 				params: &PullInput{
 					APIID:                     "APIID",
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -944,14 +4378,36 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
 					},
 				},
 			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{},
 			},
@@ -985,6 +4441,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
@@ -998,6 +4462,60 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -1022,6 +4540,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -1030,6 +4556,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -1068,6 +4617,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
@@ -1081,6 +4638,68 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -1105,6 +4724,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -1113,6 +4740,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -1163,17 +4813,87 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
-			expected: expected{
-				res:   nil,
-				errIs: nil,
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForAppSync.List() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
 			},
-		},
-		{
-			name: "edge path: ResolverRepositoryForAppSync.List() error",
-			args: args{
-				params: &PullInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
 				},
 			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
@@ -1200,6 +4920,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -1208,6 +4936,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -1263,6 +5014,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
@@ -1276,6 +5035,84 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -1300,6 +5137,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -1308,6 +5153,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -1381,6 +5249,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
@@ -1394,6 +5270,84 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -1418,6 +5372,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -1426,6 +5388,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -1516,17 +5501,103 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
 			},
-		},
-		{
-			name: "edge path: FunctionRepositoryForFS.List() error",
-			args: args{
-				params: &PullInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
+		},
+		{
+			name: "edge path: FunctionRepositoryForFS.List() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
 				},
 			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
@@ -1553,6 +5624,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -1561,6 +5640,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -1656,6 +5758,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
@@ -1669,6 +5779,84 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -1693,6 +5881,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -1701,6 +5897,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -1806,6 +6025,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
@@ -1819,6 +6046,84 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -1843,6 +6148,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -1851,6 +6164,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -1953,30 +6289,116 @@ func Test_pullUseCase_Execute(t *testing.T) {
 						err: &model.LibError{},
 					},
 					{
-						err: &model.LibError{},
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForFS.List() error",
+			args: args{
+				params: &PullInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{},
-			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
-			},
-			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
-				returns: []mockResolverRepositoryForFSDeleteReturn{},
-			},
-			expected: expected{
-				res:   nil,
-				errIs: nil,
-			},
-		},
-		{
-			name: "edge path: ResolverRepositoryForFS.List() error",
-			args: args{
-				params: &PullInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
 				},
 			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
@@ -2003,6 +6425,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -2011,6 +6441,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -2131,6 +6584,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
@@ -2144,6 +6605,84 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -2168,6 +6707,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -2176,6 +6723,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -2308,6 +6878,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
 				returns: []mockResolverRepositoryForFSDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
@@ -2321,6 +6899,84 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					DeleteExtraneousResources: true,
 				},
 			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSExists: mockResourceRepositoryForFSExists{
+				returns: []mockResourceRepositoryForFSExistsReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSSave: mockGraphqlApiRepositoryForFSSave{
+				returns: []mockGraphqlApiRepositoryForFSSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
@@ -2345,6 +7001,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaServiceFormat: mockSchemaServiceFormat{
+				returns: []mockSchemaServiceFormatReturn{
+					{
+						res: []model.SchemaFile{{Content: string(schema)}},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForFSSave: mockSchemaRepositoryForFSSave{
 				returns: []mockSchemaRepositoryForFSSaveReturn{
 					{
@@ -2353,6 +7017,29 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSSave: mockDataSourceRepositoryForFSSave{
+				returns: []mockDataSourceRepositoryForFSSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
@@ -2495,6 +7182,14 @@ func Test_pullUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
 				res:   nil,
 				errIs: nil,
@@ -2512,18 +7207,46 @@ func Test_pullUseCase_Execute(t *testing.T) {
 
 			var mu sync.Mutex
 
+			mockFilterService := mock_service.NewMockFilterService(ctrl)
 			mockFunctionService := mock_service.NewMockFunctionService(ctrl)
 			mockResolverService := mock_service.NewMockResolverService(ctrl)
+			mockSchemaService := mock_service.NewMockSchemaService(ctrl)
 			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockStateRepositoryForFS := mock_repository.NewMockStateRepository(ctrl)
+			mockResourceRepositoryForFS := mock_repository.NewMockResourceRepository(ctrl)
+			mockGraphqlApiRepositoryForFS := mock_repository.NewMockGraphqlApiRepository(ctrl)
+			mockGraphqlApiRepositoryForAppSync := mock_repository.NewMockGraphqlApiRepository(ctrl)
 			mockEnvironmentVariablesRepositoryForAppSync := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
 			mockEnvironmentVariablesRepositoryForFS := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
 			mockSchemaRepositoryForAppSync := mock_repository.NewMockSchemaRepository(ctrl)
 			mockSchemaRepositoryForFS := mock_repository.NewMockSchemaRepository(ctrl)
 			mockFunctionRepositoryForAppSync := mock_repository.NewMockFunctionRepository(ctrl)
+			mockDataSourceRepositoryForFS := mock_repository.NewMockDataSourceRepository(ctrl)
+			mockDataSourceRepositoryForAppSync := mock_repository.NewMockDataSourceRepository(ctrl)
 			mockFunctionRepositoryForFS := mock_repository.NewMockFunctionRepository(ctrl)
 			mockResolverRepositoryForAppSync := mock_repository.NewMockResolverRepository(ctrl)
 			mockResolverRepositoryForFS := mock_repository.NewMockResolverRepository(ctrl)
 
+			mockFilterService.
+				EXPECT().
+				Validate(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, filter *model.Filter) error {
+					r := tt.mockFilterServiceValidate.returns[tt.mockFilterServiceValidate.calls]
+					tt.mockFilterServiceValidate.calls++
+					return r.err
+				}).
+				Times(len(tt.mockFilterServiceValidate.returns))
+
+			mockFilterService.
+				EXPECT().
+				Match(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, filter *model.Filter, kind model.ResourceKind, identifier string) bool {
+					r := tt.mockFilterServiceMatch.returns[tt.mockFilterServiceMatch.calls]
+					tt.mockFilterServiceMatch.calls++
+					return r.res
+				}).
+				Times(len(tt.mockFilterServiceMatch.returns))
+
 			mockTrackerRepository.
 				EXPECT().
 				InProgress(ctx, gomock.Any()).
@@ -2539,6 +7262,65 @@ func Test_pullUseCase_Execute(t *testing.T) {
 				Failed(ctx, gomock.Any()).
 				AnyTimes()
 
+			mockTrackerRepository.
+				EXPECT().
+				Record(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockStateRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.State, error) {
+					r := tt.mockStateRepositoryForFSGet.returns[tt.mockStateRepositoryForFSGet.calls]
+					tt.mockStateRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockStateRepositoryForFSGet.returns))
+
+			var saved *model.State
+			mockStateRepositoryForFS.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, state *model.State) (*model.State, error) {
+					saved = state
+					r := tt.mockStateRepositoryForFSSave.returns[tt.mockStateRepositoryForFSSave.calls]
+					tt.mockStateRepositoryForFSSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockStateRepositoryForFSSave.returns))
+
+			mockResourceRepositoryForFS.
+				EXPECT().
+				Exists(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, kind model.ResourceKind, identifier string) (bool, error) {
+					mu.Lock()
+					r := tt.mockResourceRepositoryForFSExists.returns[tt.mockResourceRepositoryForFSExists.calls]
+					tt.mockResourceRepositoryForFSExists.calls++
+					mu.Unlock()
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResourceRepositoryForFSExists.returns))
+
+			mockGraphqlApiRepositoryForAppSync.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.GraphqlApi, error) {
+					r := tt.mockGraphqlApiRepositoryForAppSyncGet.returns[tt.mockGraphqlApiRepositoryForAppSyncGet.calls]
+					tt.mockGraphqlApiRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForAppSyncGet.returns))
+
+			mockGraphqlApiRepositoryForFS.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, api *model.GraphqlApi) (*model.GraphqlApi, error) {
+					r := tt.mockGraphqlApiRepositoryForFSSave.returns[tt.mockGraphqlApiRepositoryForFSSave.calls]
+					tt.mockGraphqlApiRepositoryForFSSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForFSSave.returns))
+
 			mockEnvironmentVariablesRepositoryForAppSync.
 				EXPECT().
 				Get(ctx, gomock.Any()).
@@ -2569,6 +7351,16 @@ func Test_pullUseCase_Execute(t *testing.T) {
 				}).
 				Times(len(tt.mockSchemaRepositoryForAppSyncGet.returns))
 
+			mockSchemaService.
+				EXPECT().
+				Format(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, files []model.SchemaFile, optFns ...func(o *model.SchemaFormatOptions)) ([]model.SchemaFile, error) {
+					r := tt.mockSchemaServiceFormat.returns[tt.mockSchemaServiceFormat.calls]
+					tt.mockSchemaServiceFormat.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaServiceFormat.returns))
+
 			mockSchemaRepositoryForFS.
 				EXPECT().
 				Save(ctx, gomock.Any(), gomock.Any()).
@@ -2579,6 +7371,28 @@ func Test_pullUseCase_Execute(t *testing.T) {
 				}).
 				Times(len(tt.mockSchemaRepositoryForFSSave.returns))
 
+			mockDataSourceRepositoryForAppSync.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.DataSource, error) {
+					r := tt.mockDataSourceRepositoryForAppSyncList.returns[tt.mockDataSourceRepositoryForAppSyncList.calls]
+					tt.mockDataSourceRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDataSourceRepositoryForAppSyncList.returns))
+
+			mockDataSourceRepositoryForFS.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, dataSource *model.DataSource) (*model.DataSource, error) {
+					mu.Lock()
+					r := tt.mockDataSourceRepositoryForFSSave.returns[tt.mockDataSourceRepositoryForFSSave.calls]
+					tt.mockDataSourceRepositoryForFSSave.calls++
+					mu.Unlock()
+					return r.res, r.err
+				}).
+				MaxTimes(len(tt.mockDataSourceRepositoryForFSSave.returns))
+
 			mockFunctionRepositoryForAppSync.
 				EXPECT().
 				List(ctx, gomock.Any()).
@@ -2700,13 +7514,21 @@ func Test_pullUseCase_Execute(t *testing.T) {
 				MaxTimes(len(tt.mockResolverRepositoryForFSDelete.returns))
 
 			uc := &pullUseCase{
+				filterService:                            mockFilterService,
 				functionService:                          mockFunctionService,
 				resolverService:                          mockResolverService,
+				schemaService:                            mockSchemaService,
 				trackerRepository:                        mockTrackerRepository,
+				stateRepositoryForFS:                     mockStateRepositoryForFS,
+				resourceRepositoryForFS:                  mockResourceRepositoryForFS,
+				graphqlApiRepositoryForAppSync:           mockGraphqlApiRepositoryForAppSync,
+				graphqlApiRepositoryForFS:                mockGraphqlApiRepositoryForFS,
 				environmentVariablesRepositoryForAppSync: mockEnvironmentVariablesRepositoryForAppSync,
 				environmentVariablesRepositoryForFS:      mockEnvironmentVariablesRepositoryForFS,
 				schemaRepositoryForAppSync:               mockSchemaRepositoryForAppSync,
 				schemaRepositoryForFS:                    mockSchemaRepositoryForFS,
+				dataSourceRepositoryForAppSync:           mockDataSourceRepositoryForAppSync,
+				dataSourceRepositoryForFS:                mockDataSourceRepositoryForFS,
 				functionRepositoryForAppSync:             mockFunctionRepositoryForAppSync,
 				functionRepositoryForFS:                  mockFunctionRepositoryForFS,
 				resolverRepositoryForAppSync:             mockResolverRepositoryForAppSync,
@@ -2719,6 +7541,10 @@ func Test_pullUseCase_Execute(t *testing.T) {
 			// Assert
 			assert.Equal(t, tt.expected.res, actual)
 
+			if tt.expected.state != nil {
+				assert.Equal(t, tt.expected.state, saved)
+			}
+
 			if strings.HasPrefix(tt.name, "happy") {
 				assert.NoError(t, err)
 			} else {
@@ -26,9 +26,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
 	"github.com/Aton-Kish/syncup/internal/syncup/domain/service"
@@ -37,6 +40,13 @@ import (
 type PushInput struct {
 	APIID                     string
 	DeleteExtraneousResources bool
+	DryRun                    bool
+	SkipConfirmation          bool
+	KeepBackups               int
+	Atomic                    bool
+	Force                     bool
+	AllowBreaking             bool
+	Filter                    *model.Filter
 }
 
 type PushOutput struct {
@@ -47,13 +57,29 @@ type PushUseCase interface {
 }
 
 type pushUseCase struct {
+	validateUseCase ValidateUseCase
+	backupUseCase   BackupUseCase
+
+	graphqlApiService                        service.GraphqlApiService
+	environmentVariablesService              service.EnvironmentVariablesService
+	schemaService                            service.SchemaService
+	dataSourceService                        service.DataSourceService
+	referenceService                         service.ReferenceService
+	filterService                            service.FilterService
 	functionService                          service.FunctionService
 	resolverService                          service.ResolverService
 	trackerRepository                        repository.TrackerRepository
+	confirmationRepository                   repository.ConfirmationRepository
+	stateRepositoryForFS                     repository.StateRepository
+	schemaFileRepositoryForFS                repository.SchemaFileRepository
+	graphqlApiRepositoryForAppSync           repository.GraphqlApiRepository
+	graphqlApiRepositoryForFS                repository.GraphqlApiRepository
 	environmentVariablesRepositoryForAppSync repository.EnvironmentVariablesRepository
 	environmentVariablesRepositoryForFS      repository.EnvironmentVariablesRepository
 	schemaRepositoryForAppSync               repository.SchemaRepository
 	schemaRepositoryForFS                    repository.SchemaRepository
+	dataSourceRepositoryForAppSync           repository.DataSourceRepository
+	dataSourceRepositoryForFS                repository.DataSourceRepository
 	functionRepositoryForAppSync             repository.FunctionRepository
 	functionRepositoryForFS                  repository.FunctionRepository
 	resolverRepositoryForAppSync             repository.ResolverRepository
@@ -62,13 +88,29 @@ type pushUseCase struct {
 
 func NewPushUseCase(repo repository.Repository) PushUseCase {
 	return &pushUseCase{
+		validateUseCase: NewValidateUseCase(repo),
+		backupUseCase:   NewBackupUseCase(repo),
+
+		graphqlApiService:                        service.NewGraphqlApiService(repo),
+		environmentVariablesService:              service.NewEnvironmentVariablesService(repo),
+		schemaService:                            service.NewSchemaService(repo),
+		dataSourceService:                        service.NewDataSourceService(repo),
+		referenceService:                         service.NewReferenceService(repo),
+		filterService:                            service.NewFilterService(repo),
 		functionService:                          service.NewFunctionService(repo),
 		resolverService:                          service.NewResolverService(repo),
 		trackerRepository:                        repo.TrackerRepository(),
+		confirmationRepository:                   repo.ConfirmationRepository(),
+		stateRepositoryForFS:                     repo.StateRepositoryForFS(),
+		schemaFileRepositoryForFS:                repo.SchemaFileRepositoryForFS(),
+		graphqlApiRepositoryForAppSync:           repo.GraphqlApiRepositoryForAppSync(),
+		graphqlApiRepositoryForFS:                repo.GraphqlApiRepositoryForFS(),
 		environmentVariablesRepositoryForAppSync: repo.EnvironmentVariablesRepositoryForAppSync(),
 		environmentVariablesRepositoryForFS:      repo.EnvironmentVariablesRepositoryForFS(),
 		schemaRepositoryForAppSync:               repo.SchemaRepositoryForAppSync(),
 		schemaRepositoryForFS:                    repo.SchemaRepositoryForFS(),
+		dataSourceRepositoryForAppSync:           repo.DataSourceRepositoryForAppSync(),
+		dataSourceRepositoryForFS:                repo.DataSourceRepositoryForFS(),
 		functionRepositoryForAppSync:             repo.FunctionRepositoryForAppSync(),
 		functionRepositoryForFS:                  repo.FunctionRepositoryForFS(),
 		resolverRepositoryForAppSync:             repo.ResolverRepositoryForAppSync(),
@@ -79,116 +121,973 @@ func NewPushUseCase(repo repository.Repository) PushUseCase {
 func (uc *pushUseCase) Execute(ctx context.Context, params *PushInput) (res *PushOutput, err error) {
 	defer wrap(&err)
 
-	if _, err := uc.pushEnvironmentVariables(ctx, params.APIID); err != nil {
+	if params.Filter != nil {
+		if err := uc.filterService.Validate(ctx, params.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	// NOTE: resolvers of removed fields are deleted from AWS AppSync after the backup and locally once everything is pushed
+	orphans, err := uc.confirmOrphanedResolvers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.validateUseCase.Execute(ctx, &ValidateInput{APIID: params.APIID, ExcludedResolvers: orphans}); err != nil {
+		return nil, err
+	}
+
+	if params.DeleteExtraneousResources && !params.DryRun && !params.SkipConfirmation {
+		if err := uc.confirmDeletion(ctx, params, orphans); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.KeepBackups > 0 && !params.DryRun {
+		if _, err := uc.backupUseCase.Execute(ctx, &BackupInput{APIID: params.APIID, Keep: params.KeepBackups}); err != nil {
+			return nil, err
+		}
+	}
+
+	var j *journal
+	if params.Atomic && !params.DryRun {
+		j = newJournal()
+	}
+
+	var st *syncState
+	if !params.DryRun {
+		uc.trackerRepository.InProgress(ctx, "loading sync state")
+
+		state, err := uc.stateRepositoryForFS.Get(ctx, params.APIID)
+		if err != nil {
+			uc.trackerRepository.Failed(ctx, "failed to load sync state")
+			return nil, err
+		}
+
+		st = newSyncState(state, params.Force)
+	}
+
+	if err := uc.push(ctx, params, j, st, orphans); err != nil {
+		if j == nil {
+			// NOTE: the resources pushed before the failure are recorded, so that the next push does not conflict with them
+			if serr := uc.saveState(ctx, params, st); serr != nil {
+				return nil, errors.Join(err, serr)
+			}
+
+			return nil, err
+		}
+
+		// NOTE: the rolled back resources are as they were at the last sync, so the state is left as it is
+		if rerr := uc.rollback(ctx, params, j); rerr != nil {
+			return nil, errors.Join(err, rerr)
+		}
+
 		return nil, err
 	}
 
-	if _, err := uc.pushSchema(ctx, params.APIID); err != nil {
+	if err := uc.saveState(ctx, params, st); err != nil {
 		return nil, err
 	}
 
-	fns, err := uc.pushFunctions(ctx, params.APIID)
+	if !params.DryRun {
+		if err := uc.deleteOrphanedResolverFiles(ctx, params, orphans); err != nil {
+			return nil, err
+		}
+	}
+
+	return &PushOutput{}, nil
+}
+
+func (uc *pushUseCase) saveState(ctx context.Context, params *PushInput, st *syncState) (err error) {
+	defer wrap(&err)
+
+	if st == nil {
+		return nil
+	}
+
+	uc.trackerRepository.InProgress(ctx, "saving sync state")
+
+	if _, err := uc.stateRepositoryForFS.Save(ctx, params.APIID, st.state); err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to save sync state")
+		return err
+	}
+
+	uc.trackerRepository.Success(ctx, "saved sync state")
+
+	return nil
+}
+
+func (uc *pushUseCase) push(ctx context.Context, params *PushInput, j *journal, st *syncState, orphans []model.Resolver) (err error) {
+	defer wrap(&err)
+
+	// NOTE: AWS AppSync keeps the resolvers of removed fields, so they are deleted before the schema is pushed
+	if err := uc.deleteOrphanedResolvers(ctx, params, j, st, orphans); err != nil {
+		return err
+	}
+
+	if matchKind(ctx, uc.filterService, params.Filter, model.ResourceKindGraphqlApi) {
+		if _, err := uc.pushGraphqlApi(ctx, params, j); err != nil {
+			return err
+		}
+	}
+
+	if matchKind(ctx, uc.filterService, params.Filter, model.ResourceKindEnvironmentVariables) {
+		if _, err := uc.pushEnvironmentVariables(ctx, params, j); err != nil {
+			return err
+		}
+	}
+
+	if matchKind(ctx, uc.filterService, params.Filter, model.ResourceKindSchema) {
+		if _, err := uc.pushSchema(ctx, params, j); err != nil {
+			return err
+		}
+	}
+
+	if _, err := uc.pushDataSources(ctx, params, j); err != nil {
+		return err
+	}
+
+	fns, err := uc.pushFunctions(ctx, params, j, st)
 	if err != nil {
+		return err
+	}
+
+	rslvs, err := uc.pushResolvers(ctx, params, j, st, fns, orphans)
+	if err != nil {
+		return err
+	}
+
+	if params.DeleteExtraneousResources {
+		if err := uc.deleteExtraneousFunctions(ctx, params, j, st, fns); err != nil {
+			return err
+		}
+
+		if err := uc.deleteExtraneousResolvers(ctx, params, j, st, rslvs, orphans); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (uc *pushUseCase) confirmDeletion(ctx context.Context, params *PushInput, orphans []model.Resolver) (err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "retrieving extraneous resources")
+
+	localFns, err := uc.functionRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load functions")
+		return err
+	}
+
+	remoteFns, err := uc.functionRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch functions")
+		return err
+	}
+
+	remoteFns, _ = filterFunctions(ctx, uc.filterService, params.Filter, remoteFns)
+
+	extraneousFns, err := uc.functionService.Difference(ctx, remoteFns, localFns)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to retrieve extraneous functions")
+		return err
+	}
+
+	localRslvs, err := uc.resolverRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load resolvers")
+		return err
+	}
+
+	remoteRslvs, err := uc.resolverRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch resolvers")
+		return err
+	}
+
+	remoteRslvs, _ = filterResolvers(ctx, uc.filterService, params.Filter, remoteRslvs)
+
+	extraneousRslvs, err := uc.resolverService.Difference(ctx, remoteRslvs, localRslvs)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to retrieve extraneous resolvers")
+		return err
+	}
+
+	// NOTE: the orphaned resolvers are confirmed on their own
+	extraneousRslvs = excludeResolvers(extraneousRslvs, orphans)
+
+	if len(extraneousFns) == 0 && len(extraneousRslvs) == 0 {
+		uc.trackerRepository.Success(ctx, "there were no extraneous resources")
+		return nil
+	}
+
+	uc.trackerRepository.Success(ctx, "retrieved extraneous resources")
+
+	var sb strings.Builder
+	sb.WriteString("The following extraneous resources will be deleted from AWS AppSync:\n")
+
+	for _, fn := range extraneousFns {
+		sb.WriteString(fmt.Sprintf("  - function %s\n", ptr.ToValue(fn.Name)))
+	}
+
+	for _, rslv := range extraneousRslvs {
+		sb.WriteString(fmt.Sprintf("  - resolver %s.%s\n", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName)))
+	}
+
+	sb.WriteString("Do you want to continue?")
+
+	ok, err := uc.confirmationRepository.Confirm(ctx, sb.String())
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: deletion of extraneous resources was declined", model.ErrNotConfirmed)
+	}
+
+	return nil
+}
+
+// confirmOrphanedResolvers retrieves the local resolvers that no longer map to a field in the schema but were pushed before, and confirms their deletion.
+func (uc *pushUseCase) confirmOrphanedResolvers(ctx context.Context, params *PushInput) (res []model.Resolver, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "retrieving orphaned resolvers")
+
+	files, err := uc.schemaFileRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load schema")
 		return nil, err
 	}
 
-	rslvs, err := uc.pushResolvers(ctx, params.APIID, fns)
+	rslvs, err := uc.resolverRepositoryForFS.List(ctx, params.APIID)
 	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load resolvers")
 		return nil, err
 	}
 
-	if params.DeleteExtraneousResources {
-		if err := uc.deleteExtraneousFunctions(ctx, params.APIID, fns); err != nil {
+	orphans, err := uc.referenceService.OrphanedResolvers(ctx, files, rslvs)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to retrieve orphaned resolvers")
+		return nil, err
+	}
+
+	orphans, _ = filterResolvers(ctx, uc.filterService, params.Filter, orphans)
+
+	if len(orphans) > 0 {
+		orphans, err = uc.pushedResolvers(ctx, params, orphans)
+		if err != nil {
 			return nil, err
 		}
+	}
 
-		if err := uc.deleteExtraneousResolvers(ctx, params.APIID, rslvs); err != nil {
-			return nil, err
+	if len(orphans) == 0 {
+		uc.trackerRepository.Success(ctx, "there were no orphaned resolvers")
+		return orphans, nil
+	}
+
+	identifiers := make([]string, 0, len(orphans))
+	for _, rslv := range orphans {
+		identifiers = append(identifiers, fmt.Sprintf("%s.%s", *rslv.TypeName, *rslv.FieldName))
+	}
+
+	orphanErr := fmt.Errorf("%w: resolvers %s no longer map to a field in the schema", model.ErrInvalidValue, strings.Join(identifiers, ", "))
+
+	uc.trackerRepository.Success(ctx, "retrieved orphaned resolvers")
+
+	if params.DryRun || params.SkipConfirmation {
+		return orphans, nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("The following resolvers no longer map to a field in the schema and will be deleted locally and from AWS AppSync:\n")
+
+	for _, identifier := range identifiers {
+		sb.WriteString(fmt.Sprintf("  - resolver %s\n", identifier))
+	}
+
+	sb.WriteString("Do you want to continue?")
+
+	ok, err := uc.confirmationRepository.Confirm(ctx, sb.String())
+	if err != nil {
+		return nil, errors.Join(orphanErr, err)
+	}
+
+	if !ok {
+		return nil, fmt.Errorf("%w: deletion of orphaned resolvers was declined: %w", model.ErrNotConfirmed, orphanErr)
+	}
+
+	return orphans, nil
+}
+
+// deleteOrphanedResolvers deletes the orphaned resolvers from AWS AppSync and records them in the journal, so that a rollback restores them.
+// pushedResolvers returns the resolvers that exist in AWS AppSync or in the sync state.
+func (uc *pushUseCase) pushedResolvers(ctx context.Context, params *PushInput, resolvers []model.Resolver) (res []model.Resolver, err error) {
+	defer wrap(&err)
+
+	remoteRslvs, err := uc.resolverRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch resolvers")
+		return nil, err
+	}
+
+	state, err := uc.stateRepositoryForFS.Get(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load sync state")
+		return nil, err
+	}
+
+	pushed := make(map[string]bool, len(remoteRslvs)+len(state.Resolvers))
+	for _, rslv := range remoteRslvs {
+		pushed[fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))] = true
+	}
+
+	for identifier := range state.Resolvers {
+		pushed[identifier] = true
+	}
+
+	// NOTE: a resolver that was never pushed is more likely a typo than a removed field, so it is left to fail the validation
+	res = make([]model.Resolver, 0, len(resolvers))
+	for _, rslv := range resolvers {
+		if pushed[fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))] {
+			res = append(res, rslv)
 		}
 	}
 
-	return &PushOutput{}, nil
+	return res, nil
+}
+
+func (uc *pushUseCase) deleteOrphanedResolvers(ctx context.Context, params *PushInput, j *journal, st *syncState, resolvers []model.Resolver) (err error) {
+	defer wrap(&err)
+
+	if len(resolvers) == 0 {
+		return nil
+	}
+
+	uc.trackerRepository.InProgress(ctx, "deleting orphaned resolvers from AWS AppSync")
+
+	errs := make([]error, 0)
+	for _, rslv := range resolvers {
+		start := time.Now()
+		identifier := fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))
+
+		if params.DryRun {
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindResolver,
+				Identifier: identifier,
+				Action:     model.TrackerActionDeleted,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("would delete orphaned resolver %s", identifier),
+			})
+			continue
+		}
+
+		before, err := uc.resolverRepositoryForAppSync.Get(ctx, params.APIID, *rslv.TypeName, *rslv.FieldName)
+		if err != nil {
+			// NOTE: a resolver created locally may have never been pushed
+			if errors.Is(err, model.ErrNotFound) {
+				st.forgetResolver(identifier)
+				continue
+			}
+
+			errs = append(errs, err)
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindResolver,
+				Identifier: identifier,
+				Action:     model.TrackerActionFailed,
+				Duration:   time.Since(start),
+				Err:        err,
+				Message:    fmt.Sprintf("failed to fetch orphaned resolver %s", identifier),
+			})
+			continue
+		}
+
+		if err := uc.resolverRepositoryForAppSync.Delete(ctx, params.APIID, *rslv.TypeName, *rslv.FieldName); err != nil {
+			errs = append(errs, err)
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindResolver,
+				Identifier: identifier,
+				Action:     model.TrackerActionFailed,
+				Duration:   time.Since(start),
+				Err:        err,
+				Message:    fmt.Sprintf("failed to delete orphaned resolver %s", identifier),
+			})
+			continue
+		}
+
+		j.recordResolver(before, nil)
+		st.forgetResolver(identifier)
+
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:       model.ResourceKindResolver,
+			Identifier: identifier,
+			Action:     model.TrackerActionDeleted,
+			Duration:   time.Since(start),
+			Message:    fmt.Sprintf("deleted orphaned resolver %s", identifier),
+		})
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	if params.DryRun {
+		uc.trackerRepository.Success(ctx, "would delete all orphaned resolvers")
+		return nil
+	}
+
+	uc.trackerRepository.Success(ctx, "deleted all orphaned resolvers")
+
+	return nil
+}
+
+// deleteOrphanedResolverFiles deletes the local directories of the orphaned resolvers once everything is pushed.
+func (uc *pushUseCase) deleteOrphanedResolverFiles(ctx context.Context, params *PushInput, resolvers []model.Resolver) (err error) {
+	defer wrap(&err)
+
+	if len(resolvers) == 0 {
+		return nil
+	}
+
+	uc.trackerRepository.InProgress(ctx, "deleting orphaned resolvers locally")
+
+	errs := make([]error, 0)
+	for _, rslv := range resolvers {
+		if err := uc.resolverRepositoryForFS.Delete(ctx, params.APIID, *rslv.TypeName, *rslv.FieldName); err != nil && !errors.Is(err, model.ErrNotFound) {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to delete orphaned resolvers locally")
+		return err
+	}
+
+	uc.trackerRepository.Success(ctx, "deleted orphaned resolvers locally")
+
+	return nil
+}
+
+func (uc *pushUseCase) pushGraphqlApi(ctx context.Context, params *PushInput, j *journal) (res *model.GraphqlApi, err error) {
+	defer wrap(&err)
+
+	start := time.Now()
+
+	uc.trackerRepository.InProgress(ctx, "loading GraphQL API settings")
+
+	a, err := uc.graphqlApiRepositoryForFS.Get(ctx, params.APIID)
+	if err != nil {
+		// NOTE: base dirs pulled before GraphQL API settings were synced have no api.json
+		if errors.Is(err, model.ErrNotFound) {
+			uc.trackerRepository.Success(ctx, "there were no GraphQL API settings")
+			return nil, nil
+		}
+
+		uc.trackerRepository.Failed(ctx, "failed to load GraphQL API settings")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "fetching GraphQL API settings")
+
+	remote, err := uc.graphqlApiRepositoryForAppSync.Get(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch GraphQL API settings")
+		return nil, err
+	}
+
+	isUnchanged, err := uc.graphqlApiService.Equal(ctx, remote, a)
+	if err != nil {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindGraphqlApi,
+			Action:   model.TrackerActionFailed,
+			Duration: time.Since(start),
+			Err:      err,
+			Message:  "failed to compare GraphQL API settings",
+		})
+		return nil, err
+	}
+
+	if isUnchanged {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindGraphqlApi,
+			Action:   model.TrackerActionUnchanged,
+			Duration: time.Since(start),
+			Message:  "unchanged GraphQL API settings",
+		})
+		return remote, nil
+	}
+
+	if params.DryRun {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindGraphqlApi,
+			Action:   model.TrackerActionUpdated,
+			Duration: time.Since(start),
+			Message:  "would push GraphQL API settings",
+		})
+		return a, nil
+	}
+
+	uc.trackerRepository.InProgress(ctx, "pushing GraphQL API settings")
+
+	api, err := uc.graphqlApiRepositoryForAppSync.Save(ctx, params.APIID, a)
+	if err != nil {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindGraphqlApi,
+			Action:   model.TrackerActionFailed,
+			Duration: time.Since(start),
+			Err:      err,
+			Message:  "failed to push GraphQL API settings",
+		})
+		return nil, err
+	}
+
+	j.recordGraphqlApi(remote)
+
+	uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+		Kind:     model.ResourceKindGraphqlApi,
+		Action:   model.TrackerActionUpdated,
+		Duration: time.Since(start),
+		Message:  "pushed GraphQL API settings",
+	})
+
+	return api, nil
 }
 
-func (uc *pushUseCase) pushEnvironmentVariables(ctx context.Context, apiID string) (res model.EnvironmentVariables, err error) {
+func (uc *pushUseCase) pushEnvironmentVariables(ctx context.Context, params *PushInput, j *journal) (res model.EnvironmentVariables, err error) {
 	defer wrap(&err)
 
+	start := time.Now()
+
 	uc.trackerRepository.InProgress(ctx, "loading environment variables")
 
-	vs, err := uc.environmentVariablesRepositoryForFS.Get(ctx, apiID)
+	vs, err := uc.environmentVariablesRepositoryForFS.Get(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to load environment variables")
 		return nil, err
 	}
 
+	uc.trackerRepository.InProgress(ctx, "fetching environment variables")
+
+	remote, err := uc.environmentVariablesRepositoryForAppSync.Get(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch environment variables")
+		return nil, err
+	}
+
+	isUnchanged, err := uc.environmentVariablesService.Equal(ctx, remote, vs)
+	if err != nil {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindEnvironmentVariables,
+			Action:   model.TrackerActionFailed,
+			Duration: time.Since(start),
+			Err:      err,
+			Message:  "failed to compare environment variables",
+		})
+		return nil, err
+	}
+
+	if isUnchanged {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindEnvironmentVariables,
+			Action:   model.TrackerActionUnchanged,
+			Duration: time.Since(start),
+			Message:  "unchanged environment variables",
+		})
+		return remote, nil
+	}
+
+	if params.DryRun {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindEnvironmentVariables,
+			Action:   model.TrackerActionUpdated,
+			Duration: time.Since(start),
+			Message:  "would push environment variables",
+		})
+		return vs, nil
+	}
+
 	uc.trackerRepository.InProgress(ctx, "pushing environment variables")
 
-	varibales, err := uc.environmentVariablesRepositoryForAppSync.Save(ctx, apiID, vs)
+	varibales, err := uc.environmentVariablesRepositoryForAppSync.Save(ctx, params.APIID, vs)
 	if err != nil {
-		uc.trackerRepository.Failed(ctx, "failed to push environment variables")
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindEnvironmentVariables,
+			Action:   model.TrackerActionFailed,
+			Duration: time.Since(start),
+			Err:      err,
+			Message:  "failed to push environment variables",
+		})
 		return nil, err
 	}
 
-	uc.trackerRepository.Success(ctx, "pushed environment variables")
+	j.recordEnvironmentVariables(remote)
+
+	uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+		Kind:     model.ResourceKindEnvironmentVariables,
+		Action:   model.TrackerActionUpdated,
+		Duration: time.Since(start),
+		Message:  "pushed environment variables",
+	})
 
 	return varibales, nil
 }
 
-func (uc *pushUseCase) pushSchema(ctx context.Context, apiID string) (res *model.Schema, err error) {
+func (uc *pushUseCase) pushSchema(ctx context.Context, params *PushInput, j *journal) (res *model.Schema, err error) {
 	defer wrap(&err)
 
+	start := time.Now()
+
 	uc.trackerRepository.InProgress(ctx, "loading schema")
 
-	s, err := uc.schemaRepositoryForFS.Get(ctx, apiID)
+	s, err := uc.schemaRepositoryForFS.Get(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to load schema")
 		return nil, err
 	}
 
+	uc.trackerRepository.InProgress(ctx, "fetching schema")
+
+	remote, err := uc.schemaRepositoryForAppSync.Get(ctx, params.APIID)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		uc.trackerRepository.Failed(ctx, "failed to fetch schema")
+		return nil, err
+	}
+
+	if remote != nil {
+		isUnchanged, err := uc.schemaService.Equal(ctx, remote, s)
+		if err != nil {
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:     model.ResourceKindSchema,
+				Action:   model.TrackerActionFailed,
+				Duration: time.Since(start),
+				Err:      err,
+				Message:  "failed to compare schema",
+			})
+			return nil, err
+		}
+
+		if isUnchanged {
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:     model.ResourceKindSchema,
+				Action:   model.TrackerActionUnchanged,
+				Duration: time.Since(start),
+				Message:  "unchanged schema",
+			})
+			return remote, nil
+		}
+
+		if err := checkSchemaChanges(ctx, uc.schemaService, uc.trackerRepository, uc.resolverRepositoryForFS, params.APIID, params.AllowBreaking, remote, s); err != nil {
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:     model.ResourceKindSchema,
+				Action:   model.TrackerActionFailed,
+				Duration: time.Since(start),
+				Err:      err,
+				Message:  "failed to push schema",
+			})
+			return nil, err
+		}
+	}
+
+	action := model.TrackerActionCreated
+	if remote != nil {
+		action = model.TrackerActionUpdated
+	}
+
+	if params.DryRun {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindSchema,
+			Action:   action,
+			Duration: time.Since(start),
+			Message:  "would push schema",
+		})
+		return s, nil
+	}
+
 	uc.trackerRepository.InProgress(ctx, "pushing schema")
 
-	schema, err := uc.schemaRepositoryForAppSync.Save(ctx, apiID, s)
+	schema, err := uc.schemaRepositoryForAppSync.Save(ctx, params.APIID, s)
 	if err != nil {
-		uc.trackerRepository.Failed(ctx, "failed to push schema")
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:     model.ResourceKindSchema,
+			Action:   model.TrackerActionFailed,
+			Duration: time.Since(start),
+			Err:      err,
+			Message:  "failed to push schema",
+		})
 		return nil, err
 	}
 
-	uc.trackerRepository.Success(ctx, "pushed schema")
+	j.recordSchema(remote)
+
+	uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+		Kind:     model.ResourceKindSchema,
+		Action:   action,
+		Duration: time.Since(start),
+		Message:  "pushed schema",
+	})
 
 	return schema, nil
 }
 
-func (uc *pushUseCase) pushFunctions(ctx context.Context, apiID string) (res []model.Function, err error) {
+func (uc *pushUseCase) pushDataSources(ctx context.Context, params *PushInput, j *journal) (res []model.DataSource, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "loading data sources")
+
+	dss, err := uc.dataSourceRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load data sources")
+		return nil, err
+	}
+
+	dss, _ = filterDataSources(ctx, uc.filterService, params.Filter, dss)
+
+	uc.trackerRepository.InProgress(ctx, "fetching data sources")
+
+	remoteDss, err := uc.dataSourceRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch data sources")
+		return nil, err
+	}
+
+	nameToRemoteDs := make(map[string]model.DataSource)
+	for _, ds := range remoteDss {
+		nameToRemoteDs[ptr.ToValue(ds.Name)] = ds
+	}
+
+	uc.trackerRepository.InProgress(ctx, "pushing data sources")
+
+	var mu sync.Mutex
+	g := syncup.WorkerPool(ctx).Group()
+	dataSources := make([]model.DataSource, 0, len(dss))
+	errs := make([]error, 0)
+
+	for _, ds := range dss {
+		ds := ds
+		g.Go(func() {
+			start := time.Now()
+
+			var before *model.DataSource
+			action := model.TrackerActionCreated
+			if remoteDs, ok := nameToRemoteDs[ptr.ToValue(ds.Name)]; ok {
+				before = &remoteDs
+				action = model.TrackerActionUpdated
+
+				isUnchanged, err := uc.dataSourceService.Equal(ctx, &remoteDs, &ds)
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+
+					uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+						Kind:       model.ResourceKindDataSource,
+						Identifier: ptr.ToValue(ds.Name),
+						Action:     model.TrackerActionFailed,
+						Duration:   time.Since(start),
+						Err:        err,
+						Message:    fmt.Sprintf("failed to compare data source %s", ptr.ToValue(ds.Name)),
+					})
+					return
+				}
+
+				if isUnchanged {
+					mu.Lock()
+					dataSources = append(dataSources, remoteDs)
+					mu.Unlock()
+
+					uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+						Kind:       model.ResourceKindDataSource,
+						Identifier: ptr.ToValue(ds.Name),
+						Action:     model.TrackerActionUnchanged,
+						Duration:   time.Since(start),
+						Message:    fmt.Sprintf("unchanged data source %s", ptr.ToValue(ds.Name)),
+					})
+					return
+				}
+			}
+
+			if params.DryRun {
+				mu.Lock()
+				dataSources = append(dataSources, ds)
+				mu.Unlock()
+
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindDataSource,
+					Identifier: ptr.ToValue(ds.Name),
+					Action:     action,
+					Duration:   time.Since(start),
+					Message:    fmt.Sprintf("would push data source %s", ptr.ToValue(ds.Name)),
+				})
+				return
+			}
+
+			dataSource, err := uc.dataSourceRepositoryForAppSync.Save(ctx, params.APIID, &ds)
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindDataSource,
+					Identifier: ptr.ToValue(ds.Name),
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to push data source %s", ptr.ToValue(ds.Name)),
+				})
+				return
+			}
+
+			mu.Lock()
+			dataSources = append(dataSources, *dataSource)
+			mu.Unlock()
+
+			j.recordDataSource(before, dataSource)
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindDataSource,
+				Identifier: ptr.ToValue(dataSource.Name),
+				Action:     action,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("pushed data source %s", ptr.ToValue(dataSource.Name)),
+			})
+		})
+	}
+
+	g.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	if params.DryRun {
+		uc.trackerRepository.Success(ctx, "would push all data sources")
+		return dataSources, nil
+	}
+
+	uc.trackerRepository.Success(ctx, "pushed all data sources")
+
+	return dataSources, nil
+}
+
+func (uc *pushUseCase) pushFunctions(ctx context.Context, params *PushInput, j *journal, st *syncState) (res []model.Function, err error) {
 	defer wrap(&err)
 
 	uc.trackerRepository.InProgress(ctx, "loading functions")
 
-	fns, err := uc.functionRepositoryForFS.List(ctx, apiID)
+	fns, err := uc.functionRepositoryForFS.List(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to load functions")
 		return nil, err
 	}
 
+	uc.trackerRepository.InProgress(ctx, "fetching functions")
+
+	remoteFns, err := uc.functionRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch functions")
+		return nil, err
+	}
+
+	nameToRemoteFn := make(map[string]model.Function)
+	for _, fn := range remoteFns {
+		if fn.Name == nil {
+			return nil, fmt.Errorf("%w: missing name", model.ErrNilValue)
+		}
+
+		nameToRemoteFn[*fn.Name] = fn
+	}
+
+	fns, _ = filterFunctions(ctx, uc.filterService, params.Filter, fns)
+
+	// NOTE: resolvers may refer to functions excluded by the filter, so they are returned as they are in AWS AppSync
+	_, excludedFns := filterFunctions(ctx, uc.filterService, params.Filter, remoteFns)
+
+	if params.DryRun {
+		functions, err := uc.planFunctions(ctx, params, fns, nameToRemoteFn)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(functions, excludedFns...), nil
+	}
+
 	uc.trackerRepository.InProgress(ctx, "pushing functions")
 
 	var mu sync.Mutex
-	var wg sync.WaitGroup
+	g := syncup.WorkerPool(ctx).Group()
 	functions := make([]model.Function, 0, len(fns))
 	errs := make([]error, 0)
 
 	for _, fn := range fns {
 		fn := fn
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
+		g.Go(func() {
+			start := time.Now()
+			action := model.TrackerActionCreated
+
+			var before *model.Function
+			if remoteFn, ok := nameToRemoteFn[ptr.ToValue(fn.Name)]; ok {
+				before = &remoteFn
+				action = model.TrackerActionUpdated
+
+				isUnchanged, err := uc.functionService.Equal(ctx, &remoteFn, &fn)
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+
+					uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+						Kind:       model.ResourceKindFunction,
+						Identifier: ptr.ToValue(fn.Name),
+						Action:     model.TrackerActionFailed,
+						Duration:   time.Since(start),
+						Err:        err,
+						Message:    fmt.Sprintf("failed to compare function %s", ptr.ToValue(fn.Name)),
+					})
+					return
+				}
+
+				if isUnchanged {
+					if err := st.recordFunction(&remoteFn); err != nil {
+						mu.Lock()
+						errs = append(errs, err)
+						mu.Unlock()
+						return
+					}
+
+					mu.Lock()
+					functions = append(functions, remoteFn)
+					mu.Unlock()
+
+					uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+						Kind:       model.ResourceKindFunction,
+						Identifier: ptr.ToValue(fn.Name),
+						Action:     model.TrackerActionUnchanged,
+						Duration:   time.Since(start),
+						Message:    fmt.Sprintf("unchanged function %s", ptr.ToValue(fn.Name)),
+					})
+					return
+				}
+			}
 
-			function, err := uc.functionRepositoryForAppSync.Save(ctx, apiID, &fn)
+			function, err := uc.functionRepositoryForAppSync.Save(st.expectFunction(ctx, ptr.ToValue(fn.Name)), params.APIID, &fn)
 			if err != nil {
 				mu.Lock()
 				errs = append(errs, err)
 				mu.Unlock()
 
-				uc.trackerRepository.Failed(ctx, fmt.Sprintf("failed to push function %s", ptr.ToValue(fn.Name)))
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: ptr.ToValue(fn.Name),
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to push function %s", ptr.ToValue(fn.Name)),
+				})
 				return
 			}
 
@@ -196,11 +1095,26 @@ func (uc *pushUseCase) pushFunctions(ctx context.Context, apiID string) (res []m
 			functions = append(functions, *function)
 			mu.Unlock()
 
-			uc.trackerRepository.Success(ctx, fmt.Sprintf("pushed function %s", ptr.ToValue(function.Name)))
-		}()
+			j.recordFunction(before, function)
+
+			if err := st.recordFunction(function); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+				return
+			}
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindFunction,
+				Identifier: ptr.ToValue(function.Name),
+				Action:     action,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("pushed function %s", ptr.ToValue(function.Name)),
+			})
+		})
 	}
 
-	wg.Wait()
+	g.Wait()
 
 	if err := errors.Join(errs...); err != nil {
 		return nil, err
@@ -208,49 +1122,209 @@ func (uc *pushUseCase) pushFunctions(ctx context.Context, apiID string) (res []m
 
 	uc.trackerRepository.Success(ctx, "pushed all functions")
 
+	return append(functions, excludedFns...), nil
+}
+
+func (uc *pushUseCase) planFunctions(ctx context.Context, params *PushInput, fns []model.Function, nameToRemoteFn map[string]model.Function) (res []model.Function, err error) {
+	defer wrap(&err)
+
+	functions := make([]model.Function, 0, len(fns))
+	for _, fn := range fns {
+		start := time.Now()
+
+		if fn.Name == nil {
+			uc.trackerRepository.Failed(ctx, "failed to push function")
+			return nil, fmt.Errorf("%w: missing name", model.ErrNilValue)
+		}
+
+		remoteFn, ok := nameToRemoteFn[*fn.Name]
+		if !ok {
+			// NOTE: a function that does not exist yet has no ID until it is created
+			fn.FunctionId = ptr.Pointer(*fn.Name)
+			functions = append(functions, fn)
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindFunction,
+				Identifier: *fn.Name,
+				Action:     model.TrackerActionCreated,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("would push function %s", *fn.Name),
+			})
+			continue
+		}
+
+		isUnchanged, err := uc.functionService.Equal(ctx, &remoteFn, &fn)
+		if err != nil {
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindFunction,
+				Identifier: *fn.Name,
+				Action:     model.TrackerActionFailed,
+				Duration:   time.Since(start),
+				Err:        err,
+				Message:    fmt.Sprintf("failed to compare function %s", *fn.Name),
+			})
+			return nil, err
+		}
+
+		fn.FunctionId = remoteFn.FunctionId
+		functions = append(functions, fn)
+
+		if isUnchanged {
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindFunction,
+				Identifier: *fn.Name,
+				Action:     model.TrackerActionUnchanged,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("unchanged function %s", *fn.Name),
+			})
+			continue
+		}
+
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:       model.ResourceKindFunction,
+			Identifier: *fn.Name,
+			Action:     model.TrackerActionUpdated,
+			Duration:   time.Since(start),
+			Message:    fmt.Sprintf("would push function %s", *fn.Name),
+		})
+	}
+
+	uc.trackerRepository.Success(ctx, "would push all functions")
+
 	return functions, nil
 }
 
-func (uc *pushUseCase) pushResolvers(ctx context.Context, apiID string, functions []model.Function) (res []model.Resolver, err error) {
+func (uc *pushUseCase) pushResolvers(ctx context.Context, params *PushInput, j *journal, st *syncState, functions []model.Function, orphans []model.Resolver) (res []model.Resolver, err error) {
 	defer wrap(&err)
 
 	uc.trackerRepository.InProgress(ctx, "loading resolvers")
 
-	rslvs, err := uc.resolverRepositoryForFS.List(ctx, apiID)
+	rslvs, err := uc.resolverRepositoryForFS.List(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to load resolvers")
 		return nil, err
 	}
 
+	rslvs, _ = filterResolvers(ctx, uc.filterService, params.Filter, rslvs)
+	rslvs = excludeResolvers(rslvs, orphans)
+
+	uc.trackerRepository.InProgress(ctx, "fetching resolvers")
+
+	remoteRslvs, err := uc.resolverRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to fetch resolvers")
+		return nil, err
+	}
+
+	identifierToRemoteRslv := make(map[string]model.Resolver)
+	for _, rslv := range remoteRslvs {
+		identifierToRemoteRslv[fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))] = rslv
+	}
+
 	uc.trackerRepository.InProgress(ctx, "pushing resolvers")
 
 	var mu sync.Mutex
-	var wg sync.WaitGroup
+	g := syncup.WorkerPool(ctx).Group()
 	resolvers := make([]model.Resolver, 0, len(rslvs))
 	errs := make([]error, 0)
 
 	for _, rslv := range rslvs {
 		rslv := rslv
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
+		g.Go(func() {
+			start := time.Now()
+			identifier := fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))
+			action := model.TrackerActionCreated
 
 			if err := uc.resolverService.ResolvePipelineConfigFunctionIDs(ctx, &rslv, functions); err != nil {
 				mu.Lock()
 				errs = append(errs, err)
 				mu.Unlock()
 
-				uc.trackerRepository.Failed(ctx, fmt.Sprintf("failed to push resolver %s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName)))
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindResolver,
+					Identifier: identifier,
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to push resolver %s", identifier),
+				})
 				return
 			}
 
-			resovler, err := uc.resolverRepositoryForAppSync.Save(ctx, apiID, &rslv)
+			var before *model.Resolver
+			if remoteRslv, ok := identifierToRemoteRslv[identifier]; ok {
+				before = &remoteRslv
+				action = model.TrackerActionUpdated
+
+				isUnchanged, err := uc.resolverService.Equal(ctx, &remoteRslv, &rslv)
+				if err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+
+					uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+						Kind:       model.ResourceKindResolver,
+						Identifier: identifier,
+						Action:     model.TrackerActionFailed,
+						Duration:   time.Since(start),
+						Err:        err,
+						Message:    fmt.Sprintf("failed to compare resolver %s", identifier),
+					})
+					return
+				}
+
+				if isUnchanged {
+					if err := st.recordResolver(&remoteRslv); err != nil {
+						mu.Lock()
+						errs = append(errs, err)
+						mu.Unlock()
+						return
+					}
+
+					mu.Lock()
+					resolvers = append(resolvers, rslv)
+					mu.Unlock()
+
+					uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+						Kind:       model.ResourceKindResolver,
+						Identifier: identifier,
+						Action:     model.TrackerActionUnchanged,
+						Duration:   time.Since(start),
+						Message:    fmt.Sprintf("unchanged resolver %s", identifier),
+					})
+					return
+				}
+			}
+
+			if params.DryRun {
+				mu.Lock()
+				resolvers = append(resolvers, rslv)
+				mu.Unlock()
+
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindResolver,
+					Identifier: identifier,
+					Action:     action,
+					Duration:   time.Since(start),
+					Message:    fmt.Sprintf("would push resolver %s", identifier),
+				})
+				return
+			}
+
+			resovler, err := uc.resolverRepositoryForAppSync.Save(st.expectResolver(ctx, identifier), params.APIID, &rslv)
 			if err != nil {
 				mu.Lock()
 				errs = append(errs, err)
 				mu.Unlock()
 
-				uc.trackerRepository.Failed(ctx, fmt.Sprintf("failed to push resolver %s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName)))
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindResolver,
+					Identifier: identifier,
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to push resolver %s", identifier),
+				})
 				return
 			}
 
@@ -258,32 +1332,54 @@ func (uc *pushUseCase) pushResolvers(ctx context.Context, apiID string, function
 			resolvers = append(resolvers, *resovler)
 			mu.Unlock()
 
-			uc.trackerRepository.Success(ctx, fmt.Sprintf("pushed resolver %s.%s", ptr.ToValue(resovler.TypeName), ptr.ToValue(resovler.FieldName)))
-		}()
+			j.recordResolver(before, resovler)
+
+			if err := st.recordResolver(resovler); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+				return
+			}
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindResolver,
+				Identifier: identifier,
+				Action:     action,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("pushed resolver %s", identifier),
+			})
+		})
 	}
 
-	wg.Wait()
+	g.Wait()
 
 	if err := errors.Join(errs...); err != nil {
 		return nil, err
 	}
 
+	if params.DryRun {
+		uc.trackerRepository.Success(ctx, "would push all resolvers")
+		return resolvers, nil
+	}
+
 	uc.trackerRepository.Success(ctx, "pushed all resolvers")
 
 	return resolvers, nil
 }
 
-func (uc *pushUseCase) deleteExtraneousFunctions(ctx context.Context, apiID string, functions []model.Function) (err error) {
+func (uc *pushUseCase) deleteExtraneousFunctions(ctx context.Context, params *PushInput, j *journal, st *syncState, functions []model.Function) (err error) {
 	defer wrap(&err)
 
 	uc.trackerRepository.InProgress(ctx, "fetching functions")
 
-	fns, err := uc.functionRepositoryForAppSync.List(ctx, apiID)
+	fns, err := uc.functionRepositoryForAppSync.List(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to fetch functions")
 		return err
 	}
 
+	fns, _ = filterFunctions(ctx, uc.filterService, params.Filter, fns)
+
 	extraneousFns, err := uc.functionService.Difference(ctx, fns, functions)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to retrieve extraneous functions")
@@ -298,56 +1394,92 @@ func (uc *pushUseCase) deleteExtraneousFunctions(ctx context.Context, apiID stri
 	uc.trackerRepository.InProgress(ctx, "deleting extraneous functions")
 
 	var mu sync.Mutex
-	var wg sync.WaitGroup
+	g := syncup.WorkerPool(ctx).Group()
 	errs := make([]error, 0)
 
 	for _, fn := range extraneousFns {
 		fn := fn
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
+		g.Go(func() {
+			start := time.Now()
+
+			if params.DryRun {
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: ptr.ToValue(fn.Name),
+					Action:     model.TrackerActionDeleted,
+					Duration:   time.Since(start),
+					Message:    fmt.Sprintf("would delete extraneous function %s", ptr.ToValue(fn.Name)),
+				})
+				return
+			}
 
-			if err := uc.functionRepositoryForAppSync.Delete(ctx, apiID, *fn.Name); err != nil {
+			if err := uc.functionRepositoryForAppSync.Delete(ctx, params.APIID, *fn.Name); err != nil {
 				mu.Lock()
 				errs = append(errs, err)
 				mu.Unlock()
 
-				uc.trackerRepository.Failed(ctx, fmt.Sprintf("failed to delete extraneous function %s", ptr.ToValue(fn.Name)))
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindFunction,
+					Identifier: ptr.ToValue(fn.Name),
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to delete extraneous function %s", ptr.ToValue(fn.Name)),
+				})
 				return
 			}
 
-			uc.trackerRepository.Success(ctx, fmt.Sprintf("deleted extraneous function %s", ptr.ToValue(fn.Name)))
-		}()
+			j.recordFunction(&fn, nil)
+			st.forgetFunction(ptr.ToValue(fn.Name))
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindFunction,
+				Identifier: ptr.ToValue(fn.Name),
+				Action:     model.TrackerActionDeleted,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("deleted extraneous function %s", ptr.ToValue(fn.Name)),
+			})
+		})
 	}
 
-	wg.Wait()
+	g.Wait()
 
 	if err := errors.Join(errs...); err != nil {
 		return err
 	}
 
+	if params.DryRun {
+		uc.trackerRepository.Success(ctx, "would delete all extraneous functions")
+		return nil
+	}
+
 	uc.trackerRepository.Success(ctx, "deleted all extraneous functions")
 
 	return nil
 }
 
-func (uc *pushUseCase) deleteExtraneousResolvers(ctx context.Context, apiID string, resolvers []model.Resolver) (err error) {
+func (uc *pushUseCase) deleteExtraneousResolvers(ctx context.Context, params *PushInput, j *journal, st *syncState, resolvers, orphans []model.Resolver) (err error) {
 	defer wrap(&err)
 
 	uc.trackerRepository.InProgress(ctx, "fetching resolvers")
 
-	rslvs, err := uc.resolverRepositoryForAppSync.List(ctx, apiID)
+	rslvs, err := uc.resolverRepositoryForAppSync.List(ctx, params.APIID)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to fetch resolvers")
 		return err
 	}
 
+	rslvs, _ = filterResolvers(ctx, uc.filterService, params.Filter, rslvs)
+
 	extraneousRslvs, err := uc.resolverService.Difference(ctx, rslvs, resolvers)
 	if err != nil {
 		uc.trackerRepository.Failed(ctx, "failed to retrieve extraneous resolvers")
 		return err
 	}
 
+	// NOTE: a dry run leaves the orphaned resolvers in AWS AppSync, and they are reported as orphaned already
+	extraneousRslvs = excludeResolvers(extraneousRslvs, orphans)
+
 	if len(extraneousRslvs) == 0 {
 		uc.trackerRepository.Success(ctx, "there were no extraneous resolvers")
 		return nil
@@ -356,34 +1488,66 @@ func (uc *pushUseCase) deleteExtraneousResolvers(ctx context.Context, apiID stri
 	uc.trackerRepository.InProgress(ctx, "deleting extraneous resolvers")
 
 	var mu sync.Mutex
-	var wg sync.WaitGroup
+	g := syncup.WorkerPool(ctx).Group()
 	errs := make([]error, 0)
 
 	for _, rslv := range extraneousRslvs {
 		rslv := rslv
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
+		g.Go(func() {
+			start := time.Now()
+			identifier := fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))
+
+			if params.DryRun {
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindResolver,
+					Identifier: identifier,
+					Action:     model.TrackerActionDeleted,
+					Duration:   time.Since(start),
+					Message:    fmt.Sprintf("would delete extraneous resolver %s", identifier),
+				})
+				return
+			}
 
-			if err := uc.resolverRepositoryForAppSync.Delete(ctx, apiID, *rslv.TypeName, *rslv.FieldName); err != nil {
+			if err := uc.resolverRepositoryForAppSync.Delete(ctx, params.APIID, *rslv.TypeName, *rslv.FieldName); err != nil {
 				mu.Lock()
 				errs = append(errs, err)
 				mu.Unlock()
 
-				uc.trackerRepository.Failed(ctx, fmt.Sprintf("failed to delete extraneous resolver %s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName)))
+				uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+					Kind:       model.ResourceKindResolver,
+					Identifier: identifier,
+					Action:     model.TrackerActionFailed,
+					Duration:   time.Since(start),
+					Err:        err,
+					Message:    fmt.Sprintf("failed to delete extraneous resolver %s", identifier),
+				})
 				return
 			}
 
-			uc.trackerRepository.Success(ctx, fmt.Sprintf("deleted extraneous resolver %s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName)))
-		}()
+			j.recordResolver(&rslv, nil)
+			st.forgetResolver(identifier)
+
+			uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+				Kind:       model.ResourceKindResolver,
+				Identifier: identifier,
+				Action:     model.TrackerActionDeleted,
+				Duration:   time.Since(start),
+				Message:    fmt.Sprintf("deleted extraneous resolver %s", identifier),
+			})
+		})
 	}
 
-	wg.Wait()
+	g.Wait()
 
 	if err := errors.Join(errs...); err != nil {
 		return err
 	}
 
+	if params.DryRun {
+		uc.trackerRepository.Success(ctx, "would delete all extraneous resolvers")
+		return nil
+	}
+
 	uc.trackerRepository.Success(ctx, "deleted all extraneous resolvers")
 
 	return nil
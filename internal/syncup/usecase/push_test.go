@@ -23,6 +23,7 @@ package usecase
 import (
 	"context"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -38,8 +39,11 @@ import (
 
 func Test_pushUseCase_Execute(t *testing.T) {
 	testdataBaseDir := "../../../testdata"
+	graphqlApi := testhelpers.MustUnmarshalJSON[model.GraphqlApi](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "graphql_api/api.json")))
 	variables := testhelpers.MustUnmarshalJSON[model.EnvironmentVariables](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "environment_variables/env.json")))
 	schema := model.Schema(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema/schema.graphqls")))
+	dataSourceAMAZON_DYNAMODB := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AMAZON_DYNAMODB/metadata.json")))
+	dataSourceAWS_LAMBDA := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AWS_LAMBDA/metadata.json")))
 	functionVTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Function](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/VTL_2018-05-29/metadata.json")))
 	functionVTL_2018_05_29.FunctionId = ptr.Pointer("VTL_2018-05-29")
 	functionVTL_2018_05_29.RequestMappingTemplate = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/VTL_2018-05-29/request.vtl"))))
@@ -62,10 +66,112 @@ func Test_pushUseCase_Execute(t *testing.T) {
 	resolverPIPELINE_APPSYNC_JS_1_0_0.PipelineConfig.Functions = []string{"VTL_2018-05-29", "APPSYNC_JS_1.0.0"}
 	resolverPIPELINE_APPSYNC_JS_1_0_0.Code = ptr.Pointer(string(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/PIPELINE/APPSYNC_JS_1.0.0/code.js"))))
 
+	mustHash := func(hash string, err error) string {
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash
+	}
+
 	type args struct {
 		params *PushInput
 	}
 
+	type mockFilterServiceValidateReturn struct {
+		err error
+	}
+	type mockFilterServiceValidate struct {
+		calls   int
+		returns []mockFilterServiceValidateReturn
+	}
+
+	type mockFilterServiceMatchReturn struct {
+		res bool
+	}
+	type mockFilterServiceMatch struct {
+		calls   int
+		returns []mockFilterServiceMatchReturn
+	}
+
+	type mockSchemaFileRepositoryForFSListReturn struct {
+		res []model.SchemaFile
+		err error
+	}
+	type mockSchemaFileRepositoryForFSList struct {
+		calls   int
+		returns []mockSchemaFileRepositoryForFSListReturn
+	}
+
+	type mockSchemaServiceValidateReturn struct {
+		err error
+	}
+	type mockSchemaServiceValidate struct {
+		calls   int
+		returns []mockSchemaServiceValidateReturn
+	}
+
+	type mockReferenceServiceValidateReturn struct {
+		err error
+	}
+	type mockReferenceServiceValidate struct {
+		calls   int
+		returns []mockReferenceServiceValidateReturn
+	}
+
+	type mockReferenceServiceOrphanedResolversReturn struct {
+		res []model.Resolver
+		err error
+	}
+	type mockReferenceServiceOrphanedResolvers struct {
+		calls   int
+		returns []mockReferenceServiceOrphanedResolversReturn
+	}
+
+	type mockBackupRepositoryForFSCreateReturn struct {
+		res *model.Backup
+		err error
+	}
+	type mockBackupRepositoryForFSCreate struct {
+		calls   int
+		returns []mockBackupRepositoryForFSCreateReturn
+	}
+
+	type mockGraphqlApiRepositoryForFSGetReturn struct {
+		res *model.GraphqlApi
+		err error
+	}
+	type mockGraphqlApiRepositoryForFSGet struct {
+		calls   int
+		returns []mockGraphqlApiRepositoryForFSGetReturn
+	}
+
+	type mockGraphqlApiRepositoryForAppSyncGetReturn struct {
+		res *model.GraphqlApi
+		err error
+	}
+	type mockGraphqlApiRepositoryForAppSyncGet struct {
+		calls   int
+		returns []mockGraphqlApiRepositoryForAppSyncGetReturn
+	}
+
+	type mockGraphqlApiServiceEqualReturn struct {
+		res bool
+		err error
+	}
+	type mockGraphqlApiServiceEqual struct {
+		calls   int
+		returns []mockGraphqlApiServiceEqualReturn
+	}
+
+	type mockGraphqlApiRepositoryForAppSyncSaveReturn struct {
+		res *model.GraphqlApi
+		err error
+	}
+	type mockGraphqlApiRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockGraphqlApiRepositoryForAppSyncSaveReturn
+	}
+
 	type mockEnvironmentVariablesRepositoryForFSGetReturn struct {
 		res model.EnvironmentVariables
 		err error
@@ -75,6 +181,24 @@ func Test_pushUseCase_Execute(t *testing.T) {
 		returns []mockEnvironmentVariablesRepositoryForFSGetReturn
 	}
 
+	type mockEnvironmentVariablesRepositoryForAppSyncGetReturn struct {
+		res model.EnvironmentVariables
+		err error
+	}
+	type mockEnvironmentVariablesRepositoryForAppSyncGet struct {
+		calls   int
+		returns []mockEnvironmentVariablesRepositoryForAppSyncGetReturn
+	}
+
+	type mockEnvironmentVariablesServiceEqualReturn struct {
+		res bool
+		err error
+	}
+	type mockEnvironmentVariablesServiceEqual struct {
+		calls   int
+		returns []mockEnvironmentVariablesServiceEqualReturn
+	}
+
 	type mockEnvironmentVariablesRepositoryForAppSyncSaveReturn struct {
 		res model.EnvironmentVariables
 		err error
@@ -93,6 +217,33 @@ func Test_pushUseCase_Execute(t *testing.T) {
 		returns []mockSchemaRepositoryForFSGetReturn
 	}
 
+	type mockSchemaRepositoryForAppSyncGetReturn struct {
+		res *model.Schema
+		err error
+	}
+	type mockSchemaRepositoryForAppSyncGet struct {
+		calls   int
+		returns []mockSchemaRepositoryForAppSyncGetReturn
+	}
+
+	type mockSchemaServiceEqualReturn struct {
+		res bool
+		err error
+	}
+	type mockSchemaServiceEqual struct {
+		calls   int
+		returns []mockSchemaServiceEqualReturn
+	}
+
+	type mockSchemaServiceCompareReturn struct {
+		res []model.SchemaChange
+		err error
+	}
+	type mockSchemaServiceCompare struct {
+		calls   int
+		returns []mockSchemaServiceCompareReturn
+	}
+
 	type mockSchemaRepositoryForAppSyncSaveReturn struct {
 		res *model.Schema
 		err error
@@ -102,6 +253,50 @@ func Test_pushUseCase_Execute(t *testing.T) {
 		returns []mockSchemaRepositoryForAppSyncSaveReturn
 	}
 
+	type mockDataSourceRepositoryForFSListReturn struct {
+		res []model.DataSource
+		err error
+	}
+	type mockDataSourceRepositoryForFSList struct {
+		calls   int
+		returns []mockDataSourceRepositoryForFSListReturn
+	}
+
+	type mockDataSourceRepositoryForAppSyncListReturn struct {
+		res []model.DataSource
+		err error
+	}
+	type mockDataSourceRepositoryForAppSyncList struct {
+		calls   int
+		returns []mockDataSourceRepositoryForAppSyncListReturn
+	}
+
+	type mockDataSourceServiceEqualReturn struct {
+		res bool
+		err error
+	}
+	type mockDataSourceServiceEqual struct {
+		calls   int
+		returns []mockDataSourceServiceEqualReturn
+	}
+
+	type mockDataSourceRepositoryForAppSyncSaveReturn struct {
+		res *model.DataSource
+		err error
+	}
+	type mockDataSourceRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockDataSourceRepositoryForAppSyncSaveReturn
+	}
+
+	type mockDataSourceRepositoryForAppSyncDeleteReturn struct {
+		err error
+	}
+	type mockDataSourceRepositoryForAppSyncDelete struct {
+		calls   int
+		returns []mockDataSourceRepositoryForAppSyncDeleteReturn
+	}
+
 	type mockFunctionRepositoryForFSListReturn struct {
 		res []model.Function
 		err error
@@ -111,6 +306,15 @@ func Test_pushUseCase_Execute(t *testing.T) {
 		returns []mockFunctionRepositoryForFSListReturn
 	}
 
+	type mockFunctionServiceEqualReturn struct {
+		res bool
+		err error
+	}
+	type mockFunctionServiceEqual struct {
+		calls   int
+		returns []mockFunctionServiceEqualReturn
+	}
+
 	type mockFunctionRepositoryForAppSyncSaveReturn struct {
 		res *model.Function
 		err error
@@ -137,6 +341,15 @@ func Test_pushUseCase_Execute(t *testing.T) {
 		returns []mockResolverServiceResolvePipelineConfigFunctionIDsReturn
 	}
 
+	type mockResolverServiceEqualReturn struct {
+		res bool
+		err error
+	}
+	type mockResolverServiceEqual struct {
+		calls   int
+		returns []mockResolverServiceEqualReturn
+	}
+
 	type mockResolverRepositoryForAppSyncSaveReturn struct {
 		res *model.Resolver
 		err error
@@ -198,22 +411,84 @@ func Test_pushUseCase_Execute(t *testing.T) {
 		returns []mockResolverRepositoryForAppSyncDeleteReturn
 	}
 
+	type mockStateRepositoryForFSGetReturn struct {
+		res *model.State
+		err error
+	}
+	type mockStateRepositoryForFSGet struct {
+		calls   int
+		returns []mockStateRepositoryForFSGetReturn
+	}
+
+	type mockResolverRepositoryForAppSyncGetReturn struct {
+		res *model.Resolver
+		err error
+	}
+	type mockResolverRepositoryForAppSyncGet struct {
+		calls   int
+		returns []mockResolverRepositoryForAppSyncGetReturn
+	}
+
+	type mockResolverRepositoryForFSDeleteReturn struct {
+		err error
+	}
+	type mockResolverRepositoryForFSDelete struct {
+		calls   int
+		returns []mockResolverRepositoryForFSDeleteReturn
+	}
+
+	type mockStateRepositoryForFSSaveReturn struct {
+		res *model.State
+		err error
+	}
+	type mockStateRepositoryForFSSave struct {
+		calls   int
+		returns []mockStateRepositoryForFSSaveReturn
+	}
+
 	type expected struct {
-		res   *PushOutput
-		errIs error
+		state            *model.State
+		deletedResolvers []string
+		res              *PushOutput
+		errIs            error
 	}
 
 	tests := []struct {
 		name                                                string
 		args                                                args
+		mockFilterServiceValidate                           mockFilterServiceValidate
+		mockFilterServiceMatch                              mockFilterServiceMatch
+		mockSchemaFileRepositoryForFSList                   mockSchemaFileRepositoryForFSList
+		mockSchemaServiceValidate                           mockSchemaServiceValidate
+		mockReferenceServiceOrphanedResolvers               mockReferenceServiceOrphanedResolvers
+		mockReferenceServiceValidate                        mockReferenceServiceValidate
+		mockBackupRepositoryForFSCreate                     mockBackupRepositoryForFSCreate
+		mockStateRepositoryForFSGet                         mockStateRepositoryForFSGet
+		mockResolverRepositoryForAppSyncGet                 mockResolverRepositoryForAppSyncGet
+		mockGraphqlApiRepositoryForFSGet                    mockGraphqlApiRepositoryForFSGet
+		mockGraphqlApiRepositoryForAppSyncGet               mockGraphqlApiRepositoryForAppSyncGet
+		mockGraphqlApiServiceEqual                          mockGraphqlApiServiceEqual
+		mockGraphqlApiRepositoryForAppSyncSave              mockGraphqlApiRepositoryForAppSyncSave
 		mockEnvironmentVariablesRepositoryForFSGet          mockEnvironmentVariablesRepositoryForFSGet
+		mockEnvironmentVariablesRepositoryForAppSyncGet     mockEnvironmentVariablesRepositoryForAppSyncGet
+		mockEnvironmentVariablesServiceEqual                mockEnvironmentVariablesServiceEqual
 		mockEnvironmentVariablesRepositoryForAppSyncSave    mockEnvironmentVariablesRepositoryForAppSyncSave
 		mockSchemaRepositoryForFSGet                        mockSchemaRepositoryForFSGet
+		mockSchemaRepositoryForAppSyncGet                   mockSchemaRepositoryForAppSyncGet
+		mockSchemaServiceEqual                              mockSchemaServiceEqual
+		mockSchemaServiceCompare                            mockSchemaServiceCompare
 		mockSchemaRepositoryForAppSyncSave                  mockSchemaRepositoryForAppSyncSave
+		mockDataSourceRepositoryForFSList                   mockDataSourceRepositoryForFSList
+		mockDataSourceRepositoryForAppSyncList              mockDataSourceRepositoryForAppSyncList
+		mockDataSourceServiceEqual                          mockDataSourceServiceEqual
+		mockDataSourceRepositoryForAppSyncSave              mockDataSourceRepositoryForAppSyncSave
+		mockDataSourceRepositoryForAppSyncDelete            mockDataSourceRepositoryForAppSyncDelete
 		mockFunctionRepositoryForFSList                     mockFunctionRepositoryForFSList
+		mockFunctionServiceEqual                            mockFunctionServiceEqual
 		mockFunctionRepositoryForAppSyncSave                mockFunctionRepositoryForAppSyncSave
 		mockResolverRepositoryForFSList                     mockResolverRepositoryForFSList
 		mockResolverServiceResolvePipelineConfigFunctionIDs mockResolverServiceResolvePipelineConfigFunctionIDs
+		mockResolverServiceEqual                            mockResolverServiceEqual
 		mockResolverRepositoryForAppSyncSave                mockResolverRepositoryForAppSyncSave
 		mockFunctionRepositoryForAppSyncList                mockFunctionRepositoryForAppSyncList
 		mockFunctionServiceDifference                       mockFunctionServiceDifference
@@ -221,6 +496,8 @@ func Test_pushUseCase_Execute(t *testing.T) {
 		mockResolverRepositoryForAppSyncList                mockResolverRepositoryForAppSyncList
 		mockResolverServiceDifference                       mockResolverServiceDifference
 		mockResolverRepositoryForAppSyncDelete              mockResolverRepositoryForAppSyncDelete
+		mockStateRepositoryForFSSave                        mockStateRepositoryForFSSave
+		mockResolverRepositoryForFSDelete                   mockResolverRepositoryForFSDelete
 		expected                                            expected
 	}{
 		{
@@ -229,202 +506,217 @@ func Test_pushUseCase_Execute(t *testing.T) {
 				params: &PushInput{
 					APIID:                     "APIID",
 					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
 				},
 			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
 					{
-						res: variables,
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
 						err: nil,
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
 					{
-						res: variables,
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
 					{
-						res: &schema,
+						res: []model.Resolver{},
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
 					{
-						res: &schema,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
 					{
-						res: []model.Function{
-							functionVTL_2018_05_29,
-							functionAPPSYNC_JS_1_0_0,
-						},
+						res: model.NewState(),
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
 					{
-						res: &functionVTL_2018_05_29,
+						res: &graphqlApi,
 						err: nil,
 					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
 					{
-						res: &functionAPPSYNC_JS_1_0_0,
+						res: &graphqlApi,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
 					{
-						res: []model.Resolver{
-							resolverUNIT_VTL_2018_05_29,
-							resolverUNIT_APPSYNC_JS_1_0_0,
-							resolverPIPELINE_VTL_2018_05_29,
-							resolverPIPELINE_APPSYNC_JS_1_0_0,
-						},
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
 					{
+						res: &graphqlApi,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
 					{
+						res: variables,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
+						res: variables,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
 					{
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
 					{
-						res: &resolverUNIT_VTL_2018_05_29,
+						res: variables,
 						err: nil,
 					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
 					{
-						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						res: &schema,
 						err: nil,
 					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
 					{
-						res: &resolverPIPELINE_VTL_2018_05_29,
+						res: &schema,
 						err: nil,
 					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
 					{
-						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
 					{
-						res: []model.Function{
-							functionVTL_2018_05_29,
-							functionAPPSYNC_JS_1_0_0,
-						},
+						res: []model.SchemaChange{},
 						err: nil,
 					},
 				},
 			},
-			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
 					{
-						res: []model.Function{},
+						res: &schema,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
 			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
 					{
-						res: []model.Resolver{
-							resolverUNIT_VTL_2018_05_29,
-							resolverUNIT_APPSYNC_JS_1_0_0,
-							resolverPIPELINE_VTL_2018_05_29,
-							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
 						},
 						err: nil,
 					},
 				},
 			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
 					{
-						res: []model.Resolver{},
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
 			},
-			expected: expected{
-				res:   &PushOutput{},
-				errIs: nil,
-			},
-		},
-		{
-			name: "happy path: delete extraneous files",
-			args: args{
-				params: &PushInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
-				},
-			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
-					{
-						res: variables,
-						err: nil,
-					},
-				},
-			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
-					{
-						res: variables,
-						err: nil,
-					},
-				},
-			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
-					{
-						res: &schema,
-						err: nil,
-					},
-				},
-			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
-					{
-						res: &schema,
-						err: nil,
-					},
-				},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
 			},
 			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
 				returns: []mockFunctionRepositoryForFSListReturn{
@@ -437,6 +729,9 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
 			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
 				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
 					{
@@ -451,6 +746,14 @@ func Test_pushUseCase_Execute(t *testing.T) {
 			},
 			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
 				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
 					{
 						res: []model.Resolver{
 							resolverUNIT_VTL_2018_05_29,
@@ -478,6 +781,9 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
 			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
 				returns: []mockResolverRepositoryForAppSyncSaveReturn{
 					{
@@ -500,12 +806,14 @@ func Test_pushUseCase_Execute(t *testing.T) {
 			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
 					{
 						res: []model.Function{
 							functionVTL_2018_05_29,
 							functionAPPSYNC_JS_1_0_0,
-							{Name: ptr.Pointer("ExtraneousFunction1")},
-							{Name: ptr.Pointer("ExtraneousFunction2")},
 						},
 						err: nil,
 					},
@@ -514,34 +822,26 @@ func Test_pushUseCase_Execute(t *testing.T) {
 			mockFunctionServiceDifference: mockFunctionServiceDifference{
 				returns: []mockFunctionServiceDifferenceReturn{
 					{
-						res: []model.Function{
-							{Name: ptr.Pointer("ExtraneousFunction1")},
-							{Name: ptr.Pointer("ExtraneousFunction2")},
-						},
+						res: []model.Function{},
 						err: nil,
 					},
 				},
 			},
 			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
-					{
-						err: nil,
-					},
-					{
-						err: nil,
-					},
-				},
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
 			},
 			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
 				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
 					{
 						res: []model.Resolver{
 							resolverUNIT_VTL_2018_05_29,
 							resolverUNIT_APPSYNC_JS_1_0_0,
 							resolverPIPELINE_VTL_2018_05_29,
 							resolverPIPELINE_APPSYNC_JS_1_0_0,
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
 						},
 						err: nil,
 					},
@@ -550,20 +850,18 @@ func Test_pushUseCase_Execute(t *testing.T) {
 			mockResolverServiceDifference: mockResolverServiceDifference{
 				returns: []mockResolverServiceDifferenceReturn{
 					{
-						res: []model.Resolver{
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
-						},
+						res: []model.Resolver{},
 						err: nil,
 					},
 				},
 			},
 			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{
-					{
-						err: nil,
-					},
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
 					{
+						res: model.NewState(),
 						err: nil,
 					},
 				},
@@ -574,11 +872,119 @@ func Test_pushUseCase_Execute(t *testing.T) {
 			},
 		},
 		{
-			name: "happy path: skip deleting extraneous files",
+			name: "happy path: delete orphaned resolvers",
 			args: args{
 				params: &PushInput{
 					APIID:                     "APIID",
-					DeleteExtraneousResources: false,
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncGet: mockResolverRepositoryForAppSyncGet{
+				returns: []mockResolverRepositoryForAppSyncGetReturn{
+					{
+						res: &model.Resolver{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField"), DataSourceName: ptr.Pointer("DataSource")},
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
 				},
 			},
 			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
@@ -589,6 +995,22 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
 					{
@@ -605,6 +1027,30 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
 			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
 				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
 					{
@@ -613,6 +1059,50 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
 			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
 				returns: []mockFunctionRepositoryForFSListReturn{
 					{
@@ -624,6 +1114,9 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
 			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
 				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
 					{
@@ -638,12 +1131,21 @@ func Test_pushUseCase_Execute(t *testing.T) {
 			},
 			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
 				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
 					{
 						res: []model.Resolver{
 							resolverUNIT_VTL_2018_05_29,
 							resolverUNIT_APPSYNC_JS_1_0_0,
 							resolverPIPELINE_VTL_2018_05_29,
 							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
 						},
 						err: nil,
 					},
@@ -665,6 +1167,9 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
 			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
 				returns: []mockResolverRepositoryForAppSyncSaveReturn{
 					{
@@ -686,238 +1191,189 @@ func Test_pushUseCase_Execute(t *testing.T) {
 				},
 			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
 			},
 			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{},
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
 			},
 			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
 				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
 			},
 			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
-			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
-			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
-			},
-			expected: expected{
-				res:   &PushOutput{},
-				errIs: nil,
-			},
-		},
-		{
-			name: "edge path: EnvironmentVariablesRepositoryForFS.Get() error",
-			args: args{
-				params: &PushInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
-				},
-			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
-			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{},
-			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
-			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{},
-			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
-			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{},
-			},
-			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
-			},
-			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
-			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{},
-			},
-			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{},
-			},
-			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
-			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
-			},
 			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
 			},
 			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
-			},
-			expected: expected{
-				res:   nil,
-				errIs: nil,
-			},
-		},
-		{
-			name: "edge path: EnvironmentVariablesRepositoryForAppSync.Save() error",
-			args: args{
-				params: &PushInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{
+					{
+						err: nil,
+					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
 					{
-						res: variables,
+						res: model.NewState(),
 						err: nil,
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{},
-			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
-			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{},
-			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
-			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{},
-			},
-			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
-			},
-			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
-			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{},
-			},
-			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{},
-			},
-			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
-			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
-			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
-			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
-			},
 			expected: expected{
-				res:   nil,
+				res:   &PushOutput{},
 				errIs: nil,
 			},
 		},
 		{
-			name: "edge path: SchemaRepositoryForFS.Get() error",
+			name: "happy path: record hashes of pushed functions and resolvers",
 			args: args{
 				params: &PushInput{
 					APIID:                     "APIID",
 					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
 				},
 			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
 					{
-						res: variables,
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
 						err: nil,
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
 					{
-						res: variables,
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: []model.Resolver{},
+						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
-			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{},
-			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
-			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{},
-			},
-			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
-			},
-			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
-			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{},
-			},
-			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
 			},
-			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
 			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
 			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
 			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
 			},
-			expected: expected{
-				res:   nil,
-				errIs: nil,
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
 			},
-		},
-		{
-			name: "edge path: SchemaRepositoryForAppSync.Save() error",
-			args: args{
-				params: &PushInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
 				},
 			},
 			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
@@ -928,6 +1384,22 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
 			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
 				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
 					{
@@ -944,237 +1416,333 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: &schema,
+						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{},
-			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
 			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
 			},
-			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
 			},
-			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
-			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{},
-			},
-			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{},
-			},
-			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
-			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
-			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
-			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
-			},
-			expected: expected{
-				res:   nil,
-				errIs: nil,
-			},
-		},
-		{
-			name: "edge path: FunctionRepositoryForAppSync.List() error",
-			args: args{
-				params: &PushInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
-				},
-			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
 					{
-						res: variables,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
 						err: nil,
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
 					{
-						res: variables,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
 					{
-						res: &schema,
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
 					{
-						res: &schema,
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
 						err: nil,
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
 			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
 				returns: []mockFunctionRepositoryForFSListReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
 					},
 				},
 			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
 			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
 			},
 			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{},
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
 			},
 			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
 			},
 			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
 			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
 			},
 			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{},
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
 			},
 			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
 				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
 			},
 			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
 			},
 			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
 			},
 			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
 				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
-				res:   nil,
+				state: &model.State{
+					Functions: map[string]string{
+						"VTL_2018-05-29":   mustHash(model.FunctionHash(&functionVTL_2018_05_29)),
+						"APPSYNC_JS_1.0.0": mustHash(model.FunctionHash(&functionAPPSYNC_JS_1_0_0)),
+					},
+					Resolvers: map[string]string{
+						"UNIT.VTL_2018-05-29":       mustHash(model.ResolverHash(&resolverUNIT_VTL_2018_05_29)),
+						"UNIT.APPSYNC_JS_1.0.0":     mustHash(model.ResolverHash(&resolverUNIT_APPSYNC_JS_1_0_0)),
+						"PIPELINE.VTL_2018-05-29":   mustHash(model.ResolverHash(&resolverPIPELINE_VTL_2018_05_29)),
+						"PIPELINE.APPSYNC_JS_1.0.0": mustHash(model.ResolverHash(&resolverPIPELINE_APPSYNC_JS_1_0_0)),
+					},
+				},
+				res:   &PushOutput{},
 				errIs: nil,
 			},
 		},
 		{
-			name: "edge path: FunctionRepositoryForAppSync.Save() error",
+			name: "happy path: no GraphQL API settings",
 			args: args{
 				params: &PushInput{
 					APIID:                     "APIID",
 					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
 				},
 			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
 					{
-						res: variables,
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
 						err: nil,
 					},
-				},
-			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
 					{
-						res: variables,
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
 					{
-						res: &schema,
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
 					{
-						res: &schema,
+						res: []model.Resolver{},
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
 					{
-						res: []model.Function{
-							functionVTL_2018_05_29,
-							functionAPPSYNC_JS_1_0_0,
-						},
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: model.NewState(),
+						err: nil,
 					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
 					{
 						res: nil,
-						err: &model.LibError{},
+						err: &model.LibError{Err: model.ErrNotFound},
 					},
 				},
 			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{},
-			},
-			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
-			},
-			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
-			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{},
-			},
-			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{},
-			},
-			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
-			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
-			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
-			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
 			},
-			expected: expected{
-				res:   nil,
-				errIs: nil,
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{},
 			},
-		},
-		{
-			name: "edge path: ResolverRepositoryForFS.List() error",
-			args: args{
-				params: &PushInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
-				},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
 			},
 			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
 				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
@@ -1184,130 +1752,114 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
 						res: variables,
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
 					{
-						res: &schema,
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
 					{
-						res: &schema,
+						res: variables,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
 					{
-						res: []model.Function{
-							functionVTL_2018_05_29,
-							functionAPPSYNC_JS_1_0_0,
-						},
+						res: &schema,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
 					{
-						res: &functionVTL_2018_05_29,
+						res: &schema,
 						err: nil,
 					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
 					{
-						res: &functionAPPSYNC_JS_1_0_0,
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: []model.SchemaChange{},
+						err: nil,
 					},
 				},
 			},
-			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
-			},
-			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
-			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{},
-			},
-			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{},
-			},
-			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
-			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
-			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
-			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
-			},
-			expected: expected{
-				res:   nil,
-				errIs: nil,
-			},
-		},
-		{
-			name: "edge path: ResolverService.ResolvePipelineConfigFunctionIDs() error",
-			args: args{
-				params: &PushInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
 					{
-						res: variables,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
 						err: nil,
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
 					{
-						res: variables,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
 					{
-						res: &schema,
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
 					{
-						res: &schema,
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
 						err: nil,
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
 			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
 				returns: []mockFunctionRepositoryForFSListReturn{
 					{
@@ -1319,6 +1871,9 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
 			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
 				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
 					{
@@ -1333,6 +1888,14 @@ func Test_pushUseCase_Execute(t *testing.T) {
 			},
 			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
 				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
 					{
 						res: []model.Resolver{
 							resolverUNIT_VTL_2018_05_29,
@@ -1347,220 +1910,327 @@ func Test_pushUseCase_Execute(t *testing.T) {
 			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
 				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
 					{
-						err: &model.LibError{},
+						err: nil,
 					},
 					{
-						err: &model.LibError{},
+						err: nil,
 					},
 					{
-						err: &model.LibError{},
+						err: nil,
 					},
 					{
-						err: &model.LibError{},
+						err: nil,
 					},
 				},
 			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
 			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
 			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
 			},
 			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{},
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
 			},
 			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
 				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
 			},
 			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
 			},
 			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
 			},
 			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
 				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
 			expected: expected{
-				res:   nil,
+				res:   &PushOutput{},
 				errIs: nil,
 			},
 		},
 		{
-			name: "edge path: ResolverRepositoryForAppSync.Save() error",
+			name: "happy path: delete extraneous files",
 			args: args{
 				params: &PushInput{
 					APIID:                     "APIID",
 					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
 				},
 			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
 					{
-						res: variables,
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
 						err: nil,
 					},
-				},
-			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
 					{
-						res: variables,
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
 					{
-						res: &schema,
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
 					{
-						res: &schema,
+						res: []model.Resolver{},
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
 					{
-						res: []model.Function{
-							functionVTL_2018_05_29,
-							functionAPPSYNC_JS_1_0_0,
-						},
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
 					{
-						res: &functionVTL_2018_05_29,
+						res: model.NewState(),
 						err: nil,
 					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
 					{
-						res: &functionAPPSYNC_JS_1_0_0,
+						res: &graphqlApi,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
 					{
-						res: []model.Resolver{
-							resolverUNIT_VTL_2018_05_29,
-							resolverUNIT_APPSYNC_JS_1_0_0,
-							resolverPIPELINE_VTL_2018_05_29,
-							resolverPIPELINE_APPSYNC_JS_1_0_0,
-						},
+						res: &graphqlApi,
 						err: nil,
 					},
 				},
 			},
-			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
 					{
+						res: false,
 						err: nil,
 					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
 					{
+						res: &graphqlApi,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
 					{
+						res: variables,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
+						res: variables,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: false,
+						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: variables,
+						err: nil,
 					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: &schema,
+						err: nil,
 					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: &schema,
+						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{},
-			},
-			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{},
-			},
-			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
-			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
-			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
-			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
 			},
-			expected: expected{
-				res:   nil,
-				errIs: nil,
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
 			},
-		},
-		{
-			name: "edge path: FunctionRepositoryForAppSync.List() error",
-			args: args{
-				params: &PushInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
 					{
-						res: variables,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
 						err: nil,
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
 					{
-						res: variables,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
 					{
-						res: &schema,
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
 					{
-						res: &schema,
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
 						err: nil,
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
 			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
 				returns: []mockFunctionRepositoryForFSListReturn{
 					{
@@ -1572,6 +2242,9 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
 			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
 				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
 					{
@@ -1586,6 +2259,14 @@ func Test_pushUseCase_Execute(t *testing.T) {
 			},
 			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
 				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
 					{
 						res: []model.Resolver{
 							resolverUNIT_VTL_2018_05_29,
@@ -1613,6 +2294,9 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
 			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
 				returns: []mockResolverRepositoryForAppSyncSaveReturn{
 					{
@@ -1636,246 +2320,315 @@ func Test_pushUseCase_Execute(t *testing.T) {
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
 					},
 				},
 			},
 			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{},
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
 			},
 			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
 			},
 			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
 			},
 			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
 			},
 			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
 			},
 			expected: expected{
-				res:   nil,
+				res:   &PushOutput{},
 				errIs: nil,
 			},
 		},
 		{
-			name: "edge path: FunctionService.Difference() error",
+			name: "happy path: dry run",
 			args: args{
 				params: &PushInput{
 					APIID:                     "APIID",
 					DeleteExtraneousResources: true,
+					DryRun:                    true,
 				},
 			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
 					{
-						res: variables,
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
 						err: nil,
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
 					{
-						res: variables,
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
 					{
-						res: &schema,
+						res: []model.Resolver{},
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
 					{
-						res: &schema,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
 					{
-						res: []model.Function{
-							functionVTL_2018_05_29,
-							functionAPPSYNC_JS_1_0_0,
-						},
+						res: &graphqlApi,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
 					{
-						res: &functionVTL_2018_05_29,
+						res: &graphqlApi,
 						err: nil,
 					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
 					{
-						res: &functionAPPSYNC_JS_1_0_0,
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
 					{
-						res: []model.Resolver{
-							resolverUNIT_VTL_2018_05_29,
-							resolverUNIT_APPSYNC_JS_1_0_0,
-							resolverPIPELINE_VTL_2018_05_29,
-							resolverPIPELINE_APPSYNC_JS_1_0_0,
-						},
+						res: variables,
 						err: nil,
 					},
 				},
 			},
-			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
+						res: variables,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
 					{
+						res: false,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
 					{
+						res: &schema,
 						err: nil,
 					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
 					{
+						res: &schema,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
 					{
-						res: &resolverUNIT_VTL_2018_05_29,
+						res: false,
 						err: nil,
 					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
 					{
-						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						res: []model.SchemaChange{},
 						err: nil,
 					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
 					{
-						res: &resolverPIPELINE_VTL_2018_05_29,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
 						err: nil,
 					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
 					{
-						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
 					{
 						res: []model.Function{
 							functionVTL_2018_05_29,
 							functionAPPSYNC_JS_1_0_0,
-							{Name: ptr.Pointer("ExtraneousFunction1")},
-							{Name: ptr.Pointer("ExtraneousFunction2")},
 						},
 						err: nil,
 					},
 				},
 			},
-			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: false,
+						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
-			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
-			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
-			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
 			},
-			expected: expected{
-				res:   nil,
-				errIs: nil,
-			},
-		},
-		{
-			name: "edge path: FunctionRepositoryForAppSync.Delete() error",
-			args: args{
-				params: &PushInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
-				},
-			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
-					{
-						res: variables,
-						err: nil,
-					},
-				},
-			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
-					{
-						res: variables,
-						err: nil,
-					},
-				},
-			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
-					{
-						res: &schema,
-						err: nil,
-					},
-				},
-			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
-					{
-						res: &schema,
-						err: nil,
-					},
-				},
-			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{
-					{
-						res: []model.Function{
-							functionVTL_2018_05_29,
-							functionAPPSYNC_JS_1_0_0,
-						},
-						err: nil,
-					},
-				},
-			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
 					{
-						res: &functionVTL_2018_05_29,
+						res: []model.Resolver{},
 						err: nil,
 					},
 					{
-						res: &functionAPPSYNC_JS_1_0_0,
+						res: []model.Resolver{},
 						err: nil,
 					},
-				},
-			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{
 					{
 						res: []model.Resolver{
 							resolverUNIT_VTL_2018_05_29,
@@ -1903,28 +2656,20 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
 			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{
-					{
-						res: &resolverUNIT_VTL_2018_05_29,
-						err: nil,
-					},
-					{
-						res: &resolverUNIT_APPSYNC_JS_1_0_0,
-						err: nil,
-					},
-					{
-						res: &resolverPIPELINE_VTL_2018_05_29,
-						err: nil,
-					},
-					{
-						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
-						err: nil,
-					},
-				},
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
 			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+						},
+						err: nil,
+					},
 					{
 						res: []model.Function{
 							functionVTL_2018_05_29,
@@ -1948,411 +2693,587 @@ func Test_pushUseCase_Execute(t *testing.T) {
 				},
 			},
 			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
 					{
-						err: &model.LibError{},
+						res: []model.Resolver{},
+						err: nil,
 					},
 					{
-						err: &model.LibError{},
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{},
-			},
 			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
 			},
 			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
 				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
 			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
 			expected: expected{
-				res:   nil,
+				res:   &PushOutput{},
 				errIs: nil,
 			},
 		},
 		{
-			name: "edge path: ResolverRepositoryForAppSync.List() error",
+			name: "happy path: dry run with orphaned resolvers",
 			args: args{
 				params: &PushInput{
 					APIID:                     "APIID",
 					DeleteExtraneousResources: true,
+					DryRun:                    true,
 				},
 			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
 					{
-						res: variables,
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
 						err: nil,
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
 					{
-						res: variables,
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
 					{
-						res: &schema,
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
 					{
-						res: &schema,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
 					{
-						res: []model.Function{
-							functionVTL_2018_05_29,
-							functionAPPSYNC_JS_1_0_0,
-						},
+						res: model.NewState(),
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
 					{
-						res: &functionVTL_2018_05_29,
+						res: &graphqlApi,
 						err: nil,
 					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
 					{
-						res: &functionAPPSYNC_JS_1_0_0,
+						res: &graphqlApi,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
 					{
-						res: []model.Resolver{
-							resolverUNIT_VTL_2018_05_29,
-							resolverUNIT_APPSYNC_JS_1_0_0,
-							resolverPIPELINE_VTL_2018_05_29,
-							resolverPIPELINE_APPSYNC_JS_1_0_0,
-						},
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
 					{
+						res: variables,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
+						res: variables,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
 					{
+						res: false,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
 					{
+						res: &schema,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
 					{
-						res: &resolverUNIT_VTL_2018_05_29,
+						res: &schema,
 						err: nil,
 					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
 					{
-						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						res: false,
 						err: nil,
 					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
 					{
-						res: &resolverPIPELINE_VTL_2018_05_29,
+						res: []model.SchemaChange{},
 						err: nil,
 					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
 					{
-						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
 					{
 						res: []model.Function{
 							functionVTL_2018_05_29,
 							functionAPPSYNC_JS_1_0_0,
-							{Name: ptr.Pointer("ExtraneousFunction1")},
-							{Name: ptr.Pointer("ExtraneousFunction2")},
 						},
 						err: nil,
 					},
 				},
 			},
-			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{
 					{
-						res: []model.Function{
-							{Name: ptr.Pointer("ExtraneousFunction1")},
-							{Name: ptr.Pointer("ExtraneousFunction2")},
-						},
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
 					{
+						res: []model.Resolver{},
 						err: nil,
 					},
 					{
+						res: []model.Resolver{},
 						err: nil,
 					},
-				},
-			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
 					},
 				},
 			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{},
-			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
-			},
-			expected: expected{
-				res:   nil,
-				errIs: nil,
-			},
-		},
-		{
-			name: "edge path: ResolverService.Difference() error",
-			args: args{
-				params: &PushInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
-				},
-			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
 					{
-						res: variables,
 						err: nil,
 					},
-				},
-			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
 					{
-						res: variables,
 						err: nil,
 					},
-				},
-			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
 					{
-						res: &schema,
 						err: nil,
 					},
-				},
-			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
 					{
-						res: &schema,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
-				returns: []mockFunctionRepositoryForFSListReturn{
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+						},
+						err: nil,
+					},
 					{
 						res: []model.Function{
 							functionVTL_2018_05_29,
 							functionAPPSYNC_JS_1_0_0,
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
 						},
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
 					{
-						res: &functionVTL_2018_05_29,
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
 						err: nil,
 					},
 					{
-						res: &functionAPPSYNC_JS_1_0_0,
+						res: []model.Resolver{},
 						err: nil,
 					},
-				},
-			},
-			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
-				returns: []mockResolverRepositoryForFSListReturn{
 					{
 						res: []model.Resolver{
 							resolverUNIT_VTL_2018_05_29,
 							resolverUNIT_APPSYNC_JS_1_0_0,
 							resolverPIPELINE_VTL_2018_05_29,
 							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
 						},
 						err: nil,
 					},
 				},
 			},
-			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
-				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
 					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
 						err: nil,
 					},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				deletedResolvers: []string{
+					"ExtraneousResolverTypeName1.ExtraneousResolverFieldName1",
+					"ExtraneousResolverTypeName2.ExtraneousResolverFieldName2",
+					"Query.removedField",
+				},
+				res:   &PushOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: dry run without backup",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					DryRun:                    true,
+					KeepBackups:               10,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
 					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
 						err: nil,
 					},
 					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
 						err: nil,
 					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
 					{
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
 					{
-						res: &resolverUNIT_VTL_2018_05_29,
+						res: []model.Resolver{},
 						err: nil,
 					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
 					{
-						res: &resolverUNIT_APPSYNC_JS_1_0_0,
 						err: nil,
 					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
 					{
-						res: &resolverPIPELINE_VTL_2018_05_29,
+						res: &graphqlApi,
 						err: nil,
 					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
 					{
-						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						res: &graphqlApi,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
-				returns: []mockFunctionRepositoryForAppSyncListReturn{
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
 					{
-						res: []model.Function{
-							functionVTL_2018_05_29,
-							functionAPPSYNC_JS_1_0_0,
-							{Name: ptr.Pointer("ExtraneousFunction1")},
-							{Name: ptr.Pointer("ExtraneousFunction2")},
-						},
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionServiceDifference: mockFunctionServiceDifference{
-				returns: []mockFunctionServiceDifferenceReturn{
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
 					{
-						res: []model.Function{
-							{Name: ptr.Pointer("ExtraneousFunction1")},
-							{Name: ptr.Pointer("ExtraneousFunction2")},
-						},
+						res: variables,
 						err: nil,
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
 					{
+						res: variables,
 						err: nil,
 					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
 					{
+						res: false,
 						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
 					{
-						res: []model.Resolver{
-							resolverUNIT_VTL_2018_05_29,
-							resolverUNIT_APPSYNC_JS_1_0_0,
-							resolverPIPELINE_VTL_2018_05_29,
-							resolverPIPELINE_APPSYNC_JS_1_0_0,
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
-						},
+						res: &schema,
 						err: nil,
 					},
 				},
 			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
 					{
-						res: nil,
-						err: &model.LibError{},
+						res: &schema,
+						err: nil,
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
-			},
-			expected: expected{
-				res:   nil,
-				errIs: nil,
-			},
-		},
-		{
-			name: "edge path: ResolverRepositoryForAppSync.Delete() error",
-			args: args{
-				params: &PushInput{
-					APIID:                     "APIID",
-					DeleteExtraneousResources: true,
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
-				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
 					{
-						res: variables,
+						res: []model.SchemaChange{},
 						err: nil,
 					},
 				},
 			},
-			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
-				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
 					{
-						res: variables,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
-				returns: []mockSchemaRepositoryForFSGetReturn{
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
 					{
-						res: &schema,
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
 						err: nil,
 					},
 				},
 			},
-			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
-				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
 					{
-						res: &schema,
+						res: false,
 						err: nil,
 					},
 				},
 			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
 			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
 				returns: []mockFunctionRepositoryForFSListReturn{
 					{
@@ -2364,20 +3285,27 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
-			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
-				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
-					{
-						res: &functionVTL_2018_05_29,
-						err: nil,
-					},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{
 					{
-						res: &functionAPPSYNC_JS_1_0_0,
+						res: false,
 						err: nil,
 					},
 				},
 			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
 			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
 				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
 					{
 						res: []model.Resolver{
 							resolverUNIT_VTL_2018_05_29,
@@ -2405,28 +3333,20 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
 			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
-				returns: []mockResolverRepositoryForAppSyncSaveReturn{
-					{
-						res: &resolverUNIT_VTL_2018_05_29,
-						err: nil,
-					},
-					{
-						res: &resolverUNIT_APPSYNC_JS_1_0_0,
-						err: nil,
-					},
-					{
-						res: &resolverPIPELINE_VTL_2018_05_29,
-						err: nil,
-					},
-					{
-						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
-						err: nil,
-					},
-				},
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
 			},
 			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
 				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+						},
+						err: nil,
+					},
 					{
 						res: []model.Function{
 							functionVTL_2018_05_29,
@@ -2450,7 +3370,13445 @@ func Test_pushUseCase_Execute(t *testing.T) {
 				},
 			},
 			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
-				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   &PushOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: skip deleting extraneous files",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: false,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &PushOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: skip unchanged resources",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: false,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &PushOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: filter resources",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+					Filter: &model.Filter{
+						Kinds: []model.ResourceKind{model.ResourceKindFunction},
+					},
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+					{
+						res: false,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &PushOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: FilterService.Validate() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+					Filter: &model.Filter{
+						Include: []string{"Query.["},
+					},
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{
+					{
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: SchemaFileRepositoryForFS.List() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: SchemaService.Validate() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ReferenceService.Validate() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: BackupRepositoryForFS.Create() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+					KeepBackups:               10,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: GraphqlApiRepositoryForFS.Get() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: GraphqlApiService.Equal() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: GraphqlApiRepositoryForAppSync.Save() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: EnvironmentVariablesRepositoryForFS.Get() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: EnvironmentVariablesRepositoryForAppSync.Save() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: SchemaRepositoryForFS.Get() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: SchemaRepositoryForAppSync.Get() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: SchemaService.Equal() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForFS.List() error when comparing schema",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: SchemaService.Compare() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrInvalidValue},
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: breaking schema changes",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{
+							{
+								Level:   model.SchemaChangeLevelBreaking,
+								Path:    "Query.getPost",
+								Message: "field Query.getPost was removed",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrBreakingChange,
+			},
+		},
+		{
+			name: "edge path: SchemaRepositoryForAppSync.Save() error with breaking schema changes allowed",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+					AllowBreaking:             true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{
+							{
+								Level:   model.SchemaChangeLevelBreaking,
+								Path:    "Query.getPost",
+								Message: "field Query.getPost was removed",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: SchemaRepositoryForAppSync.Save() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: DataSourceRepositoryForFS.List() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: DataSourceRepositoryForAppSync.Save() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: DataSourceRepositoryForAppSync.List() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: FunctionRepositoryForAppSync.List() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: FunctionService.Equal() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: FunctionRepositoryForAppSync.Save() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForFS.List() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverService.ResolvePipelineConfigFunctionIDs() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: &model.LibError{},
+					},
+					{
+						err: &model.LibError{},
+					},
+					{
+						err: &model.LibError{},
+					},
+					{
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverService.Equal() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+					{
+						res: false,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForAppSync.Save() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: roll back after ResolverRepositoryForAppSync.Save() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+					Atomic:                    true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: FunctionRepositoryForAppSync.List() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: FunctionService.Difference() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: FunctionRepositoryForAppSync.Delete() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+					{
+						err: &model.LibError{},
+					},
+					{
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForAppSync.List() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverService.Difference() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForAppSync.Delete() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction1")},
+							{Name: ptr.Pointer("ExtraneousFunction2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
+							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{
+					{
+						err: &model.LibError{},
+					},
+					{
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: keep orphaned resolvers locally after StateRepositoryForFS.Save() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+					SkipConfirmation:          true,
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+					{
+						res: []model.SchemaFile{
+							{
+								Path:    "schema.graphqls",
+								Content: "type Query {\n  hello: String\n}\n",
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockBackupRepositoryForFSCreate: mockBackupRepositoryForFSCreate{
+				returns: []mockBackupRepositoryForFSCreateReturn{},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncGet: mockResolverRepositoryForAppSyncGet{
+				returns: []mockResolverRepositoryForAppSyncGetReturn{
+					{
+						res: &model.Resolver{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField"), DataSourceName: ptr.Pointer("DataSource")},
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncGet: mockGraphqlApiRepositoryForAppSyncGet{
+				returns: []mockGraphqlApiRepositoryForAppSyncGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiServiceEqual: mockGraphqlApiServiceEqual{
+				returns: []mockGraphqlApiServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncGet: mockEnvironmentVariablesRepositoryForAppSyncGet{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceEqual: mockEnvironmentVariablesServiceEqual{
+				returns: []mockEnvironmentVariablesServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSList: mockDataSourceRepositoryForFSList{
+				returns: []mockDataSourceRepositoryForFSListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+							dataSourceAWS_LAMBDA,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncList: mockDataSourceRepositoryForAppSyncList{
+				returns: []mockDataSourceRepositoryForAppSyncListReturn{
+					{
+						res: []model.DataSource{
+							dataSourceAMAZON_DYNAMODB,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceServiceEqual: mockDataSourceServiceEqual{
+				returns: []mockDataSourceServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+					{
+						res: &dataSourceAWS_LAMBDA,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceEqual: mockFunctionServiceEqual{
+				returns: []mockFunctionServiceEqualReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionVTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceEqual: mockResolverServiceEqual{
+				returns: []mockResolverServiceEqualReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverUNIT_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverUNIT_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_VTL_2018_05_29,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+					{
+						res: []model.Function{
+							functionVTL_2018_05_29,
+							functionAPPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
+				returns: []mockResolverRepositoryForAppSyncListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+					{
+						res: []model.Resolver{
+							resolverUNIT_VTL_2018_05_29,
+							resolverUNIT_APPSYNC_JS_1_0_0,
+							resolverPIPELINE_VTL_2018_05_29,
+							resolverPIPELINE_APPSYNC_JS_1_0_0,
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryForFSDelete{
+				returns: []mockResolverRepositoryForFSDeleteReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var mu sync.Mutex
+
+			mockFilterService := mock_service.NewMockFilterService(ctrl)
+			mockGraphqlApiService := mock_service.NewMockGraphqlApiService(ctrl)
+			mockEnvironmentVariablesService := mock_service.NewMockEnvironmentVariablesService(ctrl)
+			mockSchemaService := mock_service.NewMockSchemaService(ctrl)
+			mockDataSourceService := mock_service.NewMockDataSourceService(ctrl)
+			mockFunctionService := mock_service.NewMockFunctionService(ctrl)
+			mockResolverService := mock_service.NewMockResolverService(ctrl)
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockStateRepositoryForFS := mock_repository.NewMockStateRepository(ctrl)
+			mockGraphqlApiRepositoryForFS := mock_repository.NewMockGraphqlApiRepository(ctrl)
+			mockGraphqlApiRepositoryForAppSync := mock_repository.NewMockGraphqlApiRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForFS := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForAppSync := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockSchemaRepositoryForFS := mock_repository.NewMockSchemaRepository(ctrl)
+			mockSchemaFileRepositoryForFS := mock_repository.NewMockSchemaFileRepository(ctrl)
+			mockBackupRepositoryForFS := mock_repository.NewMockBackupRepository(ctrl)
+			mockReferenceService := mock_service.NewMockReferenceService(ctrl)
+			mockValidateEnvironmentVariablesService := mock_service.NewMockEnvironmentVariablesService(ctrl)
+			mockValidateEnvironmentVariablesRepositoryForFS := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockValidateResourceRepositoryForFS := mock_repository.NewMockResourceRepository(ctrl)
+			mockValidateFunctionRepositoryForFS := mock_repository.NewMockFunctionRepository(ctrl)
+			mockValidateResolverRepositoryForFS := mock_repository.NewMockResolverRepository(ctrl)
+			mockSchemaRepositoryForAppSync := mock_repository.NewMockSchemaRepository(ctrl)
+			mockDataSourceRepositoryForFS := mock_repository.NewMockDataSourceRepository(ctrl)
+			mockDataSourceRepositoryForAppSync := mock_repository.NewMockDataSourceRepository(ctrl)
+			mockFunctionRepositoryForFS := mock_repository.NewMockFunctionRepository(ctrl)
+			mockFunctionRepositoryForAppSync := mock_repository.NewMockFunctionRepository(ctrl)
+			mockResolverRepositoryForFS := mock_repository.NewMockResolverRepository(ctrl)
+			mockResolverRepositoryForAppSync := mock_repository.NewMockResolverRepository(ctrl)
+
+			mockFilterService.
+				EXPECT().
+				Validate(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, filter *model.Filter) error {
+					r := tt.mockFilterServiceValidate.returns[tt.mockFilterServiceValidate.calls]
+					tt.mockFilterServiceValidate.calls++
+					return r.err
+				}).
+				Times(len(tt.mockFilterServiceValidate.returns))
+
+			mockFilterService.
+				EXPECT().
+				Match(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, filter *model.Filter, kind model.ResourceKind, identifier string) bool {
+					r := tt.mockFilterServiceMatch.returns[tt.mockFilterServiceMatch.calls]
+					tt.mockFilterServiceMatch.calls++
+					return r.res
+				}).
+				Times(len(tt.mockFilterServiceMatch.returns))
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			deletedResolvers := make([]string, 0)
+			mockTrackerRepository.
+				EXPECT().
+				Record(ctx, gomock.Any()).
+				Do(func(ctx context.Context, event *model.TrackerEvent) {
+					if event.Kind == model.ResourceKindResolver && event.Action == model.TrackerActionDeleted {
+						mu.Lock()
+						deletedResolvers = append(deletedResolvers, event.Identifier)
+						mu.Unlock()
+					}
+				}).
+				AnyTimes()
+
+			mockStateRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.State, error) {
+					r := tt.mockStateRepositoryForFSGet.returns[tt.mockStateRepositoryForFSGet.calls]
+					tt.mockStateRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockStateRepositoryForFSGet.returns))
+
+			var saved *model.State
+			mockStateRepositoryForFS.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, state *model.State) (*model.State, error) {
+					saved = state
+					r := tt.mockStateRepositoryForFSSave.returns[tt.mockStateRepositoryForFSSave.calls]
+					tt.mockStateRepositoryForFSSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockStateRepositoryForFSSave.returns))
+
+			mockSchemaFileRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.SchemaFile, error) {
+					r := tt.mockSchemaFileRepositoryForFSList.returns[tt.mockSchemaFileRepositoryForFSList.calls]
+					tt.mockSchemaFileRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaFileRepositoryForFSList.returns))
+
+			mockSchemaService.
+				EXPECT().
+				Validate(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, files []model.SchemaFile) error {
+					r := tt.mockSchemaServiceValidate.returns[tt.mockSchemaServiceValidate.calls]
+					tt.mockSchemaServiceValidate.calls++
+					return r.err
+				}).
+				Times(len(tt.mockSchemaServiceValidate.returns))
+
+			// NOTE: the local resources loaded for validation are covered by validate_test.go
+			mockValidateEnvironmentVariablesRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				Return(model.EnvironmentVariables{}, nil).
+				AnyTimes()
+
+			mockValidateEnvironmentVariablesService.
+				EXPECT().
+				Validate(ctx, gomock.Any()).
+				Return(nil).
+				AnyTimes()
+
+			mockValidateResourceRepositoryForFS.
+				EXPECT().
+				CheckFiles(ctx, gomock.Any()).
+				Return(nil).
+				AnyTimes()
+
+			mockValidateFunctionRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				Return([]model.Function{}, nil).
+				AnyTimes()
+
+			mockValidateResolverRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				Return([]model.Resolver{}, nil).
+				AnyTimes()
+
+			mockReferenceService.
+				EXPECT().
+				Validate(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, schemaFiles []model.SchemaFile, functions []model.Function, resolvers []model.Resolver) error {
+					r := tt.mockReferenceServiceValidate.returns[tt.mockReferenceServiceValidate.calls]
+					tt.mockReferenceServiceValidate.calls++
+					return r.err
+				}).
+				Times(len(tt.mockReferenceServiceValidate.returns))
+
+			mockReferenceService.
+				EXPECT().
+				OrphanedResolvers(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, schemaFiles []model.SchemaFile, resolvers []model.Resolver) ([]model.Resolver, error) {
+					r := tt.mockReferenceServiceOrphanedResolvers.returns[tt.mockReferenceServiceOrphanedResolvers.calls]
+					tt.mockReferenceServiceOrphanedResolvers.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockReferenceServiceOrphanedResolvers.returns))
+
+			// NOTE: the snapshot of the remote resources is covered by backup_test.go
+			mockBackupRepositoryForFS.
+				EXPECT().
+				Create(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.Backup, error) {
+					r := tt.mockBackupRepositoryForFSCreate.returns[tt.mockBackupRepositoryForFSCreate.calls]
+					tt.mockBackupRepositoryForFSCreate.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockBackupRepositoryForFSCreate.returns))
+
+			mockGraphqlApiRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.GraphqlApi, error) {
+					r := tt.mockGraphqlApiRepositoryForFSGet.returns[tt.mockGraphqlApiRepositoryForFSGet.calls]
+					tt.mockGraphqlApiRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForFSGet.returns))
+
+			mockGraphqlApiRepositoryForAppSync.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.GraphqlApi, error) {
+					r := tt.mockGraphqlApiRepositoryForAppSyncGet.returns[tt.mockGraphqlApiRepositoryForAppSyncGet.calls]
+					tt.mockGraphqlApiRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForAppSyncGet.returns))
+
+			mockGraphqlApiService.
+				EXPECT().
+				Equal(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, graphqlApi1 *model.GraphqlApi, graphqlApi2 *model.GraphqlApi) (bool, error) {
+					r := tt.mockGraphqlApiServiceEqual.returns[tt.mockGraphqlApiServiceEqual.calls]
+					tt.mockGraphqlApiServiceEqual.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiServiceEqual.returns))
+
+			mockGraphqlApiRepositoryForAppSync.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, api *model.GraphqlApi) (*model.GraphqlApi, error) {
+					r := tt.mockGraphqlApiRepositoryForAppSyncSave.returns[tt.mockGraphqlApiRepositoryForAppSyncSave.calls]
+					tt.mockGraphqlApiRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForAppSyncSave.returns))
+
+			mockEnvironmentVariablesRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForFSGet.returns[tt.mockEnvironmentVariablesRepositoryForFSGet.calls]
+					tt.mockEnvironmentVariablesRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForFSGet.returns))
+
+			mockEnvironmentVariablesRepositoryForAppSync.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForAppSyncGet.returns[tt.mockEnvironmentVariablesRepositoryForAppSyncGet.calls]
+					tt.mockEnvironmentVariablesRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForAppSyncGet.returns))
+
+			mockEnvironmentVariablesService.
+				EXPECT().
+				Equal(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, variables1 model.EnvironmentVariables, variables2 model.EnvironmentVariables) (bool, error) {
+					r := tt.mockEnvironmentVariablesServiceEqual.returns[tt.mockEnvironmentVariablesServiceEqual.calls]
+					tt.mockEnvironmentVariablesServiceEqual.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesServiceEqual.returns))
+
+			mockEnvironmentVariablesRepositoryForAppSync.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, variables model.EnvironmentVariables) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForAppSyncSave.returns[tt.mockEnvironmentVariablesRepositoryForAppSyncSave.calls]
+					tt.mockEnvironmentVariablesRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForAppSyncSave.returns))
+
+			mockSchemaRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForFSGet.returns[tt.mockSchemaRepositoryForFSGet.calls]
+					tt.mockSchemaRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForFSGet.returns))
+
+			mockSchemaRepositoryForAppSync.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForAppSyncGet.returns[tt.mockSchemaRepositoryForAppSyncGet.calls]
+					tt.mockSchemaRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForAppSyncGet.returns))
+
+			mockSchemaService.
+				EXPECT().
+				Equal(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, schema1 *model.Schema, schema2 *model.Schema) (bool, error) {
+					r := tt.mockSchemaServiceEqual.returns[tt.mockSchemaServiceEqual.calls]
+					tt.mockSchemaServiceEqual.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaServiceEqual.returns))
+
+			mockSchemaService.
+				EXPECT().
+				Compare(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, schema1 *model.Schema, schema2 *model.Schema, resolvers []model.Resolver) ([]model.SchemaChange, error) {
+					r := tt.mockSchemaServiceCompare.returns[tt.mockSchemaServiceCompare.calls]
+					tt.mockSchemaServiceCompare.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaServiceCompare.returns))
+
+			mockSchemaRepositoryForAppSync.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, schema *model.Schema) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForAppSyncSave.returns[tt.mockSchemaRepositoryForAppSyncSave.calls]
+					tt.mockSchemaRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForAppSyncSave.returns))
+
+			mockDataSourceRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.DataSource, error) {
+					r := tt.mockDataSourceRepositoryForFSList.returns[tt.mockDataSourceRepositoryForFSList.calls]
+					tt.mockDataSourceRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDataSourceRepositoryForFSList.returns))
+
+			mockDataSourceRepositoryForAppSync.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.DataSource, error) {
+					r := tt.mockDataSourceRepositoryForAppSyncList.returns[tt.mockDataSourceRepositoryForAppSyncList.calls]
+					tt.mockDataSourceRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDataSourceRepositoryForAppSyncList.returns))
+
+			mockDataSourceService.
+				EXPECT().
+				Equal(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, dataSource1 *model.DataSource, dataSource2 *model.DataSource) (bool, error) {
+					mu.Lock()
+					r := tt.mockDataSourceServiceEqual.returns[tt.mockDataSourceServiceEqual.calls]
+					tt.mockDataSourceServiceEqual.calls++
+					mu.Unlock()
+					return r.res, r.err
+				}).
+				MaxTimes(len(tt.mockDataSourceServiceEqual.returns))
+
+			mockDataSourceRepositoryForAppSync.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, dataSource *model.DataSource) (*model.DataSource, error) {
+					mu.Lock()
+					r := tt.mockDataSourceRepositoryForAppSyncSave.returns[tt.mockDataSourceRepositoryForAppSyncSave.calls]
+					tt.mockDataSourceRepositoryForAppSyncSave.calls++
+					mu.Unlock()
+					return r.res, r.err
+				}).
+				MaxTimes(len(tt.mockDataSourceRepositoryForAppSyncSave.returns))
+
+			mockDataSourceRepositoryForAppSync.
+				EXPECT().
+				Delete(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, name string) error {
+					mu.Lock()
+					r := tt.mockDataSourceRepositoryForAppSyncDelete.returns[tt.mockDataSourceRepositoryForAppSyncDelete.calls]
+					tt.mockDataSourceRepositoryForAppSyncDelete.calls++
+					mu.Unlock()
+					return r.err
+				}).
+				MaxTimes(len(tt.mockDataSourceRepositoryForAppSyncDelete.returns))
+
+			mockFunctionRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
+					r := tt.mockFunctionRepositoryForFSList.returns[tt.mockFunctionRepositoryForFSList.calls]
+					tt.mockFunctionRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForFSList.returns))
+
+			mockFunctionService.
+				EXPECT().
+				Equal(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, function1 *model.Function, function2 *model.Function) (bool, error) {
+					mu.Lock()
+					r := tt.mockFunctionServiceEqual.returns[tt.mockFunctionServiceEqual.calls]
+					tt.mockFunctionServiceEqual.calls++
+					mu.Unlock()
+					return r.res, r.err
+				}).
+				MaxTimes(len(tt.mockFunctionServiceEqual.returns))
+
+			mockFunctionRepositoryForAppSync.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, function *model.Function) (*model.Function, error) {
+					mu.Lock()
+					r := tt.mockFunctionRepositoryForAppSyncSave.returns[tt.mockFunctionRepositoryForAppSyncSave.calls]
+					tt.mockFunctionRepositoryForAppSyncSave.calls++
+					mu.Unlock()
+					return r.res, r.err
+				}).
+				MaxTimes(len(tt.mockFunctionRepositoryForAppSyncSave.returns))
+
+			mockFunctionRepositoryForAppSync.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
+					r := tt.mockFunctionRepositoryForAppSyncList.returns[tt.mockFunctionRepositoryForAppSyncList.calls]
+					tt.mockFunctionRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForAppSyncList.returns))
+
+			mockFunctionService.
+				EXPECT().
+				Difference(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, functions1 []model.Function, functions2 []model.Function) ([]model.Function, error) {
+					r := tt.mockFunctionServiceDifference.returns[tt.mockFunctionServiceDifference.calls]
+					tt.mockFunctionServiceDifference.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionServiceDifference.returns))
+
+			mockFunctionRepositoryForAppSync.
+				EXPECT().
+				Delete(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, name string) error {
+					mu.Lock()
+					r := tt.mockFunctionRepositoryForAppSyncDelete.returns[tt.mockFunctionRepositoryForAppSyncDelete.calls]
+					tt.mockFunctionRepositoryForAppSyncDelete.calls++
+					mu.Unlock()
+					return r.err
+				}).
+				MaxTimes(len(tt.mockFunctionRepositoryForAppSyncDelete.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				Get(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, typeName string, fieldName string) (*model.Resolver, error) {
+					r := tt.mockResolverRepositoryForAppSyncGet.returns[tt.mockResolverRepositoryForAppSyncGet.calls]
+					tt.mockResolverRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForAppSyncGet.returns))
+
+			mockResolverRepositoryForFS.
+				EXPECT().
+				Delete(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, typeName string, fieldName string) error {
+					r := tt.mockResolverRepositoryForFSDelete.returns[tt.mockResolverRepositoryForFSDelete.calls]
+					tt.mockResolverRepositoryForFSDelete.calls++
+					return r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForFSDelete.returns))
+
+			mockResolverRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForFSList.returns[tt.mockResolverRepositoryForFSList.calls]
+					tt.mockResolverRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForFSList.returns))
+
+			mockResolverService.
+				EXPECT().
+				ResolvePipelineConfigFunctionIDs(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, resolver *model.Resolver, functions []model.Function) error {
+					mu.Lock()
+					r := tt.mockResolverServiceResolvePipelineConfigFunctionIDs.returns[tt.mockResolverServiceResolvePipelineConfigFunctionIDs.calls]
+					tt.mockResolverServiceResolvePipelineConfigFunctionIDs.calls++
+					mu.Unlock()
+					return r.err
+				}).
+				MaxTimes(len(tt.mockResolverServiceResolvePipelineConfigFunctionIDs.returns))
+
+			mockResolverService.
+				EXPECT().
+				Equal(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, resolver1 *model.Resolver, resolver2 *model.Resolver) (bool, error) {
+					mu.Lock()
+					r := tt.mockResolverServiceEqual.returns[tt.mockResolverServiceEqual.calls]
+					tt.mockResolverServiceEqual.calls++
+					mu.Unlock()
+					return r.res, r.err
+				}).
+				MaxTimes(len(tt.mockResolverServiceEqual.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, resolver *model.Resolver) (*model.Resolver, error) {
+					mu.Lock()
+					r := tt.mockResolverRepositoryForAppSyncSave.returns[tt.mockResolverRepositoryForAppSyncSave.calls]
+					tt.mockResolverRepositoryForAppSyncSave.calls++
+					mu.Unlock()
+					return r.res, r.err
+				}).
+				MaxTimes(len(tt.mockResolverRepositoryForAppSyncSave.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForAppSyncList.returns[tt.mockResolverRepositoryForAppSyncList.calls]
+					tt.mockResolverRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForAppSyncList.returns))
+
+			mockResolverService.
+				EXPECT().
+				Difference(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, resolvers1 []model.Resolver, resolvers2 []model.Resolver) ([]model.Resolver, error) {
+					r := tt.mockResolverServiceDifference.returns[tt.mockResolverServiceDifference.calls]
+					tt.mockResolverServiceDifference.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverServiceDifference.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				Delete(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, typeName string, fieldName string) error {
+					mu.Lock()
+					r := tt.mockResolverRepositoryForAppSyncDelete.returns[tt.mockResolverRepositoryForAppSyncDelete.calls]
+					tt.mockResolverRepositoryForAppSyncDelete.calls++
+					mu.Unlock()
+					return r.err
+				}).
+				MaxTimes(len(tt.mockResolverRepositoryForAppSyncDelete.returns))
+
+			uc := &pushUseCase{
+				validateUseCase: &validateUseCase{
+					schemaService:                       mockSchemaService,
+					referenceService:                    mockReferenceService,
+					environmentVariablesService:         mockValidateEnvironmentVariablesService,
+					trackerRepository:                   mockTrackerRepository,
+					resourceRepositoryForFS:             mockValidateResourceRepositoryForFS,
+					environmentVariablesRepositoryForFS: mockValidateEnvironmentVariablesRepositoryForFS,
+					schemaFileRepositoryForFS:           mockSchemaFileRepositoryForFS,
+					functionRepositoryForFS:             mockValidateFunctionRepositoryForFS,
+					resolverRepositoryForFS:             mockValidateResolverRepositoryForFS,
+				},
+				backupUseCase: &backupUseCase{
+					trackerRepository:     mockTrackerRepository,
+					backupRepositoryForFS: mockBackupRepositoryForFS,
+				},
+
+				filterService:                            mockFilterService,
+				graphqlApiService:                        mockGraphqlApiService,
+				environmentVariablesService:              mockEnvironmentVariablesService,
+				schemaService:                            mockSchemaService,
+				dataSourceService:                        mockDataSourceService,
+				referenceService:                         mockReferenceService,
+				functionService:                          mockFunctionService,
+				resolverService:                          mockResolverService,
+				trackerRepository:                        mockTrackerRepository,
+				stateRepositoryForFS:                     mockStateRepositoryForFS,
+				schemaFileRepositoryForFS:                mockSchemaFileRepositoryForFS,
+				graphqlApiRepositoryForAppSync:           mockGraphqlApiRepositoryForAppSync,
+				graphqlApiRepositoryForFS:                mockGraphqlApiRepositoryForFS,
+				environmentVariablesRepositoryForAppSync: mockEnvironmentVariablesRepositoryForAppSync,
+				environmentVariablesRepositoryForFS:      mockEnvironmentVariablesRepositoryForFS,
+				schemaRepositoryForAppSync:               mockSchemaRepositoryForAppSync,
+				schemaRepositoryForFS:                    mockSchemaRepositoryForFS,
+				dataSourceRepositoryForAppSync:           mockDataSourceRepositoryForAppSync,
+				dataSourceRepositoryForFS:                mockDataSourceRepositoryForFS,
+				functionRepositoryForAppSync:             mockFunctionRepositoryForAppSync,
+				functionRepositoryForFS:                  mockFunctionRepositoryForFS,
+				resolverRepositoryForAppSync:             mockResolverRepositoryForAppSync,
+				resolverRepositoryForFS:                  mockResolverRepositoryForFS,
+			}
+
+			// Act
+			actual, err := uc.Execute(ctx, tt.args.params)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if tt.expected.deletedResolvers != nil {
+				slices.Sort(deletedResolvers)
+				assert.Equal(t, tt.expected.deletedResolvers, deletedResolvers)
+			}
+
+			if tt.expected.state != nil {
+				assert.Equal(t, tt.expected.state, saved)
+			}
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_pushUseCase_confirmDeletion(t *testing.T) {
+	type args struct {
+		params  *PushInput
+		orphans []model.Resolver
+	}
+
+	type mockFunctionRepositoryListReturn struct {
+		res []model.Function
+		err error
+	}
+	type mockFunctionRepositoryList struct {
+		calls   int
+		returns []mockFunctionRepositoryListReturn
+	}
+
+	type mockFunctionServiceDifferenceReturn struct {
+		res []model.Function
+		err error
+	}
+	type mockFunctionServiceDifference struct {
+		calls   int
+		returns []mockFunctionServiceDifferenceReturn
+	}
+
+	type mockResolverRepositoryListReturn struct {
+		res []model.Resolver
+		err error
+	}
+	type mockResolverRepositoryList struct {
+		calls   int
+		returns []mockResolverRepositoryListReturn
+	}
+
+	type mockResolverServiceDifferenceReturn struct {
+		res []model.Resolver
+		err error
+	}
+	type mockResolverServiceDifference struct {
+		calls   int
+		returns []mockResolverServiceDifferenceReturn
+	}
+
+	type mockConfirmationRepositoryConfirmReturn struct {
+		res bool
+		err error
+	}
+	type mockConfirmationRepositoryConfirm struct {
+		calls   int
+		msgs    []string
+		returns []mockConfirmationRepositoryConfirmReturn
+	}
+
+	type expected struct {
+		msgs  []string
+		errIs error
+	}
+
+	tests := []struct {
+		name                                 string
+		args                                 args
+		mockFunctionRepositoryForFSList      mockFunctionRepositoryList
+		mockFunctionRepositoryForAppSyncList mockFunctionRepositoryList
+		mockFunctionServiceDifference        mockFunctionServiceDifference
+		mockResolverRepositoryForFSList      mockResolverRepositoryList
+		mockResolverRepositoryForAppSyncList mockResolverRepositoryList
+		mockResolverServiceDifference        mockResolverServiceDifference
+		mockConfirmationRepositoryConfirm    mockConfirmationRepositoryConfirm
+		expected                             expected
+	}{
+		{
+			name: "happy path: confirmed",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("extraneousField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("extraneousField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				msgs: []string{
+					"The following extraneous resources will be deleted from AWS AppSync:\n  - function ExtraneousFunction\n  - resolver Query.extraneousField\nDo you want to continue?",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: leave out orphaned resolvers",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+				orphans: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("extraneousField")},
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("extraneousField")},
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				msgs: []string{
+					"The following extraneous resources will be deleted from AWS AppSync:\n  - function ExtraneousFunction\n  - resolver Query.extraneousField\nDo you want to continue?",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: exist no extraneous resources",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				msgs:  nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: declined",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				msgs: []string{
+					"The following extraneous resources will be deleted from AWS AppSync:\n  - function ExtraneousFunction\nDo you want to continue?",
+				},
+				errIs: model.ErrNotConfirmed,
+			},
+		},
+		{
+			name: "edge path: ConfirmationRepository.Confirm() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{
+					{
+						res: []model.Function{
+							{Name: ptr.Pointer("ExtraneousFunction")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{
+					{
+						res: false,
+						err: model.ErrNotConfirmed,
+					},
+				},
+			},
+			expected: expected{
+				msgs: []string{
+					"The following extraneous resources will be deleted from AWS AppSync:\n  - function ExtraneousFunction\nDo you want to continue?",
+				},
+				errIs: model.ErrNotConfirmed,
+			},
+		},
+		{
+			name: "edge path: FunctionRepositoryForAppSync.List() error",
+			args: args{
+				params: &PushInput{
+					APIID:                     "APIID",
+					DeleteExtraneousResources: true,
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryList{
+				returns: []mockFunctionRepositoryListReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockFunctionServiceDifference: mockFunctionServiceDifference{
+				returns: []mockFunctionServiceDifferenceReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{},
+			},
+			mockResolverServiceDifference: mockResolverServiceDifference{
+				returns: []mockResolverServiceDifferenceReturn{},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				msgs:  nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFunctionService := mock_service.NewMockFunctionService(ctrl)
+			mockResolverService := mock_service.NewMockResolverService(ctrl)
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockConfirmationRepository := mock_repository.NewMockConfirmationRepository(ctrl)
+			mockFunctionRepositoryForAppSync := mock_repository.NewMockFunctionRepository(ctrl)
+			mockFunctionRepositoryForFS := mock_repository.NewMockFunctionRepository(ctrl)
+			mockResolverRepositoryForAppSync := mock_repository.NewMockResolverRepository(ctrl)
+			mockResolverRepositoryForFS := mock_repository.NewMockResolverRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Record(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockFunctionRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
+					r := tt.mockFunctionRepositoryForFSList.returns[tt.mockFunctionRepositoryForFSList.calls]
+					tt.mockFunctionRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForFSList.returns))
+
+			mockFunctionRepositoryForAppSync.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
+					r := tt.mockFunctionRepositoryForAppSyncList.returns[tt.mockFunctionRepositoryForAppSyncList.calls]
+					tt.mockFunctionRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForAppSyncList.returns))
+
+			mockFunctionService.
+				EXPECT().
+				Difference(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, functions1 []model.Function, functions2 []model.Function) ([]model.Function, error) {
+					r := tt.mockFunctionServiceDifference.returns[tt.mockFunctionServiceDifference.calls]
+					tt.mockFunctionServiceDifference.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionServiceDifference.returns))
+
+			mockResolverRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForFSList.returns[tt.mockResolverRepositoryForFSList.calls]
+					tt.mockResolverRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForFSList.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForAppSyncList.returns[tt.mockResolverRepositoryForAppSyncList.calls]
+					tt.mockResolverRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForAppSyncList.returns))
+
+			mockResolverService.
+				EXPECT().
+				Difference(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, resolvers1 []model.Resolver, resolvers2 []model.Resolver) ([]model.Resolver, error) {
+					r := tt.mockResolverServiceDifference.returns[tt.mockResolverServiceDifference.calls]
+					tt.mockResolverServiceDifference.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverServiceDifference.returns))
+
+			mockConfirmationRepository.
+				EXPECT().
+				Confirm(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, msg string) (bool, error) {
+					r := tt.mockConfirmationRepositoryConfirm.returns[tt.mockConfirmationRepositoryConfirm.calls]
+					tt.mockConfirmationRepositoryConfirm.calls++
+					tt.mockConfirmationRepositoryConfirm.msgs = append(tt.mockConfirmationRepositoryConfirm.msgs, msg)
+					return r.res, r.err
+				}).
+				Times(len(tt.mockConfirmationRepositoryConfirm.returns))
+
+			uc := &pushUseCase{
+				functionService:              mockFunctionService,
+				resolverService:              mockResolverService,
+				trackerRepository:            mockTrackerRepository,
+				confirmationRepository:       mockConfirmationRepository,
+				functionRepositoryForAppSync: mockFunctionRepositoryForAppSync,
+				functionRepositoryForFS:      mockFunctionRepositoryForFS,
+				resolverRepositoryForAppSync: mockResolverRepositoryForAppSync,
+				resolverRepositoryForFS:      mockResolverRepositoryForFS,
+			}
+
+			// Act
+			err := uc.confirmDeletion(ctx, tt.args.params, tt.args.orphans)
+
+			// Assert
+			assert.Equal(t, tt.expected.msgs, tt.mockConfirmationRepositoryConfirm.msgs)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_pushUseCase_confirmOrphanedResolvers(t *testing.T) {
+	schemaFiles := []model.SchemaFile{
+		{
+			Path:    "schema.graphqls",
+			Content: "type Query {\n  hello: String\n}\n",
+		},
+	}
+
+	type args struct {
+		params *PushInput
+	}
+
+	type mockSchemaFileRepositoryListReturn struct {
+		res []model.SchemaFile
+		err error
+	}
+	type mockSchemaFileRepositoryList struct {
+		calls   int
+		returns []mockSchemaFileRepositoryListReturn
+	}
+
+	type mockResolverRepositoryListReturn struct {
+		res []model.Resolver
+		err error
+	}
+	type mockResolverRepositoryList struct {
+		calls   int
+		returns []mockResolverRepositoryListReturn
+	}
+
+	type mockFilterServiceMatchReturn struct {
+		res bool
+	}
+	type mockFilterServiceMatch struct {
+		calls   int
+		returns []mockFilterServiceMatchReturn
+	}
+
+	type mockReferenceServiceOrphanedResolversReturn struct {
+		res []model.Resolver
+		err error
+	}
+	type mockReferenceServiceOrphanedResolvers struct {
+		calls   int
+		returns []mockReferenceServiceOrphanedResolversReturn
+	}
+
+	type mockStateRepositoryGetReturn struct {
+		res *model.State
+		err error
+	}
+	type mockStateRepositoryGet struct {
+		calls   int
+		returns []mockStateRepositoryGetReturn
+	}
+
+	type mockConfirmationRepositoryConfirmReturn struct {
+		res bool
+		err error
+	}
+	type mockConfirmationRepositoryConfirm struct {
+		calls   int
+		msgs    []string
+		returns []mockConfirmationRepositoryConfirmReturn
+	}
+
+	type expected struct {
+		res   []model.Resolver
+		msgs  []string
+		errIs error
+	}
+
+	tests := []struct {
+		name                                  string
+		args                                  args
+		mockSchemaFileRepositoryForFSList     mockSchemaFileRepositoryList
+		mockResolverRepositoryForFSList       mockResolverRepositoryList
+		mockReferenceServiceOrphanedResolvers mockReferenceServiceOrphanedResolvers
+		mockFilterServiceMatch                mockFilterServiceMatch
+		mockResolverRepositoryForAppSyncList  mockResolverRepositoryList
+		mockStateRepositoryForFSGet           mockStateRepositoryGet
+		mockConfirmationRepositoryConfirm     mockConfirmationRepositoryConfirm
+		expected                              expected
+	}{
+		{
+			name: "happy path: confirmed",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("hello")},
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryGet{
+				returns: []mockStateRepositoryGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+				},
+				msgs: []string{
+					"The following resolvers no longer map to a field in the schema and will be deleted locally and from AWS AppSync:\n  - resolver Query.removedField\nDo you want to continue?",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: skip confirmation",
+			args: args{
+				params: &PushInput{
+					APIID:            "APIID",
+					SkipConfirmation: true,
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryGet{
+				returns: []mockStateRepositoryGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				res: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+				},
+				msgs:  nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: leave never pushed resolvers to the validation",
+			args: args{
+				params: &PushInput{
+					APIID:            "APIID",
+					SkipConfirmation: true,
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryGet{
+				returns: []mockStateRepositoryGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				res:   []model.Resolver{},
+				msgs:  nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: orphaned resolver recorded in the sync state",
+			args: args{
+				params: &PushInput{
+					APIID:            "APIID",
+					SkipConfirmation: true,
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryGet{
+				returns: []mockStateRepositoryGetReturn{
+					{
+						res: &model.State{
+							Functions: map[string]string{},
+							Resolvers: map[string]string{"Query.removedField": "hash"},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				res: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+				},
+				msgs:  nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: exist no orphaned resolvers",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("hello")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				res:   []model.Resolver{},
+				msgs:  nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: declined",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryGet{
+				returns: []mockStateRepositoryGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res: nil,
+				msgs: []string{
+					"The following resolvers no longer map to a field in the schema and will be deleted locally and from AWS AppSync:\n  - resolver Query.removedField\nDo you want to continue?",
+				},
+				errIs: model.ErrNotConfirmed,
+			},
+		},
+		{
+			name: "happy path: dry run",
+			args: args{
+				params: &PushInput{
+					APIID:  "APIID",
+					DryRun: true,
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryGet{
+				returns: []mockStateRepositoryGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				res: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+				},
+				msgs:  nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: filter orphaned resolvers",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+					Filter: &model.Filter{
+						Include: []string{"Query.*"},
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+							{TypeName: ptr.Pointer("RemovedType"), FieldName: ptr.Pointer("field")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+							{TypeName: ptr.Pointer("RemovedType"), FieldName: ptr.Pointer("field")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{
+					{
+						res: true,
+					},
+					{
+						res: false,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryGet{
+				returns: []mockStateRepositoryGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+				},
+				msgs: []string{
+					"The following resolvers no longer map to a field in the schema and will be deleted locally and from AWS AppSync:\n  - resolver Query.removedField\nDo you want to continue?",
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ConfirmationRepository.Confirm() error",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryGet{
+				returns: []mockStateRepositoryGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{
+					{
+						res: false,
+						err: &model.LibError{Err: model.ErrNotConfirmed},
+					},
+				},
+			},
+			expected: expected{
+				res: nil,
+				msgs: []string{
+					"The following resolvers no longer map to a field in the schema and will be deleted locally and from AWS AppSync:\n  - resolver Query.removedField\nDo you want to continue?",
+				},
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: SchemaFileRepositoryForFS.List() error",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrNotFound},
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				msgs:  nil,
+				errIs: model.ErrNotFound,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForFS.List() error",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				msgs:  nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ReferenceService.OrphanedResolvers() error",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrInvalidValue},
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				msgs:  nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForAppSync.List() error",
+			args: args{
+				params: &PushInput{
+					APIID:            "APIID",
+					SkipConfirmation: true,
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryGet{
+				returns: []mockStateRepositoryGetReturn{},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				msgs:  nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: StateRepositoryForFS.Get() error",
+			args: args{
+				params: &PushInput{
+					APIID:            "APIID",
+					SkipConfirmation: true,
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryList{
+				returns: []mockSchemaFileRepositoryListReturn{
+					{
+						res: schemaFiles,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceOrphanedResolvers: mockReferenceServiceOrphanedResolvers{
+				returns: []mockReferenceServiceOrphanedResolversReturn{
+					{
+						res: []model.Resolver{
+							{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncList: mockResolverRepositoryList{
+				returns: []mockResolverRepositoryListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryGet{
+				returns: []mockStateRepositoryGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockConfirmationRepositoryConfirm: mockConfirmationRepositoryConfirm{
+				returns: []mockConfirmationRepositoryConfirmReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				msgs:  nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockReferenceService := mock_service.NewMockReferenceService(ctrl)
+			mockFilterService := mock_service.NewMockFilterService(ctrl)
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockConfirmationRepository := mock_repository.NewMockConfirmationRepository(ctrl)
+			mockSchemaFileRepositoryForFS := mock_repository.NewMockSchemaFileRepository(ctrl)
+			mockResolverRepositoryForFS := mock_repository.NewMockResolverRepository(ctrl)
+			mockResolverRepositoryForAppSync := mock_repository.NewMockResolverRepository(ctrl)
+			mockStateRepositoryForFS := mock_repository.NewMockStateRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockSchemaFileRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.SchemaFile, error) {
+					r := tt.mockSchemaFileRepositoryForFSList.returns[tt.mockSchemaFileRepositoryForFSList.calls]
+					tt.mockSchemaFileRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaFileRepositoryForFSList.returns))
+
+			mockResolverRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForFSList.returns[tt.mockResolverRepositoryForFSList.calls]
+					tt.mockResolverRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForFSList.returns))
+
+			mockReferenceService.
+				EXPECT().
+				OrphanedResolvers(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, schemaFiles []model.SchemaFile, resolvers []model.Resolver) ([]model.Resolver, error) {
+					r := tt.mockReferenceServiceOrphanedResolvers.returns[tt.mockReferenceServiceOrphanedResolvers.calls]
+					tt.mockReferenceServiceOrphanedResolvers.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockReferenceServiceOrphanedResolvers.returns))
+
+			mockFilterService.
+				EXPECT().
+				Match(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, filter *model.Filter, kind model.ResourceKind, identifier string) bool {
+					r := tt.mockFilterServiceMatch.returns[tt.mockFilterServiceMatch.calls]
+					tt.mockFilterServiceMatch.calls++
+					return r.res
+				}).
+				Times(len(tt.mockFilterServiceMatch.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForAppSyncList.returns[tt.mockResolverRepositoryForAppSyncList.calls]
+					tt.mockResolverRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForAppSyncList.returns))
+
+			mockStateRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.State, error) {
+					r := tt.mockStateRepositoryForFSGet.returns[tt.mockStateRepositoryForFSGet.calls]
+					tt.mockStateRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockStateRepositoryForFSGet.returns))
+
+			mockConfirmationRepository.
+				EXPECT().
+				Confirm(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, msg string) (bool, error) {
+					r := tt.mockConfirmationRepositoryConfirm.returns[tt.mockConfirmationRepositoryConfirm.calls]
+					tt.mockConfirmationRepositoryConfirm.calls++
+					tt.mockConfirmationRepositoryConfirm.msgs = append(tt.mockConfirmationRepositoryConfirm.msgs, msg)
+					return r.res, r.err
+				}).
+				Times(len(tt.mockConfirmationRepositoryConfirm.returns))
+
+			uc := &pushUseCase{
+				referenceService:             mockReferenceService,
+				filterService:                mockFilterService,
+				trackerRepository:            mockTrackerRepository,
+				confirmationRepository:       mockConfirmationRepository,
+				schemaFileRepositoryForFS:    mockSchemaFileRepositoryForFS,
+				resolverRepositoryForFS:      mockResolverRepositoryForFS,
+				resolverRepositoryForAppSync: mockResolverRepositoryForAppSync,
+				stateRepositoryForFS:         mockStateRepositoryForFS,
+			}
+
+			// Act
+			actual, err := uc.confirmOrphanedResolvers(ctx, tt.args.params)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+			assert.Equal(t, tt.expected.msgs, tt.mockConfirmationRepositoryConfirm.msgs)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_pushUseCase_deleteOrphanedResolvers(t *testing.T) {
+	resolverQueryRemovedField := model.Resolver{
+		TypeName:  ptr.Pointer("Query"),
+		FieldName: ptr.Pointer("removedField"),
+	}
+	resolverRemovedTypeField := model.Resolver{
+		TypeName:  ptr.Pointer("RemovedType"),
+		FieldName: ptr.Pointer("field"),
+	}
+
+	type args struct {
+		params    *PushInput
+		resolvers []model.Resolver
+	}
+
+	type mockResolverRepositoryGetReturn struct {
+		res *model.Resolver
+		err error
+	}
+	type mockResolverRepositoryGet struct {
+		calls   int
+		returns []mockResolverRepositoryGetReturn
+	}
+
+	type mockResolverRepositoryDeleteReturn struct {
+		err error
+	}
+	type mockResolverRepositoryDelete struct {
+		calls   int
+		returns []mockResolverRepositoryDeleteReturn
+	}
+
+	type expected struct {
+		journal []change[model.Resolver]
+		errIs   error
+	}
+
+	tests := []struct {
+		name                                   string
+		args                                   args
+		mockResolverRepositoryForAppSyncGet    mockResolverRepositoryGet
+		mockResolverRepositoryForAppSyncDelete mockResolverRepositoryDelete
+		expected                               expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+					{TypeName: ptr.Pointer("RemovedType"), FieldName: ptr.Pointer("field")},
+				},
+			},
+			mockResolverRepositoryForAppSyncGet: mockResolverRepositoryGet{
+				returns: []mockResolverRepositoryGetReturn{
+					{
+						res: &resolverQueryRemovedField,
+						err: nil,
+					},
+					{
+						res: &resolverRemovedTypeField,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryDelete{
+				returns: []mockResolverRepositoryDeleteReturn{
+					{
+						err: nil,
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				journal: []change[model.Resolver]{
+					{before: &resolverQueryRemovedField, after: nil},
+					{before: &resolverRemovedTypeField, after: nil},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: not found",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+				},
+			},
+			mockResolverRepositoryForAppSyncGet: mockResolverRepositoryGet{
+				returns: []mockResolverRepositoryGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrNotFound},
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryDelete{
+				returns: []mockResolverRepositoryDeleteReturn{},
+			},
+			expected: expected{
+				journal: []change[model.Resolver]{},
+				errIs:   nil,
+			},
+		},
+		{
+			name: "happy path: dry run",
+			args: args{
+				params: &PushInput{
+					APIID:  "APIID",
+					DryRun: true,
+				},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+				},
+			},
+			mockResolverRepositoryForAppSyncGet: mockResolverRepositoryGet{
+				returns: []mockResolverRepositoryGetReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryDelete{
+				returns: []mockResolverRepositoryDeleteReturn{},
+			},
+			expected: expected{
+				journal: []change[model.Resolver]{},
+				errIs:   nil,
+			},
+		},
+		{
+			name: "happy path: no orphaned resolvers",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+				resolvers: []model.Resolver{},
+			},
+			mockResolverRepositoryForAppSyncGet: mockResolverRepositoryGet{
+				returns: []mockResolverRepositoryGetReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryDelete{
+				returns: []mockResolverRepositoryDeleteReturn{},
+			},
+			expected: expected{
+				journal: []change[model.Resolver]{},
+				errIs:   nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForAppSync.Get() error",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+				},
+			},
+			mockResolverRepositoryForAppSyncGet: mockResolverRepositoryGet{
+				returns: []mockResolverRepositoryGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryDelete{
+				returns: []mockResolverRepositoryDeleteReturn{},
+			},
+			expected: expected{
+				journal: []change[model.Resolver]{},
+				errIs:   nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForAppSync.Delete() error",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+					{TypeName: ptr.Pointer("RemovedType"), FieldName: ptr.Pointer("field")},
+				},
+			},
+			mockResolverRepositoryForAppSyncGet: mockResolverRepositoryGet{
+				returns: []mockResolverRepositoryGetReturn{
+					{
+						res: &resolverQueryRemovedField,
+						err: nil,
+					},
+					{
+						res: &resolverRemovedTypeField,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryDelete{
+				returns: []mockResolverRepositoryDeleteReturn{
+					{
+						err: &model.LibError{},
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				journal: []change[model.Resolver]{
+					{before: &resolverRemovedTypeField, after: nil},
+				},
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockResolverRepositoryForAppSync := mock_repository.NewMockResolverRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Record(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				Get(ctx, tt.args.params.APIID, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, typeName string, fieldName string) (*model.Resolver, error) {
+					r := tt.mockResolverRepositoryForAppSyncGet.returns[tt.mockResolverRepositoryForAppSyncGet.calls]
+					tt.mockResolverRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForAppSyncGet.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				Delete(ctx, tt.args.params.APIID, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, typeName string, fieldName string) error {
+					r := tt.mockResolverRepositoryForAppSyncDelete.returns[tt.mockResolverRepositoryForAppSyncDelete.calls]
+					tt.mockResolverRepositoryForAppSyncDelete.calls++
+					return r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForAppSyncDelete.returns))
+
+			uc := &pushUseCase{
+				trackerRepository:            mockTrackerRepository,
+				resolverRepositoryForAppSync: mockResolverRepositoryForAppSync,
+			}
+
+			j := newJournal()
+
+			// Act
+			err := uc.deleteOrphanedResolvers(ctx, tt.args.params, j, nil, tt.args.resolvers)
+
+			// Assert
+			assert.Equal(t, tt.expected.journal, j.resolvers)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
+
+func Test_pushUseCase_deleteOrphanedResolverFiles(t *testing.T) {
+	type args struct {
+		params    *PushInput
+		resolvers []model.Resolver
+	}
+
+	type mockResolverRepositoryDeleteReturn struct {
+		err error
+	}
+	type mockResolverRepositoryDelete struct {
+		calls   int
+		returns []mockResolverRepositoryDeleteReturn
+	}
+
+	type expected struct {
+		errIs error
+	}
+
+	tests := []struct {
+		name                              string
+		args                              args
+		mockResolverRepositoryForFSDelete mockResolverRepositoryDelete
+		expected                          expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+					{TypeName: ptr.Pointer("RemovedType"), FieldName: ptr.Pointer("field")},
+				},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryDelete{
+				returns: []mockResolverRepositoryDeleteReturn{
 					{
 						err: nil,
 					},
@@ -2459,44 +16817,68 @@ func Test_pushUseCase_Execute(t *testing.T) {
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncList: mockResolverRepositoryForAppSyncList{
-				returns: []mockResolverRepositoryForAppSyncListReturn{
-					{
-						res: []model.Resolver{
-							resolverUNIT_VTL_2018_05_29,
-							resolverUNIT_APPSYNC_JS_1_0_0,
-							resolverPIPELINE_VTL_2018_05_29,
-							resolverPIPELINE_APPSYNC_JS_1_0_0,
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
-						},
-						err: nil,
-					},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: not found",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
 				},
 			},
-			mockResolverServiceDifference: mockResolverServiceDifference{
-				returns: []mockResolverServiceDifferenceReturn{
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryDelete{
+				returns: []mockResolverRepositoryDeleteReturn{
 					{
-						res: []model.Resolver{
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName1"), FieldName: ptr.Pointer("ExtraneousResolverFieldName1")},
-							{TypeName: ptr.Pointer("ExtraneousResolverTypeName2"), FieldName: ptr.Pointer("ExtraneousResolverFieldName2")},
-						},
-						err: nil,
+						err: &model.LibError{Err: model.ErrNotFound},
 					},
 				},
 			},
-			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
-				returns: []mockResolverRepositoryForAppSyncDeleteReturn{
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: no orphaned resolvers",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+				resolvers: []model.Resolver{},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryDelete{
+				returns: []mockResolverRepositoryDeleteReturn{},
+			},
+			expected: expected{
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForFS.Delete() error",
+			args: args{
+				params: &PushInput{
+					APIID: "APIID",
+				},
+				resolvers: []model.Resolver{
+					{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+					{TypeName: ptr.Pointer("RemovedType"), FieldName: ptr.Pointer("field")},
+				},
+			},
+			mockResolverRepositoryForFSDelete: mockResolverRepositoryDelete{
+				returns: []mockResolverRepositoryDeleteReturn{
 					{
 						err: &model.LibError{},
 					},
 					{
-						err: &model.LibError{},
+						err: nil,
 					},
 				},
 			},
 			expected: expected{
-				res:   nil,
 				errIs: nil,
 			},
 		},
@@ -2510,19 +16892,8 @@ func Test_pushUseCase_Execute(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			defer ctrl.Finish()
 
-			var mu sync.Mutex
-
-			mockFunctionService := mock_service.NewMockFunctionService(ctrl)
-			mockResolverService := mock_service.NewMockResolverService(ctrl)
 			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
-			mockEnvironmentVariablesRepositoryForFS := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
-			mockEnvironmentVariablesRepositoryForAppSync := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
-			mockSchemaRepositoryForFS := mock_repository.NewMockSchemaRepository(ctrl)
-			mockSchemaRepositoryForAppSync := mock_repository.NewMockSchemaRepository(ctrl)
-			mockFunctionRepositoryForFS := mock_repository.NewMockFunctionRepository(ctrl)
-			mockFunctionRepositoryForAppSync := mock_repository.NewMockFunctionRepository(ctrl)
 			mockResolverRepositoryForFS := mock_repository.NewMockResolverRepository(ctrl)
-			mockResolverRepositoryForAppSync := mock_repository.NewMockResolverRepository(ctrl)
 
 			mockTrackerRepository.
 				EXPECT().
@@ -2539,186 +16910,25 @@ func Test_pushUseCase_Execute(t *testing.T) {
 				Failed(ctx, gomock.Any()).
 				AnyTimes()
 
-			mockEnvironmentVariablesRepositoryForFS.
-				EXPECT().
-				Get(ctx, gomock.Any()).
-				DoAndReturn(func(ctx context.Context, apiID string) (model.EnvironmentVariables, error) {
-					r := tt.mockEnvironmentVariablesRepositoryForFSGet.returns[tt.mockEnvironmentVariablesRepositoryForFSGet.calls]
-					tt.mockEnvironmentVariablesRepositoryForFSGet.calls++
-					return r.res, r.err
-				}).
-				Times(len(tt.mockEnvironmentVariablesRepositoryForFSGet.returns))
-
-			mockEnvironmentVariablesRepositoryForAppSync.
-				EXPECT().
-				Save(ctx, gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, apiID string, variables model.EnvironmentVariables) (model.EnvironmentVariables, error) {
-					r := tt.mockEnvironmentVariablesRepositoryForAppSyncSave.returns[tt.mockEnvironmentVariablesRepositoryForAppSyncSave.calls]
-					tt.mockEnvironmentVariablesRepositoryForAppSyncSave.calls++
-					return r.res, r.err
-				}).
-				Times(len(tt.mockEnvironmentVariablesRepositoryForAppSyncSave.returns))
-
-			mockSchemaRepositoryForFS.
-				EXPECT().
-				Get(ctx, gomock.Any()).
-				DoAndReturn(func(ctx context.Context, apiID string) (*model.Schema, error) {
-					r := tt.mockSchemaRepositoryForFSGet.returns[tt.mockSchemaRepositoryForFSGet.calls]
-					tt.mockSchemaRepositoryForFSGet.calls++
-					return r.res, r.err
-				}).
-				Times(len(tt.mockSchemaRepositoryForFSGet.returns))
-
-			mockSchemaRepositoryForAppSync.
-				EXPECT().
-				Save(ctx, gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, apiID string, schema *model.Schema) (*model.Schema, error) {
-					r := tt.mockSchemaRepositoryForAppSyncSave.returns[tt.mockSchemaRepositoryForAppSyncSave.calls]
-					tt.mockSchemaRepositoryForAppSyncSave.calls++
-					return r.res, r.err
-				}).
-				Times(len(tt.mockSchemaRepositoryForAppSyncSave.returns))
-
-			mockFunctionRepositoryForFS.
-				EXPECT().
-				List(ctx, gomock.Any()).
-				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
-					r := tt.mockFunctionRepositoryForFSList.returns[tt.mockFunctionRepositoryForFSList.calls]
-					tt.mockFunctionRepositoryForFSList.calls++
-					return r.res, r.err
-				}).
-				Times(len(tt.mockFunctionRepositoryForFSList.returns))
-
-			mockFunctionRepositoryForAppSync.
-				EXPECT().
-				Save(ctx, gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, apiID string, function *model.Function) (*model.Function, error) {
-					mu.Lock()
-					r := tt.mockFunctionRepositoryForAppSyncSave.returns[tt.mockFunctionRepositoryForAppSyncSave.calls]
-					tt.mockFunctionRepositoryForAppSyncSave.calls++
-					mu.Unlock()
-					return r.res, r.err
-				}).
-				MaxTimes(len(tt.mockFunctionRepositoryForAppSyncSave.returns))
-
-			mockFunctionRepositoryForAppSync.
-				EXPECT().
-				List(ctx, gomock.Any()).
-				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
-					r := tt.mockFunctionRepositoryForAppSyncList.returns[tt.mockFunctionRepositoryForAppSyncList.calls]
-					tt.mockFunctionRepositoryForAppSyncList.calls++
-					return r.res, r.err
-				}).
-				Times(len(tt.mockFunctionRepositoryForAppSyncList.returns))
-
-			mockFunctionService.
-				EXPECT().
-				Difference(ctx, gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, functions1 []model.Function, functions2 []model.Function) ([]model.Function, error) {
-					r := tt.mockFunctionServiceDifference.returns[tt.mockFunctionServiceDifference.calls]
-					tt.mockFunctionServiceDifference.calls++
-					return r.res, r.err
-				}).
-				Times(len(tt.mockFunctionServiceDifference.returns))
-
-			mockFunctionRepositoryForAppSync.
-				EXPECT().
-				Delete(ctx, gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, apiID string, name string) error {
-					mu.Lock()
-					r := tt.mockFunctionRepositoryForAppSyncDelete.returns[tt.mockFunctionRepositoryForAppSyncDelete.calls]
-					tt.mockFunctionRepositoryForAppSyncDelete.calls++
-					mu.Unlock()
-					return r.err
-				}).
-				MaxTimes(len(tt.mockFunctionRepositoryForAppSyncDelete.returns))
-
 			mockResolverRepositoryForFS.
 				EXPECT().
-				List(ctx, gomock.Any()).
-				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
-					r := tt.mockResolverRepositoryForFSList.returns[tt.mockResolverRepositoryForFSList.calls]
-					tt.mockResolverRepositoryForFSList.calls++
-					return r.res, r.err
-				}).
-				Times(len(tt.mockResolverRepositoryForFSList.returns))
-
-			mockResolverService.
-				EXPECT().
-				ResolvePipelineConfigFunctionIDs(ctx, gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, resolver *model.Resolver, functions []model.Function) error {
-					mu.Lock()
-					r := tt.mockResolverServiceResolvePipelineConfigFunctionIDs.returns[tt.mockResolverServiceResolvePipelineConfigFunctionIDs.calls]
-					tt.mockResolverServiceResolvePipelineConfigFunctionIDs.calls++
-					mu.Unlock()
-					return r.err
-				}).
-				MaxTimes(len(tt.mockResolverServiceResolvePipelineConfigFunctionIDs.returns))
-
-			mockResolverRepositoryForAppSync.
-				EXPECT().
-				Save(ctx, gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, apiID string, resolver *model.Resolver) (*model.Resolver, error) {
-					mu.Lock()
-					r := tt.mockResolverRepositoryForAppSyncSave.returns[tt.mockResolverRepositoryForAppSyncSave.calls]
-					tt.mockResolverRepositoryForAppSyncSave.calls++
-					mu.Unlock()
-					return r.res, r.err
-				}).
-				MaxTimes(len(tt.mockResolverRepositoryForAppSyncSave.returns))
-
-			mockResolverRepositoryForAppSync.
-				EXPECT().
-				List(ctx, gomock.Any()).
-				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
-					r := tt.mockResolverRepositoryForAppSyncList.returns[tt.mockResolverRepositoryForAppSyncList.calls]
-					tt.mockResolverRepositoryForAppSyncList.calls++
-					return r.res, r.err
-				}).
-				Times(len(tt.mockResolverRepositoryForAppSyncList.returns))
-
-			mockResolverService.
-				EXPECT().
-				Difference(ctx, gomock.Any(), gomock.Any()).
-				DoAndReturn(func(ctx context.Context, resolvers1 []model.Resolver, resolvers2 []model.Resolver) ([]model.Resolver, error) {
-					r := tt.mockResolverServiceDifference.returns[tt.mockResolverServiceDifference.calls]
-					tt.mockResolverServiceDifference.calls++
-					return r.res, r.err
-				}).
-				Times(len(tt.mockResolverServiceDifference.returns))
-
-			mockResolverRepositoryForAppSync.
-				EXPECT().
-				Delete(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				Delete(ctx, tt.args.params.APIID, gomock.Any(), gomock.Any()).
 				DoAndReturn(func(ctx context.Context, apiID string, typeName string, fieldName string) error {
-					mu.Lock()
-					r := tt.mockResolverRepositoryForAppSyncDelete.returns[tt.mockResolverRepositoryForAppSyncDelete.calls]
-					tt.mockResolverRepositoryForAppSyncDelete.calls++
-					mu.Unlock()
+					r := tt.mockResolverRepositoryForFSDelete.returns[tt.mockResolverRepositoryForFSDelete.calls]
+					tt.mockResolverRepositoryForFSDelete.calls++
 					return r.err
 				}).
-				MaxTimes(len(tt.mockResolverRepositoryForAppSyncDelete.returns))
+				Times(len(tt.mockResolverRepositoryForFSDelete.returns))
 
 			uc := &pushUseCase{
-				functionService:                          mockFunctionService,
-				resolverService:                          mockResolverService,
-				trackerRepository:                        mockTrackerRepository,
-				environmentVariablesRepositoryForAppSync: mockEnvironmentVariablesRepositoryForAppSync,
-				environmentVariablesRepositoryForFS:      mockEnvironmentVariablesRepositoryForFS,
-				schemaRepositoryForAppSync:               mockSchemaRepositoryForAppSync,
-				schemaRepositoryForFS:                    mockSchemaRepositoryForFS,
-				functionRepositoryForAppSync:             mockFunctionRepositoryForAppSync,
-				functionRepositoryForFS:                  mockFunctionRepositoryForFS,
-				resolverRepositoryForAppSync:             mockResolverRepositoryForAppSync,
-				resolverRepositoryForFS:                  mockResolverRepositoryForFS,
+				trackerRepository:       mockTrackerRepository,
+				resolverRepositoryForFS: mockResolverRepositoryForFS,
 			}
 
 			// Act
-			actual, err := uc.Execute(ctx, tt.args.params)
+			err := uc.deleteOrphanedResolverFiles(ctx, tt.args.params, tt.args.resolvers)
 
 			// Assert
-			assert.Equal(t, tt.expected.res, actual)
-
 			if strings.HasPrefix(tt.name, "happy") {
 				assert.NoError(t, err)
 			} else {
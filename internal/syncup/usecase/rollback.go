@@ -0,0 +1,444 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+)
+
+// NOTE: a nil before means that the resource was created, and a nil after means that it was deleted
+type change[T any] struct {
+	before *T
+	after  *T
+}
+
+// journal records the remote resources as they were before an atomic push touched them.
+// A nil journal records nothing, so that non-atomic pushes pay nothing for it.
+type journal struct {
+	mu          sync.Mutex
+	graphqlApi  *model.GraphqlApi
+	variables   *model.EnvironmentVariables
+	schema      *model.Schema
+	dataSources []change[model.DataSource]
+	functions   []change[model.Function]
+	resolvers   []change[model.Resolver]
+}
+
+func newJournal() *journal {
+	return &journal{
+		dataSources: make([]change[model.DataSource], 0),
+		functions:   make([]change[model.Function], 0),
+		resolvers:   make([]change[model.Resolver], 0),
+	}
+}
+
+func (j *journal) recordGraphqlApi(before *model.GraphqlApi) {
+	if j == nil {
+		return
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.graphqlApi = before
+}
+
+func (j *journal) recordEnvironmentVariables(before model.EnvironmentVariables) {
+	if j == nil {
+		return
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.variables = &before
+}
+
+// NOTE: a schema cannot be deleted from AWS AppSync, so a created schema is not recorded
+func (j *journal) recordSchema(before *model.Schema) {
+	if j == nil || before == nil {
+		return
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.schema = before
+}
+
+func (j *journal) recordDataSource(before, after *model.DataSource) {
+	if j == nil {
+		return
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.dataSources = append(j.dataSources, change[model.DataSource]{before: before, after: after})
+}
+
+func (j *journal) recordFunction(before, after *model.Function) {
+	if j == nil {
+		return
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.functions = append(j.functions, change[model.Function]{before: before, after: after})
+}
+
+func (j *journal) recordResolver(before, after *model.Resolver) {
+	if j == nil {
+		return
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.resolvers = append(j.resolvers, change[model.Resolver]{before: before, after: after})
+}
+
+// rollback restores the remote resources recorded in the journal.
+// It keeps going on errors, so that as many resources as possible are restored.
+func (uc *pushUseCase) rollback(ctx context.Context, params *PushInput, j *journal) (err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "rolling back changes")
+
+	errs := make([]error, 0)
+
+	// NOTE: created resolvers may refer to created functions and data sources, so they are deleted first
+	errs = append(errs, uc.rollbackCreatedResolvers(ctx, params, j))
+	errs = append(errs, uc.rollbackGraphqlApi(ctx, params, j))
+	errs = append(errs, uc.rollbackEnvironmentVariables(ctx, params, j))
+	errs = append(errs, uc.rollbackSchema(ctx, params, j))
+	errs = append(errs, uc.rollbackDataSources(ctx, params, j))
+
+	functionIDs, err := uc.rollbackFunctions(ctx, params, j)
+	errs = append(errs, err)
+
+	errs = append(errs, uc.rollbackResolvers(ctx, params, j, functionIDs))
+	errs = append(errs, uc.rollbackCreatedFunctions(ctx, params, j))
+	errs = append(errs, uc.rollbackCreatedDataSources(ctx, params, j))
+
+	if err := errors.Join(errs...); err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to roll back all changes")
+		return err
+	}
+
+	uc.trackerRepository.Success(ctx, "rolled back all changes")
+
+	return nil
+}
+
+func (uc *pushUseCase) rollbackGraphqlApi(ctx context.Context, params *PushInput, j *journal) (err error) {
+	defer wrap(&err)
+
+	if j.graphqlApi == nil {
+		return nil
+	}
+
+	return uc.undo(ctx, model.ResourceKindGraphqlApi, "", model.TrackerActionUpdated, "GraphQL API settings", func() error {
+		_, err := uc.graphqlApiRepositoryForAppSync.Save(ctx, params.APIID, j.graphqlApi)
+		return err
+	})
+}
+
+func (uc *pushUseCase) rollbackEnvironmentVariables(ctx context.Context, params *PushInput, j *journal) (err error) {
+	defer wrap(&err)
+
+	if j.variables == nil {
+		return nil
+	}
+
+	return uc.undo(ctx, model.ResourceKindEnvironmentVariables, "", model.TrackerActionUpdated, "environment variables", func() error {
+		_, err := uc.environmentVariablesRepositoryForAppSync.Save(ctx, params.APIID, *j.variables)
+		return err
+	})
+}
+
+func (uc *pushUseCase) rollbackSchema(ctx context.Context, params *PushInput, j *journal) (err error) {
+	defer wrap(&err)
+
+	if j.schema == nil {
+		return nil
+	}
+
+	return uc.undo(ctx, model.ResourceKindSchema, "", model.TrackerActionUpdated, "schema", func() error {
+		_, err := uc.schemaRepositoryForAppSync.Save(ctx, params.APIID, j.schema)
+		return err
+	})
+}
+
+func (uc *pushUseCase) rollbackDataSources(ctx context.Context, params *PushInput, j *journal) (err error) {
+	defer wrap(&err)
+
+	var mu sync.Mutex
+	g := syncup.WorkerPool(ctx).Group()
+	errs := make([]error, 0)
+
+	for _, c := range j.dataSources {
+		if c.before == nil {
+			continue
+		}
+
+		ds := *c.before
+		g.Go(func() {
+			name := ptr.ToValue(ds.Name)
+			if err := uc.undo(ctx, model.ResourceKindDataSource, name, model.TrackerActionUpdated, fmt.Sprintf("data source %s", name), func() error {
+				_, err := uc.dataSourceRepositoryForAppSync.Save(ctx, params.APIID, &ds)
+				return err
+			}); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		})
+	}
+
+	g.Wait()
+
+	return errors.Join(errs...)
+}
+
+// rollbackFunctions returns the IDs of the recreated functions keyed by their IDs before the push,
+// since AWS AppSync assigns a new ID to a function when it is recreated.
+func (uc *pushUseCase) rollbackFunctions(ctx context.Context, params *PushInput, j *journal) (res map[string]string, err error) {
+	defer wrap(&err)
+
+	var mu sync.Mutex
+	g := syncup.WorkerPool(ctx).Group()
+	functionIDs := make(map[string]string)
+	errs := make([]error, 0)
+
+	for _, c := range j.functions {
+		if c.before == nil {
+			continue
+		}
+
+		fn := *c.before
+		action := model.TrackerActionUpdated
+		if c.after == nil {
+			action = model.TrackerActionCreated
+		}
+
+		g.Go(func() {
+			name := ptr.ToValue(fn.Name)
+			if err := uc.undo(ctx, model.ResourceKindFunction, name, action, fmt.Sprintf("function %s", name), func() error {
+				function, err := uc.functionRepositoryForAppSync.Save(ctx, params.APIID, &fn)
+				if err != nil {
+					return err
+				}
+
+				mu.Lock()
+				functionIDs[ptr.ToValue(fn.FunctionId)] = ptr.ToValue(function.FunctionId)
+				mu.Unlock()
+
+				return nil
+			}); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		})
+	}
+
+	g.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return functionIDs, err
+	}
+
+	return functionIDs, nil
+}
+
+func (uc *pushUseCase) rollbackResolvers(ctx context.Context, params *PushInput, j *journal, functionIDs map[string]string) (err error) {
+	defer wrap(&err)
+
+	var mu sync.Mutex
+	g := syncup.WorkerPool(ctx).Group()
+	errs := make([]error, 0)
+
+	for _, c := range j.resolvers {
+		if c.before == nil {
+			continue
+		}
+
+		rslv := *c.before
+		if rslv.PipelineConfig != nil {
+			pipelineConfig := *rslv.PipelineConfig
+			pipelineConfig.Functions = make([]string, 0, len(rslv.PipelineConfig.Functions))
+			for _, id := range rslv.PipelineConfig.Functions {
+				if newID, ok := functionIDs[id]; ok {
+					id = newID
+				}
+
+				pipelineConfig.Functions = append(pipelineConfig.Functions, id)
+			}
+
+			rslv.PipelineConfig = &pipelineConfig
+		}
+
+		action := model.TrackerActionUpdated
+		if c.after == nil {
+			action = model.TrackerActionCreated
+		}
+
+		g.Go(func() {
+			identifier := fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))
+			if err := uc.undo(ctx, model.ResourceKindResolver, identifier, action, fmt.Sprintf("resolver %s", identifier), func() error {
+				_, err := uc.resolverRepositoryForAppSync.Save(ctx, params.APIID, &rslv)
+				return err
+			}); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		})
+	}
+
+	g.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (uc *pushUseCase) rollbackCreatedResolvers(ctx context.Context, params *PushInput, j *journal) (err error) {
+	defer wrap(&err)
+
+	var mu sync.Mutex
+	g := syncup.WorkerPool(ctx).Group()
+	errs := make([]error, 0)
+
+	for _, c := range j.resolvers {
+		if c.before != nil {
+			continue
+		}
+
+		rslv := *c.after
+		g.Go(func() {
+			identifier := fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))
+			if err := uc.undo(ctx, model.ResourceKindResolver, identifier, model.TrackerActionDeleted, fmt.Sprintf("resolver %s", identifier), func() error {
+				return uc.resolverRepositoryForAppSync.Delete(ctx, params.APIID, ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))
+			}); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		})
+	}
+
+	g.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (uc *pushUseCase) rollbackCreatedFunctions(ctx context.Context, params *PushInput, j *journal) (err error) {
+	defer wrap(&err)
+
+	var mu sync.Mutex
+	g := syncup.WorkerPool(ctx).Group()
+	errs := make([]error, 0)
+
+	for _, c := range j.functions {
+		if c.before != nil {
+			continue
+		}
+
+		name := ptr.ToValue(c.after.Name)
+		g.Go(func() {
+			if err := uc.undo(ctx, model.ResourceKindFunction, name, model.TrackerActionDeleted, fmt.Sprintf("function %s", name), func() error {
+				return uc.functionRepositoryForAppSync.Delete(ctx, params.APIID, name)
+			}); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		})
+	}
+
+	g.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (uc *pushUseCase) rollbackCreatedDataSources(ctx context.Context, params *PushInput, j *journal) (err error) {
+	defer wrap(&err)
+
+	var mu sync.Mutex
+	g := syncup.WorkerPool(ctx).Group()
+	errs := make([]error, 0)
+
+	for _, c := range j.dataSources {
+		if c.before != nil {
+			continue
+		}
+
+		name := ptr.ToValue(c.after.Name)
+		g.Go(func() {
+			if err := uc.undo(ctx, model.ResourceKindDataSource, name, model.TrackerActionDeleted, fmt.Sprintf("data source %s", name), func() error {
+				return uc.dataSourceRepositoryForAppSync.Delete(ctx, params.APIID, name)
+			}); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		})
+	}
+
+	g.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (uc *pushUseCase) undo(ctx context.Context, kind model.ResourceKind, identifier string, action model.TrackerAction, label string, fn func() error) error {
+	start := time.Now()
+
+	if err := fn(); err != nil {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:       kind,
+			Identifier: identifier,
+			Action:     model.TrackerActionFailed,
+			Duration:   time.Since(start),
+			Err:        err,
+			Message:    fmt.Sprintf("failed to roll back %s", label),
+		})
+		return err
+	}
+
+	uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+		Kind:       kind,
+		Identifier: identifier,
+		Action:     action,
+		Duration:   time.Since(start),
+		Message:    fmt.Sprintf("rolled back %s", label),
+	})
+
+	return nil
+}
@@ -0,0 +1,552 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_pushUseCase_rollback(t *testing.T) {
+	testdataBaseDir := "../../../testdata"
+	graphqlApi := testhelpers.MustUnmarshalJSON[model.GraphqlApi](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "graphql_api/api.json")))
+	variables := testhelpers.MustUnmarshalJSON[model.EnvironmentVariables](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "environment_variables/env.json")))
+	schema := model.Schema(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema/schema.graphqls")))
+	dataSourceAMAZON_DYNAMODB := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AMAZON_DYNAMODB/metadata.json")))
+	dataSourceAWS_LAMBDA := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AWS_LAMBDA/metadata.json")))
+	functionVTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Function](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/VTL_2018-05-29/metadata.json")))
+	functionVTL_2018_05_29.FunctionId = ptr.Pointer("VTL_2018-05-29")
+	functionAPPSYNC_JS_1_0_0 := testhelpers.MustUnmarshalJSON[model.Function](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/APPSYNC_JS_1.0.0/metadata.json")))
+	functionAPPSYNC_JS_1_0_0.FunctionId = ptr.Pointer("APPSYNC_JS_1.0.0")
+	functionAPPSYNC_JS_1_0_0_recreated := functionAPPSYNC_JS_1_0_0
+	functionAPPSYNC_JS_1_0_0_recreated.FunctionId = ptr.Pointer("APPSYNC_JS_1.0.0-recreated")
+	functionCreated := model.Function{Name: ptr.Pointer("created"), FunctionId: ptr.Pointer("created")}
+	resolverUNIT_VTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Resolver](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/UNIT/VTL_2018-05-29/metadata.json")))
+	resolverUNIT_APPSYNC_JS_1_0_0 := testhelpers.MustUnmarshalJSON[model.Resolver](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/UNIT/APPSYNC_JS_1.0.0/metadata.json")))
+	resolverPIPELINE_VTL_2018_05_29 := testhelpers.MustUnmarshalJSON[model.Resolver](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/PIPELINE/VTL_2018-05-29/metadata.json")))
+	resolverPIPELINE_VTL_2018_05_29.PipelineConfig.FunctionNames = nil
+	resolverPIPELINE_VTL_2018_05_29.PipelineConfig.Functions = []string{"VTL_2018-05-29", "APPSYNC_JS_1.0.0"}
+	resolverPIPELINE_VTL_2018_05_29_restored := testhelpers.MustUnmarshalJSON[model.Resolver](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/PIPELINE/VTL_2018-05-29/metadata.json")))
+	resolverPIPELINE_VTL_2018_05_29_restored.PipelineConfig.FunctionNames = nil
+	resolverPIPELINE_VTL_2018_05_29_restored.PipelineConfig.Functions = []string{"VTL_2018-05-29", "APPSYNC_JS_1.0.0-recreated"}
+
+	type args struct {
+		params  *PushInput
+		journal *journal
+	}
+
+	type mockGraphqlApiRepositoryForAppSyncSaveReturn struct {
+		res *model.GraphqlApi
+		err error
+	}
+	type mockGraphqlApiRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockGraphqlApiRepositoryForAppSyncSaveReturn
+	}
+
+	type mockEnvironmentVariablesRepositoryForAppSyncSaveReturn struct {
+		res model.EnvironmentVariables
+		err error
+	}
+	type mockEnvironmentVariablesRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn
+	}
+
+	type mockSchemaRepositoryForAppSyncSaveReturn struct {
+		res *model.Schema
+		err error
+	}
+	type mockSchemaRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockSchemaRepositoryForAppSyncSaveReturn
+	}
+
+	type mockDataSourceRepositoryForAppSyncSaveReturn struct {
+		res *model.DataSource
+		err error
+	}
+	type mockDataSourceRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockDataSourceRepositoryForAppSyncSaveReturn
+	}
+
+	type mockDataSourceRepositoryForAppSyncDeleteReturn struct {
+		err error
+	}
+	type mockDataSourceRepositoryForAppSyncDelete struct {
+		calls   int
+		returns []mockDataSourceRepositoryForAppSyncDeleteReturn
+	}
+
+	type mockFunctionRepositoryForAppSyncSaveReturn struct {
+		res *model.Function
+		err error
+	}
+	type mockFunctionRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockFunctionRepositoryForAppSyncSaveReturn
+	}
+
+	type mockFunctionRepositoryForAppSyncDeleteReturn struct {
+		err error
+	}
+	type mockFunctionRepositoryForAppSyncDelete struct {
+		calls   int
+		returns []mockFunctionRepositoryForAppSyncDeleteReturn
+	}
+
+	type mockResolverRepositoryForAppSyncSaveReturn struct {
+		res *model.Resolver
+		err error
+	}
+	type mockResolverRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockResolverRepositoryForAppSyncSaveReturn
+	}
+
+	type mockResolverRepositoryForAppSyncDeleteReturn struct {
+		err error
+	}
+	type mockResolverRepositoryForAppSyncDelete struct {
+		calls   int
+		returns []mockResolverRepositoryForAppSyncDeleteReturn
+	}
+
+	type expected struct {
+		savedResolvers   []model.Resolver
+		deletedFunctions []string
+		errIs            error
+	}
+
+	tests := []struct {
+		name                                             string
+		args                                             args
+		mockGraphqlApiRepositoryForAppSyncSave           mockGraphqlApiRepositoryForAppSyncSave
+		mockEnvironmentVariablesRepositoryForAppSyncSave mockEnvironmentVariablesRepositoryForAppSyncSave
+		mockSchemaRepositoryForAppSyncSave               mockSchemaRepositoryForAppSyncSave
+		mockDataSourceRepositoryForAppSyncSave           mockDataSourceRepositoryForAppSyncSave
+		mockDataSourceRepositoryForAppSyncDelete         mockDataSourceRepositoryForAppSyncDelete
+		mockFunctionRepositoryForAppSyncSave             mockFunctionRepositoryForAppSyncSave
+		mockFunctionRepositoryForAppSyncDelete           mockFunctionRepositoryForAppSyncDelete
+		mockResolverRepositoryForAppSyncSave             mockResolverRepositoryForAppSyncSave
+		mockResolverRepositoryForAppSyncDelete           mockResolverRepositoryForAppSyncDelete
+		expected                                         expected
+	}{
+		{
+			name: "happy path: nothing to roll back",
+			args: args{
+				params: &PushInput{
+					APIID:  "APIID",
+					Atomic: true,
+				},
+				journal: newJournal(),
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{},
+			},
+			expected: expected{
+				savedResolvers:   []model.Resolver{},
+				deletedFunctions: []string{},
+				errIs:            nil,
+			},
+		},
+		{
+			name: "happy path: restore all changes",
+			args: args{
+				params: &PushInput{
+					APIID:  "APIID",
+					Atomic: true,
+				},
+				journal: &journal{
+					graphqlApi: &graphqlApi,
+					variables:  &variables,
+					schema:     &schema,
+					dataSources: []change[model.DataSource]{
+						{before: &dataSourceAMAZON_DYNAMODB, after: &dataSourceAMAZON_DYNAMODB},
+						{before: nil, after: &dataSourceAWS_LAMBDA},
+					},
+					functions: []change[model.Function]{
+						{before: &functionVTL_2018_05_29, after: &functionVTL_2018_05_29},
+						{before: &functionAPPSYNC_JS_1_0_0, after: nil},
+						{before: nil, after: &functionCreated},
+					},
+					resolvers: []change[model.Resolver]{
+						{before: &resolverPIPELINE_VTL_2018_05_29, after: &resolverPIPELINE_VTL_2018_05_29},
+						{before: &resolverUNIT_VTL_2018_05_29, after: nil},
+						{before: nil, after: &resolverUNIT_APPSYNC_JS_1_0_0},
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{res: &dataSourceAMAZON_DYNAMODB, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{
+					{err: nil},
+				},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{res: &functionVTL_2018_05_29, err: nil},
+					{res: &functionAPPSYNC_JS_1_0_0_recreated, err: nil},
+				},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+					{err: nil},
+				},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{res: &resolverPIPELINE_VTL_2018_05_29, err: nil},
+					{res: &resolverUNIT_VTL_2018_05_29, err: nil},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{
+					{err: nil},
+				},
+			},
+			expected: expected{
+				savedResolvers:   []model.Resolver{resolverPIPELINE_VTL_2018_05_29_restored, resolverUNIT_VTL_2018_05_29},
+				deletedFunctions: []string{"created"},
+				errIs:            nil,
+			},
+		},
+		{
+			name: "edge path: keep rolling back after an error",
+			args: args{
+				params: &PushInput{
+					APIID:  "APIID",
+					Atomic: true,
+				},
+				journal: &journal{
+					graphqlApi: &graphqlApi,
+					variables:  &variables,
+					schema:     &schema,
+					dataSources: []change[model.DataSource]{
+						{before: &dataSourceAMAZON_DYNAMODB, after: &dataSourceAMAZON_DYNAMODB},
+						{before: nil, after: &dataSourceAWS_LAMBDA},
+					},
+					functions: []change[model.Function]{
+						{before: &functionVTL_2018_05_29, after: &functionVTL_2018_05_29},
+						{before: &functionAPPSYNC_JS_1_0_0, after: nil},
+						{before: nil, after: &functionCreated},
+					},
+					resolvers: []change[model.Resolver]{
+						{before: &resolverPIPELINE_VTL_2018_05_29, after: &resolverPIPELINE_VTL_2018_05_29},
+						{before: &resolverUNIT_VTL_2018_05_29, after: nil},
+						{before: nil, after: &resolverUNIT_APPSYNC_JS_1_0_0},
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{res: &graphqlApi, err: nil},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{res: variables, err: nil},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{res: &schema, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{res: &dataSourceAMAZON_DYNAMODB, err: nil},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncDelete: mockDataSourceRepositoryForAppSyncDelete{
+				returns: []mockDataSourceRepositoryForAppSyncDeleteReturn{
+					{err: nil},
+				},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{res: &functionVTL_2018_05_29, err: nil},
+					{res: &functionAPPSYNC_JS_1_0_0_recreated, err: nil},
+				},
+			},
+			mockFunctionRepositoryForAppSyncDelete: mockFunctionRepositoryForAppSyncDelete{
+				returns: []mockFunctionRepositoryForAppSyncDeleteReturn{
+					{err: nil},
+				},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{res: &resolverPIPELINE_VTL_2018_05_29, err: nil},
+					{res: &resolverUNIT_VTL_2018_05_29, err: nil},
+				},
+			},
+			mockResolverRepositoryForAppSyncDelete: mockResolverRepositoryForAppSyncDelete{
+				returns: []mockResolverRepositoryForAppSyncDeleteReturn{
+					{err: &model.LibError{Err: model.ErrNotFound}},
+				},
+			},
+			expected: expected{
+				savedResolvers:   []model.Resolver{resolverPIPELINE_VTL_2018_05_29_restored, resolverUNIT_VTL_2018_05_29},
+				deletedFunctions: []string{"created"},
+				errIs:            model.ErrNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var mu sync.Mutex
+			savedResolvers := make([]model.Resolver, 0)
+			deletedFunctions := make([]string, 0)
+
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockGraphqlApiRepositoryForAppSync := mock_repository.NewMockGraphqlApiRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForAppSync := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockSchemaRepositoryForAppSync := mock_repository.NewMockSchemaRepository(ctrl)
+			mockDataSourceRepositoryForAppSync := mock_repository.NewMockDataSourceRepository(ctrl)
+			mockFunctionRepositoryForAppSync := mock_repository.NewMockFunctionRepository(ctrl)
+			mockResolverRepositoryForAppSync := mock_repository.NewMockResolverRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Record(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockGraphqlApiRepositoryForAppSync.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, api *model.GraphqlApi) (*model.GraphqlApi, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					r := tt.mockGraphqlApiRepositoryForAppSyncSave.returns[tt.mockGraphqlApiRepositoryForAppSyncSave.calls]
+					tt.mockGraphqlApiRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForAppSyncSave.returns))
+
+			mockEnvironmentVariablesRepositoryForAppSync.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, variables model.EnvironmentVariables) (model.EnvironmentVariables, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					r := tt.mockEnvironmentVariablesRepositoryForAppSyncSave.returns[tt.mockEnvironmentVariablesRepositoryForAppSyncSave.calls]
+					tt.mockEnvironmentVariablesRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForAppSyncSave.returns))
+
+			mockSchemaRepositoryForAppSync.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, schema *model.Schema) (*model.Schema, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					r := tt.mockSchemaRepositoryForAppSyncSave.returns[tt.mockSchemaRepositoryForAppSyncSave.calls]
+					tt.mockSchemaRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForAppSyncSave.returns))
+
+			mockDataSourceRepositoryForAppSync.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, dataSource *model.DataSource) (*model.DataSource, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					r := tt.mockDataSourceRepositoryForAppSyncSave.returns[tt.mockDataSourceRepositoryForAppSyncSave.calls]
+					tt.mockDataSourceRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDataSourceRepositoryForAppSyncSave.returns))
+
+			mockDataSourceRepositoryForAppSync.
+				EXPECT().
+				Delete(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, name string) error {
+					mu.Lock()
+					defer mu.Unlock()
+					r := tt.mockDataSourceRepositoryForAppSyncDelete.returns[tt.mockDataSourceRepositoryForAppSyncDelete.calls]
+					tt.mockDataSourceRepositoryForAppSyncDelete.calls++
+					return r.err
+				}).
+				Times(len(tt.mockDataSourceRepositoryForAppSyncDelete.returns))
+
+			mockFunctionRepositoryForAppSync.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, function *model.Function) (*model.Function, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					tt.mockFunctionRepositoryForAppSyncSave.calls++
+					// NOTE: functions are restored concurrently, so the return is looked up by name
+					for _, r := range tt.mockFunctionRepositoryForAppSyncSave.returns {
+						if ptr.ToValue(r.res.Name) == ptr.ToValue(function.Name) {
+							return r.res, r.err
+						}
+					}
+					return nil, nil
+				}).
+				Times(len(tt.mockFunctionRepositoryForAppSyncSave.returns))
+
+			mockFunctionRepositoryForAppSync.
+				EXPECT().
+				Delete(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, name string) error {
+					mu.Lock()
+					defer mu.Unlock()
+					deletedFunctions = append(deletedFunctions, name)
+					r := tt.mockFunctionRepositoryForAppSyncDelete.returns[tt.mockFunctionRepositoryForAppSyncDelete.calls]
+					tt.mockFunctionRepositoryForAppSyncDelete.calls++
+					return r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForAppSyncDelete.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				Save(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, resolver *model.Resolver) (*model.Resolver, error) {
+					mu.Lock()
+					defer mu.Unlock()
+					savedResolvers = append(savedResolvers, *resolver)
+					r := tt.mockResolverRepositoryForAppSyncSave.returns[tt.mockResolverRepositoryForAppSyncSave.calls]
+					tt.mockResolverRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForAppSyncSave.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				Delete(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, typeName string, fieldName string) error {
+					mu.Lock()
+					defer mu.Unlock()
+					r := tt.mockResolverRepositoryForAppSyncDelete.returns[tt.mockResolverRepositoryForAppSyncDelete.calls]
+					tt.mockResolverRepositoryForAppSyncDelete.calls++
+					return r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForAppSyncDelete.returns))
+
+			uc := &pushUseCase{
+				trackerRepository:                        mockTrackerRepository,
+				graphqlApiRepositoryForAppSync:           mockGraphqlApiRepositoryForAppSync,
+				environmentVariablesRepositoryForAppSync: mockEnvironmentVariablesRepositoryForAppSync,
+				schemaRepositoryForAppSync:               mockSchemaRepositoryForAppSync,
+				dataSourceRepositoryForAppSync:           mockDataSourceRepositoryForAppSync,
+				functionRepositoryForAppSync:             mockFunctionRepositoryForAppSync,
+				resolverRepositoryForAppSync:             mockResolverRepositoryForAppSync,
+			}
+
+			// Act
+			err := uc.rollback(ctx, tt.args.params, tt.args.journal)
+
+			// Assert
+			assert.ElementsMatch(t, tt.expected.savedResolvers, savedResolvers)
+			assert.ElementsMatch(t, tt.expected.deletedFunctions, deletedFunctions)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/service"
+)
+
+// checkSchemaChanges records the changes from the remote schema and refuses the breaking ones unless they are allowed.
+func checkSchemaChanges(ctx context.Context, schemaService service.SchemaService, trackerRepository repository.TrackerRepository, resolverRepository repository.ResolverRepository, apiID string, allowBreaking bool, remote, local *model.Schema) (err error) {
+	defer wrap(&err)
+
+	trackerRepository.InProgress(ctx, "loading resolvers")
+
+	rslvs, err := resolverRepository.List(ctx, apiID)
+	if err != nil {
+		trackerRepository.Failed(ctx, "failed to load resolvers")
+		return err
+	}
+
+	trackerRepository.InProgress(ctx, "comparing schema")
+
+	changes, err := schemaService.Compare(ctx, remote, local, rslvs)
+	if err != nil {
+		trackerRepository.Failed(ctx, "failed to compare schema")
+		return err
+	}
+
+	breaking := 0
+	for _, c := range changes {
+		event := &model.TrackerEvent{
+			Kind:       model.ResourceKindSchema,
+			Identifier: c.Path,
+			Action:     model.TrackerActionChanged,
+			Message:    fmt.Sprintf("%s change: %s", c.Level, c.Message),
+			Level:      c.Level,
+		}
+
+		if c.Level == model.SchemaChangeLevelBreaking {
+			breaking++
+
+			if !allowBreaking {
+				event.Action = model.TrackerActionFailed
+				event.Err = fmt.Errorf("%w: %s", model.ErrBreakingChange, c.Message)
+			}
+		}
+
+		trackerRepository.Record(ctx, event)
+	}
+
+	if breaking > 0 && !allowBreaking {
+		return fmt.Errorf("%w: refused to push %d breaking changes to the schema", model.ErrBreakingChange, breaking)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+)
+
+// syncState tracks the hashes of the remote resources as of the last pull or push.
+// A nil syncState tracks nothing, so that dry runs leave the state file untouched.
+type syncState struct {
+	mu    sync.Mutex
+	state *model.State
+	force bool
+}
+
+func newSyncState(state *model.State, force bool) *syncState {
+	return &syncState{
+		state: state,
+		force: force,
+	}
+}
+
+// expectFunction returns a context that makes the save of the function fail if it was changed remotely since the last sync.
+func (s *syncState) expectFunction(ctx context.Context, name string) context.Context {
+	if s == nil || s.force {
+		return ctx
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if hash, ok := s.state.Functions[name]; ok {
+		return syncup.WithExpectedHash(ctx, hash)
+	}
+
+	return ctx
+}
+
+// expectResolver returns a context that makes the save of the resolver fail if it was changed remotely since the last sync.
+func (s *syncState) expectResolver(ctx context.Context, identifier string) context.Context {
+	if s == nil || s.force {
+		return ctx
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if hash, ok := s.state.Resolvers[identifier]; ok {
+		return syncup.WithExpectedHash(ctx, hash)
+	}
+
+	return ctx
+}
+
+func (s *syncState) recordFunction(function *model.Function) (err error) {
+	if s == nil {
+		return nil
+	}
+
+	hash, err := model.FunctionHash(function)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state.Functions[ptr.ToValue(function.Name)] = hash
+
+	return nil
+}
+
+func (s *syncState) recordResolver(resolver *model.Resolver) (err error) {
+	if s == nil {
+		return nil
+	}
+
+	hash, err := model.ResolverHash(resolver)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state.Resolvers[fmt.Sprintf("%s.%s", ptr.ToValue(resolver.TypeName), ptr.ToValue(resolver.FieldName))] = hash
+
+	return nil
+}
+
+func (s *syncState) forgetFunction(name string) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.state.Functions, name)
+}
+
+func (s *syncState) forgetResolver(identifier string) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.state.Resolvers, identifier)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_syncState_expectFunction(t *testing.T) {
+	state := &model.State{
+		Functions: map[string]string{
+			"name": "hash",
+		},
+		Resolvers: map[string]string{},
+	}
+
+	type args struct {
+		name string
+	}
+
+	type expected struct {
+		hash string
+	}
+
+	tests := []struct {
+		name     string
+		st       *syncState
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: recorded function",
+			st:   newSyncState(state, false),
+			args: args{
+				name: "name",
+			},
+			expected: expected{
+				hash: "hash",
+			},
+		},
+		{
+			name: "happy path: unrecorded function",
+			st:   newSyncState(state, false),
+			args: args{
+				name: "unknown",
+			},
+			expected: expected{
+				hash: "",
+			},
+		},
+		{
+			name: "happy path: force",
+			st:   newSyncState(state, true),
+			args: args{
+				name: "name",
+			},
+			expected: expected{
+				hash: "",
+			},
+		},
+		{
+			name: "happy path: nil",
+			st:   nil,
+			args: args{
+				name: "name",
+			},
+			expected: expected{
+				hash: "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			// Act
+			actual := tt.st.expectFunction(ctx, tt.args.name)
+
+			// Assert
+			assert.Equal(t, tt.expected.hash, syncup.ExpectedHash(actual))
+		})
+	}
+}
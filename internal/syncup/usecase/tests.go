@@ -0,0 +1,264 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+)
+
+type TestInput struct {
+	APIID string
+}
+
+type TestOutput struct {
+	Results []model.TestResult
+}
+
+type TestUseCase interface {
+	Execute(ctx context.Context, params *TestInput) (*TestOutput, error)
+}
+
+type testUseCase struct {
+	trackerRepository              repository.TrackerRepository
+	evaluationRepositoryForAppSync repository.EvaluationRepository
+	testRepositoryForFS            repository.TestRepository
+	functionRepositoryForFS        repository.FunctionRepository
+	resolverRepositoryForFS        repository.ResolverRepository
+}
+
+func NewTestUseCase(repo repository.Repository) TestUseCase {
+	return &testUseCase{
+		trackerRepository:              repo.TrackerRepository(),
+		evaluationRepositoryForAppSync: repo.EvaluationRepositoryForAppSync(),
+		testRepositoryForFS:            repo.TestRepositoryForFS(),
+		functionRepositoryForFS:        repo.FunctionRepositoryForFS(),
+		resolverRepositoryForFS:        repo.ResolverRepositoryForFS(),
+	}
+}
+
+// testTarget is the code or the mapping templates of a resolver or function under test.
+type testTarget struct {
+	runtime   *model.Runtime
+	code      *string
+	templates map[model.EvaluationHandler]*string
+}
+
+func (uc *testUseCase) Execute(ctx context.Context, params *TestInput) (res *TestOutput, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "loading tests")
+
+	tests, err := uc.testRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load tests")
+		return nil, err
+	}
+
+	if len(tests) == 0 {
+		uc.trackerRepository.Success(ctx, "no tests found")
+		return &TestOutput{Results: []model.TestResult{}}, nil
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading functions")
+
+	fns, err := uc.functionRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load functions")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading resolvers")
+
+	rslvs, err := uc.resolverRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load resolvers")
+		return nil, err
+	}
+
+	targets := map[model.ResourceKind]map[string]*testTarget{
+		model.ResourceKindFunction: make(map[string]*testTarget),
+		model.ResourceKindResolver: make(map[string]*testTarget),
+	}
+	for _, fn := range fns {
+		targets[model.ResourceKindFunction][ptr.ToValue(fn.Name)] = &testTarget{
+			runtime: fn.Runtime,
+			code:    fn.Code,
+			templates: map[model.EvaluationHandler]*string{
+				model.EvaluationHandlerRequest:  fn.RequestMappingTemplate,
+				model.EvaluationHandlerResponse: fn.ResponseMappingTemplate,
+			},
+		}
+	}
+	for _, rslv := range rslvs {
+		targets[model.ResourceKindResolver][fmt.Sprintf("%s.%s", ptr.ToValue(rslv.TypeName), ptr.ToValue(rslv.FieldName))] = &testTarget{
+			runtime: rslv.Runtime,
+			code:    rslv.Code,
+			templates: map[model.EvaluationHandler]*string{
+				model.EvaluationHandlerRequest:  rslv.RequestMappingTemplate,
+				model.EvaluationHandlerResponse: rslv.ResponseMappingTemplate,
+			},
+		}
+	}
+
+	uc.trackerRepository.InProgress(ctx, "running tests")
+
+	var mu sync.Mutex
+	g := syncup.WorkerPool(ctx).Group()
+	resultsByTest := make([][]model.TestResult, len(tests))
+	errs := make([]error, 0)
+
+	for i, test := range tests {
+		g.Go(func() {
+			results, err := uc.run(ctx, &test, targets[test.Kind][test.Identifier])
+			if err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+				return
+			}
+
+			// NOTE: each goroutine writes only its own index, so that the results keep the order of the tests
+			resultsByTest[i] = results
+		})
+	}
+
+	g.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to run tests")
+		return nil, err
+	}
+
+	results := make([]model.TestResult, 0, len(tests))
+	failed := 0
+	for _, rs := range resultsByTest {
+		for _, r := range rs {
+			if !r.Passed {
+				failed++
+			}
+
+			results = append(results, r)
+		}
+	}
+
+	if failed > 0 {
+		uc.trackerRepository.Failed(ctx, fmt.Sprintf("%d of %d tests failed", failed, len(results)))
+	} else {
+		uc.trackerRepository.Success(ctx, fmt.Sprintf("passed %d tests", len(results)))
+	}
+
+	return &TestOutput{Results: results}, nil
+}
+
+func (uc *testUseCase) run(ctx context.Context, test *model.Test, target *testTarget) ([]model.TestResult, error) {
+	results := make([]model.TestResult, 0, 2)
+	for _, c := range []struct {
+		handler  model.EvaluationHandler
+		expected json.RawMessage
+	}{
+		{handler: model.EvaluationHandlerRequest, expected: test.Expected.Request},
+		{handler: model.EvaluationHandlerResponse, expected: test.Expected.Response},
+	} {
+		if c.expected == nil {
+			continue
+		}
+
+		result := model.TestResult{
+			Kind:       test.Kind,
+			Identifier: test.Identifier,
+			Name:       test.Name,
+			Handler:    c.handler,
+			Expected:   compactJSON(c.expected),
+		}
+
+		if target == nil {
+			result.Message = fmt.Sprintf("%s %s not found", test.Kind, test.Identifier)
+			results = append(results, result)
+			continue
+		}
+
+		evaluation := &model.Evaluation{
+			Runtime: target.runtime,
+			Handler: c.handler,
+			Context: string(test.Context),
+		}
+		if target.runtime == nil {
+			evaluation.Template = target.templates[c.handler]
+		} else {
+			evaluation.Code = target.code
+		}
+
+		out, err := uc.evaluationRepositoryForAppSync.Evaluate(ctx, evaluation)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Logs = out.Logs
+
+		if out.Error != nil {
+			result.Message = *out.Error
+			results = append(results, result)
+			continue
+		}
+
+		actual := ptr.ToValue(out.Result)
+		result.Actual = compactJSON([]byte(actual))
+
+		var ev, av any
+		if err := json.Unmarshal(c.expected, &ev); err != nil {
+			return nil, fmt.Errorf("%w: expected %s output of test %s: %w", model.ErrInvalidValue, c.handler, test.Name, err)
+		}
+
+		if err := json.Unmarshal([]byte(actual), &av); err != nil {
+			result.Message = fmt.Sprintf("%s output is not JSON: %v", c.handler, err)
+			results = append(results, result)
+			continue
+		}
+
+		result.Passed = reflect.DeepEqual(ev, av)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// compactJSON returns the JSON without insignificant spaces, or the data as it is if it is not JSON.
+func compactJSON(data []byte) string {
+	buf := new(bytes.Buffer)
+	if err := json.Compact(buf, data); err != nil {
+		return string(data)
+	}
+
+	return buf.String()
+}
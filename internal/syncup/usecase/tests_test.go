@@ -0,0 +1,547 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_testUseCase_Execute(t *testing.T) {
+	runtime := &model.Runtime{Name: model.RuntimeNameAppsyncJs, RuntimeVersion: ptr.Pointer("1.0.0")}
+	fns := []model.Function{
+		{
+			Name:    ptr.Pointer("getPost"),
+			Runtime: runtime,
+			Code:    ptr.Pointer("code"),
+		},
+	}
+	rslvs := []model.Resolver{
+		{
+			TypeName:                ptr.Pointer("Query"),
+			FieldName:               ptr.Pointer("getPost"),
+			RequestMappingTemplate:  ptr.Pointer("requestTemplate"),
+			ResponseMappingTemplate: ptr.Pointer("responseTemplate"),
+		},
+	}
+	tests_ := []model.Test{
+		{
+			Kind:       model.ResourceKindFunction,
+			Identifier: "getPost",
+			Name:       "found",
+			Context:    json.RawMessage(`{"arguments":{"id":"1"}}`),
+			Expected: model.TestExpected{
+				Request: json.RawMessage(`{ "id": "1" }`),
+			},
+		},
+		{
+			Kind:       model.ResourceKindResolver,
+			Identifier: "Query.getPost",
+			Name:       "notFound",
+			Context:    json.RawMessage(`{"result":null}`),
+			Expected: model.TestExpected{
+				Request:  json.RawMessage(`{}`),
+				Response: json.RawMessage(`null`),
+			},
+		},
+	}
+
+	type args struct {
+		params *TestInput
+	}
+
+	type mockTestRepositoryForFSListReturn struct {
+		res []model.Test
+		err error
+	}
+	type mockTestRepositoryForFSList struct {
+		calls   int
+		returns []mockTestRepositoryForFSListReturn
+	}
+
+	type mockFunctionRepositoryForFSListReturn struct {
+		res []model.Function
+		err error
+	}
+	type mockFunctionRepositoryForFSList struct {
+		calls   int
+		returns []mockFunctionRepositoryForFSListReturn
+	}
+
+	type mockResolverRepositoryForFSListReturn struct {
+		res []model.Resolver
+		err error
+	}
+	type mockResolverRepositoryForFSList struct {
+		calls   int
+		returns []mockResolverRepositoryForFSListReturn
+	}
+
+	type mockEvaluationRepositoryForAppSyncEvaluateReturn struct {
+		res *model.EvaluationResult
+		err error
+	}
+	type mockEvaluationRepositoryForAppSyncEvaluate struct {
+		// NOTE: the tests run in parallel, so that the returns are looked up by the handler and the code or template
+		returns map[string]mockEvaluationRepositoryForAppSyncEvaluateReturn
+	}
+
+	type expected struct {
+		res   *TestOutput
+		errIs error
+	}
+
+	tests := []struct {
+		name                                       string
+		args                                       args
+		mockTestRepositoryForFSList                mockTestRepositoryForFSList
+		mockFunctionRepositoryForFSList            mockFunctionRepositoryForFSList
+		mockResolverRepositoryForFSList            mockResolverRepositoryForFSList
+		mockEvaluationRepositoryForAppSyncEvaluate mockEvaluationRepositoryForAppSyncEvaluate
+		expected                                   expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				params: &TestInput{
+					APIID: "APIID",
+				},
+			},
+			mockTestRepositoryForFSList: mockTestRepositoryForFSList{
+				returns: []mockTestRepositoryForFSListReturn{
+					{
+						res: tests_,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: fns,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: rslvs,
+						err: nil,
+					},
+				},
+			},
+			mockEvaluationRepositoryForAppSyncEvaluate: mockEvaluationRepositoryForAppSyncEvaluate{
+				returns: map[string]mockEvaluationRepositoryForAppSyncEvaluateReturn{
+					"request:code": {
+						res: &model.EvaluationResult{Result: ptr.Pointer(`{"id":"1"}`), Logs: []string{"log"}},
+						err: nil,
+					},
+					"request:requestTemplate": {
+						res: &model.EvaluationResult{Result: ptr.Pointer(`{"version":"2018-05-29"}`)},
+						err: nil,
+					},
+					"response:responseTemplate": {
+						res: &model.EvaluationResult{Error: ptr.Pointer("not found")},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res: &TestOutput{
+					Results: []model.TestResult{
+						{
+							Kind:       model.ResourceKindFunction,
+							Identifier: "getPost",
+							Name:       "found",
+							Handler:    model.EvaluationHandlerRequest,
+							Passed:     true,
+							Expected:   `{"id":"1"}`,
+							Actual:     `{"id":"1"}`,
+							Logs:       []string{"log"},
+						},
+						{
+							Kind:       model.ResourceKindResolver,
+							Identifier: "Query.getPost",
+							Name:       "notFound",
+							Handler:    model.EvaluationHandlerRequest,
+							Passed:     false,
+							Expected:   `{}`,
+							Actual:     `{"version":"2018-05-29"}`,
+						},
+						{
+							Kind:       model.ResourceKindResolver,
+							Identifier: "Query.getPost",
+							Name:       "notFound",
+							Handler:    model.EvaluationHandlerResponse,
+							Passed:     false,
+							Expected:   `null`,
+							Message:    "not found",
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: resource not found",
+			args: args{
+				params: &TestInput{
+					APIID: "APIID",
+				},
+			},
+			mockTestRepositoryForFSList: mockTestRepositoryForFSList{
+				returns: []mockTestRepositoryForFSListReturn{
+					{
+						res: tests_[:1],
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: []model.Function{},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{},
+						err: nil,
+					},
+				},
+			},
+			mockEvaluationRepositoryForAppSyncEvaluate: mockEvaluationRepositoryForAppSyncEvaluate{
+				returns: map[string]mockEvaluationRepositoryForAppSyncEvaluateReturn{},
+			},
+			expected: expected{
+				res: &TestOutput{
+					Results: []model.TestResult{
+						{
+							Kind:       model.ResourceKindFunction,
+							Identifier: "getPost",
+							Name:       "found",
+							Handler:    model.EvaluationHandlerRequest,
+							Passed:     false,
+							Expected:   `{"id":"1"}`,
+							Message:    "function getPost not found",
+						},
+					},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: no tests",
+			args: args{
+				params: &TestInput{
+					APIID: "APIID",
+				},
+			},
+			mockTestRepositoryForFSList: mockTestRepositoryForFSList{
+				returns: []mockTestRepositoryForFSListReturn{
+					{
+						res: []model.Test{},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockEvaluationRepositoryForAppSyncEvaluate: mockEvaluationRepositoryForAppSyncEvaluate{
+				returns: map[string]mockEvaluationRepositoryForAppSyncEvaluateReturn{},
+			},
+			expected: expected{
+				res: &TestOutput{
+					Results: []model.TestResult{},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: TestRepositoryForFS.List() error",
+			args: args{
+				params: &TestInput{
+					APIID: "APIID",
+				},
+			},
+			mockTestRepositoryForFSList: mockTestRepositoryForFSList{
+				returns: []mockTestRepositoryForFSListReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrInvalidValue},
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockEvaluationRepositoryForAppSyncEvaluate: mockEvaluationRepositoryForAppSyncEvaluate{
+				returns: map[string]mockEvaluationRepositoryForAppSyncEvaluateReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: FunctionRepositoryForFS.List() error",
+			args: args{
+				params: &TestInput{
+					APIID: "APIID",
+				},
+			},
+			mockTestRepositoryForFSList: mockTestRepositoryForFSList{
+				returns: []mockTestRepositoryForFSListReturn{
+					{
+						res: tests_,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockEvaluationRepositoryForAppSyncEvaluate: mockEvaluationRepositoryForAppSyncEvaluate{
+				returns: map[string]mockEvaluationRepositoryForAppSyncEvaluateReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForFS.List() error",
+			args: args{
+				params: &TestInput{
+					APIID: "APIID",
+				},
+			},
+			mockTestRepositoryForFSList: mockTestRepositoryForFSList{
+				returns: []mockTestRepositoryForFSListReturn{
+					{
+						res: tests_,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: fns,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			mockEvaluationRepositoryForAppSyncEvaluate: mockEvaluationRepositoryForAppSyncEvaluate{
+				returns: map[string]mockEvaluationRepositoryForAppSyncEvaluateReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: EvaluationRepositoryForAppSync.Evaluate() error",
+			args: args{
+				params: &TestInput{
+					APIID: "APIID",
+				},
+			},
+			mockTestRepositoryForFSList: mockTestRepositoryForFSList{
+				returns: []mockTestRepositoryForFSListReturn{
+					{
+						res: tests_[:1],
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: fns,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: rslvs,
+						err: nil,
+					},
+				},
+			},
+			mockEvaluationRepositoryForAppSyncEvaluate: mockEvaluationRepositoryForAppSyncEvaluate{
+				returns: map[string]mockEvaluationRepositoryForAppSyncEvaluateReturn{
+					"request:code": {
+						res: nil,
+						err: errors.New("error"),
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockEvaluationRepositoryForAppSync := mock_repository.NewMockEvaluationRepository(ctrl)
+			mockTestRepositoryForFS := mock_repository.NewMockTestRepository(ctrl)
+			mockFunctionRepositoryForFS := mock_repository.NewMockFunctionRepository(ctrl)
+			mockResolverRepositoryForFS := mock_repository.NewMockResolverRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTestRepositoryForFS.
+				EXPECT().
+				List(ctx, tt.args.params.APIID).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Test, error) {
+					r := tt.mockTestRepositoryForFSList.returns[tt.mockTestRepositoryForFSList.calls]
+					tt.mockTestRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockTestRepositoryForFSList.returns))
+
+			mockFunctionRepositoryForFS.
+				EXPECT().
+				List(ctx, tt.args.params.APIID).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
+					r := tt.mockFunctionRepositoryForFSList.returns[tt.mockFunctionRepositoryForFSList.calls]
+					tt.mockFunctionRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForFSList.returns))
+
+			mockResolverRepositoryForFS.
+				EXPECT().
+				List(ctx, tt.args.params.APIID).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForFSList.returns[tt.mockResolverRepositoryForFSList.calls]
+					tt.mockResolverRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForFSList.returns))
+
+			mockEvaluationRepositoryForAppSync.
+				EXPECT().
+				Evaluate(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, evaluation *model.Evaluation) (*model.EvaluationResult, error) {
+					src := evaluation.Code
+					if evaluation.Runtime == nil {
+						src = evaluation.Template
+					}
+
+					r, ok := tt.mockEvaluationRepositoryForAppSyncEvaluate.returns[fmt.Sprintf("%s:%s", evaluation.Handler, ptr.ToValue(src))]
+					if !ok {
+						t.Fatalf("unexpected evaluation of %s handler", evaluation.Handler)
+					}
+
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEvaluationRepositoryForAppSyncEvaluate.returns))
+
+			uc := &testUseCase{
+				trackerRepository:              mockTrackerRepository,
+				evaluationRepositoryForAppSync: mockEvaluationRepositoryForAppSync,
+				testRepositoryForFS:            mockTestRepositoryForFS,
+				functionRepositoryForFS:        mockFunctionRepositoryForFS,
+				resolverRepositoryForFS:        mockResolverRepositoryForFS,
+			}
+
+			// Act
+			actual, err := uc.Execute(ctx, tt.args.params)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
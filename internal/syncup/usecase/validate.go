@@ -0,0 +1,144 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/service"
+)
+
+type ValidateInput struct {
+	APIID             string
+	ExcludedResolvers []model.Resolver
+}
+
+type ValidateOutput struct {
+}
+
+type ValidateUseCase interface {
+	Execute(ctx context.Context, params *ValidateInput) (*ValidateOutput, error)
+}
+
+type validateUseCase struct {
+	schemaService                       service.SchemaService
+	referenceService                    service.ReferenceService
+	environmentVariablesService         service.EnvironmentVariablesService
+	trackerRepository                   repository.TrackerRepository
+	resourceRepositoryForFS             repository.ResourceRepository
+	environmentVariablesRepositoryForFS repository.EnvironmentVariablesRepository
+	schemaFileRepositoryForFS           repository.SchemaFileRepository
+	functionRepositoryForFS             repository.FunctionRepository
+	resolverRepositoryForFS             repository.ResolverRepository
+}
+
+func NewValidateUseCase(repo repository.Repository) ValidateUseCase {
+	return &validateUseCase{
+		schemaService:                       service.NewSchemaService(repo),
+		referenceService:                    service.NewReferenceService(repo),
+		environmentVariablesService:         service.NewEnvironmentVariablesService(repo),
+		trackerRepository:                   repo.TrackerRepository(),
+		resourceRepositoryForFS:             repo.ResourceRepositoryForFS(),
+		environmentVariablesRepositoryForFS: repo.EnvironmentVariablesRepositoryForFS(),
+		schemaFileRepositoryForFS:           repo.SchemaFileRepositoryForFS(),
+		functionRepositoryForFS:             repo.FunctionRepositoryForFS(),
+		resolverRepositoryForFS:             repo.ResolverRepositoryForFS(),
+	}
+}
+
+func (uc *validateUseCase) Execute(ctx context.Context, params *ValidateInput) (res *ValidateOutput, err error) {
+	defer wrap(&err)
+
+	uc.trackerRepository.InProgress(ctx, "loading environment variables")
+
+	// NOTE: loading resolves the secret references, so that an unresolvable one fails before anything is pushed
+	variables, err := uc.environmentVariablesRepositoryForFS.Get(ctx, params.APIID)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		uc.trackerRepository.Failed(ctx, "failed to load environment variables")
+		return nil, err
+	}
+
+	if err == nil {
+		uc.trackerRepository.InProgress(ctx, "validating environment variables")
+
+		if err := uc.environmentVariablesService.Validate(ctx, variables); err != nil {
+			uc.trackerRepository.Failed(ctx, "invalid environment variables")
+			return nil, err
+		}
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading schema")
+
+	files, err := uc.schemaFileRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load schema")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "validating schema")
+
+	if err := uc.schemaService.Validate(ctx, files); err != nil {
+		uc.trackerRepository.Failed(ctx, "invalid schema")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "checking resource files")
+
+	if err := uc.resourceRepositoryForFS.CheckFiles(ctx, params.APIID); err != nil {
+		uc.trackerRepository.Failed(ctx, "invalid resource files")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading functions")
+
+	fns, err := uc.functionRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load functions")
+		return nil, err
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading resolvers")
+
+	rslvs, err := uc.resolverRepositoryForFS.List(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load resolvers")
+		return nil, err
+	}
+
+	// NOTE: push deletes the resolvers of removed fields, so they are not checked against the schema
+	rslvs = excludeResolvers(rslvs, params.ExcludedResolvers)
+
+	uc.trackerRepository.InProgress(ctx, "validating references")
+
+	if err := uc.referenceService.Validate(ctx, files, fns, rslvs); err != nil {
+		uc.trackerRepository.Failed(ctx, "invalid references")
+		return nil, err
+	}
+
+	uc.trackerRepository.Success(ctx, "validated local resources")
+
+	return &ValidateOutput{}, nil
+}
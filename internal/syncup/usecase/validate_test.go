@@ -0,0 +1,955 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	mock_service "github.com/Aton-Kish/syncup/internal/syncup/domain/service/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_validateUseCase_Execute(t *testing.T) {
+	files := []model.SchemaFile{
+		{
+			Path:    "schema.graphqls",
+			Content: "type Query {\n  hello: String\n}\n",
+		},
+	}
+	fns := []model.Function{{Name: ptr.Pointer("getPost")}}
+	rslvs := []model.Resolver{{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("hello")}}
+
+	type args struct {
+		params *ValidateInput
+	}
+
+	type mockEnvironmentVariablesRepositoryForFSGetReturn struct {
+		res model.EnvironmentVariables
+		err error
+	}
+	type mockEnvironmentVariablesRepositoryForFSGet struct {
+		calls   int
+		returns []mockEnvironmentVariablesRepositoryForFSGetReturn
+	}
+
+	type mockEnvironmentVariablesServiceValidateReturn struct {
+		err error
+	}
+	type mockEnvironmentVariablesServiceValidate struct {
+		calls   int
+		returns []mockEnvironmentVariablesServiceValidateReturn
+	}
+
+	type mockSchemaFileRepositoryForFSListReturn struct {
+		res []model.SchemaFile
+		err error
+	}
+	type mockSchemaFileRepositoryForFSList struct {
+		calls   int
+		returns []mockSchemaFileRepositoryForFSListReturn
+	}
+
+	type mockSchemaServiceValidateReturn struct {
+		err error
+	}
+	type mockSchemaServiceValidate struct {
+		calls   int
+		returns []mockSchemaServiceValidateReturn
+	}
+
+	type mockResourceRepositoryForFSCheckFilesReturn struct {
+		err error
+	}
+	type mockResourceRepositoryForFSCheckFiles struct {
+		calls   int
+		returns []mockResourceRepositoryForFSCheckFilesReturn
+	}
+
+	type mockFunctionRepositoryForFSListReturn struct {
+		res []model.Function
+		err error
+	}
+	type mockFunctionRepositoryForFSList struct {
+		calls   int
+		returns []mockFunctionRepositoryForFSListReturn
+	}
+
+	type mockResolverRepositoryForFSListReturn struct {
+		res []model.Resolver
+		err error
+	}
+	type mockResolverRepositoryForFSList struct {
+		calls   int
+		returns []mockResolverRepositoryForFSListReturn
+	}
+
+	type mockReferenceServiceValidateReturn struct {
+		err error
+	}
+	type mockReferenceServiceValidate struct {
+		calls     int
+		resolvers [][]model.Resolver
+		returns   []mockReferenceServiceValidateReturn
+	}
+
+	type expected struct {
+		res       *ValidateOutput
+		resolvers [][]model.Resolver
+		errIs     error
+	}
+
+	tests := []struct {
+		name                                       string
+		args                                       args
+		mockEnvironmentVariablesRepositoryForFSGet mockEnvironmentVariablesRepositoryForFSGet
+		mockEnvironmentVariablesServiceValidate    mockEnvironmentVariablesServiceValidate
+		mockSchemaFileRepositoryForFSList          mockSchemaFileRepositoryForFSList
+		mockSchemaServiceValidate                  mockSchemaServiceValidate
+		mockResourceRepositoryForFSCheckFiles      mockResourceRepositoryForFSCheckFiles
+		mockFunctionRepositoryForFSList            mockFunctionRepositoryForFSList
+		mockResolverRepositoryForFSList            mockResolverRepositoryForFSList
+		mockReferenceServiceValidate               mockReferenceServiceValidate
+		expected                                   expected
+	}{
+		{
+			name: "happy path",
+			args: args{
+				params: &ValidateInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: model.EnvironmentVariables{},
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSCheckFiles: mockResourceRepositoryForFSCheckFiles{
+				returns: []mockResourceRepositoryForFSCheckFilesReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: fns,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: rslvs,
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &ValidateOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: exclude resolvers",
+			args: args{
+				params: &ValidateInput{
+					APIID: "APIID",
+					ExcludedResolvers: []model.Resolver{
+						{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")},
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: model.EnvironmentVariables{},
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSCheckFiles: mockResourceRepositoryForFSCheckFiles{
+				returns: []mockResourceRepositoryForFSCheckFilesReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: fns,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: append([]model.Resolver{{TypeName: ptr.Pointer("Query"), FieldName: ptr.Pointer("removedField")}}, rslvs...),
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:       &ValidateOutput{},
+				resolvers: [][]model.Resolver{rslvs},
+				errIs:     nil,
+			},
+		},
+		{
+			name: "happy path: no environment variables",
+			args: args{
+				params: &ValidateInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrNotFound},
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSCheckFiles: mockResourceRepositoryForFSCheckFiles{
+				returns: []mockResourceRepositoryForFSCheckFilesReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: fns,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: rslvs,
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				res:   &ValidateOutput{},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: EnvironmentVariablesRepositoryForFS.Get() error",
+			args: args{
+				params: &ValidateInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrInvalidValue},
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{},
+			},
+			mockResourceRepositoryForFSCheckFiles: mockResourceRepositoryForFSCheckFiles{
+				returns: []mockResourceRepositoryForFSCheckFilesReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: EnvironmentVariablesService.Validate() error",
+			args: args{
+				params: &ValidateInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: model.EnvironmentVariables{},
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{
+					{
+						err: &model.LibError{Err: model.ErrInvalidValue},
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{},
+			},
+			mockResourceRepositoryForFSCheckFiles: mockResourceRepositoryForFSCheckFiles{
+				returns: []mockResourceRepositoryForFSCheckFilesReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: SchemaFileRepositoryForFS.List() error",
+			args: args{
+				params: &ValidateInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: model.EnvironmentVariables{},
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{},
+			},
+			mockResourceRepositoryForFSCheckFiles: mockResourceRepositoryForFSCheckFiles{
+				returns: []mockResourceRepositoryForFSCheckFilesReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: SchemaService.Validate() error",
+			args: args{
+				params: &ValidateInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: model.EnvironmentVariables{},
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: &model.SchemaError{Path: "schema.graphqls", Line: 2, Column: 9, Message: "Expected :, found Name"},
+					},
+				},
+			},
+			mockResourceRepositoryForFSCheckFiles: mockResourceRepositoryForFSCheckFiles{
+				returns: []mockResourceRepositoryForFSCheckFilesReturn{},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: ResourceRepositoryForFS.CheckFiles() error",
+			args: args{
+				params: &ValidateInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: model.EnvironmentVariables{},
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSCheckFiles: mockResourceRepositoryForFSCheckFiles{
+				returns: []mockResourceRepositoryForFSCheckFilesReturn{
+					{
+						err: &model.LibError{Err: model.ErrInvalidValue},
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: FunctionRepositoryForFS.List() error",
+			args: args{
+				params: &ValidateInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: model.EnvironmentVariables{},
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSCheckFiles: mockResourceRepositoryForFSCheckFiles{
+				returns: []mockResourceRepositoryForFSCheckFilesReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ResolverRepositoryForFS.List() error",
+			args: args{
+				params: &ValidateInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: model.EnvironmentVariables{},
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSCheckFiles: mockResourceRepositoryForFSCheckFiles{
+				returns: []mockResourceRepositoryForFSCheckFilesReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: fns,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: nil,
+						err: &model.LibError{},
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: ReferenceService.Validate() error",
+			args: args{
+				params: &ValidateInput{
+					APIID: "APIID",
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: model.EnvironmentVariables{},
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockSchemaFileRepositoryForFSList: mockSchemaFileRepositoryForFSList{
+				returns: []mockSchemaFileRepositoryForFSListReturn{
+					{
+						res: files,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceValidate: mockSchemaServiceValidate{
+				returns: []mockSchemaServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockResourceRepositoryForFSCheckFiles: mockResourceRepositoryForFSCheckFiles{
+				returns: []mockResourceRepositoryForFSCheckFilesReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSList: mockFunctionRepositoryForFSList{
+				returns: []mockFunctionRepositoryForFSListReturn{
+					{
+						res: fns,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: rslvs,
+						err: nil,
+					},
+				},
+			},
+			mockReferenceServiceValidate: mockReferenceServiceValidate{
+				returns: []mockReferenceServiceValidateReturn{
+					{
+						err: &model.LibError{},
+					},
+				},
+			},
+			expected: expected{
+				res:   nil,
+				errIs: nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSchemaService := mock_service.NewMockSchemaService(ctrl)
+			mockReferenceService := mock_service.NewMockReferenceService(ctrl)
+			mockEnvironmentVariablesService := mock_service.NewMockEnvironmentVariablesService(ctrl)
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockResourceRepositoryForFS := mock_repository.NewMockResourceRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForFS := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockSchemaFileRepositoryForFS := mock_repository.NewMockSchemaFileRepository(ctrl)
+			mockFunctionRepositoryForFS := mock_repository.NewMockFunctionRepository(ctrl)
+			mockResolverRepositoryForFS := mock_repository.NewMockResolverRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(ctx, gomock.Any()).
+				AnyTimes()
+
+			mockEnvironmentVariablesRepositoryForFS.
+				EXPECT().
+				Get(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForFSGet.returns[tt.mockEnvironmentVariablesRepositoryForFSGet.calls]
+					tt.mockEnvironmentVariablesRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForFSGet.returns))
+
+			mockEnvironmentVariablesService.
+				EXPECT().
+				Validate(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, variables model.EnvironmentVariables) error {
+					r := tt.mockEnvironmentVariablesServiceValidate.returns[tt.mockEnvironmentVariablesServiceValidate.calls]
+					tt.mockEnvironmentVariablesServiceValidate.calls++
+					return r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesServiceValidate.returns))
+
+			mockSchemaFileRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.SchemaFile, error) {
+					r := tt.mockSchemaFileRepositoryForFSList.returns[tt.mockSchemaFileRepositoryForFSList.calls]
+					tt.mockSchemaFileRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaFileRepositoryForFSList.returns))
+
+			mockSchemaService.
+				EXPECT().
+				Validate(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, files []model.SchemaFile) error {
+					r := tt.mockSchemaServiceValidate.returns[tt.mockSchemaServiceValidate.calls]
+					tt.mockSchemaServiceValidate.calls++
+					return r.err
+				}).
+				Times(len(tt.mockSchemaServiceValidate.returns))
+
+			mockResourceRepositoryForFS.
+				EXPECT().
+				CheckFiles(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) error {
+					r := tt.mockResourceRepositoryForFSCheckFiles.returns[tt.mockResourceRepositoryForFSCheckFiles.calls]
+					tt.mockResourceRepositoryForFSCheckFiles.calls++
+					return r.err
+				}).
+				Times(len(tt.mockResourceRepositoryForFSCheckFiles.returns))
+
+			mockFunctionRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
+					r := tt.mockFunctionRepositoryForFSList.returns[tt.mockFunctionRepositoryForFSList.calls]
+					tt.mockFunctionRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForFSList.returns))
+
+			mockResolverRepositoryForFS.
+				EXPECT().
+				List(ctx, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForFSList.returns[tt.mockResolverRepositoryForFSList.calls]
+					tt.mockResolverRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForFSList.returns))
+
+			mockReferenceService.
+				EXPECT().
+				Validate(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, schemaFiles []model.SchemaFile, functions []model.Function, resolvers []model.Resolver) error {
+					r := tt.mockReferenceServiceValidate.returns[tt.mockReferenceServiceValidate.calls]
+					tt.mockReferenceServiceValidate.calls++
+					tt.mockReferenceServiceValidate.resolvers = append(tt.mockReferenceServiceValidate.resolvers, resolvers)
+					return r.err
+				}).
+				Times(len(tt.mockReferenceServiceValidate.returns))
+
+			uc := &validateUseCase{
+				schemaService:                       mockSchemaService,
+				referenceService:                    mockReferenceService,
+				environmentVariablesService:         mockEnvironmentVariablesService,
+				trackerRepository:                   mockTrackerRepository,
+				resourceRepositoryForFS:             mockResourceRepositoryForFS,
+				environmentVariablesRepositoryForFS: mockEnvironmentVariablesRepositoryForFS,
+				schemaFileRepositoryForFS:           mockSchemaFileRepositoryForFS,
+				functionRepositoryForFS:             mockFunctionRepositoryForFS,
+				resolverRepositoryForFS:             mockResolverRepositoryForFS,
+			}
+
+			// Act
+			actual, err := uc.Execute(ctx, tt.args.params)
+
+			// Assert
+			assert.Equal(t, tt.expected.res, actual)
+
+			if tt.expected.resolvers != nil {
+				assert.Equal(t, tt.expected.resolvers, tt.mockReferenceServiceValidate.resolvers)
+			}
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+			} else {
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
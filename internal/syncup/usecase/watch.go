@@ -0,0 +1,433 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+//go:generate mockgen -source=$GOFILE -destination=./mock/mock_$GOFILE
+
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Aton-Kish/syncup/internal/syncup"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/repository"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/service"
+	"github.com/google/uuid"
+)
+
+// NOTE: changes are pushed in dependency order, e.g. a new function before the resolver referring to it
+var watchOrder = []model.ResourceKind{
+	model.ResourceKindGraphqlApi,
+	model.ResourceKindEnvironmentVariables,
+	model.ResourceKindSchema,
+	model.ResourceKindDataSource,
+	model.ResourceKindFunction,
+	model.ResourceKindResolver,
+}
+
+// NOTE: the result of pushing a change tells pushChange which event to record
+type watchResult int
+
+const (
+	watchResultFailed watchResult = iota
+	watchResultPushed
+	watchResultUnchanged
+	watchResultRemoved
+	watchResultExcluded
+)
+
+type WatchInput struct {
+	APIID         string
+	Debounce      time.Duration
+	Force         bool
+	AllowBreaking bool
+	Filter        *model.Filter
+}
+
+type WatchOutput struct {
+}
+
+type WatchUseCase interface {
+	Execute(ctx context.Context, params *WatchInput) (*WatchOutput, error)
+}
+
+type watchUseCase struct {
+	filterService                            service.FilterService
+	schemaService                            service.SchemaService
+	resolverService                          service.ResolverService
+	environmentVariablesService              service.EnvironmentVariablesService
+	trackerRepository                        repository.TrackerRepository
+	stateRepositoryForFS                     repository.StateRepository
+	watcherRepositoryForFS                   repository.WatcherRepository
+	graphqlApiRepositoryForAppSync           repository.GraphqlApiRepository
+	graphqlApiRepositoryForFS                repository.GraphqlApiRepository
+	environmentVariablesRepositoryForAppSync repository.EnvironmentVariablesRepository
+	environmentVariablesRepositoryForFS      repository.EnvironmentVariablesRepository
+	schemaRepositoryForAppSync               repository.SchemaRepository
+	schemaRepositoryForFS                    repository.SchemaRepository
+	dataSourceRepositoryForAppSync           repository.DataSourceRepository
+	dataSourceRepositoryForFS                repository.DataSourceRepository
+	functionRepositoryForAppSync             repository.FunctionRepository
+	functionRepositoryForFS                  repository.FunctionRepository
+	resolverRepositoryForAppSync             repository.ResolverRepository
+	resolverRepositoryForFS                  repository.ResolverRepository
+}
+
+func NewWatchUseCase(repo repository.Repository) WatchUseCase {
+	return &watchUseCase{
+		filterService:                            service.NewFilterService(repo),
+		schemaService:                            service.NewSchemaService(repo),
+		resolverService:                          service.NewResolverService(repo),
+		environmentVariablesService:              service.NewEnvironmentVariablesService(repo),
+		trackerRepository:                        repo.TrackerRepository(),
+		stateRepositoryForFS:                     repo.StateRepositoryForFS(),
+		watcherRepositoryForFS:                   repo.WatcherRepositoryForFS(),
+		graphqlApiRepositoryForAppSync:           repo.GraphqlApiRepositoryForAppSync(),
+		graphqlApiRepositoryForFS:                repo.GraphqlApiRepositoryForFS(),
+		environmentVariablesRepositoryForAppSync: repo.EnvironmentVariablesRepositoryForAppSync(),
+		environmentVariablesRepositoryForFS:      repo.EnvironmentVariablesRepositoryForFS(),
+		schemaRepositoryForAppSync:               repo.SchemaRepositoryForAppSync(),
+		schemaRepositoryForFS:                    repo.SchemaRepositoryForFS(),
+		dataSourceRepositoryForAppSync:           repo.DataSourceRepositoryForAppSync(),
+		dataSourceRepositoryForFS:                repo.DataSourceRepositoryForFS(),
+		functionRepositoryForAppSync:             repo.FunctionRepositoryForAppSync(),
+		functionRepositoryForFS:                  repo.FunctionRepositoryForFS(),
+		resolverRepositoryForAppSync:             repo.ResolverRepositoryForAppSync(),
+		resolverRepositoryForFS:                  repo.ResolverRepositoryForFS(),
+	}
+}
+
+func (uc *watchUseCase) Execute(ctx context.Context, params *WatchInput) (res *WatchOutput, err error) {
+	defer wrap(&err)
+
+	if params.Debounce <= 0 {
+		return nil, fmt.Errorf("%w: debounce must be positive", model.ErrInvalidValue)
+	}
+
+	if params.Filter != nil {
+		if err := uc.filterService.Validate(ctx, params.Filter); err != nil {
+			return nil, err
+		}
+	}
+
+	uc.trackerRepository.InProgress(ctx, "loading sync state")
+
+	state, err := uc.stateRepositoryForFS.Get(ctx, params.APIID)
+	if err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to load sync state")
+		return nil, err
+	}
+
+	st := newSyncState(state, params.Force)
+
+	uc.trackerRepository.InProgress(ctx, "watching for changes")
+
+	if err := uc.watcherRepositoryForFS.Watch(ctx, params.Debounce, func(changes []model.ResourceChange) {
+		// NOTE: each batch gets its own request ID, so that resources cached during the previous batch are fetched again
+		ctx := syncup.WithRequestID(ctx, uuid.NewString())
+
+		slices.SortStableFunc(changes, func(a, b model.ResourceChange) int {
+			return cmp.Compare(slices.Index(watchOrder, a.Kind), slices.Index(watchOrder, b.Kind))
+		})
+
+		for _, change := range changes {
+			// NOTE: a failed change is reported and the watch goes on, so that it can be fixed and saved again
+			_ = uc.pushChange(ctx, params, st, &change)
+		}
+
+		// NOTE: the state is saved after every batch, so that a later push does not conflict with the changes pushed while watching
+		if _, err := uc.stateRepositoryForFS.Save(ctx, params.APIID, st.state); err != nil {
+			uc.trackerRepository.Failed(ctx, "failed to save sync state")
+		}
+
+		uc.trackerRepository.InProgress(ctx, "watching for changes")
+	}); err != nil {
+		uc.trackerRepository.Failed(ctx, "failed to watch for changes")
+		return nil, err
+	}
+
+	uc.trackerRepository.Success(ctx, "stopped watching for changes")
+
+	return &WatchOutput{}, nil
+}
+
+func (uc *watchUseCase) pushChange(ctx context.Context, params *WatchInput, st *syncState, change *model.ResourceChange) error {
+	start := time.Now()
+
+	// NOTE: changes to resources excluded by the filter are ignored as push does
+	if !matchKind(ctx, uc.filterService, params.Filter, change.Kind) {
+		return nil
+	}
+
+	var label string
+	var push func() (watchResult, error)
+	switch change.Kind {
+	case model.ResourceKindGraphqlApi:
+		label = "GraphQL API settings"
+		push = func() (watchResult, error) { return uc.pushGraphqlApi(ctx, params) }
+	case model.ResourceKindEnvironmentVariables:
+		label = "environment variables"
+		push = func() (watchResult, error) { return uc.pushEnvironmentVariables(ctx, params) }
+	case model.ResourceKindSchema:
+		label = "schema"
+		push = func() (watchResult, error) { return uc.pushSchema(ctx, params) }
+	case model.ResourceKindDataSource:
+		label = fmt.Sprintf("data source %s", change.Identifier)
+		push = func() (watchResult, error) { return uc.pushDataSource(ctx, params, change.Identifier) }
+	case model.ResourceKindFunction:
+		label = fmt.Sprintf("function %s", change.Identifier)
+		push = func() (watchResult, error) { return uc.pushFunction(ctx, params, st, change.Identifier) }
+	case model.ResourceKindResolver:
+		label = fmt.Sprintf("resolver %s", change.Identifier)
+		push = func() (watchResult, error) { return uc.pushResolver(ctx, params, st, change.Identifier) }
+	default:
+		return fmt.Errorf("%w: resource kind %s", model.ErrInvalidValue, change.Kind)
+	}
+
+	uc.trackerRepository.InProgress(ctx, fmt.Sprintf("pushing %s", label))
+
+	res, err := push()
+	if err != nil {
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:       change.Kind,
+			Identifier: change.Identifier,
+			Action:     model.TrackerActionFailed,
+			Duration:   time.Since(start),
+			Err:        err,
+			Message:    fmt.Sprintf("failed to push %s", label),
+		})
+		return err
+	}
+
+	switch res {
+	case watchResultExcluded:
+		return nil
+	case watchResultRemoved:
+		// NOTE: resources removed locally are left in AWS AppSync; push --delete removes them
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:       change.Kind,
+			Identifier: change.Identifier,
+			Action:     model.TrackerActionUnchanged,
+			Duration:   time.Since(start),
+			Message:    fmt.Sprintf("skipped %s removed locally", label),
+		})
+		return nil
+	case watchResultUnchanged:
+		uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+			Kind:       change.Kind,
+			Identifier: change.Identifier,
+			Action:     model.TrackerActionUnchanged,
+			Duration:   time.Since(start),
+			Message:    fmt.Sprintf("unchanged %s", label),
+		})
+		return nil
+	}
+
+	uc.trackerRepository.Record(ctx, &model.TrackerEvent{
+		Kind:       change.Kind,
+		Identifier: change.Identifier,
+		Action:     model.TrackerActionUpdated,
+		Duration:   time.Since(start),
+		Message:    fmt.Sprintf("pushed %s", label),
+	})
+
+	return nil
+}
+
+func (uc *watchUseCase) pushGraphqlApi(ctx context.Context, params *WatchInput) (res watchResult, err error) {
+	defer wrap(&err)
+
+	api, err := uc.graphqlApiRepositoryForFS.Get(ctx, params.APIID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return watchResultRemoved, nil
+		}
+
+		return watchResultFailed, err
+	}
+
+	if _, err := uc.graphqlApiRepositoryForAppSync.Save(ctx, params.APIID, api); err != nil {
+		return watchResultFailed, err
+	}
+
+	return watchResultPushed, nil
+}
+
+func (uc *watchUseCase) pushEnvironmentVariables(ctx context.Context, params *WatchInput) (res watchResult, err error) {
+	defer wrap(&err)
+
+	variables, err := uc.environmentVariablesRepositoryForFS.Get(ctx, params.APIID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return watchResultRemoved, nil
+		}
+
+		return watchResultFailed, err
+	}
+
+	if err := uc.environmentVariablesService.Validate(ctx, variables); err != nil {
+		return watchResultFailed, err
+	}
+
+	if _, err := uc.environmentVariablesRepositoryForAppSync.Save(ctx, params.APIID, variables); err != nil {
+		return watchResultFailed, err
+	}
+
+	return watchResultPushed, nil
+}
+
+func (uc *watchUseCase) pushSchema(ctx context.Context, params *WatchInput) (res watchResult, err error) {
+	defer wrap(&err)
+
+	schema, err := uc.schemaRepositoryForFS.Get(ctx, params.APIID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return watchResultRemoved, nil
+		}
+
+		return watchResultFailed, err
+	}
+
+	remote, err := uc.schemaRepositoryForAppSync.Get(ctx, params.APIID)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return watchResultFailed, err
+	}
+
+	// NOTE: the schema pushed while watching is checked for breaking changes as push does
+	if remote != nil {
+		isUnchanged, err := uc.schemaService.Equal(ctx, remote, schema)
+		if err != nil {
+			return watchResultFailed, err
+		}
+
+		if isUnchanged {
+			return watchResultUnchanged, nil
+		}
+
+		if err := checkSchemaChanges(ctx, uc.schemaService, uc.trackerRepository, uc.resolverRepositoryForFS, params.APIID, params.AllowBreaking, remote, schema); err != nil {
+			return watchResultFailed, err
+		}
+	}
+
+	if _, err := uc.schemaRepositoryForAppSync.Save(ctx, params.APIID, schema); err != nil {
+		return watchResultFailed, err
+	}
+
+	return watchResultPushed, nil
+}
+
+func (uc *watchUseCase) pushDataSource(ctx context.Context, params *WatchInput, name string) (res watchResult, err error) {
+	defer wrap(&err)
+
+	ds, err := uc.dataSourceRepositoryForFS.Get(ctx, params.APIID, name)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return watchResultRemoved, nil
+		}
+
+		return watchResultFailed, err
+	}
+
+	if dss, _ := filterDataSources(ctx, uc.filterService, params.Filter, []model.DataSource{*ds}); len(dss) == 0 {
+		return watchResultExcluded, nil
+	}
+
+	if _, err := uc.dataSourceRepositoryForAppSync.Save(ctx, params.APIID, ds); err != nil {
+		return watchResultFailed, err
+	}
+
+	return watchResultPushed, nil
+}
+
+func (uc *watchUseCase) pushFunction(ctx context.Context, params *WatchInput, st *syncState, name string) (res watchResult, err error) {
+	defer wrap(&err)
+
+	fn, err := uc.functionRepositoryForFS.Get(ctx, params.APIID, name)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return watchResultRemoved, nil
+		}
+
+		return watchResultFailed, err
+	}
+
+	if fns, _ := filterFunctions(ctx, uc.filterService, params.Filter, []model.Function{*fn}); len(fns) == 0 {
+		return watchResultExcluded, nil
+	}
+
+	function, err := uc.functionRepositoryForAppSync.Save(st.expectFunction(ctx, name), params.APIID, fn)
+	if err != nil {
+		return watchResultFailed, err
+	}
+
+	if err := st.recordFunction(function); err != nil {
+		return watchResultFailed, err
+	}
+
+	return watchResultPushed, nil
+}
+
+func (uc *watchUseCase) pushResolver(ctx context.Context, params *WatchInput, st *syncState, identifier string) (res watchResult, err error) {
+	defer wrap(&err)
+
+	typeName, fieldName, found := strings.Cut(identifier, ".")
+	if !found {
+		return watchResultFailed, fmt.Errorf("%w: resolver %s", model.ErrInvalidValue, identifier)
+	}
+
+	rslv, err := uc.resolverRepositoryForFS.Get(ctx, params.APIID, typeName, fieldName)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return watchResultRemoved, nil
+		}
+
+		return watchResultFailed, err
+	}
+
+	if rslvs, _ := filterResolvers(ctx, uc.filterService, params.Filter, []model.Resolver{*rslv}); len(rslvs) == 0 {
+		return watchResultExcluded, nil
+	}
+
+	functions, err := uc.functionRepositoryForAppSync.List(ctx, params.APIID)
+	if err != nil {
+		return watchResultFailed, err
+	}
+
+	if err := uc.resolverService.ResolvePipelineConfigFunctionIDs(ctx, rslv, functions); err != nil {
+		return watchResultFailed, err
+	}
+
+	resolver, err := uc.resolverRepositoryForAppSync.Save(st.expectResolver(ctx, identifier), params.APIID, rslv)
+	if err != nil {
+		return watchResultFailed, err
+	}
+
+	if err := st.recordResolver(resolver); err != nil {
+		return watchResultFailed, err
+	}
+
+	return watchResultPushed, nil
+}
@@ -0,0 +1,1489 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	ptr "github.com/Aton-Kish/goptr"
+	"github.com/Aton-Kish/syncup/internal/syncup/domain/model"
+	mock_repository "github.com/Aton-Kish/syncup/internal/syncup/domain/repository/mock"
+	mock_service "github.com/Aton-Kish/syncup/internal/syncup/domain/service/mock"
+	"github.com/Aton-Kish/syncup/internal/testhelpers"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_watchUseCase_Execute(t *testing.T) {
+	testdataBaseDir := "../../../testdata"
+	graphqlApi := testhelpers.MustUnmarshalJSON[model.GraphqlApi](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "graphql_api/api.json")))
+	variables := testhelpers.MustUnmarshalJSON[model.EnvironmentVariables](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "environment_variables/env.json")))
+	schema := model.Schema(testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "schema/schema.graphqls")))
+	remoteSchema := model.Schema("type Query {\n  hello: String\n}\n")
+	dataSourceAMAZON_DYNAMODB := testhelpers.MustUnmarshalJSON[model.DataSource](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "datasources/AMAZON_DYNAMODB/metadata.json")))
+	functionAPPSYNC_JS_1_0_0 := testhelpers.MustUnmarshalJSON[model.Function](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "functions/APPSYNC_JS_1.0.0/metadata.json")))
+	functionAPPSYNC_JS_1_0_0.FunctionId = ptr.Pointer("APPSYNC_JS_1.0.0")
+	resolverPIPELINE_APPSYNC_JS_1_0_0 := testhelpers.MustUnmarshalJSON[model.Resolver](t, testhelpers.MustReadFile(t, filepath.Join(testdataBaseDir, "resolvers/PIPELINE/APPSYNC_JS_1.0.0/metadata.json")))
+
+	type args struct {
+		params *WatchInput
+	}
+
+	type mockFilterServiceValidateReturn struct {
+		err error
+	}
+	type mockFilterServiceValidate struct {
+		calls   int
+		returns []mockFilterServiceValidateReturn
+	}
+
+	type mockFilterServiceMatchReturn struct {
+		res bool
+	}
+	type mockFilterServiceMatch struct {
+		calls   int
+		returns []mockFilterServiceMatchReturn
+	}
+
+	type mockWatcherRepositoryForFSWatchReturn struct {
+		batches [][]model.ResourceChange
+		err     error
+	}
+	type mockWatcherRepositoryForFSWatch struct {
+		calls   int
+		returns []mockWatcherRepositoryForFSWatchReturn
+	}
+
+	type mockGraphqlApiRepositoryForFSGetReturn struct {
+		res *model.GraphqlApi
+		err error
+	}
+	type mockGraphqlApiRepositoryForFSGet struct {
+		calls   int
+		returns []mockGraphqlApiRepositoryForFSGetReturn
+	}
+
+	type mockGraphqlApiRepositoryForAppSyncSaveReturn struct {
+		res *model.GraphqlApi
+		err error
+	}
+	type mockGraphqlApiRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockGraphqlApiRepositoryForAppSyncSaveReturn
+	}
+
+	type mockEnvironmentVariablesRepositoryForFSGetReturn struct {
+		res model.EnvironmentVariables
+		err error
+	}
+	type mockEnvironmentVariablesRepositoryForFSGet struct {
+		calls   int
+		returns []mockEnvironmentVariablesRepositoryForFSGetReturn
+	}
+
+	type mockEnvironmentVariablesServiceValidateReturn struct {
+		err error
+	}
+	type mockEnvironmentVariablesServiceValidate struct {
+		calls   int
+		returns []mockEnvironmentVariablesServiceValidateReturn
+	}
+
+	type mockEnvironmentVariablesRepositoryForAppSyncSaveReturn struct {
+		res model.EnvironmentVariables
+		err error
+	}
+	type mockEnvironmentVariablesRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn
+	}
+
+	type mockSchemaRepositoryForFSGetReturn struct {
+		res *model.Schema
+		err error
+	}
+	type mockSchemaRepositoryForFSGet struct {
+		calls   int
+		returns []mockSchemaRepositoryForFSGetReturn
+	}
+
+	type mockSchemaRepositoryForAppSyncGetReturn struct {
+		res *model.Schema
+		err error
+	}
+	type mockSchemaRepositoryForAppSyncGet struct {
+		calls   int
+		returns []mockSchemaRepositoryForAppSyncGetReturn
+	}
+
+	type mockSchemaServiceEqualReturn struct {
+		res bool
+		err error
+	}
+	type mockSchemaServiceEqual struct {
+		calls   int
+		returns []mockSchemaServiceEqualReturn
+	}
+
+	type mockResolverRepositoryForFSListReturn struct {
+		res []model.Resolver
+		err error
+	}
+	type mockResolverRepositoryForFSList struct {
+		calls   int
+		returns []mockResolverRepositoryForFSListReturn
+	}
+
+	type mockSchemaServiceCompareReturn struct {
+		res []model.SchemaChange
+		err error
+	}
+	type mockSchemaServiceCompare struct {
+		calls   int
+		returns []mockSchemaServiceCompareReturn
+	}
+
+	type mockSchemaRepositoryForAppSyncSaveReturn struct {
+		res *model.Schema
+		err error
+	}
+	type mockSchemaRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockSchemaRepositoryForAppSyncSaveReturn
+	}
+
+	type mockDataSourceRepositoryForFSGetReturn struct {
+		res *model.DataSource
+		err error
+	}
+	type mockDataSourceRepositoryForFSGet struct {
+		calls   int
+		returns []mockDataSourceRepositoryForFSGetReturn
+	}
+
+	type mockDataSourceRepositoryForAppSyncSaveReturn struct {
+		res *model.DataSource
+		err error
+	}
+	type mockDataSourceRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockDataSourceRepositoryForAppSyncSaveReturn
+	}
+
+	type mockFunctionRepositoryForFSGetReturn struct {
+		res *model.Function
+		err error
+	}
+	type mockFunctionRepositoryForFSGet struct {
+		calls   int
+		returns []mockFunctionRepositoryForFSGetReturn
+	}
+
+	type mockFunctionRepositoryForAppSyncSaveReturn struct {
+		res *model.Function
+		err error
+	}
+	type mockFunctionRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockFunctionRepositoryForAppSyncSaveReturn
+	}
+
+	type mockFunctionRepositoryForAppSyncListReturn struct {
+		res []model.Function
+		err error
+	}
+	type mockFunctionRepositoryForAppSyncList struct {
+		calls   int
+		returns []mockFunctionRepositoryForAppSyncListReturn
+	}
+
+	type mockResolverRepositoryForFSGetReturn struct {
+		res *model.Resolver
+		err error
+	}
+	type mockResolverRepositoryForFSGet struct {
+		calls   int
+		returns []mockResolverRepositoryForFSGetReturn
+	}
+
+	type mockResolverRepositoryForAppSyncSaveReturn struct {
+		res *model.Resolver
+		err error
+	}
+	type mockResolverRepositoryForAppSyncSave struct {
+		calls   int
+		returns []mockResolverRepositoryForAppSyncSaveReturn
+	}
+
+	type mockResolverServiceResolvePipelineConfigFunctionIDsReturn struct {
+		err error
+	}
+	type mockResolverServiceResolvePipelineConfigFunctionIDs struct {
+		calls   int
+		returns []mockResolverServiceResolvePipelineConfigFunctionIDsReturn
+	}
+
+	type mockStateRepositoryForFSGetReturn struct {
+		res *model.State
+		err error
+	}
+	type mockStateRepositoryForFSGet struct {
+		calls   int
+		returns []mockStateRepositoryForFSGetReturn
+	}
+
+	type mockStateRepositoryForFSSaveReturn struct {
+		res *model.State
+		err error
+	}
+	type mockStateRepositoryForFSSave struct {
+		calls   int
+		returns []mockStateRepositoryForFSSaveReturn
+	}
+
+	type expected struct {
+		events []model.TrackerEvent
+		errIs  error
+	}
+
+	tests := []struct {
+		name                                                string
+		args                                                args
+		mockFilterServiceValidate                           mockFilterServiceValidate
+		mockFilterServiceMatch                              mockFilterServiceMatch
+		mockStateRepositoryForFSGet                         mockStateRepositoryForFSGet
+		mockStateRepositoryForFSSave                        mockStateRepositoryForFSSave
+		mockWatcherRepositoryForFSWatch                     mockWatcherRepositoryForFSWatch
+		mockGraphqlApiRepositoryForFSGet                    mockGraphqlApiRepositoryForFSGet
+		mockGraphqlApiRepositoryForAppSyncSave              mockGraphqlApiRepositoryForAppSyncSave
+		mockEnvironmentVariablesRepositoryForFSGet          mockEnvironmentVariablesRepositoryForFSGet
+		mockEnvironmentVariablesServiceValidate             mockEnvironmentVariablesServiceValidate
+		mockEnvironmentVariablesRepositoryForAppSyncSave    mockEnvironmentVariablesRepositoryForAppSyncSave
+		mockSchemaRepositoryForFSGet                        mockSchemaRepositoryForFSGet
+		mockSchemaRepositoryForAppSyncGet                   mockSchemaRepositoryForAppSyncGet
+		mockSchemaServiceEqual                              mockSchemaServiceEqual
+		mockResolverRepositoryForFSList                     mockResolverRepositoryForFSList
+		mockSchemaServiceCompare                            mockSchemaServiceCompare
+		mockSchemaRepositoryForAppSyncSave                  mockSchemaRepositoryForAppSyncSave
+		mockDataSourceRepositoryForFSGet                    mockDataSourceRepositoryForFSGet
+		mockDataSourceRepositoryForAppSyncSave              mockDataSourceRepositoryForAppSyncSave
+		mockFunctionRepositoryForFSGet                      mockFunctionRepositoryForFSGet
+		mockFunctionRepositoryForAppSyncSave                mockFunctionRepositoryForAppSyncSave
+		mockFunctionRepositoryForAppSyncList                mockFunctionRepositoryForAppSyncList
+		mockResolverRepositoryForFSGet                      mockResolverRepositoryForFSGet
+		mockResolverRepositoryForAppSyncSave                mockResolverRepositoryForAppSyncSave
+		mockResolverServiceResolvePipelineConfigFunctionIDs mockResolverServiceResolvePipelineConfigFunctionIDs
+		expected                                            expected
+	}{
+		{
+			name: "happy path: push changes in dependency order",
+			args: args{
+				params: &WatchInput{
+					APIID:    "apiID",
+					Debounce: 300 * time.Millisecond,
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockWatcherRepositoryForFSWatch: mockWatcherRepositoryForFSWatch{
+				returns: []mockWatcherRepositoryForFSWatchReturn{
+					{
+						batches: [][]model.ResourceChange{
+							{
+								{Kind: model.ResourceKindResolver, Identifier: "PIPELINE.APPSYNC_JS_1.0.0"},
+								{Kind: model.ResourceKindFunction, Identifier: "APPSYNC_JS_1.0.0"},
+								{Kind: model.ResourceKindDataSource, Identifier: "AMAZON_DYNAMODB"},
+								{Kind: model.ResourceKindSchema},
+								{Kind: model.ResourceKindEnvironmentVariables},
+								{Kind: model.ResourceKindGraphqlApi},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForFSGet: mockGraphqlApiRepositoryForFSGet{
+				returns: []mockGraphqlApiRepositoryForFSGetReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockGraphqlApiRepositoryForAppSyncSave: mockGraphqlApiRepositoryForAppSyncSave{
+				returns: []mockGraphqlApiRepositoryForAppSyncSaveReturn{
+					{
+						res: &graphqlApi,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForAppSyncSave: mockEnvironmentVariablesRepositoryForAppSyncSave{
+				returns: []mockEnvironmentVariablesRepositoryForAppSyncSaveReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &remoteSchema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{resolverPIPELINE_APPSYNC_JS_1_0_0},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{
+							{Level: model.SchemaChangeLevelSafe, Path: "Query.hello", Message: "field Query.hello was added"},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForFSGet: mockDataSourceRepositoryForFSGet{
+				returns: []mockDataSourceRepositoryForFSGetReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+				},
+			},
+			mockDataSourceRepositoryForAppSyncSave: mockDataSourceRepositoryForAppSyncSave{
+				returns: []mockDataSourceRepositoryForAppSyncSaveReturn{
+					{
+						res: &dataSourceAMAZON_DYNAMODB,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSGet: mockFunctionRepositoryForFSGet{
+				returns: []mockFunctionRepositoryForFSGetReturn{
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncSave: mockFunctionRepositoryForAppSyncSave{
+				returns: []mockFunctionRepositoryForAppSyncSaveReturn{
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{functionAPPSYNC_JS_1_0_0},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSGet: mockResolverRepositoryForFSGet{
+				returns: []mockResolverRepositoryForFSGetReturn{
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				events: []model.TrackerEvent{
+					{Kind: model.ResourceKindGraphqlApi, Action: model.TrackerActionUpdated},
+					{Kind: model.ResourceKindEnvironmentVariables, Action: model.TrackerActionUpdated},
+					{Kind: model.ResourceKindSchema, Identifier: "Query.hello", Action: model.TrackerActionChanged},
+					{Kind: model.ResourceKindSchema, Action: model.TrackerActionUpdated},
+					{Kind: model.ResourceKindDataSource, Identifier: "AMAZON_DYNAMODB", Action: model.TrackerActionUpdated},
+					{Kind: model.ResourceKindFunction, Identifier: "APPSYNC_JS_1.0.0", Action: model.TrackerActionUpdated},
+					{Kind: model.ResourceKindResolver, Identifier: "PIPELINE.APPSYNC_JS_1.0.0", Action: model.TrackerActionUpdated},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: skip resources removed locally",
+			args: args{
+				params: &WatchInput{
+					APIID:    "apiID",
+					Debounce: 300 * time.Millisecond,
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockWatcherRepositoryForFSWatch: mockWatcherRepositoryForFSWatch{
+				returns: []mockWatcherRepositoryForFSWatchReturn{
+					{
+						batches: [][]model.ResourceChange{
+							{
+								{Kind: model.ResourceKindFunction, Identifier: "APPSYNC_JS_1.0.0"},
+								{Kind: model.ResourceKindResolver, Identifier: "PIPELINE.APPSYNC_JS_1.0.0"},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSGet: mockFunctionRepositoryForFSGet{
+				returns: []mockFunctionRepositoryForFSGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrNotFound},
+					},
+				},
+			},
+			mockResolverRepositoryForFSGet: mockResolverRepositoryForFSGet{
+				returns: []mockResolverRepositoryForFSGetReturn{
+					{
+						res: nil,
+						err: &model.LibError{Err: model.ErrNotFound},
+					},
+				},
+			},
+			expected: expected{
+				events: []model.TrackerEvent{
+					{Kind: model.ResourceKindFunction, Identifier: "APPSYNC_JS_1.0.0", Action: model.TrackerActionUnchanged},
+					{Kind: model.ResourceKindResolver, Identifier: "PIPELINE.APPSYNC_JS_1.0.0", Action: model.TrackerActionUnchanged},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: record unchanged schema",
+			args: args{
+				params: &WatchInput{
+					APIID:    "apiID",
+					Debounce: 300 * time.Millisecond,
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockWatcherRepositoryForFSWatch: mockWatcherRepositoryForFSWatch{
+				returns: []mockWatcherRepositoryForFSWatchReturn{
+					{
+						batches: [][]model.ResourceChange{
+							{
+								{Kind: model.ResourceKindSchema},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: true,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				events: []model.TrackerEvent{
+					{Kind: model.ResourceKindSchema, Action: model.TrackerActionUnchanged},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: filter resources",
+			args: args{
+				params: &WatchInput{
+					APIID:    "apiID",
+					Debounce: 300 * time.Millisecond,
+					Filter: &model.Filter{
+						Kinds:   []model.ResourceKind{model.ResourceKindFunction, model.ResourceKindResolver},
+						Include: []string{"PIPELINE.*"},
+					},
+				},
+			},
+			mockFilterServiceValidate: mockFilterServiceValidate{
+				returns: []mockFilterServiceValidateReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			mockFilterServiceMatch: mockFilterServiceMatch{
+				returns: []mockFilterServiceMatchReturn{
+					{
+						res: false,
+					},
+					{
+						res: true,
+					},
+					{
+						res: false,
+					},
+					{
+						res: true,
+					},
+					{
+						res: true,
+					},
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockWatcherRepositoryForFSWatch: mockWatcherRepositoryForFSWatch{
+				returns: []mockWatcherRepositoryForFSWatchReturn{
+					{
+						batches: [][]model.ResourceChange{
+							{
+								{Kind: model.ResourceKindResolver, Identifier: "PIPELINE.APPSYNC_JS_1.0.0"},
+								{Kind: model.ResourceKindFunction, Identifier: "APPSYNC_JS_1.0.0"},
+								{Kind: model.ResourceKindGraphqlApi},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForFSGet: mockFunctionRepositoryForFSGet{
+				returns: []mockFunctionRepositoryForFSGetReturn{
+					{
+						res: &functionAPPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{functionAPPSYNC_JS_1_0_0},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSGet: mockResolverRepositoryForFSGet{
+				returns: []mockResolverRepositoryForFSGetReturn{
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				events: []model.TrackerEvent{
+					{Kind: model.ResourceKindResolver, Identifier: "PIPELINE.APPSYNC_JS_1.0.0", Action: model.TrackerActionUpdated},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: keep watching after a failed push",
+			args: args{
+				params: &WatchInput{
+					APIID:    "apiID",
+					Debounce: 300 * time.Millisecond,
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockWatcherRepositoryForFSWatch: mockWatcherRepositoryForFSWatch{
+				returns: []mockWatcherRepositoryForFSWatchReturn{
+					{
+						batches: [][]model.ResourceChange{
+							{
+								{Kind: model.ResourceKindResolver, Identifier: "PIPELINE.APPSYNC_JS_1.0.0"},
+							},
+							{
+								{Kind: model.ResourceKindResolver, Identifier: "PIPELINE.APPSYNC_JS_1.0.0"},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockFunctionRepositoryForAppSyncList: mockFunctionRepositoryForAppSyncList{
+				returns: []mockFunctionRepositoryForAppSyncListReturn{
+					{
+						res: []model.Function{functionAPPSYNC_JS_1_0_0},
+						err: nil,
+					},
+					{
+						res: []model.Function{functionAPPSYNC_JS_1_0_0},
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSGet: mockResolverRepositoryForFSGet{
+				returns: []mockResolverRepositoryForFSGetReturn{
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForAppSyncSave: mockResolverRepositoryForAppSyncSave{
+				returns: []mockResolverRepositoryForAppSyncSaveReturn{
+					{
+						res: &resolverPIPELINE_APPSYNC_JS_1_0_0,
+						err: nil,
+					},
+				},
+			},
+			mockResolverServiceResolvePipelineConfigFunctionIDs: mockResolverServiceResolvePipelineConfigFunctionIDs{
+				returns: []mockResolverServiceResolvePipelineConfigFunctionIDsReturn{
+					{
+						err: &model.LibError{Err: model.ErrNotFound},
+					},
+					{
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				events: []model.TrackerEvent{
+					{Kind: model.ResourceKindResolver, Identifier: "PIPELINE.APPSYNC_JS_1.0.0", Action: model.TrackerActionFailed},
+					{Kind: model.ResourceKindResolver, Identifier: "PIPELINE.APPSYNC_JS_1.0.0", Action: model.TrackerActionUpdated},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: keep watching after invalid environment variables",
+			args: args{
+				params: &WatchInput{
+					APIID:    "apiID",
+					Debounce: 300 * time.Millisecond,
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockWatcherRepositoryForFSWatch: mockWatcherRepositoryForFSWatch{
+				returns: []mockWatcherRepositoryForFSWatchReturn{
+					{
+						batches: [][]model.ResourceChange{
+							{
+								{Kind: model.ResourceKindEnvironmentVariables},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesRepositoryForFSGet: mockEnvironmentVariablesRepositoryForFSGet{
+				returns: []mockEnvironmentVariablesRepositoryForFSGetReturn{
+					{
+						res: variables,
+						err: nil,
+					},
+				},
+			},
+			mockEnvironmentVariablesServiceValidate: mockEnvironmentVariablesServiceValidate{
+				returns: []mockEnvironmentVariablesServiceValidateReturn{
+					{
+						err: &model.LibError{Err: model.ErrInvalidValue},
+					},
+				},
+			},
+			expected: expected{
+				events: []model.TrackerEvent{
+					{Kind: model.ResourceKindEnvironmentVariables, Action: model.TrackerActionFailed},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: keep watching after refused breaking schema changes",
+			args: args{
+				params: &WatchInput{
+					APIID:         "apiID",
+					Debounce:      300 * time.Millisecond,
+					AllowBreaking: false,
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockWatcherRepositoryForFSWatch: mockWatcherRepositoryForFSWatch{
+				returns: []mockWatcherRepositoryForFSWatchReturn{
+					{
+						batches: [][]model.ResourceChange{
+							{
+								{Kind: model.ResourceKindSchema},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &remoteSchema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{resolverPIPELINE_APPSYNC_JS_1_0_0},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{
+							{Level: model.SchemaChangeLevelBreaking, Path: "Post.title", Message: "field Post.title was removed"},
+						},
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				events: []model.TrackerEvent{
+					{Kind: model.ResourceKindSchema, Identifier: "Post.title", Action: model.TrackerActionFailed},
+					{Kind: model.ResourceKindSchema, Action: model.TrackerActionFailed},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "happy path: allow breaking schema changes",
+			args: args{
+				params: &WatchInput{
+					APIID:         "apiID",
+					Debounce:      300 * time.Millisecond,
+					AllowBreaking: true,
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockWatcherRepositoryForFSWatch: mockWatcherRepositoryForFSWatch{
+				returns: []mockWatcherRepositoryForFSWatchReturn{
+					{
+						batches: [][]model.ResourceChange{
+							{
+								{Kind: model.ResourceKindSchema},
+							},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForFSGet: mockSchemaRepositoryForFSGet{
+				returns: []mockSchemaRepositoryForFSGetReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncGet: mockSchemaRepositoryForAppSyncGet{
+				returns: []mockSchemaRepositoryForAppSyncGetReturn{
+					{
+						res: &remoteSchema,
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceEqual: mockSchemaServiceEqual{
+				returns: []mockSchemaServiceEqualReturn{
+					{
+						res: false,
+						err: nil,
+					},
+				},
+			},
+			mockResolverRepositoryForFSList: mockResolverRepositoryForFSList{
+				returns: []mockResolverRepositoryForFSListReturn{
+					{
+						res: []model.Resolver{resolverPIPELINE_APPSYNC_JS_1_0_0},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaServiceCompare: mockSchemaServiceCompare{
+				returns: []mockSchemaServiceCompareReturn{
+					{
+						res: []model.SchemaChange{
+							{Level: model.SchemaChangeLevelBreaking, Path: "Post.title", Message: "field Post.title was removed"},
+						},
+						err: nil,
+					},
+				},
+			},
+			mockSchemaRepositoryForAppSyncSave: mockSchemaRepositoryForAppSyncSave{
+				returns: []mockSchemaRepositoryForAppSyncSaveReturn{
+					{
+						res: &schema,
+						err: nil,
+					},
+				},
+			},
+			expected: expected{
+				events: []model.TrackerEvent{
+					{Kind: model.ResourceKindSchema, Identifier: "Post.title", Action: model.TrackerActionChanged},
+					{Kind: model.ResourceKindSchema, Action: model.TrackerActionUpdated},
+				},
+				errIs: nil,
+			},
+		},
+		{
+			name: "edge path: non-positive debounce",
+			args: args{
+				params: &WatchInput{
+					APIID:    "apiID",
+					Debounce: 0,
+				},
+			},
+			expected: expected{
+				events: []model.TrackerEvent{},
+				errIs:  model.ErrInvalidValue,
+			},
+		},
+		{
+			name: "edge path: StateRepositoryForFS.Get() error",
+			args: args{
+				params: &WatchInput{
+					APIID:    "apiID",
+					Debounce: 300 * time.Millisecond,
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: nil,
+						err: model.ErrNilValue,
+					},
+				},
+			},
+			expected: expected{
+				events: []model.TrackerEvent{},
+				errIs:  model.ErrNilValue,
+			},
+		},
+		{
+			name: "edge path: WatcherRepositoryForFS.Watch() error",
+			args: args{
+				params: &WatchInput{
+					APIID:    "apiID",
+					Debounce: 300 * time.Millisecond,
+				},
+			},
+			mockStateRepositoryForFSGet: mockStateRepositoryForFSGet{
+				returns: []mockStateRepositoryForFSGetReturn{
+					{
+						res: model.NewState(),
+						err: nil,
+					},
+				},
+			},
+			mockStateRepositoryForFSSave: mockStateRepositoryForFSSave{
+				returns: []mockStateRepositoryForFSSaveReturn{},
+			},
+			mockWatcherRepositoryForFSWatch: mockWatcherRepositoryForFSWatch{
+				returns: []mockWatcherRepositoryForFSWatchReturn{
+					{
+						batches: nil,
+						err:     errors.New("test"),
+					},
+				},
+			},
+			expected: expected{
+				events: []model.TrackerEvent{},
+				errIs:  nil,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockFilterService := mock_service.NewMockFilterService(ctrl)
+			mockSchemaService := mock_service.NewMockSchemaService(ctrl)
+			mockResolverService := mock_service.NewMockResolverService(ctrl)
+			mockEnvironmentVariablesService := mock_service.NewMockEnvironmentVariablesService(ctrl)
+			mockTrackerRepository := mock_repository.NewMockTrackerRepository(ctrl)
+			mockStateRepositoryForFS := mock_repository.NewMockStateRepository(ctrl)
+			mockWatcherRepositoryForFS := mock_repository.NewMockWatcherRepository(ctrl)
+			mockGraphqlApiRepositoryForAppSync := mock_repository.NewMockGraphqlApiRepository(ctrl)
+			mockGraphqlApiRepositoryForFS := mock_repository.NewMockGraphqlApiRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForAppSync := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockEnvironmentVariablesRepositoryForFS := mock_repository.NewMockEnvironmentVariablesRepository(ctrl)
+			mockSchemaRepositoryForAppSync := mock_repository.NewMockSchemaRepository(ctrl)
+			mockSchemaRepositoryForFS := mock_repository.NewMockSchemaRepository(ctrl)
+			mockDataSourceRepositoryForAppSync := mock_repository.NewMockDataSourceRepository(ctrl)
+			mockDataSourceRepositoryForFS := mock_repository.NewMockDataSourceRepository(ctrl)
+			mockFunctionRepositoryForAppSync := mock_repository.NewMockFunctionRepository(ctrl)
+			mockFunctionRepositoryForFS := mock_repository.NewMockFunctionRepository(ctrl)
+			mockResolverRepositoryForAppSync := mock_repository.NewMockResolverRepository(ctrl)
+			mockResolverRepositoryForFS := mock_repository.NewMockResolverRepository(ctrl)
+
+			mockTrackerRepository.
+				EXPECT().
+				InProgress(gomock.Any(), gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Success(gomock.Any(), gomock.Any()).
+				AnyTimes()
+
+			mockTrackerRepository.
+				EXPECT().
+				Failed(gomock.Any(), gomock.Any()).
+				AnyTimes()
+
+			events := make([]model.TrackerEvent, 0)
+			mockTrackerRepository.
+				EXPECT().
+				Record(gomock.Any(), gomock.Any()).
+				Do(func(ctx context.Context, event *model.TrackerEvent) {
+					events = append(events, model.TrackerEvent{Kind: event.Kind, Identifier: event.Identifier, Action: event.Action})
+				}).
+				AnyTimes()
+
+			mockFilterService.
+				EXPECT().
+				Validate(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, filter *model.Filter) error {
+					r := tt.mockFilterServiceValidate.returns[tt.mockFilterServiceValidate.calls]
+					tt.mockFilterServiceValidate.calls++
+					return r.err
+				}).
+				Times(len(tt.mockFilterServiceValidate.returns))
+
+			mockFilterService.
+				EXPECT().
+				Match(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, filter *model.Filter, kind model.ResourceKind, identifier string) bool {
+					r := tt.mockFilterServiceMatch.returns[tt.mockFilterServiceMatch.calls]
+					tt.mockFilterServiceMatch.calls++
+					return r.res
+				}).
+				Times(len(tt.mockFilterServiceMatch.returns))
+
+			mockStateRepositoryForFS.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.State, error) {
+					r := tt.mockStateRepositoryForFSGet.returns[tt.mockStateRepositoryForFSGet.calls]
+					tt.mockStateRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockStateRepositoryForFSGet.returns))
+
+			mockStateRepositoryForFS.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, state *model.State) (*model.State, error) {
+					r := tt.mockStateRepositoryForFSSave.returns[tt.mockStateRepositoryForFSSave.calls]
+					tt.mockStateRepositoryForFSSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockStateRepositoryForFSSave.returns))
+
+			// NOTE: the watcher hands the batches over as if the files were changed one batch after another
+			mockWatcherRepositoryForFS.
+				EXPECT().
+				Watch(ctx, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, debounce time.Duration, fn func(changes []model.ResourceChange)) error {
+					r := tt.mockWatcherRepositoryForFSWatch.returns[tt.mockWatcherRepositoryForFSWatch.calls]
+					tt.mockWatcherRepositoryForFSWatch.calls++
+					for _, changes := range r.batches {
+						fn(changes)
+					}
+					return r.err
+				}).
+				Times(len(tt.mockWatcherRepositoryForFSWatch.returns))
+
+			mockGraphqlApiRepositoryForFS.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.GraphqlApi, error) {
+					r := tt.mockGraphqlApiRepositoryForFSGet.returns[tt.mockGraphqlApiRepositoryForFSGet.calls]
+					tt.mockGraphqlApiRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForFSGet.returns))
+
+			mockGraphqlApiRepositoryForAppSync.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, api *model.GraphqlApi) (*model.GraphqlApi, error) {
+					r := tt.mockGraphqlApiRepositoryForAppSyncSave.returns[tt.mockGraphqlApiRepositoryForAppSyncSave.calls]
+					tt.mockGraphqlApiRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockGraphqlApiRepositoryForAppSyncSave.returns))
+
+			mockEnvironmentVariablesRepositoryForFS.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForFSGet.returns[tt.mockEnvironmentVariablesRepositoryForFSGet.calls]
+					tt.mockEnvironmentVariablesRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForFSGet.returns))
+
+			mockEnvironmentVariablesService.
+				EXPECT().
+				Validate(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, variables model.EnvironmentVariables) error {
+					r := tt.mockEnvironmentVariablesServiceValidate.returns[tt.mockEnvironmentVariablesServiceValidate.calls]
+					tt.mockEnvironmentVariablesServiceValidate.calls++
+					return r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesServiceValidate.returns))
+
+			mockEnvironmentVariablesRepositoryForAppSync.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, variables model.EnvironmentVariables) (model.EnvironmentVariables, error) {
+					r := tt.mockEnvironmentVariablesRepositoryForAppSyncSave.returns[tt.mockEnvironmentVariablesRepositoryForAppSyncSave.calls]
+					tt.mockEnvironmentVariablesRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockEnvironmentVariablesRepositoryForAppSyncSave.returns))
+
+			mockSchemaRepositoryForFS.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForFSGet.returns[tt.mockSchemaRepositoryForFSGet.calls]
+					tt.mockSchemaRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForFSGet.returns))
+
+			mockSchemaRepositoryForAppSync.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForAppSyncGet.returns[tt.mockSchemaRepositoryForAppSyncGet.calls]
+					tt.mockSchemaRepositoryForAppSyncGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForAppSyncGet.returns))
+
+			mockSchemaService.
+				EXPECT().
+				Equal(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, schema1, schema2 *model.Schema) (bool, error) {
+					r := tt.mockSchemaServiceEqual.returns[tt.mockSchemaServiceEqual.calls]
+					tt.mockSchemaServiceEqual.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaServiceEqual.returns))
+
+			mockResolverRepositoryForFS.
+				EXPECT().
+				List(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Resolver, error) {
+					r := tt.mockResolverRepositoryForFSList.returns[tt.mockResolverRepositoryForFSList.calls]
+					tt.mockResolverRepositoryForFSList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForFSList.returns))
+
+			mockSchemaService.
+				EXPECT().
+				Compare(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, schema1, schema2 *model.Schema, resolvers []model.Resolver) ([]model.SchemaChange, error) {
+					r := tt.mockSchemaServiceCompare.returns[tt.mockSchemaServiceCompare.calls]
+					tt.mockSchemaServiceCompare.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaServiceCompare.returns))
+
+			mockSchemaRepositoryForAppSync.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, schema *model.Schema) (*model.Schema, error) {
+					r := tt.mockSchemaRepositoryForAppSyncSave.returns[tt.mockSchemaRepositoryForAppSyncSave.calls]
+					tt.mockSchemaRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockSchemaRepositoryForAppSyncSave.returns))
+
+			mockDataSourceRepositoryForFS.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, name string) (*model.DataSource, error) {
+					r := tt.mockDataSourceRepositoryForFSGet.returns[tt.mockDataSourceRepositoryForFSGet.calls]
+					tt.mockDataSourceRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDataSourceRepositoryForFSGet.returns))
+
+			mockDataSourceRepositoryForAppSync.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, dataSource *model.DataSource) (*model.DataSource, error) {
+					r := tt.mockDataSourceRepositoryForAppSyncSave.returns[tt.mockDataSourceRepositoryForAppSyncSave.calls]
+					tt.mockDataSourceRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockDataSourceRepositoryForAppSyncSave.returns))
+
+			mockFunctionRepositoryForFS.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, name string) (*model.Function, error) {
+					r := tt.mockFunctionRepositoryForFSGet.returns[tt.mockFunctionRepositoryForFSGet.calls]
+					tt.mockFunctionRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForFSGet.returns))
+
+			mockFunctionRepositoryForAppSync.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, function *model.Function) (*model.Function, error) {
+					r := tt.mockFunctionRepositoryForAppSyncSave.returns[tt.mockFunctionRepositoryForAppSyncSave.calls]
+					tt.mockFunctionRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForAppSyncSave.returns))
+
+			mockFunctionRepositoryForAppSync.
+				EXPECT().
+				List(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string) ([]model.Function, error) {
+					r := tt.mockFunctionRepositoryForAppSyncList.returns[tt.mockFunctionRepositoryForAppSyncList.calls]
+					tt.mockFunctionRepositoryForAppSyncList.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockFunctionRepositoryForAppSyncList.returns))
+
+			mockResolverRepositoryForFS.
+				EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, typeName string, fieldName string) (*model.Resolver, error) {
+					r := tt.mockResolverRepositoryForFSGet.returns[tt.mockResolverRepositoryForFSGet.calls]
+					tt.mockResolverRepositoryForFSGet.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForFSGet.returns))
+
+			mockResolverRepositoryForAppSync.
+				EXPECT().
+				Save(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, apiID string, resolver *model.Resolver) (*model.Resolver, error) {
+					r := tt.mockResolverRepositoryForAppSyncSave.returns[tt.mockResolverRepositoryForAppSyncSave.calls]
+					tt.mockResolverRepositoryForAppSyncSave.calls++
+					return r.res, r.err
+				}).
+				Times(len(tt.mockResolverRepositoryForAppSyncSave.returns))
+
+			mockResolverService.
+				EXPECT().
+				ResolvePipelineConfigFunctionIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, resolver *model.Resolver, functions []model.Function) error {
+					r := tt.mockResolverServiceResolvePipelineConfigFunctionIDs.returns[tt.mockResolverServiceResolvePipelineConfigFunctionIDs.calls]
+					tt.mockResolverServiceResolvePipelineConfigFunctionIDs.calls++
+					return r.err
+				}).
+				Times(len(tt.mockResolverServiceResolvePipelineConfigFunctionIDs.returns))
+
+			uc := &watchUseCase{
+				filterService:                            mockFilterService,
+				schemaService:                            mockSchemaService,
+				resolverService:                          mockResolverService,
+				environmentVariablesService:              mockEnvironmentVariablesService,
+				trackerRepository:                        mockTrackerRepository,
+				stateRepositoryForFS:                     mockStateRepositoryForFS,
+				watcherRepositoryForFS:                   mockWatcherRepositoryForFS,
+				graphqlApiRepositoryForAppSync:           mockGraphqlApiRepositoryForAppSync,
+				graphqlApiRepositoryForFS:                mockGraphqlApiRepositoryForFS,
+				environmentVariablesRepositoryForAppSync: mockEnvironmentVariablesRepositoryForAppSync,
+				environmentVariablesRepositoryForFS:      mockEnvironmentVariablesRepositoryForFS,
+				schemaRepositoryForAppSync:               mockSchemaRepositoryForAppSync,
+				schemaRepositoryForFS:                    mockSchemaRepositoryForFS,
+				dataSourceRepositoryForAppSync:           mockDataSourceRepositoryForAppSync,
+				dataSourceRepositoryForFS:                mockDataSourceRepositoryForFS,
+				functionRepositoryForAppSync:             mockFunctionRepositoryForAppSync,
+				functionRepositoryForFS:                  mockFunctionRepositoryForFS,
+				resolverRepositoryForAppSync:             mockResolverRepositoryForAppSync,
+				resolverRepositoryForFS:                  mockResolverRepositoryForFS,
+			}
+
+			// Act
+			actual, err := uc.Execute(ctx, tt.args.params)
+
+			// Assert
+			assert.Equal(t, tt.expected.events, events)
+
+			if strings.HasPrefix(tt.name, "happy") {
+				assert.NoError(t, err)
+				assert.Equal(t, &WatchOutput{}, actual)
+			} else {
+				assert.Error(t, err)
+
+				var le *model.LibError
+				assert.ErrorAs(t, err, &le)
+
+				if tt.expected.errIs != nil {
+					assert.ErrorIs(t, err, tt.expected.errIs)
+				}
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ package testhelpers
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -35,3 +36,21 @@ func MustReadFile(t *testing.T, name string) []byte {
 
 	return data
 }
+
+// MustWriteFiles writes files, keyed by their slash-separated path relative to dir, and returns dir.
+func MustWriteFiles(t *testing.T, dir string, files map[string]string) string {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
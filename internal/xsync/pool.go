@@ -0,0 +1,84 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package xsync
+
+import (
+	"sync"
+)
+
+type Pool struct {
+	sem chan struct{}
+}
+
+func NewPool(size int) *Pool {
+	if size < 1 {
+		size = 1
+	}
+
+	return &Pool{
+		sem: make(chan struct{}, size),
+	}
+}
+
+func (p *Pool) Size() int {
+	if p == nil {
+		return 0
+	}
+
+	return cap(p.sem)
+}
+
+func (p *Pool) Group() *Group {
+	return &Group{
+		pool: p,
+	}
+}
+
+type Group struct {
+	pool *Pool
+	wg   sync.WaitGroup
+}
+
+func (g *Group) Go(f func()) {
+	g.wg.Add(1)
+
+	if g.pool == nil {
+		go func() {
+			defer g.wg.Done()
+			f()
+		}()
+		return
+	}
+
+	// NOTE: block until a worker is free, so tasks must not start groups on the same pool or they may deadlock
+	g.pool.sem <- struct{}{}
+	go func() {
+		defer func() {
+			<-g.pool.sem
+			g.wg.Done()
+		}()
+		f()
+	}()
+}
+
+func (g *Group) Wait() {
+	g.wg.Wait()
+}
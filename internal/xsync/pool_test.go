@@ -0,0 +1,174 @@
+// Copyright (c) 2023 Aton-Kish
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package xsync
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPool(t *testing.T) {
+	type args struct {
+		size int
+	}
+
+	type expected struct {
+		size int
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: positive size",
+			args: args{
+				size: 4,
+			},
+			expected: expected{
+				size: 4,
+			},
+		},
+		{
+			name: "happy path: zero size",
+			args: args{
+				size: 0,
+			},
+			expected: expected{
+				size: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			actual := NewPool(tt.args.size)
+
+			// Assert
+			assert.Equal(t, tt.expected.size, actual.Size())
+		})
+	}
+}
+
+func TestGroup_Go(t *testing.T) {
+	type args struct {
+		pool  *Pool
+		tasks int
+	}
+
+	type expected struct {
+		maxRunning int64
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		expected expected
+	}{
+		{
+			name: "happy path: bounded",
+			args: args{
+				pool:  NewPool(2),
+				tasks: 10,
+			},
+			expected: expected{
+				maxRunning: 2,
+			},
+		},
+		{
+			name: "happy path: nil pool",
+			args: args{
+				pool:  nil,
+				tasks: 10,
+			},
+			expected: expected{
+				maxRunning: 10,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var running, maxRunning, done atomic.Int64
+			var mu sync.Mutex
+			g := tt.args.pool.Group()
+
+			// Act
+			for i := 0; i < tt.args.tasks; i++ {
+				g.Go(func() {
+					n := running.Add(1)
+					mu.Lock()
+					if n > maxRunning.Load() {
+						maxRunning.Store(n)
+					}
+					mu.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					running.Add(-1)
+					done.Add(1)
+				})
+			}
+
+			g.Wait()
+
+			// Assert
+			assert.Equal(t, int64(tt.args.tasks), done.Load())
+			assert.LessOrEqual(t, maxRunning.Load(), tt.expected.maxRunning)
+		})
+	}
+}
+
+func TestGroup_Go_blocking(t *testing.T) {
+	// Arrange
+	p := NewPool(1)
+	g := p.Group()
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	g.Go(func() {
+		<-release
+	})
+
+	// Act
+	go func() {
+		g.Go(func() {})
+		close(started)
+	}()
+
+	// Assert
+	select {
+	case <-started:
+		t.Fatal("Go returned while all workers are busy")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	<-started
+	g.Wait()
+}